@@ -121,6 +121,15 @@ func cloudInitUUIDFromVMI(vmi *v1.VirtualMachineInstance) string {
 	return string(vmi.Spec.Domain.Firmware.UUID)
 }
 
+// cloudInitInstanceIDFromVMI returns the instance ID requested by the CloudInitInstanceIDAnnotation
+// of the vmi, or the given default instance ID.
+func cloudInitInstanceIDFromVMI(vmi *v1.VirtualMachineInstance, defaultInstanceID string) string {
+	if instanceID := vmi.Annotations[v1.CloudInitInstanceIDAnnotation]; instanceID != "" {
+		return instanceID
+	}
+	return defaultInstanceID
+}
+
 // ReadCloudInitVolumeDataSource scans the given VMI for CloudInit volumes and
 // reads their content into a CloudInitData struct. Does not resolve secret refs.
 func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceDir string) (cloudInitData *CloudInitData, err error) {
@@ -142,7 +151,7 @@ func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceD
 			}
 
 			cloudInitData, err = readCloudInitNoCloudSource(volume.CloudInitNoCloud)
-			cloudInitData.NoCloudMetaData = readCloudInitNoCloudMetaData(hostname, cloudInitUUIDFromVMI(vmi), instancetype, keys)
+			cloudInitData.NoCloudMetaData = readCloudInitNoCloudMetaData(hostname, cloudInitInstanceIDFromVMI(vmi, cloudInitUUIDFromVMI(vmi)), instancetype, keys)
 			cloudInitData.VolumeName = volume.Name
 			return cloudInitData, err
 		}
@@ -155,7 +164,8 @@ func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceD
 
 			uuid := cloudInitUUIDFromVMI(vmi)
 			cloudInitData, err = readCloudInitConfigDriveSource(volume.CloudInitConfigDrive)
-			cloudInitData.ConfigDriveMetaData = readCloudInitConfigDriveMetaData(vmi.Name, uuid, hostname, vmi.Namespace, keys, instancetype)
+			instanceID := cloudInitInstanceIDFromVMI(vmi, fmt.Sprintf("%s.%s", vmi.Name, vmi.Namespace))
+			cloudInitData.ConfigDriveMetaData = readCloudInitConfigDriveMetaData(instanceID, uuid, hostname, keys, instancetype)
 			cloudInitData.VolumeName = volume.Name
 			return cloudInitData, err
 		}
@@ -171,6 +181,18 @@ func isConfigDriveAccessCredential(accessCred v1.AccessCredential) bool {
 	return accessCred.SSHPublicKey != nil && accessCred.SSHPublicKey.PropagationMethod.ConfigDrive != nil
 }
 
+func isSSHPublicKeyAccessCredential(accessCred v1.AccessCredential) bool {
+	return accessCred.SSHPublicKey != nil
+}
+
+// ResolveSSHPublicKeys reads the ssh public keys of all the access credentials of the vmi,
+// regardless of their propagation method.
+//
+// Note: when using this function, make sure that your code can access the secret volumes.
+func ResolveSSHPublicKeys(vmi *v1.VirtualMachineInstance, secretSourceDir string) (map[string]string, error) {
+	return resolveSSHPublicKeys(vmi.Spec.AccessCredentials, secretSourceDir, isSSHPublicKeyAccessCredential)
+}
+
 func resolveSSHPublicKeys(accessCredentials []v1.AccessCredential, secretSourceDir string, isAccessCredentialValidFunc func(v1.AccessCredential) bool) (map[string]string, error) {
 	keys := make(map[string]string)
 	count := 0
@@ -412,11 +434,11 @@ func readCloudInitNoCloudMetaData(hostname, instanceId string, instanceType stri
 	}
 }
 
-func readCloudInitConfigDriveMetaData(name, uuid, hostname, namespace string, keys map[string]string, instanceType string) *ConfigDriveMetadata {
+func readCloudInitConfigDriveMetaData(instanceId, uuid, hostname string, keys map[string]string, instanceType string) *ConfigDriveMetadata {
 	return &ConfigDriveMetadata{
 		InstanceType:  instanceType,
 		UUID:          uuid,
-		InstanceID:    fmt.Sprintf("%s.%s", name, namespace),
+		InstanceID:    instanceId,
 		Hostname:      hostname,
 		PublicSSHKeys: keys,
 	}
@@ -588,7 +610,7 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 		if data.NoCloudMetaData == nil {
 			log.Log.V(2).Infof("No metadata found in cloud-init data. Create minimal metadata with instance-id.")
 			data.NoCloudMetaData = &NoCloudMetadata{
-				InstanceID: cloudInitUUIDFromVMI(vmi),
+				InstanceID: cloudInitInstanceIDFromVMI(vmi, cloudInitUUIDFromVMI(vmi)),
 			}
 			data.NoCloudMetaData.InstanceType = instanceType
 		}
@@ -605,7 +627,7 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 		isoStaging = fmt.Sprintf(isoStagingFmt, iso)
 		if data.ConfigDriveMetaData == nil {
 			log.Log.V(2).Infof("No metadata found in cloud-init data. Create minimal metadata with instance-id.")
-			instanceId := fmt.Sprintf("%s.%s", vmi.Name, vmi.Namespace)
+			instanceId := cloudInitInstanceIDFromVMI(vmi, fmt.Sprintf("%s.%s", vmi.Name, vmi.Namespace))
 			data.ConfigDriveMetaData = &ConfigDriveMetadata{
 				InstanceID: instanceId,
 				UUID:       cloudInitUUIDFromVMI(vmi),
@@ -589,6 +589,79 @@ var _ = Describe("CloudInit", func() {
 		})
 	})
 
+	Describe("ReadCloudInitVolumeDataSource", func() {
+		newVMI := func(volumeSource v1.VolumeSource) *v1.VirtualMachineInstance {
+			vmi := createEmptyVMIWithVolumes([]v1.Volume{{Name: "cloudinit", VolumeSource: volumeSource}})
+			vmi.Name = "fake"
+			vmi.Namespace = "fake-namespace"
+			vmi.Spec.Domain.Firmware = &v1.Firmware{UUID: "5d307ca9-b3ef-428c-8861-06e72d69f223"}
+			return vmi
+		}
+
+		It("should use the UUID of the vmi as nocloud instance ID", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "fake"}})
+			cloudInitData, err := ReadCloudInitVolumeDataSource(vmi, tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cloudInitData.NoCloudMetaData.InstanceID).To(Equal("5d307ca9-b3ef-428c-8861-06e72d69f223"))
+		})
+
+		It("should use the name of the vmi as configdrive instance ID", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{UserData: "fake"}})
+			cloudInitData, err := ReadCloudInitVolumeDataSource(vmi, tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cloudInitData.ConfigDriveMetaData.InstanceID).To(Equal("fake.fake-namespace"))
+			Expect(cloudInitData.ConfigDriveMetaData.UUID).To(Equal("5d307ca9-b3ef-428c-8861-06e72d69f223"))
+		})
+
+		It("should use the instance ID of the annotation for nocloud", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "fake"}})
+			vmi.Annotations = map[string]string{v1.CloudInitInstanceIDAnnotation: "new-instance-id"}
+			cloudInitData, err := ReadCloudInitVolumeDataSource(vmi, tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cloudInitData.NoCloudMetaData.InstanceID).To(Equal("new-instance-id"))
+		})
+
+		It("should use the instance ID of the annotation for configdrive", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{UserData: "fake"}})
+			vmi.Annotations = map[string]string{v1.CloudInitInstanceIDAnnotation: "new-instance-id"}
+			cloudInitData, err := ReadCloudInitVolumeDataSource(vmi, tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cloudInitData.ConfigDriveMetaData.InstanceID).To(Equal("new-instance-id"))
+			Expect(cloudInitData.ConfigDriveMetaData.UUID).To(Equal("5d307ca9-b3ef-428c-8861-06e72d69f223"))
+		})
+	})
+
+	Describe("ResolveSSHPublicKeys", func() {
+		newSSHAccessCredential := func(secretName string, propagationMethod v1.SSHPublicKeyAccessCredentialPropagationMethod) v1.AccessCredential {
+			return v1.AccessCredential{
+				SSHPublicKey: &v1.SSHPublicKeyAccessCredential{
+					Source: v1.SSHPublicKeyAccessCredentialSource{
+						Secret: &v1.AccessCredentialSecretSource{SecretName: secretName},
+					},
+					PropagationMethod: propagationMethod,
+				},
+			}
+		}
+
+		It("should resolve the keys of all the propagation methods", func() {
+			vmi := createEmptyVMIWithVolumes([]v1.Volume{})
+			vmi.Spec.AccessCredentials = []v1.AccessCredential{
+				newSSHAccessCredential("nocloud-key", v1.SSHPublicKeyAccessCredentialPropagationMethod{
+					NoCloud: &v1.NoCloudSSHPublicKeyAccessCredentialPropagation{},
+				}),
+				newSSHAccessCredential("agent-key", v1.SSHPublicKeyAccessCredentialPropagationMethod{
+					QemuGuestAgent: &v1.QemuGuestAgentSSHPublicKeyAccessCredentialPropagation{Users: []string{"fedora"}},
+				}),
+			}
+			fakeVolumeMountDir("nocloud-key-access-cred", map[string]string{"key": "ssh-1234"})
+			fakeVolumeMountDir("agent-key-access-cred", map[string]string{"key": "ssh-5678"})
+
+			keys, err := ResolveSSHPublicKeys(vmi, tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keys).To(Equal(map[string]string{"0": "ssh-1234", "1": "ssh-5678"}))
+		})
+	})
+
 	Describe("GenerateLocalData", func() {
 		It("should cleanly run twice", func() {
 			instancetype := "fake-instancetype"
@@ -112,6 +112,10 @@ const (
 	// MigrationBackoffReason is set when an error has occured while migrating
 	// and virt-controller is backing off before retrying.
 	MigrationBackoffReason = "MigrationBackoff"
+	// SuccessfulRetryMigrationReason is added when a migration retrying a failed migration is created
+	SuccessfulRetryMigrationReason = "SuccessfulRetryMigration"
+	// FailedRetryMigrationReason is added when creating a migration retrying a failed migration fails
+	FailedRetryMigrationReason = "FailedRetryMigration"
 )
 
 type PodCacheStore struct {
@@ -427,7 +431,8 @@ func SetVMIMigrationPhaseTransitionTimestamp(oldVMIMigration *v1.VirtualMachineI
 }
 
 func SetSourcePod(migration *v1.VirtualMachineInstanceMigration, vmi *v1.VirtualMachineInstance, podIndexer cache.Indexer) {
-	if migration.Status.Phase != v1.MigrationPending {
+	// the source pod of a received VMI runs in another cluster
+	if migration.Status.Phase != v1.MigrationPending || migration.Spec.Receive != nil {
 		return
 	}
 	sourcePod, err := CurrentVMIPod(vmi, podIndexer)
@@ -78,6 +78,10 @@ const (
 	*/
 	OperatorLabel    = kubev1.ManagedByLabel + " in (" + kubev1.ManagedByLabelOperatorValue + "," + kubev1.ManagedByLabelOperatorOldValue + " )"
 	NotOperatorLabel = kubev1.ManagedByLabel + " notin (" + kubev1.ManagedByLabelOperatorValue + "," + kubev1.ManagedByLabelOperatorOldValue + " )"
+
+	// MigrationRemoteCAConfigMapName is the optional config map in the KubeVirt namespace
+	// holding the CA bundle of the remote clusters trusted by cross-cluster migrations
+	MigrationRemoteCAConfigMapName = "kubevirt-migration-remote-ca"
 )
 
 var unexpectedObjectError = errors.New("unexpected object")
@@ -135,9 +139,18 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineRestore objects
 	VirtualMachineRestore() cache.SharedIndexInformer
 
+	// Watches VirtualMachineSnapshotSchedule objects
+	VirtualMachineSnapshotSchedule() cache.SharedIndexInformer
+
+	// Watches VirtualMachineSnapshotGroup objects
+	VirtualMachineSnapshotGroup() cache.SharedIndexInformer
+
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
+	// Watches VirtualMachineStorageMigration objects
+	VirtualMachineStorageMigration() cache.SharedIndexInformer
+
 	// Watches VirtualMachineClone objects
 	VirtualMachineClone() cache.SharedIndexInformer
 
@@ -162,6 +175,9 @@ type KubeInformerFactory interface {
 	// Watches for the kubevirt export CA config map
 	KubeVirtExportCAConfigMap() cache.SharedIndexInformer
 
+	// Watches for the config map of the CAs trusted by cross-cluster migrations
+	MigrationRemoteCAConfigMap() cache.SharedIndexInformer
+
 	// Watches for the export route config map
 	ExportRouteConfigMap() cache.SharedIndexInformer
 
@@ -601,7 +617,11 @@ func GetVirtualMachineExportInformerIndexers() cache.Indexers {
 			if export.Spec.Source.APIGroup != nil &&
 				*export.Spec.Source.APIGroup == snapshotv1.SchemeGroupVersion.Group &&
 				export.Spec.Source.Kind == "VirtualMachineSnapshot" {
-				return []string{fmt.Sprintf("%s/%s", export.Namespace, export.Spec.Source.Name)}, nil
+				keys := []string{fmt.Sprintf("%s/%s", export.Namespace, export.Spec.Source.Name)}
+				if export.Spec.BaseSnapshotName != nil {
+					keys = append(keys, fmt.Sprintf("%s/%s", export.Namespace, *export.Spec.BaseSnapshotName))
+				}
+				return keys, nil
 			}
 
 			return nil, nil
@@ -709,6 +729,20 @@ func (f *kubeInformerFactory) VirtualMachineRestore() cache.SharedIndexInformer
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineSnapshotSchedule() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotScheduleInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotschedules", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotSchedule{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineSnapshotGroup() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotGroupInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotgroups", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotGroup{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	return f.getInformer("migrationPolicyInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceMigrationPolicies, k8sv1.NamespaceAll, fields.Everything())
@@ -716,10 +750,38 @@ func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	})
 }
 
+func GetVirtualMachineStorageMigrationInformerIndexers() cache.Indexers {
+	return cache.Indexers{
+		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		"vm": func(obj interface{}) ([]string, error) {
+			storageMigration, ok := obj.(*migrationsv1.VirtualMachineStorageMigration)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			return []string{fmt.Sprintf("%s/%s", storageMigration.Namespace, storageMigration.Spec.VMName)}, nil
+		},
+	}
+}
+
+func (f *kubeInformerFactory) VirtualMachineStorageMigration() cache.SharedIndexInformer {
+	return f.getInformer("vmStorageMigrationInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceVirtualMachineStorageMigrations, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &migrationsv1.VirtualMachineStorageMigration{}, f.defaultResync, GetVirtualMachineStorageMigrationInformerIndexers())
+	})
+}
+
 func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 	getkey := func(vmClone *clone.VirtualMachineClone, resourceName string) string {
 		return fmt.Sprintf("%s/%s", vmClone.Namespace, resourceName)
 	}
+	// restores and target VMs are created in the target namespace of the clone
+	getTargetKey := func(vmClone *clone.VirtualMachineClone, resourceName string) string {
+		if vmClone.Spec.TargetNamespace != "" {
+			return fmt.Sprintf("%s/%s", vmClone.Spec.TargetNamespace, resourceName)
+		}
+		return getkey(vmClone, resourceName)
+	}
 
 	return cache.Indexers{
 		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
@@ -758,7 +820,7 @@ func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 			}
 
 			if vmClone.Status.Phase == clone.RestoreInProgress && vmClone.Status.RestoreName != nil {
-				return []string{getkey(vmClone, *vmClone.Status.RestoreName)}, nil
+				return []string{getTargetKey(vmClone, *vmClone.Status.RestoreName)}, nil
 			}
 
 			return nil, nil
@@ -771,7 +833,23 @@ func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 			}
 
 			if vmClone.Status.Phase == clone.Succeeded && vmClone.Status.RestoreName != nil {
-				return []string{getkey(vmClone, *vmClone.Status.RestoreName)}, nil
+				return []string{getTargetKey(vmClone, *vmClone.Status.RestoreName)}, nil
+			}
+
+			return nil, nil
+		},
+		// Gets: vm key. Returns: clones that target the specified vm
+		"targetVM": func(obj interface{}) ([]string, error) {
+			vmClone, ok := obj.(*clone.VirtualMachineClone)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if vmClone.Status.TargetName != nil {
+				return []string{getTargetKey(vmClone, *vmClone.Status.TargetName)}, nil
+			}
+			if vmClone.Spec.Target != nil && vmClone.Spec.Target.Name != "" {
+				return []string{getTargetKey(vmClone, vmClone.Spec.Target.Name)}, nil
 			}
 
 			return nil, nil
@@ -915,6 +993,15 @@ func (f *kubeInformerFactory) KubeVirtExportCAConfigMap() cache.SharedIndexInfor
 	})
 }
 
+func (f *kubeInformerFactory) MigrationRemoteCAConfigMap() cache.SharedIndexInformer {
+	return f.getInformer("extensionsMigrationRemoteCAConfigMapInformer", func() cache.SharedIndexInformer {
+		restClient := f.clientSet.CoreV1().RESTClient()
+		fieldSelector := fields.OneTermEqualSelector("metadata.name", MigrationRemoteCAConfigMapName)
+		lw := cache.NewListWatchFromClient(restClient, "configmaps", f.kubevirtNamespace, fieldSelector)
+		return cache.NewSharedIndexInformer(lw, &k8sv1.ConfigMap{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) ExportRouteConfigMap() cache.SharedIndexInformer {
 	return f.getInformer("extensionsExportRouteConfigMapInformer", func() cache.SharedIndexInformer {
 		restClient := f.clientSet.CoreV1().RESTClient()
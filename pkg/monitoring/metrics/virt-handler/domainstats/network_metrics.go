@@ -19,7 +19,10 @@
 
 package domainstats
 
-import "github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	k6tv1 "kubevirt.io/api/core/v1"
+)
 
 var (
 	networkTrafficBytesDeprecated = operatormetrics.NewCounter(
@@ -84,6 +87,20 @@ var (
 			Help: "The total number of tx packets dropped on vNIC interfaces.",
 		},
 	)
+
+	networkBandwidthLimitBitsPerSecond = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_network_bandwidth_limit_bits_per_second",
+			Help: "The average and peak bandwidth limits applied on vNIC interfaces, per direction, in bits per second.",
+		},
+	)
+
+	networkBandwidthBurstBytes = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_network_bandwidth_burst_bytes",
+			Help: "The bandwidth burst size applied on vNIC interfaces, per direction, in bytes.",
+		},
+	)
 )
 
 type networkMetrics struct{}
@@ -99,11 +116,13 @@ func (networkMetrics) Describe() []operatormetrics.Metric {
 		networkTransmitErrors,
 		networkReceivePacketsDropped,
 		networkTransmitPacketsDropped,
+		networkBandwidthLimitBitsPerSecond,
+		networkBandwidthBurstBytes,
 	}
 }
 
 func (networkMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
-	var crs []operatormetrics.CollectorResult
+	crs := collectBandwidthLimits(vmiReport)
 
 	if vmiReport.vmiStats.DomainStats == nil || vmiReport.vmiStats.DomainStats.Net == nil {
 		return crs
@@ -159,3 +178,40 @@ func (networkMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operato
 
 	return crs
 }
+
+func collectBandwidthLimits(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+
+	for _, iface := range vmiReport.vmi.Status.Interfaces {
+		if iface.Bandwidth == nil {
+			continue
+		}
+		crs = append(crs, collectDirectionBandwidthLimits(vmiReport, iface.Name, "inbound", iface.Bandwidth.Inbound)...)
+		crs = append(crs, collectDirectionBandwidthLimits(vmiReport, iface.Name, "outbound", iface.Bandwidth.Outbound)...)
+	}
+
+	return crs
+}
+
+func collectDirectionBandwidthLimits(vmiReport *VirtualMachineInstanceReport, iface, direction string, limits *k6tv1.BandwidthLimits) []operatormetrics.CollectorResult {
+	if limits == nil {
+		return nil
+	}
+
+	averageLabels := map[string]string{"interface": iface, "direction": direction, "type": "average"}
+	crs := []operatormetrics.CollectorResult{
+		vmiReport.newCollectorResultWithLabels(networkBandwidthLimitBitsPerSecond, limits.Average.AsApproximateFloat64(), averageLabels),
+	}
+
+	if limits.Peak != nil {
+		peakLabels := map[string]string{"interface": iface, "direction": direction, "type": "peak"}
+		crs = append(crs, vmiReport.newCollectorResultWithLabels(networkBandwidthLimitBitsPerSecond, limits.Peak.AsApproximateFloat64(), peakLabels))
+	}
+
+	if limits.Burst != nil {
+		burstLabels := map[string]string{"interface": iface, "direction": direction}
+		crs = append(crs, vmiReport.newCollectorResultWithLabels(networkBandwidthBurstBytes, limits.Burst.AsApproximateFloat64(), burstLabels))
+	}
+
+	return crs
+}
@@ -24,6 +24,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k6tv1 "kubevirt.io/api/core/v1"
 
@@ -89,4 +90,53 @@ var _ = Describe("network metrics", func() {
 			Expect(crs).To(BeEmpty())
 		})
 	})
+
+	Context("on Collect with bandwidth limits", func() {
+		peak := resource.MustParse("20M")
+		burst := resource.MustParse("1Mi")
+		vmi := &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vmi-1",
+				Namespace: "test-ns-1",
+			},
+		}
+		vmi.Spec.Domain.Devices.Interfaces = []k6tv1.Interface{
+			{
+				Name: "default",
+				Bandwidth: &k6tv1.InterfaceBandwidth{
+					Outbound: &k6tv1.BandwidthLimits{Average: resource.MustParse("10M"), Peak: &peak, Burst: &burst},
+				},
+			},
+			{
+				Name: "not-applied",
+				Bandwidth: &k6tv1.InterfaceBandwidth{
+					Outbound: &k6tv1.BandwidthLimits{Average: resource.MustParse("1M")},
+				},
+			},
+		}
+		vmi.Status.Interfaces = []k6tv1.VirtualMachineInstanceNetworkInterface{
+			{Name: "default", Bandwidth: vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth},
+			{Name: "not-applied"},
+		}
+
+		vmiReport := newVirtualMachineInstanceReport(vmi, &VirtualMachineInstanceStats{})
+
+		DescribeTable("should collect metrics values", func(metric operatormetrics.Metric, expectedValue float64) {
+			crs := networkMetrics{}.Collect(vmiReport)
+			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(metric, expectedValue)))
+		},
+			Entry("kubevirt_vmi_network_bandwidth_limit_bits_per_second average", networkBandwidthLimitBitsPerSecond, 10000000.0),
+			Entry("kubevirt_vmi_network_bandwidth_limit_bits_per_second peak", networkBandwidthLimitBitsPerSecond, 20000000.0),
+			Entry("kubevirt_vmi_network_bandwidth_burst_bytes", networkBandwidthBurstBytes, 1048576.0),
+		)
+
+		It("should only collect the applied limits of the directions which are set", func() {
+			crs := networkMetrics{}.Collect(vmiReport)
+			Expect(crs).To(HaveLen(3))
+			for _, cr := range crs {
+				Expect(cr.ConstLabels).To(HaveKeyWithValue("interface", "default"))
+				Expect(cr.ConstLabels).To(HaveKeyWithValue("direction", "outbound"))
+			}
+		})
+	})
 })
@@ -34,6 +34,9 @@ var (
 			migrateVMIDataProcessed,
 			migrateVmiDirtyMemoryRate,
 			migrateVmiMemoryTransferRate,
+			migrateVmiMemoryIterations,
+			migrateVmiAutoConvergeThrottle,
+			migrateVmiPostcopyRequests,
 		},
 		CollectCallback: migrationStatsCollectorCallback,
 	}
@@ -72,6 +75,27 @@ var (
 			Help: "The rate at which the memory is being transferred.",
 		},
 	)
+
+	migrateVmiMemoryIterations = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_memory_iterations_total",
+			Help: "The number of memory pre-copy iterations performed by the migration.",
+		},
+	)
+
+	migrateVmiAutoConvergeThrottle = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_auto_converge_throttle_percent",
+			Help: "The percentage by which auto-converge is currently throttling the Guest OS vCPUs.",
+		},
+	)
+
+	migrateVmiPostcopyRequests = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_postcopy_requests_total",
+			Help: "The number of page faults the new VM requested from the source during post-copy.",
+		},
+	)
 )
 
 func SetupMigrationStatsCollector(vmiInformer cache.SharedIndexInformer) error {
@@ -120,6 +144,18 @@ func parse(r *result) []operatormetrics.CollectorResult {
 		crs = append(crs, newCR(r, migrateVmiMemoryTransferRate, float64(jobInfo.MemoryBps)))
 	}
 
+	if jobInfo.MemIterationSet {
+		crs = append(crs, newCR(r, migrateVmiMemoryIterations, float64(jobInfo.MemIteration)))
+	}
+
+	if jobInfo.AutoConvergeThrottleSet {
+		crs = append(crs, newCR(r, migrateVmiAutoConvergeThrottle, float64(jobInfo.AutoConvergeThrottle)))
+	}
+
+	if jobInfo.MemPostcopyReqsSet {
+		crs = append(crs, newCR(r, migrateVmiPostcopyRequests, float64(jobInfo.MemPostcopyReqs)))
+	}
+
 	return crs
 }
 
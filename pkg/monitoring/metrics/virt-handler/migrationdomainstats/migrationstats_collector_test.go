@@ -45,6 +45,13 @@ var _ = Describe("migration metrics", func() {
 				MemDirtyRate:     3,
 				MemoryBpsSet:     true,
 				MemoryBps:        4,
+
+				MemIterationSet:         true,
+				MemIteration:            5,
+				AutoConvergeThrottleSet: true,
+				AutoConvergeThrottle:    30,
+				MemPostcopyReqsSet:      true,
+				MemPostcopyReqs:         6,
 			},
 		}
 
@@ -57,6 +64,9 @@ var _ = Describe("migration metrics", func() {
 			Entry("kubevirt_vmi_migration_data_processed_bytes", migrateVMIDataProcessed, 2.0),
 			Entry("kubevirt_vmi_migration_dirty_memory_rate_bytes", migrateVmiDirtyMemoryRate, 3.0),
 			Entry("kubevirt_vmi_migration_disk_transfer_rate_bytes", migrateVmiMemoryTransferRate, 4.0),
+			Entry("kubevirt_vmi_migration_memory_iterations_total", migrateVmiMemoryIterations, 5.0),
+			Entry("kubevirt_vmi_migration_auto_converge_throttle_percent", migrateVmiAutoConvergeThrottle, 30.0),
+			Entry("kubevirt_vmi_migration_postcopy_requests_total", migrateVmiPostcopyRequests, 6.0),
 		)
 
 		It("result should be empty if stat not populated or set is false", func() {
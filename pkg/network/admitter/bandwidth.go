@@ -0,0 +1,111 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"math"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// The outbound traffic is policed by the kernel, which takes the rates in bytes per second
+// and the burst in bytes as 32 bit values.
+var (
+	maxBandwidthRate  = *resource.NewQuantity(math.MaxUint32*8, resource.DecimalSI)
+	maxBandwidthBurst = *resource.NewQuantity(math.MaxUint32, resource.BinarySI)
+)
+
+func validateInterfaceBandwidth(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Bandwidth == nil {
+			continue
+		}
+		bandwidthField := field.Child("domain", "devices", "interfaces").Index(idx).Child("bandwidth")
+
+		if iface.Bridge == nil && iface.Masquerade == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%q interface's bandwidth is only supported for bridge and masquerade bindings", iface.Name),
+				Field:   bandwidthField.String(),
+			})
+		}
+
+		causes = append(causes, validateBandwidthLimits(bandwidthField.Child("inbound"), iface.Name, iface.Bandwidth.Inbound)...)
+		causes = append(causes, validateBandwidthLimits(bandwidthField.Child("outbound"), iface.Name, iface.Bandwidth.Outbound)...)
+	}
+	return causes
+}
+
+func validateBandwidthLimits(field *k8sfield.Path, ifaceName string, limits *v1.BandwidthLimits) []metav1.StatusCause {
+	if limits == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	if limits.Average.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%q interface's average bandwidth must be greater than zero", ifaceName),
+			Field:   field.Child("average").String(),
+		})
+	}
+	if limits.Average.Cmp(maxBandwidthRate) > 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%q interface's average bandwidth must not exceed %s", ifaceName, maxBandwidthRate.String()),
+			Field:   field.Child("average").String(),
+		})
+	}
+	if limits.Peak != nil && limits.Peak.Cmp(maxBandwidthRate) > 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%q interface's peak bandwidth must not exceed %s", ifaceName, maxBandwidthRate.String()),
+			Field:   field.Child("peak").String(),
+		})
+	}
+	if limits.Peak != nil && limits.Peak.Cmp(limits.Average) < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%q interface's peak bandwidth must not be lower than the average bandwidth", ifaceName),
+			Field:   field.Child("peak").String(),
+		})
+	}
+	if limits.Burst != nil && limits.Burst.Cmp(maxBandwidthBurst) > 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%q interface's bandwidth burst must not exceed %s", ifaceName, maxBandwidthBurst.String()),
+			Field:   field.Child("burst").String(),
+		})
+	}
+	if limits.Burst != nil && limits.Burst.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%q interface's bandwidth burst must be greater than zero", ifaceName),
+			Field:   field.Child("burst").String(),
+		})
+	}
+	return causes
+}
@@ -0,0 +1,131 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Validating interface bandwidth", func() {
+	newSpec := func(binding v1.InterfaceBindingMethod, bandwidth *v1.InterfaceBandwidth) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "foo",
+			InterfaceBindingMethod: binding,
+			Bandwidth:              bandwidth,
+		}}
+		spec.Networks = []v1.Network{
+			{Name: "foo", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net"}}},
+		}
+		return spec
+	}
+	bridgeBinding := v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}
+
+	It("should accept valid bandwidth limits", func() {
+		spec := newSpec(bridgeBinding, &v1.InterfaceBandwidth{
+			Inbound: &v1.BandwidthLimits{Average: resource.MustParse("10M")},
+			Outbound: &v1.BandwidthLimits{
+				Average: resource.MustParse("10M"),
+				Peak:    pointer.P(resource.MustParse("20M")),
+				Burst:   pointer.P(resource.MustParse("1Mi")),
+			},
+		})
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	DescribeTable("should reject bandwidth limits with", func(binding v1.InterfaceBindingMethod) {
+		spec := newSpec(binding, &v1.InterfaceBandwidth{})
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ContainElement(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "\"foo\" interface's bandwidth is only supported for bridge and masquerade bindings",
+			Field:   "fake.domain.devices.interfaces[0].bandwidth",
+		}))
+	},
+		Entry("SR-IOV binding", v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}),
+		Entry("no core binding method", v1.InterfaceBindingMethod{}),
+	)
+
+	DescribeTable("should reject", func(bandwidth *v1.InterfaceBandwidth, expectedCause metav1.StatusCause) {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(bridgeBinding, bandwidth), stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(expectedCause))
+	},
+		Entry("a zero average",
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimits{}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "\"foo\" interface's average bandwidth must be greater than zero",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth.inbound.average",
+			},
+		),
+		Entry("a peak lower than the average",
+			&v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimits{
+				Average: resource.MustParse("10M"),
+				Peak:    pointer.P(resource.MustParse("1M")),
+			}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "\"foo\" interface's peak bandwidth must not be lower than the average bandwidth",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth.outbound.peak",
+			},
+		),
+		Entry("an average exceeding the policer range",
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimits{Average: resource.MustParse("40G")}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "\"foo\" interface's average bandwidth must not exceed 34359738360",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth.inbound.average",
+			},
+		),
+		Entry("a burst exceeding the policer range",
+			&v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimits{
+				Average: resource.MustParse("10M"),
+				Burst:   pointer.P(resource.MustParse("4Gi")),
+			}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "\"foo\" interface's bandwidth burst must not exceed 4294967295",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth.outbound.burst",
+			},
+		),
+		Entry("a negative burst",
+			&v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimits{
+				Average: resource.MustParse("10M"),
+				Burst:   pointer.P(resource.MustParse("-1")),
+			}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "\"foo\" interface's bandwidth burst must be greater than zero",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth.outbound.burst",
+			},
+		),
+	)
+})
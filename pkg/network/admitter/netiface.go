@@ -269,7 +269,8 @@ func validateDHCPOptions(field *k8sfield.Path, idx int, iface v1.Interface) []me
 	var causes []metav1.StatusCause
 	if iface.DHCPOptions != nil {
 		causes = append(causes, validateDHCPExtraOptions(field, iface)...)
-		causes = append(causes, validateDHCPNTPServersAreValidIPv4Addresses(field, iface, idx)...)
+		causes = append(causes, validateDHCPv6ExtraOptions(field, iface, idx)...)
+		causes = append(causes, validateDHCPNTPServersAreValidIPAddresses(field, iface, idx)...)
 	}
 	return causes
 }
@@ -291,13 +292,62 @@ func validateDHCPExtraOptions(field *k8sfield.Path, iface v1.Interface) []metav1
 	return causes
 }
 
-func validateDHCPNTPServersAreValidIPv4Addresses(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+// reservedDHCPv6Options are the options set by the DHCPv6 server itself
+var reservedDHCPv6Options = map[int]struct{}{
+	1:  {}, // client identifier
+	2:  {}, // server identifier
+	3:  {}, // IA_NA
+	4:  {}, // IA_TA
+	5:  {}, // IA address
+	13: {}, // status code
+	23: {}, // DNS servers
+	24: {}, // domain search list
+	25: {}, // IA_PD
+	26: {}, // IA prefix
+	56: {}, // NTP servers
+	59: {}, // boot file URL
+}
+
+func validateDHCPv6ExtraOptions(field *k8sfield.Path, iface v1.Interface, idx int) []metav1.StatusCause {
+	const maxDHCPv6Option = 65535
+
+	var causes []metav1.StatusCause
+	optionsField := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "dhcpv6Options")
+	seenOptions := map[int]struct{}{}
+	for index, option := range iface.DHCPOptions.DHCPv6Options {
+		if _, seen := seenOptions[option.Option]; seen {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("DHCPv6 option %d is specified more than once", option.Option),
+				Field:   optionsField.Index(index).String(),
+			})
+		}
+		seenOptions[option.Option] = struct{}{}
+
+		if option.Option < 1 || option.Option > maxDHCPv6Option {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("DHCPv6 option %d is out of range, must be in range 1 to %d", option.Option, maxDHCPv6Option),
+				Field:   optionsField.Index(index).String(),
+			})
+		} else if _, reserved := reservedDHCPv6Options[option.Option]; reserved {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("DHCPv6 option %d is set by the DHCPv6 server and cannot be specified", option.Option),
+				Field:   optionsField.Index(index).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateDHCPNTPServersAreValidIPAddresses(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
 	if iface.DHCPOptions != nil {
 		for index, ip := range iface.DHCPOptions.NTPServers {
-			if net.ParseIP(ip).To4() == nil {
+			if net.ParseIP(ip) == nil {
 				causes = append(causes, metav1.StatusCause{
 					Type:    metav1.CauseTypeFieldValueInvalid,
-					Message: "NTP servers must be a list of valid IPv4 addresses.",
+					Message: "NTP servers must be a list of valid IP addresses.",
 					Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "ntpServers").Index(index).String(),
 				})
 			}
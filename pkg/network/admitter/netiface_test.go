@@ -350,16 +350,47 @@ var _ = Describe("Validating VMI network spec", func() {
 				}},
 			),
 			Entry(
-				"non-IPv4 NTP servers",
-				v1.DHCPOptions{NTPServers: []string{"::1", "hostname"}},
+				"non-IP NTP servers",
+				v1.DHCPOptions{NTPServers: []string{"hostname", "::1", "127.0.0.1.1"}},
 				[]metav1.StatusCause{{
 					Type:    "FieldValueInvalid",
-					Message: "NTP servers must be a list of valid IPv4 addresses.",
+					Message: "NTP servers must be a list of valid IP addresses.",
 					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.ntpServers[0]",
 				}, {
 					Type:    "FieldValueInvalid",
-					Message: "NTP servers must be a list of valid IPv4 addresses.",
-					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.ntpServers[1]",
+					Message: "NTP servers must be a list of valid IP addresses.",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.ntpServers[2]",
+				}},
+			),
+			Entry(
+				"out of range DHCPv6Options",
+				v1.DHCPOptions{DHCPv6Options: []v1.DHCPv6Option{{Option: 0}, {Option: 65536}}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCPv6 option 0 is out of range, must be in range 1 to 65535",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.dhcpv6Options[0]",
+				}, {
+					Type:    "FieldValueInvalid",
+					Message: "DHCPv6 option 65536 is out of range, must be in range 1 to 65535",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.dhcpv6Options[1]",
+				}},
+			),
+			Entry(
+				"DHCPv6Options set by the DHCPv6 server",
+				v1.DHCPOptions{DHCPv6Options: []v1.DHCPv6Option{{Option: 23, Value: "fd10::1"}}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueNotSupported",
+					Message: "DHCPv6 option 23 is set by the DHCPv6 server and cannot be specified",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.dhcpv6Options[0]",
+				}},
+			),
+			Entry(
+				"duplicate DHCPv6Options",
+				v1.DHCPOptions{DHCPv6Options: []v1.DHCPv6Option{{Option: 240, Value: "a"}, {Option: 240, Value: "b"}}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueDuplicate",
+					Message: "DHCPv6 option 240 is specified more than once",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.dhcpv6Options[1]",
 				}},
 			),
 		)
@@ -380,6 +411,17 @@ var _ = Describe("Validating VMI network spec", func() {
 				PrivateOptions: []v1.DHCPPrivateOptions{{Option: 240, Value: "extra.options.kubevirt.io"}},
 			}),
 			Entry(" valid NTP servers", v1.DHCPOptions{NTPServers: []string{"127.0.0.1", "127.0.0.2"}}),
+			Entry(" valid IPv6 NTP servers", v1.DHCPOptions{NTPServers: []string{"127.0.0.1", "fd10::2"}}),
+			Entry("valid DHCPv6Options", v1.DHCPOptions{
+				DHCPv6Options: []v1.DHCPv6Option{{Option: 17, Value: "vendor"}, {Option: 240, Value: "extra.options.kubevirt.io"}},
+			}),
+			Entry(
+				"DHCPPrivateOptions and DHCPv6Options with the same codes",
+				v1.DHCPOptions{
+					PrivateOptions: []v1.DHCPPrivateOptions{{Option: 240, Value: "extra.options.kubevirt.io"}},
+					DHCPv6Options:  []v1.DHCPv6Option{{Option: 240, Value: "extra.options.kubevirt.io"}},
+				},
+			),
 			Entry(
 				"unique DHCPPrivateOptions",
 				v1.DHCPOptions{
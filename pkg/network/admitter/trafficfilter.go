@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func validateInterfaceTrafficFilter(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		filter := iface.TrafficFilter
+		if filter == nil {
+			continue
+		}
+		filterField := field.Child("domain", "devices", "interfaces").Index(idx).Child("trafficFilter")
+
+		if iface.Bridge == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%q interface's traffic filter is supported only for bridge binding", iface.Name),
+				Field:   filterField.String(),
+			})
+		}
+
+		causes = append(causes, validateTrafficFilterAddresses(filterField, fmt.Sprintf("%q interface's traffic filter", iface.Name), filter)...)
+
+		if filter.NeighborDiscoveryProtection && len(filter.AllowedMACAddresses) == 0 &&
+			iface.MacAddress == "" && len(filter.AllowedIPAddresses) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%q interface's neighbor discovery protection requires allowed MAC or IP addresses", iface.Name),
+				Field:   filterField.Child("neighborDiscoveryProtection").String(),
+			})
+		}
+
+		causes = append(causes, validateTrafficFilterPorts(filterField.Child("ingressPorts"), filter.IngressPorts)...)
+		causes = append(causes, validateTrafficFilterPorts(filterField.Child("egressPorts"), filter.EgressPorts)...)
+	}
+	return causes
+}
+
+// ValidateClusterTrafficFilter validates the traffic filter enforced by the cluster configuration
+func ValidateClusterTrafficFilter(field *k8sfield.Path, filter *v1.InterfaceTrafficFilter) []metav1.StatusCause {
+	if filter == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	causes = append(causes, validateTrafficFilterAddresses(field, "traffic filter", filter)...)
+	causes = append(causes, validateTrafficFilterPorts(field.Child("ingressPorts"), filter.IngressPorts)...)
+	causes = append(causes, validateTrafficFilterPorts(field.Child("egressPorts"), filter.EgressPorts)...)
+	return causes
+}
+
+func validateTrafficFilterAddresses(field *k8sfield.Path, subject string, filter *v1.InterfaceTrafficFilter) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for macIdx, mac := range filter.AllowedMACAddresses {
+		if _, err := net.ParseMAC(mac); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s has an invalid MAC address: %s", subject, mac),
+				Field:   field.Child("allowedMACAddresses").Index(macIdx).String(),
+			})
+		}
+	}
+
+	for ipIdx, address := range filter.AllowedIPAddresses {
+		if _, _, err := net.ParseCIDR(address); err != nil && net.ParseIP(address) == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s has an invalid IP address or CIDR: %s", subject, address),
+				Field:   field.Child("allowedIPAddresses").Index(ipIdx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateTrafficFilterPorts(field *k8sfield.Path, ports []v1.Port) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for portIdx, port := range ports {
+		if port.Port <= 0 || port.Port > 65535 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Port field must be in range 0 < x < 65536.",
+				Field:   field.Index(portIdx).Child("port").String(),
+			})
+		}
+		if port.Protocol != "" && port.Protocol != "TCP" && port.Protocol != "UDP" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Unknown protocol, only TCP or UDP allowed",
+				Field:   field.Index(portIdx).Child("protocol").String(),
+			})
+		}
+	}
+	return causes
+}
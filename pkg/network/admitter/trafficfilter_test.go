@@ -0,0 +1,145 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating interface traffic filter", func() {
+	newSpec := func(binding v1.InterfaceBindingMethod, filter *v1.InterfaceTrafficFilter) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "foo",
+			InterfaceBindingMethod: binding,
+			TrafficFilter:          filter,
+		}}
+		spec.Networks = []v1.Network{
+			{Name: "foo", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net"}}},
+		}
+		return spec
+	}
+	bridgeBinding := v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}
+
+	It("should accept a valid traffic filter", func() {
+		spec := newSpec(bridgeBinding, &v1.InterfaceTrafficFilter{
+			AllowedMACAddresses:         []string{"02:00:00:00:00:01"},
+			AllowedIPAddresses:          []string{"10.0.0.5", "10.1.0.0/24", "fd00::/64"},
+			NeighborDiscoveryProtection: true,
+			DHCPServerGuard:             true,
+			IngressPorts:                []v1.Port{{Port: 22}, {Protocol: "UDP", Port: 53}},
+			EgressPorts:                 []v1.Port{{Protocol: "TCP", Port: 443}},
+		})
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject a traffic filter when bridge binding is not used", func() {
+		spec := newSpec(v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}, &v1.InterfaceTrafficFilter{DHCPServerGuard: true})
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "\"foo\" interface's traffic filter is supported only for bridge binding",
+			Field:   "fake.domain.devices.interfaces[0].trafficFilter",
+		}))
+	})
+
+	DescribeTable("should reject", func(filter *v1.InterfaceTrafficFilter, expectedCause metav1.StatusCause) {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newSpec(bridgeBinding, filter), stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(expectedCause))
+	},
+		Entry("an invalid MAC address",
+			&v1.InterfaceTrafficFilter{AllowedMACAddresses: []string{"02:00:00:00:00:01", "invalid"}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "\"foo\" interface's traffic filter has an invalid MAC address: invalid",
+				Field:   "fake.domain.devices.interfaces[0].trafficFilter.allowedMACAddresses[1]",
+			},
+		),
+		Entry("an invalid IP address",
+			&v1.InterfaceTrafficFilter{AllowedIPAddresses: []string{"10.0.0.256"}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "\"foo\" interface's traffic filter has an invalid IP address or CIDR: 10.0.0.256",
+				Field:   "fake.domain.devices.interfaces[0].trafficFilter.allowedIPAddresses[0]",
+			},
+		),
+		Entry("neighbor discovery protection without allowed addresses",
+			&v1.InterfaceTrafficFilter{NeighborDiscoveryProtection: true},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "\"foo\" interface's neighbor discovery protection requires allowed MAC or IP addresses",
+				Field:   "fake.domain.devices.interfaces[0].trafficFilter.neighborDiscoveryProtection",
+			},
+		),
+		Entry("an ingress port out of range",
+			&v1.InterfaceTrafficFilter{IngressPorts: []v1.Port{{Port: 65536}}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Port field must be in range 0 < x < 65536.",
+				Field:   "fake.domain.devices.interfaces[0].trafficFilter.ingressPorts[0].port",
+			},
+		),
+		Entry("an egress port with an unknown protocol",
+			&v1.InterfaceTrafficFilter{EgressPorts: []v1.Port{{Protocol: "SCTP", Port: 80}}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Unknown protocol, only TCP or UDP allowed",
+				Field:   "fake.domain.devices.interfaces[0].trafficFilter.egressPorts[0].protocol",
+			},
+		),
+	)
+})
+
+var _ = Describe("Validating the cluster traffic filter", func() {
+	It("should accept a valid traffic filter", func() {
+		Expect(admitter.ValidateClusterTrafficFilter(k8sfield.NewPath("fake"), &v1.InterfaceTrafficFilter{
+			NeighborDiscoveryProtection: true,
+			DHCPServerGuard:             true,
+			EgressPorts:                 []v1.Port{{Protocol: "TCP", Port: 443}},
+		})).To(BeEmpty())
+	})
+
+	It("should reject invalid addresses and ports", func() {
+		Expect(admitter.ValidateClusterTrafficFilter(k8sfield.NewPath("fake"), &v1.InterfaceTrafficFilter{
+			AllowedMACAddresses: []string{"invalid"},
+			IngressPorts:        []v1.Port{{Port: 0}},
+		})).To(ConsistOf(
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "traffic filter has an invalid MAC address: invalid",
+				Field:   "fake.allowedMACAddresses[0]",
+			},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Port field must be in range 0 < x < 65536.",
+				Field:   "fake.ingressPorts[0].port",
+			},
+		))
+	})
+})
@@ -53,6 +53,8 @@ func (v Validator) Validate() []metav1.StatusCause {
 	causes = append(causes, validateSingleNetworkSource(v.field, v.vmiSpec)...)
 	causes = append(causes, validateMultusNetworkSource(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfaceStateValue(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceTrafficFilter(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceBandwidth(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfaceBinding(v.field, v.vmiSpec, v.configChecker)...)
 	causes = append(causes, validateSlirpBinding(v.field, v.vmiSpec, v.configChecker)...)
 	causes = append(causes, validateNetworkNameUnique(v.field, v.vmiSpec)...)
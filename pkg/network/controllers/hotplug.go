@@ -47,10 +47,27 @@ func ApplyDynamicIfaceRequestOnVMI(
 			vmiIface := vmispec.LookupInterfaceByName(vmiSpecCopy.Domain.Devices.Interfaces, vmIface.Name)
 			vmiIface.State = v1.InterfaceStateAbsent
 		}
+		if existsInVMISpec && isLinkStateChange(vmIface.State, vmiIndexedInterfaces[vmIface.Name].State) {
+			vmiIface := vmispec.LookupInterfaceByName(vmiSpecCopy.Domain.Devices.Interfaces, vmIface.Name)
+			vmiIface.State = vmIface.State
+		}
 	}
 	return vmiSpecCopy
 }
 
+// isLinkStateChange reports whether the requested state sets the link of a
+// present interface up or down.
+func isLinkStateChange(requestedState, currentState v1.InterfaceState) bool {
+	if requestedState == v1.InterfaceStateAbsent || currentState == v1.InterfaceStateAbsent {
+		return false
+	}
+	return isLinkDown(requestedState) != isLinkDown(currentState)
+}
+
+func isLinkDown(state v1.InterfaceState) bool {
+	return state == v1.InterfaceStateLinkDown
+}
+
 func ClearDetachedInterfaces(
 	specIfaces []v1.Interface,
 	specNets []v1.Network,
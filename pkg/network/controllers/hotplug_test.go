@@ -148,6 +148,62 @@ var _ = Describe("Network interface hot{un}plug", func() {
 				libvmi.WithNetwork(&v1.Network{Name: testNetworkName2}),
 			),
 			!ordinal),
+		Entry("when the link of an interface has to be set down",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateLinkDown)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateLinkDown)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			ordinal),
+		Entry("when the link of an interface has to be set up",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateLinkUp)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateLinkDown)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateLinkUp)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal),
+		Entry("when the link of an interface is set up implicitly",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateLinkDown)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal),
+		Entry("when the link state of an interface being hotunplugged is requested",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateLinkDown)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal),
 	)
 
 	DescribeTable("spec interfaces",
@@ -208,8 +264,12 @@ func sriovInterface(name string) v1.Interface {
 }
 
 func bridgeAbsentInterface(name string) v1.Interface {
+	return bridgeInterfaceWithState(name, v1.InterfaceStateAbsent)
+}
+
+func bridgeInterfaceWithState(name string, state v1.InterfaceState) v1.Interface {
 	iface := bridgeInterface(name)
-	iface.State = v1.InterfaceStateAbsent
+	iface.State = state
 	return iface
 }
 
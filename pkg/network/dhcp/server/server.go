@@ -42,7 +42,7 @@ const (
 	infiniteLease             = 999 * 24 * time.Hour
 	errorSearchDomainNotValid = "Search domain is not valid"
 	errorSearchDomainTooLong  = "Search domains length exceeded allowable size"
-	errorNTPConfiguration     = "Could not parse NTP server as IPv4 address: %s"
+	errorNTPConfiguration     = "Could not parse NTP server as IP address: %s"
 )
 
 // simple domain validation regex. Put it here to avoid compiling each time.
@@ -163,15 +163,20 @@ func prepareDHCPOptions(
 			ntpServers := [][]byte{}
 
 			for _, server := range customDHCPOptions.NTPServers {
-				ip := net.ParseIP(server).To4()
+				ip := net.ParseIP(server)
 
 				if ip == nil {
 					return nil, fmt.Errorf(errorNTPConfiguration, server)
 				}
-				ntpServers = append(ntpServers, []byte(ip))
+				// IPv6 NTP servers are served by DHCPv6
+				if ip.To4() != nil {
+					ntpServers = append(ntpServers, []byte(ip.To4()))
+				}
 			}
 
-			dhcpOptions[dhcp.OptionNetworkTimeProtocolServers] = bytes.Join(ntpServers, nil)
+			if len(ntpServers) > 0 {
+				dhcpOptions[dhcp.OptionNetworkTimeProtocolServers] = bytes.Join(ntpServers, nil)
+			}
 		}
 
 		if customDHCPOptions.PrivateOptions != nil {
@@ -212,6 +212,16 @@ var _ = Describe("DHCP Server", func() {
 			Expect(options[240]).To(Equal([]byte("private.options.kubevirt.io")))
 		})
 
+		It("should omit the IPv6 NTP servers", func() {
+			ip := net.ParseIP("192.168.2.1")
+			dhcpOptions := &v1.DHCPOptions{NTPServers: []string{"192.168.2.2", "fd10::2"}}
+
+			options, err := prepareDHCPOptions(ip.DefaultMask(), ip, nil, nil, nil, 1500, "myhost", dhcpOptions)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options[dhcp4.OptionNetworkTimeProtocolServers]).To(Equal([]byte{192, 168, 2, 2}))
+		})
+
 		It("expects the gateway as an IPv4 addresses", func() {
 			gw := net.ParseIP("192.168.2.1")
 			options, err := prepareDHCPOptions(gw.DefaultMask(), gw, nil, nil, nil, 1500, "myhost", nil)
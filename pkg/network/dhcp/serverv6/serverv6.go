@@ -22,17 +22,21 @@ package serverv6
 import (
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/insomniacslk/dhcp/dhcpv6"
 	"github.com/insomniacslk/dhcp/dhcpv6/server6"
 	"github.com/insomniacslk/dhcp/iana"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 )
 
 const (
 	infiniteLease = 999 * 24 * time.Hour
+
+	errorNTPConfiguration = "Could not parse NTP server as IP address: %s"
 )
 
 type DHCPv6Handler struct {
@@ -40,7 +44,13 @@ type DHCPv6Handler struct {
 	modifiers []dhcpv6.Modifier
 }
 
-func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
+func SingleClientDHCPv6Server(
+	clientIP net.IP,
+	serverIfaceName string,
+	dnsIPs []net.IP,
+	searchDomains []string,
+	customDHCPOptions *v1.DHCPOptions,
+) error {
 	log.Log.Info("Starting SingleClientDHCPv6Server")
 
 	iface, err := net.InterfaceByName(serverIfaceName)
@@ -48,7 +58,10 @@ func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
 		return fmt.Errorf("couldn't create DHCPv6 server, couldn't get the dhcp6 server interface: %v", err)
 	}
 
-	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr)
+	modifiers, err := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr, dnsIPs, searchDomains, customDHCPOptions)
+	if err != nil {
+		return fmt.Errorf("couldn't create DHCPv6 server: %v", err)
+	}
 
 	handler := &DHCPv6Handler{
 		clientIP:  clientIP,
@@ -118,12 +131,98 @@ func (h *DHCPv6Handler) buildResponse(msg dhcpv6.DHCPv6) (*dhcpv6.Message, error
 		ianaResponse.IaId = ianaRequest.IaId
 		response.UpdateOption(ianaResponse)
 	}
+
+	// Prefix delegation is out of scope, the VMI is given a single address on the pod network.
+	// Each requested IA_PD is answered with the NoPrefixAvail status, as defined by RFC 8415.
+	for _, iapdRequest := range dhcpv6Msg.Options.IAPD() {
+		response.AddOption(&dhcpv6.OptIAPD{
+			IaId: iapdRequest.IaId,
+			Options: dhcpv6.PDOptions{Options: dhcpv6.Options{
+				&dhcpv6.OptStatusCode{StatusCode: iana.StatusNoPrefixAvail, StatusMessage: "prefix delegation is not supported"},
+			}},
+		})
+	}
 	return response, nil
 }
 
-func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr) []dhcpv6.Modifier {
+func prepareDHCPv6Modifiers(
+	clientIP net.IP,
+	serverInterfaceMac net.HardwareAddr,
+	dnsIPs []net.IP,
+	searchDomains []string,
+	customDHCPOptions *v1.DHCPOptions,
+) ([]dhcpv6.Modifier, error) {
 	optIAAddress := dhcpv6.OptIAAddress{IPv6Addr: clientIP, PreferredLifetime: infiniteLease, ValidLifetime: infiniteLease}
 	duid := &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: serverInterfaceMac}
 
-	return []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
+	modifiers := []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
+
+	if len(dnsIPs) > 0 {
+		modifiers = append(modifiers, dhcpv6.WithDNS(dnsIPs...))
+	}
+	if len(searchDomains) > 0 {
+		modifiers = append(modifiers, dhcpv6.WithDomainSearchList(searchDomains...))
+	}
+
+	if customDHCPOptions == nil {
+		return modifiers, nil
+	}
+
+	if bootFileURL := bootFileURL(customDHCPOptions); bootFileURL != "" {
+		log.Log.Infof("Setting dhcpv6 option boot file URL to %s", bootFileURL)
+		modifiers = append(modifiers, dhcpv6.WithOption(dhcpv6.OptBootFileURL(bootFileURL)))
+	}
+
+	ntpServers, err := ntpServersSuboptions(customDHCPOptions.NTPServers)
+	if err != nil {
+		return nil, err
+	}
+	if len(ntpServers) > 0 {
+		log.Log.Infof("Setting dhcpv6 option NTP servers to %s", customDHCPOptions.NTPServers)
+		modifiers = append(modifiers, dhcpv6.WithOption(&dhcpv6.OptNTPServer{Suboptions: ntpServers}))
+	}
+
+	for _, option := range customDHCPOptions.DHCPv6Options {
+		modifiers = append(modifiers, dhcpv6.WithOption(&dhcpv6.OptionGeneric{
+			OptionCode: dhcpv6.OptionCode(option.Option),
+			OptionData: []byte(option.Value),
+		}))
+	}
+
+	return modifiers, nil
+}
+
+// bootFileURL returns the boot file URL (RFC 5970) of the boot file name.
+// A boot file name which is not a URL is served from the TFTP server, if specified.
+func bootFileURL(customDHCPOptions *v1.DHCPOptions) string {
+	bootFileName := customDHCPOptions.BootFileName
+	if bootFileName == "" || strings.Contains(bootFileName, "://") {
+		return bootFileName
+	}
+	if customDHCPOptions.TFTPServerName == "" {
+		log.Log.Warningf("Ignoring the boot file name %s for DHCPv6, a URL or a TFTP server name is required", bootFileName)
+		return ""
+	}
+
+	tftpServer := customDHCPOptions.TFTPServerName
+	if ip := net.ParseIP(tftpServer); ip != nil && ip.To4() == nil {
+		tftpServer = "[" + tftpServer + "]"
+	}
+	return fmt.Sprintf("tftp://%s/%s", tftpServer, strings.TrimPrefix(bootFileName, "/"))
+}
+
+// ntpServersSuboptions returns the IPv6 NTP servers, the IPv4 ones are served by DHCPv4
+func ntpServersSuboptions(ntpServers []string) (dhcpv6.Options, error) {
+	var suboptions dhcpv6.Options
+	for _, server := range ntpServers {
+		ip := net.ParseIP(server)
+		if ip == nil {
+			return nil, fmt.Errorf(errorNTPConfiguration, server)
+		}
+		if ip.To4() == nil {
+			srvAddr := dhcpv6.NTPSuboptionSrvAddr(ip)
+			suboptions.Add(&srvAddr)
+		}
+	}
+	return suboptions, nil
 }
@@ -27,6 +27,8 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
 )
 
 var _ = Describe("DHCPv6", func() {
@@ -34,7 +36,8 @@ var _ = Describe("DHCPv6", func() {
 		It("should contain ianaAdrress and duid", func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers, err := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
 			Expect(modifiers).To(HaveLen(2))
 
 			msg := &dhcpv6.Message{
@@ -53,13 +56,84 @@ var _ = Describe("DHCPv6", func() {
 			Expect(msg.GetOneOption(dhcpv6.OptionServerID).String()).To(Equal(expectedServerId.String()))
 		})
 	})
+	Context("prepareDHCPv6Modifiers with options", func() {
+		clientIP := net.ParseIP("fd10:0:2::2")
+		serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
+
+		applyModifiers := func(modifiers []dhcpv6.Modifier) *dhcpv6.Message {
+			msg := &dhcpv6.Message{MessageType: dhcpv6.MessageTypeReply}
+			for _, modifier := range modifiers {
+				modifier(msg)
+			}
+			return msg
+		}
+
+		It("should contain the DNS servers and the search list", func() {
+			dnsIP := net.ParseIP("fd00:10:96::a")
+			modifiers, err := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, []net.IP{dnsIP}, []string{"ns.svc.cluster.local", "cluster.local"}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			msg := applyModifiers(modifiers)
+			Expect(msg.Options.DNS()).To(Equal([]net.IP{dnsIP}))
+			Expect(msg.Options.DomainSearchList().Labels).To(Equal([]string{"ns.svc.cluster.local", "cluster.local"}))
+		})
+
+		It("should contain the custom options", func() {
+			dhcpOptions := &v1.DHCPOptions{
+				BootFileName:   "config",
+				TFTPServerName: "fd10::5",
+				NTPServers:     []string{"192.168.2.2", "fd10::2"},
+				PrivateOptions: []v1.DHCPPrivateOptions{{Option: 224, Value: "private.options.kubevirt.io"}},
+				DHCPv6Options:  []v1.DHCPv6Option{{Option: 240, Value: "extra.options.kubevirt.io"}},
+			}
+			modifiers, err := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil, dhcpOptions)
+			Expect(err).ToNot(HaveOccurred())
+
+			msg := applyModifiers(modifiers)
+			Expect(msg.Options.BootFileURL()).To(Equal("tftp://[fd10::5]/config"))
+			Expect(msg.GetOneOption(dhcpv6.OptionNTPServer).ToBytes()).To(Equal(
+				append([]byte{0, 1, 0, 16}, net.ParseIP("fd10::2")...),
+			))
+			Expect(msg.GetOneOption(dhcpv6.OptionCode(240)).ToBytes()).To(Equal([]byte("extra.options.kubevirt.io")))
+			Expect(msg.GetOneOption(dhcpv6.OptionCode(224))).To(BeNil())
+		})
+
+		DescribeTable("should set the boot file URL", func(dhcpOptions *v1.DHCPOptions, expectedURL string) {
+			modifiers, err := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil, dhcpOptions)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applyModifiers(modifiers).Options.BootFileURL()).To(Equal(expectedURL))
+		},
+			Entry("as is when it is a URL",
+				&v1.DHCPOptions{BootFileName: "http://boot.kubevirt.io/ipxe.efi", TFTPServerName: "tftp.kubevirt.io"},
+				"http://boot.kubevirt.io/ipxe.efi",
+			),
+			Entry("from the TFTP server name",
+				&v1.DHCPOptions{BootFileName: "/pxelinux.0", TFTPServerName: "tftp.kubevirt.io"},
+				"tftp://tftp.kubevirt.io/pxelinux.0",
+			),
+			Entry("to nothing without a TFTP server name", &v1.DHCPOptions{BootFileName: "pxelinux.0"}, ""),
+		)
+
+		It("should not contain NTP servers when only IPv4 ones are specified", func() {
+			modifiers, err := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil, &v1.DHCPOptions{NTPServers: []string{"192.168.2.2"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applyModifiers(modifiers).GetOneOption(dhcpv6.OptionNTPServer)).To(BeNil())
+		})
+
+		It("should fail on an invalid NTP server", func() {
+			_, err := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil, &v1.DHCPOptions{NTPServers: []string{"hostname"}})
+			Expect(err).To(MatchError("Could not parse NTP server as IP address: hostname"))
+		})
+	})
+
 	Context("buildResponse should build a response with", func() {
 		var handler *DHCPv6Handler
 
 		BeforeEach(func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers, err := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
 
 			handler = &DHCPv6Handler{
 				clientIP:  clientIP,
@@ -102,6 +176,19 @@ var _ = Describe("DHCPv6", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(replyMessage.Options.OneIANA().IaId).To(Equal([4]byte{5, 6, 7, 8}))
 		})
+		It("iapd options with the NoPrefixAvail status", func() {
+			clientMessage, err := newMessage(dhcpv6.MessageTypeSolicit)
+			Expect(err).ToNot(HaveOccurred())
+			clientMessage.AddOption(&dhcpv6.OptIAPD{IaId: [4]byte{5, 6, 7, 8}})
+
+			replyMessage, err := handler.buildResponse(clientMessage)
+			Expect(err).ToNot(HaveOccurred())
+			iapd := replyMessage.Options.OneIAPD()
+			Expect(iapd).ToNot(BeNil())
+			Expect(iapd.IaId).To(Equal([4]byte{5, 6, 7, 8}))
+			Expect(iapd.Options.Prefixes()).To(BeEmpty())
+			Expect(iapd.Options.Status().StatusCode).To(Equal(iana.StatusNoPrefixAvail))
+		})
 		It("the correct number of options", func() {
 			clientMessage, err := newMessage(dhcpv6.MessageTypeSolicit)
 			Expect(err).ToNot(HaveOccurred())
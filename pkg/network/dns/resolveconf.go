@@ -16,6 +16,7 @@ const (
 	nameserverPrefix    = "nameserver"
 	defaultDNS          = "8.8.8.8"
 	defaultSearchDomain = "cluster.local"
+	resolvConf          = "/etc/resolv.conf"
 )
 
 func ParseNameservers(content string) ([][]byte, error) {
@@ -50,6 +51,29 @@ func ParseNameservers(content string) ([][]byte, error) {
 	return nameservers, nil
 }
 
+// ParseIPv6Nameservers returns the IPv6 nameservers, without a default
+func ParseIPv6Nameservers(content string) ([]net.IP, error) {
+	var nameservers []net.IP
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != nameserverPrefix {
+			continue
+		}
+		if ip := net.ParseIP(fields[1]); ip != nil && ip.To4() == nil {
+			nameservers = append(nameservers, ip)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nameservers, nil
+}
+
 func ParseSearchDomains(content string) ([]string, error) {
 	var searchDomains []string
 
@@ -128,27 +152,32 @@ func DomainNameWithSubdomain(searchDomains []string, subdomain string) string {
 }
 
 // GetResolvConfDetailsFromPod reads and parses the DNS resolver's configuration file.
-func GetResolvConfDetailsFromPod() ([][]byte, []string, error) {
+// It returns the IPv4 nameservers, the IPv6 nameservers and the search domains.
+func GetResolvConfDetailsFromPod() ([][]byte, []net.IP, []string, error) {
 	// #nosec No risk for path injection. resolvConf is static "/etc/resolve.conf"
-	const resolvConf = "/etc/resolv.conf"
-
 	b, err := os.ReadFile(resolvConf)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	nameservers, err := ParseNameservers(string(b))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+
+	ipv6Nameservers, err := ParseIPv6Nameservers(string(b))
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
 	searchDomains, err := ParseSearchDomains(string(b))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	log.Log.Reason(err).Infof("Found nameservers in %s: %s", resolvConf, bytes.Join(nameservers, []byte{' '}))
+	log.Log.Reason(err).Infof("Found IPv6 nameservers in %s: %v", resolvConf, ipv6Nameservers)
 	log.Log.Reason(err).Infof("Found search domains in %s: %s", resolvConf, strings.Join(searchDomains, " "))
 
-	return nameservers, searchDomains, err
+	return nameservers, ipv6Nameservers, searchDomains, err
 }
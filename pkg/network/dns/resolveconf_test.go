@@ -34,6 +34,21 @@ var _ = Describe("Resolveconf", func() {
 		})
 	})
 
+	Context("Function ParseIPv6Nameservers()", func() {
+		It("should return the IPv6 nameservers", func() {
+			resolvConf := "nameserver 8.8.8.8\nnameserver fd00:10:96::a\nnameserver mynameserver\nnameserver 2001:4860:4860::8888\n"
+			nameservers, err := ParseIPv6Nameservers(resolvConf)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nameservers).To(Equal([]net.IP{net.ParseIP("fd00:10:96::a"), net.ParseIP("2001:4860:4860::8888")}))
+		})
+
+		It("should not return a default nameserver if none is parsed", func() {
+			nameservers, err := ParseIPv6Nameservers("nameserver 8.8.8.8\n")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nameservers).To(BeEmpty())
+		})
+	})
+
 	Context("Function ParseSearchDomains()", func() {
 		It("should return a string of search domains", func() {
 			resolvConf := "search cluster.local svc.cluster.local example.com\nnameserver 8.8.8.8\n"
@@ -29,8 +29,9 @@ type NFTBin struct{}
 type IPFamily string
 
 const (
-	IPv4 IPFamily = "ip"
-	IPv6 IPFamily = "ip6"
+	IPv4   IPFamily = "ip"
+	IPv6   IPFamily = "ip6"
+	Bridge IPFamily = "bridge"
 )
 
 const (
@@ -48,6 +49,11 @@ func (n NFTBin) AddChain(family IPFamily, table, name string, chainspec ...strin
 	return execute(cmd)
 }
 
+func (n NFTBin) FlushChain(family IPFamily, table, name string) error {
+	cmd := exec.Command(nftBin, "flush", "chain", string(family), table, name)
+	return execute(cmd)
+}
+
 func (n NFTBin) AddRule(family IPFamily, table, chain string, rulespec ...string) error {
 	args := append([]string{"add", "rule", string(family), table, chain}, rulespec...)
 	cmd := exec.Command(nftBin, args...)
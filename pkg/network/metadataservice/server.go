@@ -0,0 +1,392 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package metadataservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
+	"kubevirt.io/kubevirt/pkg/util/net/dns"
+)
+
+const (
+	// IPv4Address is the link-local address the guest reaches the metadata service on.
+	// It is assigned to the masquerade bridge of the pod network.
+	IPv4Address = "169.254.169.254"
+	// HTTPPort is the port the guest reaches the metadata service on.
+	// The masquerade NAT redirects it to Port, as a non-root virt-launcher cannot bind it.
+	HTTPPort = 80
+	// Port is the unprivileged port the metadata service listens on.
+	Port = 8775
+
+	readHeaderTimeout = 10 * time.Second
+
+	ec2TokenPath      = "/latest/api/token"
+	ec2TokenHeader    = "X-aws-ec2-metadata-token"
+	ec2TokenTTLHeader = "X-aws-ec2-metadata-token-ttl-seconds"
+	ec2TokenMaxTTL    = 6 * time.Hour
+	// ec2MaxTokens bounds the live session tokens, as each may last up to ec2TokenMaxTTL
+	ec2MaxTokens      = 64
+	openStackRootPath = "openstack"
+)
+
+// Server answers the EC2 and, when enabled, the OpenStack metadata requests of the guest.
+// The data is read on every request, reflecting the updates of the secrets
+// referenced by the access credentials and the cloud-init volume.
+type Server struct {
+	vmi             *v1.VirtualMachineInstance
+	secretSourceDir string
+	serveOpenStack  bool
+
+	// ec2Tokens holds the expiration time of the IMDSv2 session tokens
+	ec2Tokens      map[string]time.Time
+	ec2TokensMutex sync.Mutex
+}
+
+type instanceData struct {
+	instanceID   string
+	hostname     string
+	instanceType string
+	publicKeys   []string
+	userData     string
+	// networkData is set only when it is in the OpenStack network_data.json format
+	networkData string
+}
+
+func NewServer(vmi *v1.VirtualMachineInstance, secretSourceDir string) *Server {
+	return &Server{
+		vmi:             vmi.DeepCopy(),
+		secretSourceDir: secretSourceDir,
+		serveOpenStack:  vmi.Spec.MetadataService != nil && vmi.Spec.MetadataService.OpenStack != nil,
+		ec2Tokens:       map[string]time.Time{},
+	}
+}
+
+// Start serves the metadata of the VMI on the link-local address, from a background goroutine.
+// The address and the redirection of HTTPPort have to be set up in the pod beforehand.
+func Start(vmi *v1.VirtualMachineInstance, secretSourceDir string) error {
+	listener, err := net.Listen("tcp", net.JoinHostPort(IPv4Address, strconv.Itoa(Port)))
+	if err != nil {
+		return fmt.Errorf("failed to listen on the metadata service address: %v", err)
+	}
+
+	server := &http.Server{
+		Handler:           NewServer(vmi, secretSourceDir),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Log.Object(vmi).Reason(err).Error("metadata service stopped")
+		}
+	}()
+	return nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPut && r.URL.Path == ec2TokenPath {
+		s.serveEC2Token(w, r)
+		return
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var path []string
+	if trimmedPath := strings.Trim(r.URL.Path, "/"); trimmedPath != "" {
+		path = strings.Split(trimmedPath, "/")
+	}
+
+	isOpenStackPath := len(path) > 0 && path[0] == openStackRootPath
+	if isOpenStackPath && !s.serveOpenStack {
+		http.NotFound(w, r)
+		return
+	}
+	// The EC2 metadata is served to IMDSv2 clients only, holding a valid session token.
+	// The OpenStack data source of cloud-init has no session tokens, its tree is served only when enabled.
+	if len(path) > 0 && !isOpenStackPath && !s.validEC2Token(r.Header.Get(ec2TokenHeader)) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	data, err := s.readInstanceData()
+	if err != nil {
+		log.Log.Object(s.vmi).Reason(err).Error("failed to read the metadata of the instance")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	var content string
+	var found bool
+	switch {
+	case len(path) == 0:
+		content, found = s.rootListing(), true
+	case isOpenStackPath:
+		content, found, err = openStackContent(path[1:], data)
+	default:
+		content, found = ec2Content(path[1:], data)
+	}
+	if err != nil {
+		log.Log.Object(s.vmi).Reason(err).Errorf("failed to serve the metadata path %s", r.URL.Path)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !found {
+		http.NotFound(w, r)
+		return
+	}
+	writeResponse(w, content)
+}
+
+func (s *Server) rootListing() string {
+	if s.serveOpenStack {
+		return listing("latest/", openStackRootPath+"/")
+	}
+	return listing("latest/")
+}
+
+// serveEC2Token issues an IMDSv2 session token, valid for the TTL requested by the client.
+// Up to ec2MaxTokens tokens are live at once, further requests are rejected until some expire.
+func (s *Server) serveEC2Token(w http.ResponseWriter, r *http.Request) {
+	ttlSeconds, err := strconv.Atoi(r.Header.Get(ec2TokenTTLHeader))
+	if err != nil || ttlSeconds < 1 || time.Duration(ttlSeconds)*time.Second > ec2TokenMaxTTL {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	token := uuid.NewString()
+	now := time.Now()
+
+	s.ec2TokensMutex.Lock()
+	for t, expiration := range s.ec2Tokens {
+		if !now.Before(expiration) {
+			delete(s.ec2Tokens, t)
+		}
+	}
+	if len(s.ec2Tokens) >= ec2MaxTokens {
+		s.ec2TokensMutex.Unlock()
+		http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+		return
+	}
+	s.ec2Tokens[token] = now.Add(time.Duration(ttlSeconds) * time.Second)
+	s.ec2TokensMutex.Unlock()
+
+	w.Header().Set(ec2TokenTTLHeader, strconv.Itoa(ttlSeconds))
+	writeResponse(w, token)
+}
+
+func (s *Server) validEC2Token(token string) bool {
+	if token == "" {
+		return false
+	}
+	s.ec2TokensMutex.Lock()
+	defer s.ec2TokensMutex.Unlock()
+	expiration, exists := s.ec2Tokens[token]
+	if !exists {
+		return false
+	}
+	if !time.Now().Before(expiration) {
+		delete(s.ec2Tokens, token)
+		return false
+	}
+	return true
+}
+
+func (s *Server) readInstanceData() (*instanceData, error) {
+	// Resolving the cloud-init data populates the volumes of the VMI
+	vmi := s.vmi.DeepCopy()
+
+	cloudInitData, err := cloudinit.ReadCloudInitVolumeDataSource(vmi, s.secretSourceDir)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := cloudinit.ResolveSSHPublicKeys(vmi, s.secretSourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &instanceData{
+		instanceID:   instanceID(vmi),
+		hostname:     dns.SanitizeHostname(vmi),
+		instanceType: instanceType(vmi),
+	}
+	for idx := 0; idx < len(keys); idx++ {
+		data.publicKeys = append(data.publicKeys, keys[strconv.Itoa(idx)])
+	}
+	if cloudInitData != nil {
+		data.userData = cloudInitData.UserData
+		if cloudInitData.DataSource == cloudinit.DataSourceConfigDrive {
+			data.networkData = cloudInitData.NetworkData
+		}
+	}
+	return data, nil
+}
+
+// instanceID returns the instance ID requested by the CloudInitInstanceIDAnnotation of the VMI,
+// or its firmware UUID, as the noCloud data source does.
+func instanceID(vmi *v1.VirtualMachineInstance) string {
+	if id := vmi.Annotations[v1.CloudInitInstanceIDAnnotation]; id != "" {
+		return id
+	}
+	if vmi.Spec.Domain.Firmware != nil && vmi.Spec.Domain.Firmware.UUID != "" {
+		return string(vmi.Spec.Domain.Firmware.UUID)
+	}
+	return string(vmi.UID)
+}
+
+func instanceType(vmi *v1.VirtualMachineInstance) string {
+	if clusterInstancetype := vmi.Annotations[v1.ClusterInstancetypeAnnotation]; clusterInstancetype != "" {
+		return clusterInstancetype
+	}
+	return vmi.Annotations[v1.InstancetypeAnnotation]
+}
+
+// ec2Content returns the content of the EC2 path, following the API version
+func ec2Content(path []string, data *instanceData) (string, bool) {
+	if len(path) == 0 {
+		if data.userData == "" {
+			return listing("meta-data/"), true
+		}
+		return listing("meta-data/", "user-data"), true
+	}
+
+	switch path[0] {
+	case "user-data":
+		return data.userData, len(path) == 1 && data.userData != ""
+	case "meta-data":
+		return ec2MetaData(path[1:], data)
+	}
+	return "", false
+}
+
+func ec2MetaData(path []string, data *instanceData) (string, bool) {
+	switch strings.Join(path, "/") {
+	case "":
+		entries := []string{"hostname", "instance-id", "local-hostname"}
+		if data.instanceType != "" {
+			entries = append(entries, "instance-type")
+		}
+		if len(data.publicKeys) > 0 {
+			entries = append(entries, "public-keys/")
+		}
+		return listing(entries...), true
+	case "instance-id":
+		return data.instanceID, true
+	case "hostname", "local-hostname":
+		return data.hostname, true
+	case "instance-type":
+		return data.instanceType, data.instanceType != ""
+	case "public-keys":
+		var entries []string
+		for idx := range data.publicKeys {
+			entries = append(entries, fmt.Sprintf("%d=key-%d", idx, idx))
+		}
+		return listing(entries...), len(entries) > 0
+	}
+
+	if path[0] != "public-keys" || len(path) > 3 {
+		return "", false
+	}
+	idx, err := strconv.Atoi(path[1])
+	if err != nil || idx < 0 || idx >= len(data.publicKeys) {
+		return "", false
+	}
+	if len(path) == 2 {
+		return listing("openssh-key"), true
+	}
+	return data.publicKeys[idx], path[2] == "openssh-key"
+}
+
+// openStackContent returns the content of the OpenStack path, following the API version
+func openStackContent(path []string, data *instanceData) (string, bool, error) {
+	if len(path) == 0 {
+		return listing("latest"), true, nil
+	}
+	if len(path) == 1 {
+		entries := []string{"meta_data.json"}
+		if data.userData != "" {
+			entries = append(entries, "user_data")
+		}
+		if data.networkData != "" {
+			entries = append(entries, "network_data.json")
+		}
+		return listing(entries...), true, nil
+	}
+	if len(path) > 2 {
+		return "", false, nil
+	}
+
+	switch path[1] {
+	case "meta_data.json":
+		content, err := openStackMetaData(data)
+		return content, err == nil, err
+	case "user_data":
+		return data.userData, data.userData != "", nil
+	case "network_data.json":
+		return data.networkData, data.networkData != "", nil
+	}
+	return "", false, nil
+}
+
+func openStackMetaData(data *instanceData) (string, error) {
+	metadata := cloudinit.ConfigDriveMetadata{
+		InstanceType:  data.instanceType,
+		InstanceID:    data.instanceID,
+		LocalHostname: data.hostname,
+		Hostname:      data.hostname,
+		// The OpenStack data source of cloud-init takes the instance ID from the UUID
+		UUID: data.instanceID,
+	}
+	if len(data.publicKeys) > 0 {
+		metadata.PublicSSHKeys = map[string]string{}
+		for idx, key := range data.publicKeys {
+			metadata.PublicSSHKeys[strconv.Itoa(idx)] = key
+		}
+	}
+
+	content, err := json.Marshal(metadata)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the OpenStack metadata: %v", err)
+	}
+	return string(content), nil
+}
+
+func listing(entries ...string) string {
+	return strings.Join(entries, "\n")
+}
+
+func writeResponse(w http.ResponseWriter, content string) {
+	w.Header().Set("Content-Type", "text/plain")
+	if _, err := w.Write([]byte(content)); err != nil {
+		log.Log.Reason(err).Warning("failed to write the metadata service response")
+	}
+}
@@ -0,0 +1,236 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package metadataservice_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/metadataservice"
+)
+
+var _ = Describe("Metadata service", func() {
+	const (
+		instanceUUID = "5d307ca9-b3ef-428c-8861-06e72d69f223"
+		sshKey       = "ssh-ed25519 AAAA test@kubevirt"
+		userData     = "#cloud-config\npassword: fedora\n"
+		keySecret    = "my-key"
+	)
+
+	var (
+		secretSourceDir string
+		vmi             *v1.VirtualMachineInstance
+		server          *httptest.Server
+	)
+
+	writeSSHKey := func(key string) {
+		keyDir := filepath.Join(secretSourceDir, keySecret+"-access-cred")
+		Expect(os.MkdirAll(keyDir, 0700)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(keyDir, "key"), []byte(key), 0600)).To(Succeed())
+	}
+
+	do := func(method, path string, header http.Header) (int, string) {
+		req, err := http.NewRequest(method, server.URL+path, nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header = header
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return resp.StatusCode, string(body)
+	}
+
+	requestToken := func(ttlSeconds string) (int, string) {
+		return do(http.MethodPut, "/latest/api/token", http.Header{"X-Aws-Ec2-Metadata-Token-Ttl-Seconds": {ttlSeconds}})
+	}
+
+	get := func(path string) (int, string) {
+		status, token := requestToken("60")
+		Expect(status).To(Equal(http.StatusOK))
+		return do(http.MethodGet, path, http.Header{"X-Aws-Ec2-Metadata-Token": {token}})
+	}
+
+	startServer := func() {
+		server = httptest.NewServer(metadataservice.NewServer(vmi, secretSourceDir))
+		DeferCleanup(server.Close)
+	}
+
+	BeforeEach(func() {
+		secretSourceDir = GinkgoT().TempDir()
+
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default"},
+			Spec: v1.VirtualMachineInstanceSpec{
+				Domain:          v1.DomainSpec{Firmware: &v1.Firmware{UUID: instanceUUID}},
+				MetadataService: &v1.MetadataService{OpenStack: &v1.MetadataServiceOpenStack{}},
+				Volumes: []v1.Volume{{
+					Name: "cloudinit",
+					VolumeSource: v1.VolumeSource{
+						CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{
+							UserData:    userData,
+							NetworkData: `{"links": []}`,
+						},
+					},
+				}},
+				AccessCredentials: []v1.AccessCredential{{
+					SSHPublicKey: &v1.SSHPublicKeyAccessCredential{
+						Source: v1.SSHPublicKeyAccessCredentialSource{
+							Secret: &v1.AccessCredentialSecretSource{SecretName: keySecret},
+						},
+						PropagationMethod: v1.SSHPublicKeyAccessCredentialPropagationMethod{
+							QemuGuestAgent: &v1.QemuGuestAgentSSHPublicKeyAccessCredentialPropagation{Users: []string{"fedora"}},
+						},
+					},
+				}},
+			},
+		}
+		writeSSHKey(sshKey)
+	})
+
+	DescribeTable("should serve", func(path, expectedContent string) {
+		startServer()
+		status, content := get(path)
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(content).To(Equal(expectedContent))
+	},
+		Entry("the root index", "/", "latest/\nopenstack/"),
+		Entry("the EC2 version index", "/latest", "meta-data/\nuser-data"),
+		Entry("the EC2 metadata index", "/latest/meta-data/", "hostname\ninstance-id\nlocal-hostname\npublic-keys/"),
+		Entry("the EC2 instance ID", "/latest/meta-data/instance-id", instanceUUID),
+		Entry("the EC2 instance ID of a dated version", "/2009-04-04/meta-data/instance-id", instanceUUID),
+		Entry("the EC2 hostname", "/latest/meta-data/local-hostname", "testvmi"),
+		Entry("the EC2 public keys index", "/latest/meta-data/public-keys/", "0=key-0"),
+		Entry("the EC2 public key formats", "/latest/meta-data/public-keys/0/", "openssh-key"),
+		Entry("the EC2 public key", "/latest/meta-data/public-keys/0/openssh-key", sshKey),
+		Entry("the EC2 user data", "/latest/user-data", userData),
+		Entry("the OpenStack versions", "/openstack/", "latest"),
+		Entry("the OpenStack version index", "/openstack/latest/", "meta_data.json\nuser_data\nnetwork_data.json"),
+		Entry("the OpenStack metadata", "/openstack/latest/meta_data.json",
+			`{"instance_id":"`+instanceUUID+`","local_hostname":"testvmi","hostname":"testvmi","uuid":"`+instanceUUID+`",`+
+				`"public_keys":{"0":"`+sshKey+`"}}`),
+		Entry("the OpenStack user data", "/openstack/latest/user_data", userData),
+		Entry("the OpenStack network data", "/openstack/latest/network_data.json", `{"links": []}`),
+	)
+
+	DescribeTable("should not find", func(path string) {
+		startServer()
+		status, _ := get(path)
+		Expect(status).To(Equal(http.StatusNotFound))
+	},
+		Entry("an unknown EC2 metadata", "/latest/meta-data/placement/"),
+		Entry("a public key out of range", "/latest/meta-data/public-keys/1/openssh-key"),
+		Entry("an unknown public key format", "/latest/meta-data/public-keys/0/ssh-rsa"),
+		Entry("an unknown OpenStack file", "/openstack/latest/vendor_data.json"),
+	)
+
+	It("should use the instance ID of the annotation", func() {
+		vmi.Annotations = map[string]string{v1.CloudInitInstanceIDAnnotation: "new-instance-id"}
+		startServer()
+		_, content := get("/latest/meta-data/instance-id")
+		Expect(content).To(Equal("new-instance-id"))
+	})
+
+	It("should not serve user data when the VMI has no cloud-init volume", func() {
+		vmi.Spec.Volumes = nil
+		startServer()
+		status, _ := get("/latest/user-data")
+		Expect(status).To(Equal(http.StatusNotFound))
+		_, content := get("/openstack/latest/")
+		Expect(content).To(Equal("meta_data.json"))
+	})
+
+	It("should serve the updated SSH public key", func() {
+		startServer()
+		const updatedSSHKey = "ssh-ed25519 BBBB test@kubevirt"
+		writeSSHKey(updatedSSHKey)
+		_, content := get("/latest/meta-data/public-keys/0/openssh-key")
+		Expect(content).To(Equal(updatedSSHKey))
+	})
+
+	It("should provide an IMDSv2 session token", func() {
+		startServer()
+		status, token := requestToken("21600")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(token).ToNot(BeEmpty())
+	})
+
+	DescribeTable("should not provide an IMDSv2 session token", func(header http.Header) {
+		startServer()
+		status, _ := do(http.MethodPut, "/latest/api/token", header)
+		Expect(status).To(Equal(http.StatusBadRequest))
+	},
+		Entry("without a TTL", http.Header{}),
+		Entry("with an invalid TTL", http.Header{"X-Aws-Ec2-Metadata-Token-Ttl-Seconds": {"ttl"}}),
+		Entry("with a TTL of zero", http.Header{"X-Aws-Ec2-Metadata-Token-Ttl-Seconds": {"0"}}),
+		Entry("with a TTL above six hours", http.Header{"X-Aws-Ec2-Metadata-Token-Ttl-Seconds": {"21601"}}),
+	)
+
+	DescribeTable("should reject an EC2 request", func(header http.Header) {
+		startServer()
+		status, _ := do(http.MethodGet, "/latest/meta-data/instance-id", header)
+		Expect(status).To(Equal(http.StatusUnauthorized))
+	},
+		Entry("without a session token", http.Header{}),
+		Entry("with an unknown session token", http.Header{"X-Aws-Ec2-Metadata-Token": {"unknown"}}),
+	)
+
+	It("should serve the OpenStack metadata without a session token", func() {
+		startServer()
+		status, _ := do(http.MethodGet, "/openstack/latest/meta_data.json", http.Header{})
+		Expect(status).To(Equal(http.StatusOK))
+	})
+
+	It("should not serve the OpenStack metadata when it is not enabled", func() {
+		vmi.Spec.MetadataService.OpenStack = nil
+		startServer()
+		status, _ := get("/openstack/latest/user_data")
+		Expect(status).To(Equal(http.StatusNotFound))
+		_, content := get("/")
+		Expect(content).To(Equal("latest/"))
+	})
+
+	It("should limit the number of live IMDSv2 session tokens", func() {
+		startServer()
+		for range 64 {
+			status, _ := requestToken("60")
+			Expect(status).To(Equal(http.StatusOK))
+		}
+		status, _ := requestToken("60")
+		Expect(status).To(Equal(http.StatusTooManyRequests))
+	})
+
+	It("should fail when the SSH public key secret is not mounted", func() {
+		Expect(os.RemoveAll(filepath.Join(secretSourceDir, keySecret+"-access-cred"))).To(Succeed())
+		startServer()
+		status, _ := get("/latest/meta-data/instance-id")
+		Expect(status).To(Equal(http.StatusInternalServerError))
+	})
+})
@@ -0,0 +1,141 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package routeradvertiser
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/netns"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+func NewManager() *Manager {
+	return &Manager{
+		stopServer: make(map[types.UID]context.CancelFunc),
+	}
+}
+
+// Manager controls the lifetime of the router advertisement servers.
+// The servers run from virt-handler, as virt-launcher is not allowed to open raw sockets.
+// Each server is tied to the lifetime of the VMI and Manager itself.
+type Manager struct {
+	lock       sync.Mutex
+	done       bool
+	stopServer map[types.UID]context.CancelFunc
+}
+
+// Run blocks until stopCh is closed. When done, it stops all remaining
+// running router advertisement servers.
+func (m *Manager) Run(stopCh chan struct{}) {
+	defer m.stop()
+	<-stopCh
+}
+
+func (m *Manager) stop() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.done = true
+
+	for vmiUID, stopServerFn := range m.stopServer {
+		stopServerFn()
+		delete(m.stopServer, vmiUID)
+	}
+}
+
+// StopServer stops the router advertisement server of the VMI, if any
+func (m *Manager) StopServer(vmi *v1.VirtualMachineInstance) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.done {
+		return
+	}
+
+	if cancelCtx, exists := m.stopServer[vmi.UID]; exists {
+		cancelCtx()
+		delete(m.stopServer, vmi.UID)
+	}
+}
+
+// StartServer starts a router advertisement server on the masquerade bridge of the VMI pod network.
+// The server is started only when the pod network has IPv6 and is not already started.
+// Until the pod bridge has a global IPv6 address, the VMI is left untracked and each call retries.
+func (m *Manager) StartServer(vmi *v1.VirtualMachineInstance, pid int) error {
+	podIfaceName, exists := masqueradePodInterfaceName(vmi)
+	if !exists || !vmi.IsRunning() {
+		return nil
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.done {
+		return nil
+	}
+
+	if _, alreadyStarted := m.stopServer[vmi.UID]; alreadyStarted {
+		return nil
+	}
+
+	var srv *server
+	err := netns.New(pid).Do(func() error {
+		var err error
+		srv, err = newServer(link.GenerateBridgeName(podIfaceName))
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start the router advertisement server for VMI [%s], error: %v", vmi.GetName(), err)
+	}
+
+	// The pod may not have a global IPv6 address yet, leave the VMI untracked so a later sync retries
+	if srv == nil {
+		return nil
+	}
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go srv.Serve(ctx)
+	m.stopServer[vmi.UID] = cancelCtx
+
+	return nil
+}
+
+func masqueradePodInterfaceName(vmi *v1.VirtualMachineInstance) (string, bool) {
+	podNetwork := vmispec.LookupPodNetwork(vmi.Spec.Networks)
+	if podNetwork == nil {
+		return "", false
+	}
+	iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, podNetwork.Name)
+	if iface == nil || iface.Masquerade == nil {
+		return "", false
+	}
+
+	if ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, iface.Name); ifaceStatus != nil &&
+		ifaceStatus.PodInterfaceName != "" {
+		return ifaceStatus.PodInterfaceName, true
+	}
+	return namescheme.PrimaryPodInterfaceName, true
+}
@@ -0,0 +1,62 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package routeradvertiser_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/routeradvertiser"
+)
+
+var _ = Describe("Router advertisement manager", func() {
+	It("should ignore a VMI without masquerade binding on the pod network", func() {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+		vmi.Status.Phase = v1.Running
+
+		manager := routeradvertiser.NewManager()
+		Expect(manager.StartServer(vmi, -1)).To(Succeed())
+		manager.StopServer(vmi)
+	})
+
+	It("should ignore a VMI which is not running", func() {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		vmi.Status.Phase = v1.Scheduled
+
+		manager := routeradvertiser.NewManager()
+		Expect(manager.StartServer(vmi, -1)).To(Succeed())
+	})
+
+	It("should fail to start the server when the pod network namespace is not found", func() {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		vmi.Status.Phase = v1.Running
+
+		manager := routeradvertiser.NewManager()
+		Expect(manager.StartServer(vmi, -1)).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package routeradvertiser
+
+import (
+	"encoding/binary"
+	"net"
+	"time"
+
+	"golang.org/x/net/ipv6"
+)
+
+const (
+	optionSourceLinkLayerAddress = 1
+	optionPrefixInformation      = 3
+	optionMTU                    = 5
+
+	curHopLimit = 64
+
+	// managedAddressConfigurationFlag tells the guest to acquire its address using DHCPv6
+	managedAddressConfigurationFlag = 0x80
+	// otherConfigurationFlag tells the guest to acquire the DNS and other options using DHCPv6
+	otherConfigurationFlag = 0x40
+	// onLinkFlag tells the guest the prefix is directly reachable, without going through the router
+	onLinkFlag = 0x80
+
+	routerLifetime = 30 * time.Minute
+	prefixLifetime = 24 * time.Hour
+
+	raHeaderLen     = 16
+	optionLenUnit   = 8
+	prefixOptionLen = 32
+	mtuOptionLen    = 8
+)
+
+// RouterAdvertisement describes the router advertisement (RFC 4861) sent to the guest.
+// The router advertises itself as the default gateway, the prefix as on-link and
+// requests the guest to configure its address and options using DHCPv6.
+type RouterAdvertisement struct {
+	SourceMAC net.HardwareAddr
+	MTU       uint32
+	Prefix    *net.IPNet
+}
+
+// Marshal returns the ICMPv6 message of the router advertisement.
+// The checksum is left empty, it is computed by the kernel.
+func (ra RouterAdvertisement) Marshal() []byte {
+	msg := make([]byte, raHeaderLen)
+	msg[0] = byte(ipv6.ICMPTypeRouterAdvertisement)
+	msg[4] = curHopLimit
+	msg[5] = managedAddressConfigurationFlag | otherConfigurationFlag
+	binary.BigEndian.PutUint16(msg[6:8], uint16(routerLifetime.Seconds()))
+	// Reachable time and retransmission timer are left unspecified (zero)
+
+	if len(ra.SourceMAC) > 0 {
+		msg = append(msg, sourceLinkLayerAddressOption(ra.SourceMAC)...)
+	}
+	if ra.MTU > 0 {
+		msg = append(msg, mtuOption(ra.MTU)...)
+	}
+	if ra.Prefix != nil {
+		msg = append(msg, prefixInformationOption(ra.Prefix)...)
+	}
+	return msg
+}
+
+func sourceLinkLayerAddressOption(mac net.HardwareAddr) []byte {
+	optionLen := roundUp(2+len(mac), optionLenUnit)
+	option := make([]byte, optionLen)
+	option[0] = optionSourceLinkLayerAddress
+	option[1] = byte(optionLen / optionLenUnit)
+	copy(option[2:], mac)
+	return option
+}
+
+func mtuOption(mtu uint32) []byte {
+	option := make([]byte, mtuOptionLen)
+	option[0] = optionMTU
+	option[1] = mtuOptionLen / optionLenUnit
+	binary.BigEndian.PutUint32(option[4:8], mtu)
+	return option
+}
+
+func prefixInformationOption(prefix *net.IPNet) []byte {
+	prefixLength, _ := prefix.Mask.Size()
+
+	option := make([]byte, prefixOptionLen)
+	option[0] = optionPrefixInformation
+	option[1] = prefixOptionLen / optionLenUnit
+	option[2] = byte(prefixLength)
+	// The autonomous flag is not set, the address is assigned by DHCPv6
+	option[3] = onLinkFlag
+	binary.BigEndian.PutUint32(option[4:8], uint32(prefixLifetime.Seconds()))
+	binary.BigEndian.PutUint32(option[8:12], uint32(prefixLifetime.Seconds()))
+	copy(option[16:32], prefix.IP.Mask(prefix.Mask).To16())
+	return option
+}
+
+func roundUp(length, unit int) int {
+	return (length + unit - 1) / unit * unit
+}
@@ -0,0 +1,64 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package routeradvertiser_test
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/routeradvertiser"
+)
+
+var _ = Describe("Router advertisement", func() {
+	raHeader := []byte{
+		0x86, 0x00, 0x00, 0x00, // type, code, checksum
+		0x40, 0xc0, 0x07, 0x08, // hop limit, managed and other flags, router lifetime of 1800s
+		0x00, 0x00, 0x00, 0x00, // reachable time
+		0x00, 0x00, 0x00, 0x00, // retransmission timer
+	}
+
+	It("should marshal the header when there are no options", func() {
+		Expect(routeradvertiser.RouterAdvertisement{}.Marshal()).To(Equal(raHeader))
+	})
+
+	It("should marshal the source link-layer address, MTU and prefix options", func() {
+		mac, err := net.ParseMAC("02:00:00:00:00:00")
+		Expect(err).ToNot(HaveOccurred())
+		_, prefix, err := net.ParseCIDR("fd10:0:2::1/120")
+		Expect(err).ToNot(HaveOccurred())
+
+		ra := routeradvertiser.RouterAdvertisement{SourceMAC: mac, MTU: 1500, Prefix: prefix}
+
+		expected := append([]byte{}, raHeader...)
+		expected = append(expected,
+			0x01, 0x01, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, // source link-layer address
+			0x05, 0x01, 0x00, 0x00, 0x00, 0x00, 0x05, 0xdc, // MTU
+			0x03, 0x04, 0x78, 0x80, // prefix information, /120 on-link
+			0x00, 0x01, 0x51, 0x80, // valid lifetime of 1 day
+			0x00, 0x01, 0x51, 0x80, // preferred lifetime of 1 day
+			0x00, 0x00, 0x00, 0x00, // reserved
+			0xfd, 0x10, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		)
+		Expect(ra.Marshal()).To(Equal(expected))
+	})
+})
@@ -0,0 +1,164 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package routeradvertiser
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/net/ipv6"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	advertisementInterval = 200 * time.Second
+	// Router advertisements and solicitations are only valid when received with a hop limit of 255
+	ndpHopLimit = 255
+	maxMsgSize  = 1500
+)
+
+type server struct {
+	conn   *ipv6.PacketConn
+	iface  *net.Interface
+	source net.IP
+	msg    []byte
+}
+
+// newServer creates the router advertisement server of the bridge.
+// It has to be called from the network namespace of the bridge; the server
+// keeps using that namespace regardless of the goroutine which runs it.
+// A nil server is returned when the bridge has no global IPv6 address to advertise.
+func newServer(bridgeName string) (*server, error) {
+	iface, err := net.InterfaceByName(bridgeName)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, err
+	}
+
+	var prefix *net.IPNet
+	var source net.IP
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.To4() != nil {
+			continue
+		}
+		if ipNet.IP.IsLinkLocalUnicast() {
+			source = ipNet.IP
+		} else if ipNet.IP.IsGlobalUnicast() {
+			prefix = &net.IPNet{IP: ipNet.IP.Mask(ipNet.Mask), Mask: ipNet.Mask}
+		}
+	}
+	if prefix == nil || source == nil {
+		return nil, nil
+	}
+
+	conn, err := listen(iface)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for router solicitations on %s: %v", bridgeName, err)
+	}
+
+	ra := RouterAdvertisement{
+		SourceMAC: iface.HardwareAddr,
+		MTU:       uint32(iface.MTU),
+		Prefix:    prefix,
+	}
+	return &server{conn: conn, iface: iface, source: source, msg: ra.Marshal()}, nil
+}
+
+func listen(iface *net.Interface) (*ipv6.PacketConn, error) {
+	c, err := net.ListenPacket("ip6:ipv6-icmp", "::")
+	if err != nil {
+		return nil, err
+	}
+	conn := ipv6.NewPacketConn(c)
+
+	var filter ipv6.ICMPFilter
+	filter.SetAll(true)
+	filter.Accept(ipv6.ICMPTypeRouterSolicitation)
+
+	setup := []func() error{
+		func() error { return conn.SetICMPFilter(&filter) },
+		func() error { return conn.SetControlMessage(ipv6.FlagInterface|ipv6.FlagHopLimit, true) },
+		func() error { return conn.SetMulticastHopLimit(ndpHopLimit) },
+		func() error { return conn.SetHopLimit(ndpHopLimit) },
+		func() error { return conn.SetMulticastInterface(iface) },
+		func() error { return conn.JoinGroup(iface, &net.IPAddr{IP: net.IPv6linklocalallrouters}) },
+	}
+	for _, f := range setup {
+		if err := f(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// Serve sends the router advertisement periodically and in reply to the guest
+// router solicitations, until the context is cancelled.
+func (s *server) Serve(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		s.conn.Close()
+	}()
+	go s.replySolicitations(ctx)
+
+	ticker := time.NewTicker(advertisementInterval)
+	defer ticker.Stop()
+	for {
+		s.advertise()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *server) replySolicitations(ctx context.Context) {
+	buf := make([]byte, maxMsgSize)
+	for {
+		n, cm, _, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Log.Reason(err).Errorf("failed to read router solicitations on %s", s.iface.Name)
+			}
+			return
+		}
+		if n == 0 || buf[0] != byte(ipv6.ICMPTypeRouterSolicitation) || cm == nil ||
+			cm.IfIndex != s.iface.Index || cm.HopLimit != ndpHopLimit {
+			continue
+		}
+		s.advertise()
+	}
+}
+
+func (s *server) advertise() {
+	cm := &ipv6.ControlMessage{HopLimit: ndpHopLimit, Src: s.source, IfIndex: s.iface.Index}
+	dst := &net.IPAddr{IP: net.IPv6linklocalallnodes, Zone: s.iface.Name}
+	if _, err := s.conn.WriteTo(s.msg, cm, dst); err != nil {
+		log.Log.Reason(err).Warningf("failed to send router advertisement on %s", s.iface.Name)
+	}
+}
@@ -0,0 +1,210 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package bandwidth
+
+import (
+	"fmt"
+	"math"
+
+	vishnetlink "github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/netlink"
+)
+
+type netlinkAdapter interface {
+	LinkByName(name string) (vishnetlink.Link, error)
+	QdiscList(link vishnetlink.Link) ([]vishnetlink.Qdisc, error)
+	QdiscReplace(qdisc vishnetlink.Qdisc) error
+	QdiscDel(qdisc vishnetlink.Qdisc) error
+	ClassReplace(class vishnetlink.Class) error
+	FilterReplace(filter vishnetlink.Filter) error
+}
+
+type Shaper struct {
+	netlink netlinkAdapter
+}
+
+const (
+	bitsPerByte = 8
+
+	// The tap device may receive and transmit segmentation offloaded packets, up to 64KiB in size.
+	maxPacketSize = 64 * 1024
+	// The outbound traffic is policed with a burst of a tenth of a second at the average rate by default.
+	defaultBurstRateDivisor = 10
+
+	htbMajor       = 1
+	htbClassMinor  = 1
+	ingressMajor   = 0xffff
+	policePriority = 1
+)
+
+var (
+	htbQdiscHandle     = vishnetlink.MakeHandle(htbMajor, 0)
+	htbClassHandle     = vishnetlink.MakeHandle(htbMajor, htbClassMinor)
+	ingressQdiscHandle = vishnetlink.MakeHandle(ingressMajor, 0)
+)
+
+type option func(*Shaper)
+
+func New(opts ...option) Shaper {
+	s := Shaper{netlink: netlink.NetLink{}}
+	for _, opt := range opts {
+		opt(&s)
+	}
+	return s
+}
+
+func WithNetlinkAdapter(h netlinkAdapter) option {
+	return func(s *Shaper) {
+		s.netlink = h
+	}
+}
+
+// Setup shapes the traffic of the tap device according to the bandwidth of the VMI interface.
+// The traffic received by the guest is shaped on the egress of the tap device by an HTB class,
+// the traffic sent by the guest is policed on the ingress of the tap device.
+// The setup is idempotent, directions without limits have their shaping removed.
+func (s Shaper) Setup(tapIfaceName string, bandwidth *v1.InterfaceBandwidth) error {
+	link, err := s.netlink.LinkByName(tapIfaceName)
+	if err != nil {
+		return err
+	}
+
+	var inbound, outbound *v1.BandwidthLimits
+	if bandwidth != nil {
+		inbound, outbound = bandwidth.Inbound, bandwidth.Outbound
+	}
+
+	if err := s.setupInbound(link, inbound); err != nil {
+		return fmt.Errorf("failed to shape the inbound traffic of %s: %w", tapIfaceName, err)
+	}
+	if err := s.setupOutbound(link, outbound); err != nil {
+		return fmt.Errorf("failed to police the outbound traffic of %s: %w", tapIfaceName, err)
+	}
+	return nil
+}
+
+func (s Shaper) setupInbound(link vishnetlink.Link, limits *v1.BandwidthLimits) error {
+	if limits == nil {
+		return s.deleteQdisc(link, func(qdisc vishnetlink.Qdisc) bool {
+			_, isHtb := qdisc.(*vishnetlink.Htb)
+			return isHtb && qdisc.Attrs().Handle == htbQdiscHandle
+		})
+	}
+
+	qdisc := vishnetlink.NewHtb(vishnetlink.QdiscAttrs{
+		LinkIndex: link.Attrs().Index,
+		Handle:    htbQdiscHandle,
+		Parent:    vishnetlink.HANDLE_ROOT,
+	})
+	qdisc.Defcls = htbClassMinor
+	if err := s.netlink.QdiscReplace(qdisc); err != nil {
+		return err
+	}
+
+	classAttrs := vishnetlink.HtbClassAttrs{
+		Rate: uint64(limits.Average.Value()),
+	}
+	if limits.Peak != nil {
+		classAttrs.Ceil = uint64(limits.Peak.Value())
+	}
+	if limits.Burst != nil {
+		classAttrs.Buffer = toUint32(limits.Burst.Value())
+		classAttrs.Cbuffer = classAttrs.Buffer
+	}
+	class := vishnetlink.NewHtbClass(vishnetlink.ClassAttrs{
+		LinkIndex: link.Attrs().Index,
+		Handle:    htbClassHandle,
+		Parent:    htbQdiscHandle,
+	}, classAttrs)
+	return s.netlink.ClassReplace(class)
+}
+
+func (s Shaper) setupOutbound(link vishnetlink.Link, limits *v1.BandwidthLimits) error {
+	if limits == nil {
+		return s.deleteQdisc(link, func(qdisc vishnetlink.Qdisc) bool {
+			_, isIngress := qdisc.(*vishnetlink.Ingress)
+			return isIngress
+		})
+	}
+
+	qdisc := &vishnetlink.Ingress{QdiscAttrs: vishnetlink.QdiscAttrs{
+		LinkIndex: link.Attrs().Index,
+		Handle:    ingressQdiscHandle,
+		Parent:    vishnetlink.HANDLE_INGRESS,
+	}}
+	if err := s.netlink.QdiscReplace(qdisc); err != nil {
+		return err
+	}
+
+	filter := &vishnetlink.MatchAll{
+		FilterAttrs: vishnetlink.FilterAttrs{
+			LinkIndex: link.Attrs().Index,
+			Parent:    ingressQdiscHandle,
+			Priority:  policePriority,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		Actions: []vishnetlink.Action{newPoliceAction(limits)},
+	}
+	return s.netlink.FilterReplace(filter)
+}
+
+func (s Shaper) deleteQdisc(link vishnetlink.Link, match func(vishnetlink.Qdisc) bool) error {
+	qdiscs, err := s.netlink.QdiscList(link)
+	if err != nil {
+		return err
+	}
+	for _, qdisc := range qdiscs {
+		if match(qdisc) {
+			return s.netlink.QdiscDel(qdisc)
+		}
+	}
+	return nil
+}
+
+func newPoliceAction(limits *v1.BandwidthLimits) *vishnetlink.PoliceAction {
+	rate := toUint32(limits.Average.Value() / bitsPerByte)
+
+	burst := max(rate/defaultBurstRateDivisor, maxPacketSize)
+	if limits.Burst != nil {
+		burst = max(toUint32(limits.Burst.Value()), maxPacketSize)
+	}
+
+	police := vishnetlink.NewPoliceAction()
+	police.Rate = rate
+	police.Burst = burst
+	police.Mtu = maxPacketSize
+	police.ExceedAction = vishnetlink.TC_POLICE_SHOT
+	if limits.Peak != nil {
+		police.PeakRate = toUint32(limits.Peak.Value() / bitsPerByte)
+	}
+	return police
+}
+
+// toUint32 saturates, the admitter limits the rates and the burst to the range of the kernel policer.
+func toUint32(value int64) uint32 {
+	if value > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint32(value)
+}
@@ -0,0 +1,193 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package bandwidth_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vishnetlink "github.com/vishvananda/netlink"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/setup/bandwidth"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("bandwidth", func() {
+	const (
+		tapName  = "tap0"
+		tapIndex = 3
+	)
+
+	var nlStub *netlinkStub
+
+	BeforeEach(func() {
+		nlStub = &netlinkStub{link: &vishnetlink.Tuntap{LinkAttrs: vishnetlink.LinkAttrs{Name: tapName, Index: tapIndex}}}
+	})
+
+	It("setup fails when the tap device is missing", func() {
+		shaper := bandwidth.New(bandwidth.WithNetlinkAdapter(nlStub))
+		Expect(shaper.Setup("tap1", &v1.InterfaceBandwidth{})).To(MatchError(vishnetlink.LinkNotFoundError{}))
+	})
+
+	It("setup fails when the qdisc cannot be replaced", func() {
+		testErr := errors.New("test error")
+		nlStub.qdiscReplaceErr = testErr
+		shaper := bandwidth.New(bandwidth.WithNetlinkAdapter(nlStub))
+
+		err := shaper.Setup(tapName, &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimits{Average: resource.MustParse("10M")}})
+		Expect(err).To(MatchError(testErr))
+		Expect(err).To(MatchError(ContainSubstring("inbound traffic of tap0")))
+	})
+
+	It("shapes the inbound traffic with an HTB class", func() {
+		shaper := bandwidth.New(bandwidth.WithNetlinkAdapter(nlStub))
+
+		Expect(shaper.Setup(tapName, &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimits{
+			Average: resource.MustParse("8M"),
+			Peak:    pointer.P(resource.MustParse("16M")),
+		}})).To(Succeed())
+
+		Expect(nlStub.qdiscs).To(HaveLen(1))
+		htb, isHtb := nlStub.qdiscs[0].(*vishnetlink.Htb)
+		Expect(isHtb).To(BeTrue())
+		Expect(htb.LinkIndex).To(Equal(tapIndex))
+		Expect(htb.Parent).To(Equal(uint32(vishnetlink.HANDLE_ROOT)))
+		Expect(htb.Defcls).To(Equal(uint32(1)))
+
+		Expect(nlStub.classes).To(HaveLen(1))
+		class, isHtbClass := nlStub.classes[0].(*vishnetlink.HtbClass)
+		Expect(isHtbClass).To(BeTrue())
+		Expect(class.Parent).To(Equal(htb.Handle))
+		Expect(class.Rate).To(Equal(uint64(1000000)))
+		Expect(class.Ceil).To(Equal(uint64(2000000)))
+		Expect(nlStub.filters).To(BeEmpty())
+	})
+
+	It("polices the outbound traffic on the ingress of the tap device", func() {
+		shaper := bandwidth.New(bandwidth.WithNetlinkAdapter(nlStub))
+
+		Expect(shaper.Setup(tapName, &v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimits{
+			Average: resource.MustParse("80M"),
+			Burst:   pointer.P(resource.MustParse("1Mi")),
+		}})).To(Succeed())
+
+		Expect(nlStub.qdiscs).To(HaveLen(1))
+		ingress, isIngress := nlStub.qdiscs[0].(*vishnetlink.Ingress)
+		Expect(isIngress).To(BeTrue())
+		Expect(ingress.Parent).To(Equal(uint32(vishnetlink.HANDLE_INGRESS)))
+
+		Expect(nlStub.filters).To(HaveLen(1))
+		filter, isMatchAll := nlStub.filters[0].(*vishnetlink.MatchAll)
+		Expect(isMatchAll).To(BeTrue())
+		Expect(filter.Parent).To(Equal(ingress.Handle))
+		Expect(filter.Actions).To(HaveLen(1))
+		police, isPolice := filter.Actions[0].(*vishnetlink.PoliceAction)
+		Expect(isPolice).To(BeTrue())
+		Expect(police.Rate).To(Equal(uint32(10000000)))
+		Expect(police.Burst).To(Equal(uint32(1048576)))
+		Expect(police.PeakRate).To(BeZero())
+		Expect(police.ExceedAction).To(Equal(vishnetlink.TC_POLICE_SHOT))
+		Expect(nlStub.classes).To(BeEmpty())
+	})
+
+	It("polices the outbound traffic with a burst fitting segmentation offloaded packets", func() {
+		shaper := bandwidth.New(bandwidth.WithNetlinkAdapter(nlStub))
+
+		Expect(shaper.Setup(tapName, &v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimits{
+			Average: resource.MustParse("1M"),
+		}})).To(Succeed())
+
+		Expect(nlStub.filters).To(HaveLen(1))
+		police := nlStub.filters[0].(*vishnetlink.MatchAll).Actions[0].(*vishnetlink.PoliceAction)
+		Expect(police.Rate).To(Equal(uint32(125000)))
+		Expect(police.Burst).To(Equal(uint32(65536)))
+	})
+
+	It("removes the shaping of the directions without limits", func() {
+		nlStub.listedQdiscs = []vishnetlink.Qdisc{
+			&vishnetlink.Htb{QdiscAttrs: vishnetlink.QdiscAttrs{LinkIndex: tapIndex, Handle: vishnetlink.MakeHandle(1, 0)}},
+			&vishnetlink.Ingress{QdiscAttrs: vishnetlink.QdiscAttrs{LinkIndex: tapIndex, Handle: vishnetlink.MakeHandle(0xffff, 0)}},
+		}
+		shaper := bandwidth.New(bandwidth.WithNetlinkAdapter(nlStub))
+
+		Expect(shaper.Setup(tapName, nil)).To(Succeed())
+		Expect(nlStub.deletedQdiscs).To(Equal(nlStub.listedQdiscs))
+	})
+
+	It("does not remove qdiscs it did not create", func() {
+		nlStub.listedQdiscs = []vishnetlink.Qdisc{
+			&vishnetlink.FqCodel{QdiscAttrs: vishnetlink.QdiscAttrs{LinkIndex: tapIndex, Handle: vishnetlink.MakeHandle(1, 0)}},
+		}
+		shaper := bandwidth.New(bandwidth.WithNetlinkAdapter(nlStub))
+
+		Expect(shaper.Setup(tapName, &v1.InterfaceBandwidth{})).To(Succeed())
+		Expect(nlStub.deletedQdiscs).To(BeEmpty())
+	})
+})
+
+type netlinkStub struct {
+	link            vishnetlink.Link
+	listedQdiscs    []vishnetlink.Qdisc
+	qdiscReplaceErr error
+
+	qdiscs        []vishnetlink.Qdisc
+	deletedQdiscs []vishnetlink.Qdisc
+	classes       []vishnetlink.Class
+	filters       []vishnetlink.Filter
+}
+
+func (n *netlinkStub) LinkByName(name string) (vishnetlink.Link, error) {
+	if n.link.Attrs().Name != name {
+		return nil, vishnetlink.LinkNotFoundError{}
+	}
+	return n.link, nil
+}
+
+func (n *netlinkStub) QdiscList(_ vishnetlink.Link) ([]vishnetlink.Qdisc, error) {
+	return n.listedQdiscs, nil
+}
+
+func (n *netlinkStub) QdiscReplace(qdisc vishnetlink.Qdisc) error {
+	if n.qdiscReplaceErr != nil {
+		return n.qdiscReplaceErr
+	}
+	n.qdiscs = append(n.qdiscs, qdisc)
+	return nil
+}
+
+func (n *netlinkStub) QdiscDel(qdisc vishnetlink.Qdisc) error {
+	n.deletedQdiscs = append(n.deletedQdiscs, qdisc)
+	return nil
+}
+
+func (n *netlinkStub) ClassReplace(class vishnetlink.Class) error {
+	n.classes = append(n.classes, class)
+	return nil
+}
+
+func (n *netlinkStub) FilterReplace(filter vishnetlink.Filter) error {
+	n.filters = append(n.filters, filter)
+	return nil
+}
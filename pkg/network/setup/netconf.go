@@ -20,10 +20,15 @@
 package network
 
 import (
+	"errors"
 	"fmt"
+	"maps"
 	"strconv"
 	"sync"
 
+	vishnetlink "github.com/vishvananda/netlink"
+	"k8s.io/apimachinery/pkg/api/equality"
+
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
@@ -36,7 +41,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
 	"kubevirt.io/kubevirt/pkg/network/istio"
+	"kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/netns"
+	"kubevirt.io/kubevirt/pkg/network/setup/bandwidth"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod/masquerade"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
@@ -48,6 +55,11 @@ type cacheCreator interface {
 
 type clusterConfigurer interface {
 	GetNetworkBindings() map[string]v1.InterfaceBindingPlugin
+	GetInterfaceTrafficFilter() *v1.InterfaceTrafficFilter
+}
+
+type bandwidthShaper interface {
+	Setup(tapIfaceName string, bandwidth *v1.InterfaceBandwidth) error
 }
 
 type NetConf struct {
@@ -57,6 +69,10 @@ type NetConf struct {
 	configStateMutex *sync.RWMutex
 
 	clusterConfigurer clusterConfigurer
+
+	bandwidthShaper  bandwidthShaper
+	appliedBandwidth map[string]map[string]*v1.InterfaceBandwidth
+	bandwidthMutex   *sync.RWMutex
 }
 
 type nsFactory func(int) NSExecutor
@@ -72,13 +88,28 @@ func NewNetConf(clusterConfigurer clusterConfigurer) *NetConf {
 	}, cacheFactory, map[string]*netpod.State{}, clusterConfigurer)
 }
 
-func NewNetConfWithCustomFactoryAndConfigState(nsFactory nsFactory, cacheCreator cacheCreator, state map[string]*netpod.State, clusterConfigurer clusterConfigurer) *NetConf {
-	return &NetConf{
+type netConfOption func(*NetConf)
+
+func NewNetConfWithCustomFactoryAndConfigState(nsFactory nsFactory, cacheCreator cacheCreator, state map[string]*netpod.State, clusterConfigurer clusterConfigurer, opts ...netConfOption) *NetConf {
+	netConf := &NetConf{
 		state:             state,
 		configStateMutex:  &sync.RWMutex{},
 		cacheCreator:      cacheCreator,
 		nsFactory:         nsFactory,
 		clusterConfigurer: clusterConfigurer,
+		bandwidthShaper:   bandwidth.New(),
+		appliedBandwidth:  map[string]map[string]*v1.InterfaceBandwidth{},
+		bandwidthMutex:    &sync.RWMutex{},
+	}
+	for _, opt := range opts {
+		opt(netConf)
+	}
+	return netConf
+}
+
+func WithBandwidthShaper(shaper bandwidthShaper) netConfOption {
+	return func(c *NetConf) {
+		c.bandwidthShaper = shaper
 	}
 }
 
@@ -122,6 +153,8 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 		netpod.WithBindingPlugins(c.clusterConfigurer.GetNetworkBindings()),
 		netpod.WithLogger(log.Log.Object(vmi)),
 		netpod.WithVMIIfaceStatuses(vmi.Status.Interfaces),
+		netpod.WithMetadataService(vmi.Spec.MetadataService != nil),
+		netpod.WithEnforcedTrafficFilter(c.clusterConfigurer.GetInterfaceTrafficFilter()),
 	)
 
 	if err := netpod.Setup(); err != nil {
@@ -155,10 +188,81 @@ func upgradeConfigStateCache(stateCache *ConfigStateCache, networks []v1.Network
 	return stateCache, nil
 }
 
+// SetupBandwidth shapes the traffic of the VMI interfaces on their tap devices in the virt-launcher pod,
+// according to the bandwidth limits of the interfaces.
+// The limits applied per interface are kept, only the interfaces whose limits changed are set up again.
+// Interfaces whose pod interface or tap device does not exist yet are set up on a later call.
+func (c *NetConf) SetupBandwidth(vmi *v1.VirtualMachineInstance, launcherPid int) error {
+	c.bandwidthMutex.RLock()
+	applied := maps.Clone(c.appliedBandwidth[string(vmi.UID)])
+	c.bandwidthMutex.RUnlock()
+	if applied == nil {
+		applied = map[string]*v1.InterfaceBandwidth{}
+	}
+
+	ifacesToSetup := vmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
+		if iface.State == v1.InterfaceStateAbsent || (iface.Bridge == nil && iface.Masquerade == nil) {
+			return false
+		}
+		appliedBandwidth, wasApplied := applied[iface.Name]
+		return (wasApplied || iface.Bandwidth != nil) && !equality.Semantic.DeepEqual(appliedBandwidth, iface.Bandwidth)
+	})
+	if len(ifacesToSetup) == 0 {
+		return nil
+	}
+
+	networksByName := vmispec.IndexNetworkSpecByName(vmi.Spec.Networks)
+	err := c.nsFactory(launcherPid).Do(func() error {
+		for _, iface := range ifacesToSetup {
+			ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, iface.Name)
+			network, exists := networksByName[iface.Name]
+			if !exists || ifaceStatus == nil || ifaceStatus.PodInterfaceName == "" {
+				continue
+			}
+			tapIfaceName := link.GenerateTapDeviceName(ifaceStatus.PodInterfaceName, network)
+			if err := c.bandwidthShaper.Setup(tapIfaceName, iface.Bandwidth); err != nil {
+				var linkNotFoundErr vishnetlink.LinkNotFoundError
+				if errors.As(err, &linkNotFoundErr) {
+					continue
+				}
+				return fmt.Errorf("failed to set up the bandwidth of %s: %w", iface.Name, err)
+			}
+			if iface.Bandwidth == nil {
+				delete(applied, iface.Name)
+			} else {
+				applied[iface.Name] = iface.Bandwidth.DeepCopy()
+			}
+		}
+		return nil
+	})
+
+	c.bandwidthMutex.Lock()
+	c.appliedBandwidth[string(vmi.UID)] = applied
+	c.bandwidthMutex.Unlock()
+	return err
+}
+
+// UpdateBandwidthStatus reports the bandwidth limits applied on the VMI interfaces in their status.
+func (c *NetConf) UpdateBandwidthStatus(vmi *v1.VirtualMachineInstance) {
+	c.bandwidthMutex.RLock()
+	defer c.bandwidthMutex.RUnlock()
+	applied := c.appliedBandwidth[string(vmi.UID)]
+	for i := range vmi.Status.Interfaces {
+		if appliedBandwidth := applied[vmi.Status.Interfaces[i].Name]; appliedBandwidth != nil {
+			vmi.Status.Interfaces[i].Bandwidth = appliedBandwidth.DeepCopy()
+		} else {
+			vmi.Status.Interfaces[i].Bandwidth = nil
+		}
+	}
+}
+
 func (c *NetConf) Teardown(vmi *v1.VirtualMachineInstance) error {
 	c.configStateMutex.Lock()
 	delete(c.state, string(vmi.UID))
 	c.configStateMutex.Unlock()
+	c.bandwidthMutex.Lock()
+	delete(c.appliedBandwidth, string(vmi.UID))
+	c.bandwidthMutex.Unlock()
 	podCache := cache.NewPodInterfaceCache(c.cacheCreator, string(vmi.UID))
 	if err := podCache.Remove(); err != nil {
 		return fmt.Errorf("teardown failed, err: %w", err)
@@ -169,11 +273,15 @@ func (c *NetConf) Teardown(vmi *v1.VirtualMachineInstance) error {
 
 func newMasqueradeAdapter(vmi *v1.VirtualMachineInstance) masquerade.MasqPod {
 	if vmi.Status.MigrationTransport == v1.MigrationTransportUnix {
-		return masquerade.New(masquerade.WithIstio(istio.ProxyInjectionEnabled(vmi)))
+		return masquerade.New(
+			masquerade.WithIstio(istio.ProxyInjectionEnabled(vmi)),
+			masquerade.WithMetadataService(vmi.Spec.MetadataService != nil),
+		)
 	} else {
 		return masquerade.New(
 			masquerade.WithIstio(istio.ProxyInjectionEnabled(vmi)),
 			masquerade.WithLegacyMigrationPorts(),
+			masquerade.WithMetadataService(vmi.Spec.MetadataService != nil),
 		)
 	}
 }
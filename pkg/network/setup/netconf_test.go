@@ -20,6 +20,7 @@
 package network_test
 
 import (
+	"errors"
 	"fmt"
 	"io/fs"
 	"os"
@@ -29,6 +30,8 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	vishnetlink "github.com/vishvananda/netlink"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -128,6 +131,98 @@ var _ = Describe("netconf", func() {
 		netConf := netsetup.NewNetConfWithCustomFactoryAndConfigState(nil, failingCacheCreator{}, stateMap, cConfigStub{})
 		Expect(netConf.Teardown(vmi)).NotTo(Succeed())
 	})
+	Context("bandwidth", func() {
+		const secondaryNetworkName = "secondary"
+
+		var shaper *bandwidthShaperStub
+
+		limits := &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimits{Average: resource.MustParse("10M")}}
+
+		BeforeEach(func() {
+			shaper = &bandwidthShaperStub{taps: map[string]*v1.InterfaceBandwidth{"tap0": nil, "tap1a2b3c4": nil}}
+			netConf = netsetup.NewNetConfWithCustomFactoryAndConfigState(
+				func(int) netsetup.NSExecutor { return nsExecutorStub{} }, &tempCacheCreator{}, stateMap, cConfigStub{},
+				netsetup.WithBandwidthShaper(shaper),
+			)
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{
+					Name:                   testNetworkName,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+					Bandwidth:              limits,
+				},
+				{
+					Name:                   secondaryNetworkName,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				},
+			}
+			vmi.Spec.Networks = []v1.Network{
+				{Name: testNetworkName, NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+				{Name: secondaryNetworkName, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net"}}},
+			}
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+				{Name: testNetworkName, PodInterfaceName: "eth0"},
+				{Name: secondaryNetworkName, PodInterfaceName: "pod1a2b3c4"},
+			}
+		})
+
+		It("shapes the tap device of the interface and reports the applied limits", func() {
+			Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+			Expect(shaper.setupCount).To(Equal(1))
+			Expect(shaper.taps).To(HaveKeyWithValue("tap0", limits))
+
+			netConf.UpdateBandwidthStatus(vmi)
+			Expect(vmi.Status.Interfaces[0].Bandwidth).To(Equal(limits))
+			Expect(vmi.Status.Interfaces[1].Bandwidth).To(BeNil())
+		})
+
+		It("does not shape the tap device again when the limits did not change", func() {
+			Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+			Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+			Expect(shaper.setupCount).To(Equal(1))
+		})
+
+		It("shapes the tap device of a secondary interface", func() {
+			vmi.Spec.Domain.Devices.Interfaces[1].Bandwidth = limits
+			Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+			Expect(shaper.taps).To(HaveKeyWithValue("tap1a2b3c4", limits))
+		})
+
+		It("removes the limits which are no longer requested", func() {
+			Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+
+			vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = nil
+			Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+			Expect(shaper.setupCount).To(Equal(2))
+			Expect(shaper.taps).To(HaveKeyWithValue("tap0", BeNil()))
+
+			netConf.UpdateBandwidthStatus(vmi)
+			Expect(vmi.Status.Interfaces[0].Bandwidth).To(BeNil())
+		})
+
+		It("does not report limits of a tap device which does not exist yet", func() {
+			delete(shaper.taps, "tap0")
+			Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+
+			netConf.UpdateBandwidthStatus(vmi)
+			Expect(vmi.Status.Interfaces[0].Bandwidth).To(BeNil())
+		})
+
+		It("fails when the tap device cannot be shaped", func() {
+			shaper.err = errors.New("test error")
+			Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(MatchError(shaper.err))
+
+			netConf.UpdateBandwidthStatus(vmi)
+			Expect(vmi.Status.Interfaces[0].Bandwidth).To(BeNil())
+		})
+
+		It("forgets the applied limits on teardown", func() {
+			Expect(netConf.SetupBandwidth(vmi, launcherPid)).To(Succeed())
+			Expect(netConf.Teardown(vmi)).To(Succeed())
+
+			netConf.UpdateBandwidthStatus(vmi)
+			Expect(vmi.Status.Interfaces[0].Bandwidth).To(BeNil())
+		})
+	})
 })
 
 type netnsStub struct {
@@ -213,8 +308,30 @@ func (n nsExecutorStub) Do(f func() error) error {
 	return f()
 }
 
+type bandwidthShaperStub struct {
+	taps       map[string]*v1.InterfaceBandwidth
+	setupCount int
+	err        error
+}
+
+func (b *bandwidthShaperStub) Setup(tapIfaceName string, bandwidth *v1.InterfaceBandwidth) error {
+	if _, exists := b.taps[tapIfaceName]; !exists {
+		return vishnetlink.LinkNotFoundError{}
+	}
+	if b.err != nil {
+		return b.err
+	}
+	b.setupCount++
+	b.taps[tapIfaceName] = bandwidth
+	return nil
+}
+
 type cConfigStub struct{}
 
 func (c cConfigStub) GetNetworkBindings() map[string]v1.InterfaceBindingPlugin {
 	return map[string]v1.InterfaceBindingPlugin{}
 }
+
+func (c cConfigStub) GetInterfaceTrafficFilter() *v1.InterfaceTrafficFilter {
+	return nil
+}
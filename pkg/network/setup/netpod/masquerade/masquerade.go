@@ -30,6 +30,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/driver/nft"
 	"kubevirt.io/kubevirt/pkg/network/driver/nmstate"
 	"kubevirt.io/kubevirt/pkg/network/istio"
+	"kubevirt.io/kubevirt/pkg/network/metadataservice"
 	"kubevirt.io/kubevirt/pkg/network/netmachinery"
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
 )
@@ -41,9 +42,10 @@ type nftable interface {
 }
 
 type MasqPod struct {
-	nftable        nftable
-	istioEnabled   bool
-	migrationPorts []int
+	nftable         nftable
+	istioEnabled    bool
+	migrationPorts  []int
+	metadataService bool
 }
 
 const (
@@ -73,6 +75,14 @@ func WithIstio(enabled bool) option {
 	}
 }
 
+// WithMetadataService redirects the HTTP requests of the guest to the metadata service address,
+// to the unprivileged port the metadata service listens on.
+func WithMetadataService(enabled bool) option {
+	return func(m *MasqPod) {
+		m.metadataService = enabled
+	}
+}
+
 func WithNftableAdapter(h nftable) option {
 	return func(m *MasqPod) {
 		m.nftable = h
@@ -137,6 +147,11 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 	if err := m.nftable.AddRule(family, natTable, postroutingChain, "oifname", bridgeIfaceSpec.Name, "counter", "jump", kubevirtPostInboundChain); err != nil {
 		return err
 	}
+	if m.metadataService && family == nft.IPv4 {
+		if err := m.redirectMetadataService(family, bridgeIfaceSpec.Name); err != nil {
+			return err
+		}
+	}
 
 	if len(m.migrationPorts) > 0 {
 		if err := m.skipForwardPorts(family, m.migrationPorts...); err != nil {
@@ -219,6 +234,13 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 	return nil
 }
 
+func (m MasqPod) redirectMetadataService(family nft.IPFamily, bridgeIfaceName string) error {
+	metadataServiceAddress := net.JoinHostPort(metadataservice.IPv4Address, strconv.Itoa(metadataservice.Port))
+	return m.nftable.AddRule(family, natTable, preroutingChain,
+		"iifname", bridgeIfaceName, string(family), "daddr", metadataservice.IPv4Address,
+		"tcp", "dport", strconv.Itoa(metadataservice.HTTPPort), "counter", "dnat", "to", metadataServiceAddress)
+}
+
 func (m MasqPod) skipForwardPorts(family nft.IPFamily, ports ...int) error {
 	loopback := ipLoopback(family)
 	fmtPorts := formatPorts(ports)
@@ -104,6 +104,56 @@ family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } counter dnat t
 		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 	})
 
+	It("setup with IPv4 and the metadata service, no ports", func() {
+		nftStub := &nftableStub{}
+		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub), masquerade.WithMetadataService(true))
+
+		err := masqPod.Setup(
+			&nmstate.Interface{
+				Name:     "k6t-eth0",
+				TypeName: nmstate.TypeBridge,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.0.2.1", PrefixLen: 24}, {IP: "169.254.169.254", PrefixLen: 32}},
+				},
+				Metadata: &nmstate.IfaceMetadata{NetworkName: "default"},
+			},
+			&nmstate.Interface{
+				Name:     "eth0",
+				TypeName: nmstate.TypeVETH,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.222.222.1", PrefixLen: 30}},
+				},
+				Metadata: &nmstate.IfaceMetadata{NetworkName: "default"},
+			},
+			v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		expectedConfig := `tables:
+family ip name nat
+chains:
+family ip table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip table nat name KUBEVIRT_POSTINBOUND chainspec []
+rules:
+family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
+family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table nat chain prerouting rulespec [iifname k6t-eth0 ip daddr 169.254.169.254 tcp dport 80 counter dnat to 169.254.169.254:8775]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } counter dnat to 10.0.2.2]
+`
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+	})
+
 	It("setup with IPv6, no ports", func() {
 		nftStub := &nftableStub{}
 		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
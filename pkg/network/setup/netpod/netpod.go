@@ -34,9 +34,11 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/driver/procsys"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/metadataservice"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/netmachinery"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod/masquerade"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/trafficfilter"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -55,6 +57,10 @@ type masqueradeAdapter interface {
 	Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error
 }
 
+type trafficFilterAdapter interface {
+	Setup(tapIfaceName string, vmiIface v1.Interface) error
+}
+
 type cacheCreator interface {
 	New(filePath string) *cache.Cache
 }
@@ -72,14 +78,19 @@ type NetPod struct {
 	ownerID          int
 	queuesCap        int
 
-	nmstateAdapter    nmstateAdapter
-	masqueradeAdapter masqueradeAdapter
+	nmstateAdapter       nmstateAdapter
+	masqueradeAdapter    masqueradeAdapter
+	trafficFilterAdapter trafficFilterAdapter
 
 	cacheCreator cacheCreator
 	state        *State
 
 	bindingPluginsByName map[string]v1.InterfaceBindingPlugin
 
+	metadataService bool
+
+	enforcedTrafficFilter *v1.InterfaceTrafficFilter
+
 	log *log.FilteredLogger
 }
 
@@ -95,8 +106,9 @@ func NewNetPod(vmiNetworks []v1.Network, vmiIfaces []v1.Interface, vmiUID string
 		queuesCap:     queuesCapacity,
 		state:         state,
 
-		nmstateAdapter:    nmstate.New(),
-		masqueradeAdapter: masquerade.New(),
+		nmstateAdapter:       nmstate.New(),
+		masqueradeAdapter:    masquerade.New(),
+		trafficFilterAdapter: trafficfilter.New(),
 
 		cacheCreator:         cache.CacheCreator{},
 		bindingPluginsByName: map[string]v1.InterfaceBindingPlugin{},
@@ -121,6 +133,12 @@ func WithMasqueradeAdapter(h masqueradeAdapter) option {
 	}
 }
 
+func WithTrafficFilterAdapter(h trafficFilterAdapter) option {
+	return func(n *NetPod) {
+		n.trafficFilterAdapter = h
+	}
+}
+
 func WithCacheCreator(c cacheCreator) option {
 	return func(n *NetPod) {
 		n.cacheCreator = c
@@ -139,6 +157,19 @@ func WithLogger(logger *log.FilteredLogger) option {
 	}
 }
 
+// WithMetadataService assigns the metadata service address to the masquerade bridge, when enabled
+func WithMetadataService(enabled bool) option {
+	return func(n *NetPod) {
+		n.metadataService = enabled
+	}
+}
+
+func WithEnforcedTrafficFilter(filter *v1.InterfaceTrafficFilter) option {
+	return func(n *NetPod) {
+		n.enforcedTrafficFilter = filter
+	}
+}
+
 func WithVMIIfaceStatuses(vmiIfaceStatuses []v1.VirtualMachineInstanceNetworkInterface) option {
 	return func(n *NetPod) {
 		n.vmiIfaceStatuses = vmiIfaceStatuses
@@ -245,9 +276,12 @@ func (n NetPod) config(currentStatus *nmstate.Status) error {
 		return err
 	}
 
-	// Configuring NAT (nftables) is temporary done outside nmstate.
+	// Configuring NAT and traffic filters (nftables) is temporary done outside nmstate.
 	// This should be eventually embedded into the nmstate desired state and applied by it.
-	return n.setupNAT(desiredSpec, currentStatus)
+	if err = n.setupNAT(desiredSpec, currentStatus); err != nil {
+		return err
+	}
+	return n.setupTrafficFilters(desiredSpec)
 }
 
 func (n NetPod) composeDesiredSpec(currentStatus *nmstate.Status) (*nmstate.Spec, error) {
@@ -441,6 +475,12 @@ func (n NetPod) masqueradeBindingSpec(podIfaceName string, vmiIfaceIndex int, if
 			Enabled: pointer.P(true),
 			Address: []nmstate.IPAddress{ip4GatewayAddress},
 		}
+		if n.metadataService {
+			bridgeIface.IPv4.Address = append(bridgeIface.IPv4.Address, nmstate.IPAddress{
+				IP:        metadataservice.IPv4Address,
+				PrefixLen: net.IPv4len * 8,
+			})
+		}
 		bridgeIface.LinuxStack.IP4RouteLocalNet = pointer.P(true)
 	}
 
@@ -549,6 +589,28 @@ func (n NetPod) setupNAT(desiredSpec *nmstate.Spec, currentStatus *nmstate.Statu
 	return n.masqueradeAdapter.Setup(bridgeIfaceSpec, podIfaceSpec, vmiIface[0])
 }
 
+func (n NetPod) setupTrafficFilters(desiredSpec *nmstate.Spec) error {
+	for _, vmiIface := range n.vmiSpecIfaces {
+		if vmiIface.Bridge == nil || vmiIface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		vmiIface.TrafficFilter = trafficfilter.Enforce(n.enforcedTrafficFilter, vmiIface.TrafficFilter)
+		if vmiIface.TrafficFilter == nil {
+			continue
+		}
+		tapIfaceSpec := nmstate.LookupInterface(desiredSpec.Interfaces, func(i nmstate.Interface) bool {
+			return i.Metadata != nil && i.Metadata.NetworkName == vmiIface.Name && i.TypeName == nmstate.TypeTap
+		})
+		if tapIfaceSpec == nil {
+			return fmt.Errorf("setup-traffic-filter: tap link of %s is missing", vmiIface.Name)
+		}
+		if err := n.trafficFilterAdapter.Setup(tapIfaceSpec.Name, vmiIface); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (n NetPod) lookupMasquradeBridge(desiredIfacesSpec []nmstate.Interface) *nmstate.Interface {
 	masqueradeIfaces := vmispec.FilterInterfacesSpec(n.vmiSpecIfaces, func(i v1.Interface) bool {
 		return i.Masquerade != nil
@@ -170,6 +170,90 @@ var _ = Describe("netpod", func() {
 		Expect(netPod.Setup()).To(MatchError(errMasqueradeSetup))
 	})
 
+	It("setup traffic filter of a bridge binding", func() {
+		filterstub := trafficFilterStub{}
+		vmiIface := v1.Interface{
+			Name:                   defaultPodNetworkName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			TrafficFilter:          &v1.InterfaceTrafficFilter{DHCPServerGuard: true},
+		}
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{vmiIface},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstateStub{status: nmstate.Status{
+				Interfaces: []nmstate.Interface{{
+					Name:       "eth0",
+					Index:      0,
+					TypeName:   nmstate.TypeVETH,
+					State:      nmstate.IfaceStateUp,
+					MacAddress: "12:34:56:78:90:ab",
+					MTU:        1500,
+				}},
+			}}),
+			netpod.WithTrafficFilterAdapter(&filterstub),
+			netpod.WithCacheCreator(&baseCacheCreator),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+		Expect(filterstub.tapIfaceName).To(Equal("tap0"))
+		Expect(filterstub.vmiIfaceSpec).To(Equal(vmiIface))
+	})
+
+	It("setup the enforced traffic filter of a bridge binding without traffic filter", func() {
+		filterstub := trafficFilterStub{}
+		enforcedFilter := &v1.InterfaceTrafficFilter{DHCPServerGuard: true}
+		vmiIface := v1.Interface{
+			Name:                   defaultPodNetworkName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{vmiIface},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstateStub{status: nmstate.Status{
+				Interfaces: []nmstate.Interface{{
+					Name:       "eth0",
+					Index:      0,
+					TypeName:   nmstate.TypeVETH,
+					State:      nmstate.IfaceStateUp,
+					MacAddress: "12:34:56:78:90:ab",
+					MTU:        1500,
+				}},
+			}}),
+			netpod.WithTrafficFilterAdapter(&filterstub),
+			netpod.WithEnforcedTrafficFilter(enforcedFilter),
+			netpod.WithCacheCreator(&baseCacheCreator),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+		Expect(filterstub.tapIfaceName).To(Equal("tap0"))
+		Expect(filterstub.vmiIfaceSpec.TrafficFilter).To(Equal(enforcedFilter))
+	})
+
+	It("fails setup when the traffic filter setup fails", func() {
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{{
+				Name:                   defaultPodNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				TrafficFilter:          &v1.InterfaceTrafficFilter{DHCPServerGuard: true},
+			}},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstateStub{status: nmstate.Status{
+				Interfaces: []nmstate.Interface{{
+					Name:       "eth0",
+					Index:      0,
+					TypeName:   nmstate.TypeVETH,
+					State:      nmstate.IfaceStateUp,
+					MacAddress: "12:34:56:78:90:ab",
+					MTU:        1500,
+				}},
+			}}),
+			netpod.WithTrafficFilterAdapter(&trafficFilterStub{setupErr: errTrafficFilterSetup}),
+			netpod.WithCacheCreator(&baseCacheCreator),
+		)
+		Expect(netPod.Setup()).To(MatchError(errTrafficFilterSetup))
+	})
+
 	DescribeTable("fails setup discovery when pod interface is missing", func(binding v1.InterfaceBindingMethod) {
 		netPod := netpod.NewNetPod(
 			[]v1.Network{*v1.DefaultPodNetwork()},
@@ -279,6 +363,37 @@ var _ = Describe("netpod", func() {
 		}))
 	})
 
+	It("setup masquerade binding with the metadata service", func() {
+		nmstatestub := nmstateStub{status: nmstate.Status{
+			Interfaces: []nmstate.Interface{{
+				Name:     "eth0",
+				TypeName: nmstate.TypeVETH,
+				State:    nmstate.IfaceStateUp,
+				MTU:      1500,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: primaryIPv4Address, PrefixLen: 30}},
+				},
+			}},
+		}}
+
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{*v1.DefaultMasqueradeNetworkInterface()},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithMasqueradeAdapter(&masqueradeStub{}),
+			netpod.WithCacheCreator(&baseCacheCreator),
+			netpod.WithMetadataService(true),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+		Expect(nmstatestub.spec.Interfaces[0].Name).To(Equal("k6t-eth0"))
+		Expect(nmstatestub.spec.Interfaces[0].IPv4.Address).To(Equal([]nmstate.IPAddress{
+			{IP: "10.0.2.1", PrefixLen: 24},
+			{IP: "169.254.169.254", PrefixLen: 32},
+		}))
+	})
+
 	It("setup bridge binding with IP and a static route", func() {
 		const (
 			defaultGatewayIP4Address = "10.222.222.254"
@@ -1922,6 +2037,23 @@ func (n *nmstateStub) Read() (*nmstate.Status, error) {
 	return &n.status, n.readErr
 }
 
+type trafficFilterStub struct {
+	setupErr     error
+	tapIfaceName string
+	vmiIfaceSpec v1.Interface
+}
+
+var errTrafficFilterSetup = errors.New("traffic filter Setup Test Error")
+
+func (f *trafficFilterStub) Setup(tapIfaceName string, vmiIfaceSpec v1.Interface) error {
+	if f.setupErr != nil {
+		return f.setupErr
+	}
+	f.tapIfaceName = tapIfaceName
+	f.vmiIfaceSpec = vmiIfaceSpec
+	return nil
+}
+
 type masqueradeStub struct {
 	setupErr        error
 	bridgeIfaceSpec *nmstate.Interface
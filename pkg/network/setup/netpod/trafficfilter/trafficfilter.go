@@ -0,0 +1,274 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package trafficfilter
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+)
+
+type nftable interface {
+	AddTable(family nft.IPFamily, name string) error
+	AddChain(family nft.IPFamily, table, name string, chainspec ...string) error
+	FlushChain(family nft.IPFamily, table, name string) error
+	AddRule(family nft.IPFamily, table, chain string, rulespec ...string) error
+}
+
+type FilterPod struct {
+	nftable nftable
+}
+
+const (
+	filterTable = "filter"
+
+	kubevirtFilterChainPrefix  = "KUBEVIRT_FILTER_"
+	kubevirtEgressChainPrefix  = "KUBEVIRT_EGRESS_"
+	kubevirtIngressChainPrefix = "KUBEVIRT_INGRESS_"
+
+	ipv4Unspecified = "0.0.0.0"
+	ipv6Unspecified = "::"
+	ipv6LinkLocal   = "fe80::/10"
+
+	dhcpv4ServerPort = 67
+	dhcpv4ClientPort = 68
+	dhcpv6ClientPort = 546
+	dhcpv6ServerPort = 547
+)
+
+type option func(*FilterPod)
+
+func New(opts ...option) FilterPod {
+	f := FilterPod{nftable: nft.NFTBin{}}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
+}
+
+func WithNftableAdapter(h nftable) option {
+	return func(f *FilterPod) {
+		f.nftable = h
+	}
+}
+
+// Setup programs the traffic filter of the VMI interface on the bridge port of its tap device.
+// The setup is idempotent, the chains of the interface are flushed before the rules are added.
+func (f FilterPod) Setup(tapIfaceName string, vmiIface v1.Interface) error {
+	if vmiIface.TrafficFilter == nil {
+		return nil
+	}
+
+	filterChain := kubevirtFilterChainPrefix + tapIfaceName
+	egressChain := kubevirtEgressChainPrefix + tapIfaceName
+	ingressChain := kubevirtIngressChainPrefix + tapIfaceName
+
+	if err := f.nftable.AddTable(nft.Bridge, filterTable); err != nil {
+		return err
+	}
+	if err := f.nftable.AddChain(nft.Bridge, filterTable, filterChain, "{ type filter hook forward priority 0; }"); err != nil {
+		return err
+	}
+	if err := f.nftable.AddChain(nft.Bridge, filterTable, egressChain); err != nil {
+		return err
+	}
+	if err := f.nftable.AddChain(nft.Bridge, filterTable, ingressChain); err != nil {
+		return err
+	}
+	for _, chain := range []string{filterChain, egressChain, ingressChain} {
+		if err := f.nftable.FlushChain(nft.Bridge, filterTable, chain); err != nil {
+			return err
+		}
+	}
+
+	if err := f.nftable.AddRule(nft.Bridge, filterTable, filterChain, "iifname", tapIfaceName, "counter", "jump", egressChain); err != nil {
+		return err
+	}
+	if err := f.nftable.AddRule(nft.Bridge, filterTable, filterChain, "oifname", tapIfaceName, "counter", "jump", ingressChain); err != nil {
+		return err
+	}
+
+	if err := f.addRules(egressChain, egressRules(vmiIface)); err != nil {
+		return fmt.Errorf("failed to define the egress traffic filter of %s: %w", vmiIface.Name, err)
+	}
+	if err := f.addRules(ingressChain, ingressRules(vmiIface)); err != nil {
+		return fmt.Errorf("failed to define the ingress traffic filter of %s: %w", vmiIface.Name, err)
+	}
+	return nil
+}
+
+func (f FilterPod) addRules(chain string, rules [][]string) error {
+	for _, rulespec := range rules {
+		if err := f.nftable.AddRule(nft.Bridge, filterTable, chain, rulespec...); err != nil {
+			// The port filters track the connections of the guest, which requires
+			// the conntrack support of the bridge family on the node
+			if rulespec[0] == "ct" {
+				return fmt.Errorf("connection tracking of bridged traffic is not available, the nf_conntrack_bridge kernel module is required: %w", err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Enforce combines the traffic filter of an interface with the traffic filter enforced by the cluster.
+// The protections of the enforced filter are always enabled, its allowed addresses and ports apply
+// when the interface filter does not specify its own.
+func Enforce(enforced, filter *v1.InterfaceTrafficFilter) *v1.InterfaceTrafficFilter {
+	if enforced == nil {
+		return filter
+	}
+	if filter == nil {
+		return enforced.DeepCopy()
+	}
+
+	combined := filter.DeepCopy()
+	combined.NeighborDiscoveryProtection = filter.NeighborDiscoveryProtection || enforced.NeighborDiscoveryProtection
+	combined.DHCPServerGuard = filter.DHCPServerGuard || enforced.DHCPServerGuard
+	if len(combined.AllowedMACAddresses) == 0 {
+		combined.AllowedMACAddresses = enforced.AllowedMACAddresses
+	}
+	if len(combined.AllowedIPAddresses) == 0 {
+		combined.AllowedIPAddresses = enforced.AllowedIPAddresses
+	}
+	if len(combined.IngressPorts) == 0 {
+		combined.IngressPorts = enforced.IngressPorts
+	}
+	if len(combined.EgressPorts) == 0 {
+		combined.EgressPorts = enforced.EgressPorts
+	}
+	return combined
+}
+
+func egressRules(vmiIface v1.Interface) [][]string {
+	var rules [][]string
+	filter := vmiIface.TrafficFilter
+
+	allowedMACs := filter.AllowedMACAddresses
+	if len(allowedMACs) == 0 && vmiIface.MacAddress != "" {
+		allowedMACs = []string{vmiIface.MacAddress}
+	}
+	allowedIPv4s, allowedIPv6s := splitByFamily(filter.AllowedIPAddresses)
+	filterIPs := len(filter.AllowedIPAddresses) > 0
+	// The guest requests a DHCP lease and performs the IPv6 duplicate address
+	// detection using the unspecified address, and uses link-local IPv6 addresses
+	allowedIPv4s = append(allowedIPv4s, ipv4Unspecified)
+	allowedIPv6s = append(allowedIPv6s, ipv6LinkLocal, ipv6Unspecified)
+
+	if len(allowedMACs) > 0 {
+		rules = append(rules, []string{"ether", "saddr", "!=", setSpec(allowedMACs), "counter", "drop"})
+	}
+	if filterIPs {
+		rules = append(rules,
+			[]string{"ip", "saddr", "!=", setSpec(allowedIPv4s), "counter", "drop"},
+			[]string{"ip6", "saddr", "!=", setSpec(allowedIPv6s), "counter", "drop"},
+		)
+	}
+
+	if filter.NeighborDiscoveryProtection {
+		if len(allowedMACs) > 0 {
+			rules = append(rules, []string{"arp", "saddr", "ether", "!=", setSpec(allowedMACs), "counter", "drop"})
+		}
+		if filterIPs {
+			rules = append(rules,
+				[]string{"arp", "saddr", "ip", "!=", setSpec(allowedIPv4s), "counter", "drop"},
+				[]string{"icmpv6", "type", "nd-neighbor-advert", "icmpv6", "taddr", "!=", setSpec(allowedIPv6s), "counter", "drop"},
+			)
+		}
+		rules = append(rules, []string{"icmpv6", "type", "nd-redirect", "counter", "drop"})
+	}
+
+	if filter.DHCPServerGuard {
+		rules = append(rules,
+			[]string{"udp", "sport", strconv.Itoa(dhcpv4ServerPort), "udp", "dport", strconv.Itoa(dhcpv4ClientPort), "counter", "drop"},
+			[]string{"udp", "sport", strconv.Itoa(dhcpv6ServerPort), "udp", "dport", strconv.Itoa(dhcpv6ClientPort), "counter", "drop"},
+			[]string{"icmpv6", "type", "nd-router-advert", "counter", "drop"},
+		)
+	}
+
+	if len(filter.EgressPorts) > 0 {
+		rules = append(rules,
+			[]string{"ct", "state", "established,related", "counter", "accept"},
+			[]string{"udp", "dport", setSpec([]string{strconv.Itoa(dhcpv4ServerPort), strconv.Itoa(dhcpv6ServerPort)}), "counter", "accept"},
+		)
+		rules = append(rules, portRules(filter.EgressPorts)...)
+	}
+	return rules
+}
+
+func ingressRules(vmiIface v1.Interface) [][]string {
+	var rules [][]string
+	filter := vmiIface.TrafficFilter
+
+	if len(filter.IngressPorts) > 0 {
+		rules = append(rules,
+			[]string{"ct", "state", "established,related", "counter", "accept"},
+			[]string{"udp", "dport", setSpec([]string{strconv.Itoa(dhcpv4ClientPort), strconv.Itoa(dhcpv6ClientPort)}), "counter", "accept"},
+		)
+		rules = append(rules, portRules(filter.IngressPorts)...)
+	}
+	return rules
+}
+
+// portRules drops the TCP and UDP traffic towards ports which are not allowed
+func portRules(ports []v1.Port) [][]string {
+	portsByProtocol := map[string][]string{}
+	for _, port := range ports {
+		protocol := strings.ToLower(port.Protocol)
+		if protocol == "" {
+			protocol = "tcp"
+		}
+		portsByProtocol[protocol] = append(portsByProtocol[protocol], strconv.Itoa(int(port.Port)))
+	}
+
+	var rules [][]string
+	for _, protocol := range []string{"tcp", "udp"} {
+		if protocolPorts, exists := portsByProtocol[protocol]; exists {
+			rules = append(rules, []string{protocol, "dport", "!=", setSpec(protocolPorts), "counter", "drop"})
+		} else {
+			rules = append(rules, []string{"meta", "l4proto", protocol, "counter", "drop"})
+		}
+	}
+	return rules
+}
+
+func splitByFamily(addresses []string) (ipv4s, ipv6s []string) {
+	for _, address := range addresses {
+		ip, _, err := net.ParseCIDR(address)
+		if err != nil {
+			ip = net.ParseIP(address)
+		}
+		if ip.To4() != nil {
+			ipv4s = append(ipv4s, address)
+		} else {
+			ipv6s = append(ipv6s, address)
+		}
+	}
+	return ipv4s, ipv6s
+}
+
+func setSpec(elements []string) string {
+	return fmt.Sprintf("{ %s }", strings.Join(elements, ", "))
+}
@@ -0,0 +1,211 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package trafficfilter_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/trafficfilter"
+)
+
+var _ = Describe("traffic filter", func() {
+	const tapName = "tap0"
+
+	const chainsConfig = `table bridge filter
+chain bridge filter KUBEVIRT_FILTER_tap0 [{ type filter hook forward priority 0; }]
+chain bridge filter KUBEVIRT_EGRESS_tap0 []
+chain bridge filter KUBEVIRT_INGRESS_tap0 []
+flush bridge filter KUBEVIRT_FILTER_tap0
+flush bridge filter KUBEVIRT_EGRESS_tap0
+flush bridge filter KUBEVIRT_INGRESS_tap0
+rule bridge filter KUBEVIRT_FILTER_tap0 [iifname tap0 counter jump KUBEVIRT_EGRESS_tap0]
+rule bridge filter KUBEVIRT_FILTER_tap0 [oifname tap0 counter jump KUBEVIRT_INGRESS_tap0]
+`
+
+	It("is not set up when the interface has no traffic filter", func() {
+		nftStub := &nftableStub{}
+		filterPod := trafficfilter.New(trafficfilter.WithNftableAdapter(nftStub))
+
+		Expect(filterPod.Setup(tapName, v1.Interface{Name: "default"})).To(Succeed())
+		Expect(nftStub.String()).To(BeEmpty())
+	})
+
+	It("setup fails", func() {
+		testErr := errors.New("test error")
+		filterPod := trafficfilter.New(trafficfilter.WithNftableAdapter(&nftableStub{addRuleErr: testErr}))
+
+		vmiIface := v1.Interface{
+			Name:          "default",
+			TrafficFilter: &v1.InterfaceTrafficFilter{AllowedMACAddresses: []string{"02:00:00:00:00:01"}},
+		}
+		Expect(filterPod.Setup(tapName, vmiIface)).To(MatchError(testErr))
+	})
+
+	It("setup fails when the bridge family does not track connections", func() {
+		testErr := errors.New("test error")
+		filterPod := trafficfilter.New(trafficfilter.WithNftableAdapter(&nftableStub{addRuleErr: testErr, failingRulePrefix: "ct"}))
+
+		vmiIface := v1.Interface{
+			Name:          "default",
+			TrafficFilter: &v1.InterfaceTrafficFilter{IngressPorts: []v1.Port{{Port: 22}}},
+		}
+		err := filterPod.Setup(tapName, vmiIface)
+		Expect(err).To(MatchError(testErr))
+		Expect(err).To(MatchError(ContainSubstring("nf_conntrack_bridge")))
+	})
+
+	DescribeTable("setup", func(vmiIface v1.Interface, expectedRules string) {
+		nftStub := &nftableStub{}
+		filterPod := trafficfilter.New(trafficfilter.WithNftableAdapter(nftStub))
+
+		Expect(filterPod.Setup(tapName, vmiIface)).To(Succeed())
+		expectedConfig := chainsConfig + expectedRules
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+	},
+		Entry("with an empty filter",
+			v1.Interface{TrafficFilter: &v1.InterfaceTrafficFilter{}},
+			"",
+		),
+		Entry("with the MAC address of the interface",
+			v1.Interface{MacAddress: "02:00:00:00:00:01", TrafficFilter: &v1.InterfaceTrafficFilter{}},
+			`rule bridge filter KUBEVIRT_EGRESS_tap0 [ether saddr != { 02:00:00:00:00:01 } counter drop]
+`,
+		),
+		Entry("with allowed MAC and IP addresses and neighbor discovery protection",
+			v1.Interface{
+				MacAddress: "02:00:00:00:00:01",
+				TrafficFilter: &v1.InterfaceTrafficFilter{
+					AllowedMACAddresses:         []string{"02:00:00:00:00:02", "02:00:00:00:00:03"},
+					AllowedIPAddresses:          []string{"10.0.0.5", "10.1.0.0/24", "fd00::5"},
+					NeighborDiscoveryProtection: true,
+				},
+			},
+			`rule bridge filter KUBEVIRT_EGRESS_tap0 [ether saddr != { 02:00:00:00:00:02, 02:00:00:00:00:03 } counter drop]
+rule bridge filter KUBEVIRT_EGRESS_tap0 [ip saddr != { 10.0.0.5, 10.1.0.0/24, 0.0.0.0 } counter drop]
+rule bridge filter KUBEVIRT_EGRESS_tap0 [ip6 saddr != { fd00::5, fe80::/10, :: } counter drop]
+rule bridge filter KUBEVIRT_EGRESS_tap0 [arp saddr ether != { 02:00:00:00:00:02, 02:00:00:00:00:03 } counter drop]
+rule bridge filter KUBEVIRT_EGRESS_tap0 [arp saddr ip != { 10.0.0.5, 10.1.0.0/24, 0.0.0.0 } counter drop]
+rule bridge filter KUBEVIRT_EGRESS_tap0 [icmpv6 type nd-neighbor-advert icmpv6 taddr != { fd00::5, fe80::/10, :: } counter drop]
+rule bridge filter KUBEVIRT_EGRESS_tap0 [icmpv6 type nd-redirect counter drop]
+`,
+		),
+		Entry("with the DHCP server guard",
+			v1.Interface{TrafficFilter: &v1.InterfaceTrafficFilter{DHCPServerGuard: true}},
+			`rule bridge filter KUBEVIRT_EGRESS_tap0 [udp sport 67 udp dport 68 counter drop]
+rule bridge filter KUBEVIRT_EGRESS_tap0 [udp sport 547 udp dport 546 counter drop]
+rule bridge filter KUBEVIRT_EGRESS_tap0 [icmpv6 type nd-router-advert counter drop]
+`,
+		),
+		Entry("with allowed ingress and egress ports",
+			v1.Interface{TrafficFilter: &v1.InterfaceTrafficFilter{
+				IngressPorts: []v1.Port{{Port: 22}, {Protocol: "TCP", Port: 80}},
+				EgressPorts:  []v1.Port{{Protocol: "UDP", Port: 53}, {Protocol: "TCP", Port: 443}},
+			}},
+			`rule bridge filter KUBEVIRT_EGRESS_tap0 [ct state established,related counter accept]
+rule bridge filter KUBEVIRT_EGRESS_tap0 [udp dport { 67, 547 } counter accept]
+rule bridge filter KUBEVIRT_EGRESS_tap0 [tcp dport != { 443 } counter drop]
+rule bridge filter KUBEVIRT_EGRESS_tap0 [udp dport != { 53 } counter drop]
+rule bridge filter KUBEVIRT_INGRESS_tap0 [ct state established,related counter accept]
+rule bridge filter KUBEVIRT_INGRESS_tap0 [udp dport { 68, 546 } counter accept]
+rule bridge filter KUBEVIRT_INGRESS_tap0 [tcp dport != { 22, 80 } counter drop]
+rule bridge filter KUBEVIRT_INGRESS_tap0 [meta l4proto udp counter drop]
+`,
+		),
+	)
+})
+
+var _ = Describe("enforced traffic filter", func() {
+	It("is the filter of the interface when the cluster enforces none", func() {
+		filter := &v1.InterfaceTrafficFilter{DHCPServerGuard: true}
+		Expect(trafficfilter.Enforce(nil, filter)).To(Equal(filter))
+	})
+
+	It("applies to interfaces without filter", func() {
+		enforced := &v1.InterfaceTrafficFilter{NeighborDiscoveryProtection: true, DHCPServerGuard: true}
+		Expect(trafficfilter.Enforce(enforced, nil)).To(Equal(enforced))
+	})
+
+	It("keeps its protections enabled and its addresses and ports as defaults", func() {
+		enforced := &v1.InterfaceTrafficFilter{
+			AllowedIPAddresses:          []string{"10.0.0.0/24"},
+			NeighborDiscoveryProtection: true,
+			DHCPServerGuard:             true,
+			IngressPorts:                []v1.Port{{Port: 22}},
+			EgressPorts:                 []v1.Port{{Port: 443}},
+		}
+		filter := &v1.InterfaceTrafficFilter{
+			AllowedMACAddresses: []string{"02:00:00:00:00:01"},
+			IngressPorts:        []v1.Port{{Port: 80}},
+		}
+		Expect(trafficfilter.Enforce(enforced, filter)).To(Equal(&v1.InterfaceTrafficFilter{
+			AllowedMACAddresses:         []string{"02:00:00:00:00:01"},
+			AllowedIPAddresses:          []string{"10.0.0.0/24"},
+			NeighborDiscoveryProtection: true,
+			DHCPServerGuard:             true,
+			IngressPorts:                []v1.Port{{Port: 80}},
+			EgressPorts:                 []v1.Port{{Port: 443}},
+		}))
+	})
+})
+
+type nftableStub struct {
+	addRuleErr        error
+	failingRulePrefix string
+	commands          []string
+}
+
+func (n *nftableStub) AddTable(family nft.IPFamily, name string) error {
+	n.commands = append(n.commands, fmt.Sprintf("table %s %s", family, name))
+	return nil
+}
+
+func (n *nftableStub) AddChain(family nft.IPFamily, table, name string, chainspec ...string) error {
+	n.commands = append(n.commands, fmt.Sprintf("chain %s %s %s %s", family, table, name, chainspec))
+	return nil
+}
+
+func (n *nftableStub) FlushChain(family nft.IPFamily, table, name string) error {
+	n.commands = append(n.commands, fmt.Sprintf("flush %s %s %s", family, table, name))
+	return nil
+}
+
+func (n *nftableStub) AddRule(family nft.IPFamily, table, chain string, rulespec ...string) error {
+	if n.addRuleErr != nil && (n.failingRulePrefix == "" || rulespec[0] == n.failingRulePrefix) {
+		return n.addRuleErr
+	}
+	n.commands = append(n.commands, fmt.Sprintf("rule %s %s %s %s", family, table, chain, rulespec))
+	return nil
+}
+
+func (n *nftableStub) String() string {
+	var out strings.Builder
+	for _, command := range n.commands {
+		out.WriteString(command + "\n")
+	}
+	return out.String()
+}
@@ -86,6 +86,7 @@ func (admitter *VMExportAdmitter) Admit(_ context.Context, ar *admissionv1.Admis
 		case vmSnapshotKind:
 			causes = append(causes, admitter.validateVMSnapshotName(sourceField.Child("name"), vmExport.Spec.Source.Name)...)
 			causes = append(causes, admitter.validateVMSnapshotApiGroup(sourceField.Child("APIGroup"), vmExport.Spec.Source.APIGroup)...)
+			causes = append(causes, admitter.validateBaseSnapshotName(k8sfield.NewPath("spec", "baseSnapshotName"), vmExport.Spec.BaseSnapshotName, vmExport.Spec.Source.Name)...)
 		case vmKind:
 			causes = append(causes, admitter.validateVMName(sourceField.Child("name"), vmExport.Spec.Source.Name)...)
 			causes = append(causes, admitter.validateVMApiGroup(sourceField.Child("APIGroup"), vmExport.Spec.Source.APIGroup)...)
@@ -99,6 +100,14 @@ func (admitter *VMExportAdmitter) Admit(_ context.Context, ar *admissionv1.Admis
 			}
 		}
 
+		if vmExport.Spec.BaseSnapshotName != nil && vmExport.Spec.Source.Kind != vmSnapshotKind {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "base snapshot is only supported when exporting a VMSnapshot",
+				Field:   k8sfield.NewPath("spec", "baseSnapshotName").String(),
+			})
+		}
+
 	case admissionv1.Update:
 		prevObj := &exportv1.VirtualMachineExport{}
 		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
@@ -185,6 +194,32 @@ func (admitter *VMExportAdmitter) validateVMSnapshotApiGroup(field *k8sfield.Pat
 	return []metav1.StatusCause{}
 }
 
+func (admitter *VMExportAdmitter) validateBaseSnapshotName(field *k8sfield.Path, baseName *string, sourceName string) []metav1.StatusCause {
+	if baseName == nil {
+		return []metav1.StatusCause{}
+	}
+	if *baseName == "" {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "base VMSnapshot name must not be empty",
+				Field:   field.String(),
+			},
+		}
+	}
+	if *baseName == sourceName {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "base VMSnapshot must differ from the source VMSnapshot",
+				Field:   field.String(),
+			},
+		}
+	}
+
+	return []metav1.StatusCause{}
+}
+
 func (admitter *VMExportAdmitter) validateVMName(field *k8sfield.Path, name string) []metav1.StatusCause {
 	if name == "" {
 		return []metav1.StatusCause{
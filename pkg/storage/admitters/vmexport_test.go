@@ -34,6 +34,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -238,6 +239,48 @@ var _ = Describe("Validating VirtualMachineExport Admitter", func() {
 			Entry("virtual machine", kubevirtApiGroup, vmKind),
 		)
 
+		It("should allow a base snapshot for a VMSnapshot source", func() {
+			export := &exportv1.VirtualMachineExport{
+				Spec: exportv1.VirtualMachineExportSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: &snapshotApiGroup,
+						Kind:     vmSnapshotKind,
+						Name:     "test",
+					},
+					BaseSnapshotName: pointer.P("base"),
+				},
+			}
+
+			ar := createExportAdmissionReview(export)
+			resp := createTestVMExportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("it should reject invalid base snapshots", func(apiGroup, kind, baseName, errorString string) {
+			export := &exportv1.VirtualMachineExport{
+				Spec: exportv1.VirtualMachineExportSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: &apiGroup,
+						Kind:     kind,
+						Name:     "test",
+					},
+					BaseSnapshotName: &baseName,
+				},
+			}
+
+			ar := createExportAdmissionReview(export)
+			resp := createTestVMExportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.baseSnapshotName"))
+			Expect(resp.Result.Message).To(ContainSubstring(errorString))
+		},
+			Entry("blank name", snapshotApiGroup, vmSnapshotKind, "", "base VMSnapshot name must not be empty"),
+			Entry("same as source", snapshotApiGroup, vmSnapshotKind, "test", "base VMSnapshot must differ from the source VMSnapshot"),
+			Entry("persistent volume claim source", "", pvc, "base", "base snapshot is only supported when exporting a VMSnapshot"),
+			Entry("virtual machine source", kubevirtApiGroup, vmKind, "base", "base snapshot is only supported when exporting a VMSnapshot"),
+		)
+
 		DescribeTable("it should reject invalid apigroups", func(apiGroup, kind string) {
 			export := &exportv1.VirtualMachineExport{
 				Spec: exportv1.VirtualMachineExportSpec{
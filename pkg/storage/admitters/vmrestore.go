@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 
@@ -119,6 +120,9 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 			}
 		}
 
+		causes = append(causes, validateVolumeRestoreOverrides(vmRestore.Spec.VolumeRestoreOverrides, k8sfield.NewPath("spec", "volumeRestoreOverrides"))...)
+		causes = append(causes, validateVolumeScopedRestore(&vmRestore.Spec, k8sfield.NewPath("spec"))...)
+
 		objects, err := admitter.VMRestoreInformer.GetIndexer().ByIndex(cache.NamespaceIndex, ar.Request.Namespace)
 		if err != nil {
 			return webhookutils.ToAdmissionResponseError(err)
@@ -186,6 +190,14 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 		return nil, err
 	}
 
+	if errors.IsNotFound(err) && isInPlaceVolumeRestore(&vmRestore.Spec) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("target VM %s has to exist to restore volumes in place", targetName),
+			Field:   field.Child("target").String(),
+		})
+	}
+
 	sourceTargetVmsAreDifferent := errors.IsNotFound(err) || (vmSnapshot.Status.SourceUID != nil && target.UID != *vmSnapshot.Status.SourceUID)
 	if sourceTargetVmsAreDifferent {
 		contentName := vmSnapshot.Status.VirtualMachineSnapshotContentName
@@ -251,3 +263,124 @@ func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sf
 
 	return causes
 }
+
+func validateVolumeRestoreOverrides(overrides []snapshotv1.VolumeRestoreOverride, field *k8sfield.Path) (causes []metav1.StatusCause) {
+	// the keys of the restore controller must not be overridden
+	const restoreKeyPrefix = "restore.kubevirt.io/"
+
+	volumeNames := map[string]struct{}{}
+	restoreNames := map[string]struct{}{}
+	for i, override := range overrides {
+		overrideField := field.Index(i)
+
+		if override.VolumeName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "volumeName is required",
+				Field:   overrideField.Child("volumeName").String(),
+			})
+		} else if _, exists := volumeNames[override.VolumeName]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("volume %s is overridden more than once", override.VolumeName),
+				Field:   overrideField.Child("volumeName").String(),
+			})
+		}
+		volumeNames[override.VolumeName] = struct{}{}
+
+		if override.RestoreName != "" {
+			if errs := validation.IsDNS1123Subdomain(override.RestoreName); len(errs) > 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("restoreName %s is not a valid PVC name: %s", override.RestoreName, strings.Join(errs, ", ")),
+					Field:   overrideField.Child("restoreName").String(),
+				})
+			} else if _, exists := restoreNames[override.RestoreName]; exists {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueDuplicate,
+					Message: fmt.Sprintf("restoreName %s is used more than once", override.RestoreName),
+					Field:   overrideField.Child("restoreName").String(),
+				})
+			}
+			restoreNames[override.RestoreName] = struct{}{}
+		}
+
+		if override.StorageClassName != nil && *override.StorageClassName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "storageClassName must not be empty",
+				Field:   overrideField.Child("storageClassName").String(),
+			})
+		}
+
+		for key := range override.Labels {
+			if strings.HasPrefix(key, restoreKeyPrefix) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("label %s is reserved", key),
+					Field:   overrideField.Child("labels").Key(key).String(),
+				})
+			}
+		}
+		for key := range override.Annotations {
+			if strings.HasPrefix(key, restoreKeyPrefix) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("annotation %s is reserved", key),
+					Field:   overrideField.Child("annotations").Key(key).String(),
+				})
+			}
+		}
+	}
+
+	return causes
+}
+
+func isInPlaceVolumeRestore(spec *snapshotv1.VirtualMachineRestoreSpec) bool {
+	return len(spec.Volumes) > 0 &&
+		(spec.VolumeRestoreMode == nil || *spec.VolumeRestoreMode == snapshotv1.VolumeRestoreModeInPlace)
+}
+
+func validateVolumeScopedRestore(spec *snapshotv1.VirtualMachineRestoreSpec, field *k8sfield.Path) (causes []metav1.StatusCause) {
+	if len(spec.Volumes) == 0 {
+		if spec.VolumeRestoreMode != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "volumeRestoreMode requires volumes to be set",
+				Field:   field.Child("volumeRestoreMode").String(),
+			})
+		}
+		return causes
+	}
+
+	for i, volumeName := range spec.Volumes {
+		if volumeName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "volume name must not be empty",
+				Field:   field.Child("volumes").Index(i).String(),
+			})
+		}
+	}
+
+	if spec.VolumeRestoreMode != nil &&
+		*spec.VolumeRestoreMode != snapshotv1.VolumeRestoreModeInPlace &&
+		*spec.VolumeRestoreMode != snapshotv1.VolumeRestoreModeStandalone {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("volumeRestoreMode %s is not supported", *spec.VolumeRestoreMode),
+			Field:   field.Child("volumeRestoreMode").String(),
+		})
+	}
+
+	// the spec of the target is kept when restoring volumes
+	if len(spec.Patches) > 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "patches can not be used when restoring volumes",
+			Field:   field.Child("patches").String(),
+		})
+	}
+
+	return causes
+}
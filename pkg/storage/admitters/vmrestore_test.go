@@ -561,6 +561,177 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				})
 			})
 
+			Context("when using VolumeRestoreOverrides", func() {
+
+				var restore *snapshotv1.VirtualMachineRestore
+
+				BeforeEach(func() {
+					restore = &snapshotv1.VirtualMachineRestore{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "restore",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineRestoreSpec{
+							Target: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     vmName,
+							},
+							VirtualMachineSnapshotName: vmSnapshotName,
+						},
+					}
+				})
+
+				It("should allow valid overrides", func() {
+					restore.Spec.VolumeRestoreOverrides = []snapshotv1.VolumeRestoreOverride{
+						{
+							VolumeName:       "disk1",
+							RestoreName:      "dr-disk1",
+							StorageClassName: pointer.P("cheap"),
+							Labels:           map[string]string{"tier": "dr"},
+							Annotations:      map[string]string{"dr.example.com/test": "true"},
+						},
+						{
+							VolumeName:       "disk2",
+							StorageClassName: pointer.P("cheap"),
+						},
+					}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeTrue())
+				})
+
+				DescribeTable("should reject", func(overrides []snapshotv1.VolumeRestoreOverride, field string) {
+					restore.Spec.VolumeRestoreOverrides = overrides
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+				},
+					Entry("missing volume name",
+						[]snapshotv1.VolumeRestoreOverride{{RestoreName: "dr-disk1"}},
+						"spec.volumeRestoreOverrides[0].volumeName",
+					),
+					Entry("duplicate volume name",
+						[]snapshotv1.VolumeRestoreOverride{{VolumeName: "disk1"}, {VolumeName: "disk1"}},
+						"spec.volumeRestoreOverrides[1].volumeName",
+					),
+					Entry("invalid restore name",
+						[]snapshotv1.VolumeRestoreOverride{{VolumeName: "disk1", RestoreName: "Invalid_Name"}},
+						"spec.volumeRestoreOverrides[0].restoreName",
+					),
+					Entry("duplicate restore name",
+						[]snapshotv1.VolumeRestoreOverride{{VolumeName: "disk1", RestoreName: "dr"}, {VolumeName: "disk2", RestoreName: "dr"}},
+						"spec.volumeRestoreOverrides[1].restoreName",
+					),
+					Entry("empty storage class name",
+						[]snapshotv1.VolumeRestoreOverride{{VolumeName: "disk1", StorageClassName: pointer.P("")}},
+						"spec.volumeRestoreOverrides[0].storageClassName",
+					),
+					Entry("reserved label",
+						[]snapshotv1.VolumeRestoreOverride{{VolumeName: "disk1", Labels: map[string]string{"restore.kubevirt.io/source-vm-name": "vm"}}},
+						"spec.volumeRestoreOverrides[0].labels[restore.kubevirt.io/source-vm-name]",
+					),
+					Entry("reserved annotation",
+						[]snapshotv1.VolumeRestoreOverride{{VolumeName: "disk1", Annotations: map[string]string{"restore.kubevirt.io/name": "restore"}}},
+						"spec.volumeRestoreOverrides[0].annotations[restore.kubevirt.io/name]",
+					),
+				)
+			})
+
+			Context("when restoring volumes", func() {
+
+				var restore *snapshotv1.VirtualMachineRestore
+
+				BeforeEach(func() {
+					restore = &snapshotv1.VirtualMachineRestore{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "restore",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineRestoreSpec{
+							Target: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     vmName,
+							},
+							VirtualMachineSnapshotName: vmSnapshotName,
+							Volumes:                    []string{"disk1"},
+						},
+					}
+				})
+
+				DescribeTable("should allow", func(mode *snapshotv1.VolumeRestoreMode) {
+					restore.Spec.VolumeRestoreMode = mode
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeTrue())
+				},
+					Entry("with default mode", nil),
+					Entry("in place", pointer.P(snapshotv1.VolumeRestoreModeInPlace)),
+					Entry("standalone", pointer.P(snapshotv1.VolumeRestoreModeStandalone)),
+				)
+
+				DescribeTable("should reject", func(updateSpec func(*snapshotv1.VirtualMachineRestoreSpec), field string) {
+					updateSpec(&restore.Spec)
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+				},
+					Entry("mode without volumes", func(spec *snapshotv1.VirtualMachineRestoreSpec) {
+						spec.Volumes = nil
+						spec.VolumeRestoreMode = pointer.P(snapshotv1.VolumeRestoreModeStandalone)
+					}, "spec.volumeRestoreMode"),
+					Entry("unsupported mode", func(spec *snapshotv1.VirtualMachineRestoreSpec) {
+						spec.VolumeRestoreMode = pointer.P(snapshotv1.VolumeRestoreMode("Unknown"))
+					}, "spec.volumeRestoreMode"),
+					Entry("empty volume name", func(spec *snapshotv1.VirtualMachineRestoreSpec) {
+						spec.Volumes = []string{"disk1", ""}
+					}, "spec.volumes[1]"),
+					Entry("patches", func(spec *snapshotv1.VirtualMachineRestoreSpec) {
+						spec.Patches = []string{`{"op": "replace", "path": "/spec/running", "value": true}`}
+					}, "spec.patches"),
+				)
+
+				It("should reject restoring volumes in place to a target that does not exist", func() {
+					vmSnapshotContent := &snapshotv1.VirtualMachineSnapshotContent{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "snapshot-content",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+							Source: snapshotv1.SourceSpec{
+								VirtualMachine: &snapshotv1.VirtualMachine{
+									ObjectMeta: vm.ObjectMeta,
+									Spec: v1.VirtualMachineSpec{
+										Template: &v1.VirtualMachineInstanceTemplateSpec{},
+									},
+								},
+							},
+						},
+					}
+					snapshot.Status.VirtualMachineSnapshotContentName = pointer.P(vmSnapshotContent.Name)
+					restore.Spec.Target.Name = "new-vm"
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, snapshot, vmSnapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.target"))
+
+					restore.Spec.VolumeRestoreMode = pointer.P(snapshotv1.VolumeRestoreModeStandalone)
+					ar = createRestoreAdmissionReview(restore)
+					resp = createTestVMRestoreAdmitter(config, snapshot, vmSnapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeTrue())
+				})
+			})
 		})
 	})
 })
@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
+	clone "kubevirt.io/api/clone/v1beta1"
 	"kubevirt.io/api/core"
 
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
@@ -37,19 +38,23 @@ import (
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 )
 
 // VMSnapshotAdmitter validates VirtualMachineSnapshots
 type VMSnapshotAdmitter struct {
 	Config *virtconfig.ClusterConfig
 	Client kubecli.KubevirtClient
+	// ControllerServiceAccount is the user name of virt-controller, the only user allowed to create clone copies
+	ControllerServiceAccount string
 }
 
 // NewVMSnapshotAdmitter creates a VMSnapshotAdmitter
-func NewVMSnapshotAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient) *VMSnapshotAdmitter {
+func NewVMSnapshotAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient, kubevirtNamespace string) *VMSnapshotAdmitter {
 	return &VMSnapshotAdmitter{
-		Config: config,
-		Client: client,
+		Config:                   config,
+		Client:                   client,
+		ControllerServiceAccount: fmt.Sprintf("system:serviceaccount:%s:%s", kubevirtNamespace, components.ControllerServiceAccountName),
 	}
 }
 
@@ -88,10 +93,23 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 			break
 		}
 
+		causes, err = admitter.validateGroupMember(ctx, ar.Request.Namespace, vmSnapshot)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		if len(causes) > 0 {
+			break
+		}
+
 		switch *vmSnapshot.Spec.Source.APIGroup {
 		case core.GroupName:
 			switch vmSnapshot.Spec.Source.Kind {
 			case "VirtualMachine":
+				_, isCloneCopy := vmSnapshot.Annotations[clone.VirtualMachineCloneAnnotation]
+				if isCloneCopy && ar.Request.UserInfo.Username == admitter.ControllerServiceAccount {
+					// copies of snapshots created by clones have no source in their namespace
+					break
+				}
 				causes, err = admitter.validateCreateVM(ctx, sourceField.Child("name"), ar.Request.Namespace, vmSnapshot.Spec.Source.Name)
 				if err != nil {
 					return webhookutils.ToAdmissionResponseError(err)
@@ -163,3 +181,39 @@ func (admitter *VMSnapshotAdmitter) validateCreateVM(ctx context.Context, field
 
 	return []metav1.StatusCause{}, nil
 }
+
+// validateGroupMember checks that a snapshot labelled as a member of a VirtualMachineSnapshotGroup
+// is controlled by that group, snapshots of unknown groups would wait forever for the group to freeze
+func (admitter *VMSnapshotAdmitter) validateGroupMember(ctx context.Context, namespace string, vmSnapshot *snapshotv1.VirtualMachineSnapshot) ([]metav1.StatusCause, error) {
+	groupName, ok := vmSnapshot.Labels[snapshotv1.VirtualMachineSnapshotGroupLabel]
+	if !ok {
+		return nil, nil
+	}
+
+	field := k8sfield.NewPath("metadata", "labels").Key(snapshotv1.VirtualMachineSnapshotGroupLabel)
+	group, err := admitter.Client.VirtualMachineSnapshotGroup(namespace).Get(ctx, groupName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("VirtualMachineSnapshotGroup %q does not exist", groupName),
+				Field:   field.String(),
+			},
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if controllerRef := metav1.GetControllerOf(vmSnapshot); controllerRef == nil || controllerRef.UID != group.UID {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("only members created by VirtualMachineSnapshotGroup %q may be labelled with it", groupName),
+				Field:   field.String(),
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
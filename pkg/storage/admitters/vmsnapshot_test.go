@@ -34,9 +34,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	clone "kubevirt.io/api/clone/v1beta1"
 	v1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -137,6 +139,94 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source.name"))
 		})
 
+		DescribeTable("should only accept a copy created by a clone when VM does not exist from virt-controller", func(username string, allowed bool) {
+			snapshot := &snapshotv1.VirtualMachineSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						clone.VirtualMachineCloneAnnotation: "default/clone",
+					},
+				},
+				Spec: snapshotv1.VirtualMachineSnapshotSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: &apiGroup,
+						Kind:     "VirtualMachine",
+						Name:     vmName,
+					},
+				},
+			}
+
+			ar := createSnapshotAdmissionReview(snapshot)
+			ar.Request.UserInfo.Username = username
+			resp := createTestVMSnapshotAdmitter(config, nil).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+		},
+			Entry("when created by virt-controller", "system:serviceaccount:kubevirt:kubevirt-controller", true),
+			Entry("when created by another user", "someone", false),
+			Entry("when created by virt-controller of another namespace", "system:serviceaccount:other:kubevirt-controller", false),
+		)
+
+		Context("with the group label", func() {
+			const groupName = "group"
+
+			var (
+				group    *snapshotv1.VirtualMachineSnapshotGroup
+				snapshot *snapshotv1.VirtualMachineSnapshot
+			)
+
+			admitWithGroups := func(groups ...*snapshotv1.VirtualMachineSnapshotGroup) *admissionv1.AdmissionResponse {
+				admitter := createTestVMSnapshotAdmitter(config, &v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: vmName}})
+				var objs []runtime.Object
+				for _, g := range groups {
+					objs = append(objs, g)
+				}
+				groupClient := kubevirtfake.NewSimpleClientset(objs...).SnapshotV1beta1().VirtualMachineSnapshotGroups("foo")
+				admitter.Client.(*kubecli.MockKubevirtClient).EXPECT().VirtualMachineSnapshotGroup("foo").Return(groupClient).AnyTimes()
+				return admitter.Admit(context.Background(), createSnapshotAdmissionReview(snapshot))
+			}
+
+			BeforeEach(func() {
+				group = &snapshotv1.VirtualMachineSnapshotGroup{
+					ObjectMeta: metav1.ObjectMeta{Name: groupName, Namespace: "foo", UID: "group-uid"},
+				}
+				snapshot = &snapshotv1.VirtualMachineSnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{snapshotv1.VirtualMachineSnapshotGroupLabel: groupName},
+						OwnerReferences: []metav1.OwnerReference{
+							*metav1.NewControllerRef(group, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshotGroup")),
+						},
+					},
+					Spec: snapshotv1.VirtualMachineSnapshotSpec{
+						Source: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+					},
+				}
+			})
+
+			It("should accept a member of the group", func() {
+				resp := admitWithGroups(group)
+				Expect(resp.Allowed).To(BeTrue())
+			})
+
+			It("should reject when the group does not exist", func() {
+				resp := admitWithGroups()
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("metadata.labels[snapshot.kubevirt.io/group]"))
+				Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("does not exist"))
+			})
+
+			It("should reject when the snapshot is not controlled by the group", func() {
+				snapshot.OwnerReferences = nil
+				resp := admitWithGroups(group)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("metadata.labels[snapshot.kubevirt.io/group]"))
+			})
+		})
+
 		It("should reject spec update", func() {
 			snapshot := &snapshotv1.VirtualMachineSnapshot{
 				Spec: snapshotv1.VirtualMachineSnapshotSpec{
@@ -371,5 +461,5 @@ func createTestVMSnapshotAdmitter(config *virtconfig.ClusterConfig, vm *v1.Virtu
 	} else {
 		vmInterface.EXPECT().Get(gomock.Any(), vm.Name, gomock.Any()).Return(vm, nil).AnyTimes()
 	}
-	return &VMSnapshotAdmitter{Config: config, Client: virtClient}
+	return NewVMSnapshotAdmitter(config, virtClient, "kubevirt")
 }
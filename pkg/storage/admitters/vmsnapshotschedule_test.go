@@ -0,0 +1,173 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotSchedule Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "nightly",
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "true"},
+				},
+				Schedule: "0 2 * * *",
+			},
+		}
+	}
+
+	Context("Without feature gate enabled", func() {
+		It("should reject anything", func() {
+			ar := createSnapshotScheduleAdmissionReview(newSchedule())
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"Snapshot"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		DescribeTable("should accept a valid schedule", func(cronSchedule string) {
+			schedule := newSchedule()
+			schedule.Spec.Schedule = cronSchedule
+			schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				KeepLast:  pointer.P(int32(3)),
+				KeepDaily: pointer.P(int32(7)),
+				MaxAge:    &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			}
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), createSnapshotScheduleAdmissionReview(schedule))
+			Expect(resp.Allowed).To(BeTrue())
+		},
+			Entry("in cron format", "30 2 * * 1-5"),
+			Entry("with a descriptor", "@daily"),
+		)
+
+		DescribeTable("should reject", func(update func(*snapshotv1.VirtualMachineSnapshotSchedule), field string) {
+			schedule := newSchedule()
+			update(schedule)
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), createSnapshotScheduleAdmissionReview(schedule))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("an invalid schedule", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Schedule = "every day"
+			}, "spec.schedule"),
+			Entry("an empty schedule", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Schedule = ""
+			}, "spec.schedule"),
+			Entry("an invalid selector", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{
+					Key:      "backup",
+					Operator: "Unknown",
+				}}
+			}, "spec.selector"),
+			Entry("a negative failure deadline", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.FailureDeadline = &metav1.Duration{Duration: -time.Minute}
+			}, "spec.failureDeadline"),
+			Entry("a negative keep rule", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+					KeepWeekly: pointer.P(int32(-1)),
+				}
+			}, "spec.retention.keepWeekly"),
+			Entry("a non positive max age", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+					MaxAge: &metav1.Duration{},
+				}
+			}, "spec.retention.maxAge"),
+			Entry("a name that is not a valid label value", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Name = strings.Repeat("a", 64)
+			}, "metadata.name"),
+		)
+	})
+})
+
+func createSnapshotScheduleAdmissionReview(schedule *snapshotv1.VirtualMachineSnapshotSchedule) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(schedule)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinesnapshotschedules",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+
+	return ar
+}
@@ -0,0 +1,135 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	"kubevirt.io/api/migrations"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+)
+
+// VMStorageMigrationAdmitter validates VirtualMachineStorageMigrations
+type VMStorageMigrationAdmitter struct{}
+
+// NewVMStorageMigrationAdmitter creates a VMStorageMigrationAdmitter
+func NewVMStorageMigrationAdmitter() *VMStorageMigrationAdmitter {
+	return &VMStorageMigrationAdmitter{}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMStorageMigrationAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != migrations.GroupName ||
+		ar.Request.Resource.Resource != migrations.ResourceVirtualMachineStorageMigrations {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	storageMigration := &migrationsv1.VirtualMachineStorageMigration{}
+	// TODO ideally use UniversalDeserializer here
+	err := json.Unmarshal(ar.Request.Object.Raw, storageMigration)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		causes = validateStorageMigrationSpec(k8sfield.NewPath("spec"), &storageMigration.Spec)
+	case admissionv1.Update:
+		prevObj := &migrationsv1.VirtualMachineStorageMigration{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec, storageMigration.Spec) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "spec in immutable after creation",
+				Field:   k8sfield.NewPath("spec").String(),
+			})
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateStorageMigrationSpec(field *k8sfield.Path, spec *migrationsv1.VirtualMachineStorageMigrationSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.VMName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "vmName is required",
+			Field:   field.Child("vmName").String(),
+		})
+	}
+
+	if spec.StorageClassName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "storageClassName is required",
+			Field:   field.Child("storageClassName").String(),
+		})
+	}
+
+	volumes := map[string]struct{}{}
+	for i, volume := range spec.Volumes {
+		if _, exists := volumes[volume]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("volume %s is listed more than once", volume),
+				Field:   field.Child("volumes").Index(i).String(),
+			})
+		}
+		volumes[volume] = struct{}{}
+	}
+
+	switch spec.SourceVolumePolicy {
+	case "", migrationsv1.SourceVolumePolicyRetain, migrationsv1.SourceVolumePolicyDelete:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("sourceVolumePolicy must be one of %s, %s", migrationsv1.SourceVolumePolicyRetain, migrationsv1.SourceVolumePolicyDelete),
+			Field:   field.Child("sourceVolumePolicy").String(),
+		})
+	}
+
+	return causes
+}
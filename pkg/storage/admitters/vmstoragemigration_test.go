@@ -0,0 +1,153 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"kubevirt.io/api/migrations"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineStorageMigration Admitter", func() {
+	newStorageMigration := func() *migrationsv1.VirtualMachineStorageMigration {
+		return &migrationsv1.VirtualMachineStorageMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "migrate-storage",
+				Namespace: "default",
+			},
+			Spec: migrationsv1.VirtualMachineStorageMigrationSpec{
+				VMName:           "testvm",
+				StorageClassName: "fast",
+			},
+		}
+	}
+
+	It("should reject invalid request resource", func() {
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachineGroupVersionResource,
+			},
+		}
+
+		resp := NewVMStorageMigrationAdmitter().Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+	})
+
+	DescribeTable("should accept a valid storage migration", func(update func(*migrationsv1.VirtualMachineStorageMigration)) {
+		storageMigration := newStorageMigration()
+		update(storageMigration)
+
+		resp := NewVMStorageMigrationAdmitter().Admit(context.Background(), createStorageMigrationAdmissionReview(storageMigration, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	},
+		Entry("of all volumes", func(*migrationsv1.VirtualMachineStorageMigration) {}),
+		Entry("of selected volumes", func(m *migrationsv1.VirtualMachineStorageMigration) {
+			m.Spec.Volumes = []string{"rootdisk", "datadisk"}
+		}),
+		Entry("deleting the source volumes", func(m *migrationsv1.VirtualMachineStorageMigration) {
+			m.Spec.SourceVolumePolicy = migrationsv1.SourceVolumePolicyDelete
+		}),
+	)
+
+	DescribeTable("should reject", func(update func(*migrationsv1.VirtualMachineStorageMigration), field string) {
+		storageMigration := newStorageMigration()
+		update(storageMigration)
+
+		resp := NewVMStorageMigrationAdmitter().Admit(context.Background(), createStorageMigrationAdmissionReview(storageMigration, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("a missing VM name", func(m *migrationsv1.VirtualMachineStorageMigration) {
+			m.Spec.VMName = ""
+		}, "spec.vmName"),
+		Entry("a missing storage class", func(m *migrationsv1.VirtualMachineStorageMigration) {
+			m.Spec.StorageClassName = ""
+		}, "spec.storageClassName"),
+		Entry("a duplicate volume", func(m *migrationsv1.VirtualMachineStorageMigration) {
+			m.Spec.Volumes = []string{"rootdisk", "rootdisk"}
+		}, "spec.volumes[1]"),
+		Entry("an unknown source volume policy", func(m *migrationsv1.VirtualMachineStorageMigration) {
+			m.Spec.SourceVolumePolicy = "Archive"
+		}, "spec.sourceVolumePolicy"),
+	)
+
+	It("should reject spec updates", func() {
+		oldStorageMigration := newStorageMigration()
+		storageMigration := newStorageMigration()
+		storageMigration.Spec.StorageClassName = "slow"
+
+		resp := NewVMStorageMigrationAdmitter().Admit(context.Background(), createStorageMigrationAdmissionReview(storageMigration, oldStorageMigration))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+	})
+
+	It("should allow status updates", func() {
+		oldStorageMigration := newStorageMigration()
+		storageMigration := newStorageMigration()
+		storageMigration.Status = &migrationsv1.VirtualMachineStorageMigrationStatus{
+			Phase: migrationsv1.StorageMigrationMigrating,
+		}
+
+		resp := NewVMStorageMigrationAdmitter().Admit(context.Background(), createStorageMigrationAdmissionReview(storageMigration, oldStorageMigration))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+})
+
+func createStorageMigrationAdmissionReview(storageMigration, oldStorageMigration *migrationsv1.VirtualMachineStorageMigration) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(storageMigration)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: storageMigration.Namespace,
+			Resource: metav1.GroupVersionResource{
+				Group:    migrations.GroupName,
+				Resource: migrations.ResourceVirtualMachineStorageMigrations,
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+
+	if oldStorageMigration != nil {
+		oldBytes, _ := json.Marshal(oldStorageMigration)
+		ar.Request.Operation = admissionv1.Update
+		ar.Request.OldObject = runtime.RawExtension{
+			Raw: oldBytes,
+		}
+	}
+
+	return ar
+}
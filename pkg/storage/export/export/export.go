@@ -156,6 +156,10 @@ func rawGzipURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.img.gz", urlBasePath, pvc.Name))
 }
 
+func qcow2URI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.qcow2", urlBasePath, pvc.Name))
+}
+
 func archiveURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.tar.gz", urlBasePath, pvc.Name))
 }
@@ -164,8 +168,18 @@ func dirURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/dir", urlBasePath, pvc.Name)) + "/"
 }
 
+func extentsURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/extents.json", urlBasePath, pvc.Name))
+}
+
+func rawDeltaURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.delta", urlBasePath, pvc.Name))
+}
+
 type sourceVolumes struct {
-	volumes          []*corev1.PersistentVolumeClaim
+	volumes []*corev1.PersistentVolumeClaim
+	// baseVolumes maps the name of a volume to the volume restored from the base snapshot
+	baseVolumes      map[string]*corev1.PersistentVolumeClaim
 	inUse            bool
 	isPopulated      bool
 	availableMessage string
@@ -561,7 +575,7 @@ func (ctrl *VMExportController) manageExporterPod(vmExport *exportv1.VirtualMach
 	if !podExists {
 		if sourceVolumes.isSourceAvailable() {
 			if len(sourceVolumes.volumes) > 0 {
-				pod, err = ctrl.createExporterPod(vmExport, service, sourceVolumes.volumes)
+				pod, err = ctrl.createExporterPod(vmExport, service, sourceVolumes.volumes, sourceVolumes.baseVolumes)
 				if err != nil {
 					return nil, err
 				}
@@ -868,14 +882,14 @@ func (ctrl *VMExportController) getExporterPod(vmExport *exportv1.VirtualMachine
 	}
 }
 
-func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
+func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim, basePVCs map[string]*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
 	log.Log.V(3).Infof("Checking if pod exists: %s/%s", vmExport.Namespace, ctrl.getExportPodName(vmExport))
 	key := controller.NamespacedKey(vmExport.Namespace, ctrl.getExportPodName(vmExport))
 	if obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(key); err != nil {
 		log.Log.Errorf("error %v", err)
 		return nil, err
 	} else if !exists {
-		manifest, err := ctrl.createExporterPodManifest(vmExport, service, pvcs)
+		manifest, err := ctrl.createExporterPodManifest(vmExport, service, pvcs, basePVCs)
 		if err != nil {
 			return nil, err
 		}
@@ -893,7 +907,7 @@ func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMach
 	}
 }
 
-func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
+func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim, basePVCs map[string]*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
 	certParams, err := ctrl.getCertParams()
 	if err != nil {
 		return nil, err
@@ -920,31 +934,15 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
 	}
 	for i, pvc := range pvcs {
-		var mountPoint string
-		volumeName := strings.ReplaceAll(pvc.Name, ".", "-")
-		if types.IsPVCBlock(pvc.Spec.VolumeMode) {
-			mountPoint = fmt.Sprintf("%s/%s", blockVolumeMountPath, volumeName)
-			podManifest.Spec.Containers[0].VolumeDevices = append(podManifest.Spec.Containers[0].VolumeDevices, corev1.VolumeDevice{
-				Name:       volumeName,
-				DevicePath: mountPoint,
-			})
-		} else {
-			mountPoint = fmt.Sprintf("%s/%s", fileSystemMountPath, volumeName)
-			podManifest.Spec.Containers[0].VolumeMounts = append(podManifest.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
-				Name:      volumeName,
-				ReadOnly:  true,
-				MountPath: mountPoint,
-			})
-		}
-		podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
-			Name: volumeName,
-			VolumeSource: corev1.VolumeSource{
-				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-					ClaimName: pvc.Name,
-				},
-			},
-		})
+		mountPoint := addVolumeToExporterPod(podManifest, pvc)
 		ctrl.addVolumeEnvironmentVariables(&podManifest.Spec.Containers[0], pvc, i, mountPoint)
+		if vmExport.Spec.BaseSnapshotName != nil && ctrl.isKubevirtContentType(pvc) {
+			var baseMountPoint string
+			if basePVC, ok := basePVCs[pvc.Name]; ok {
+				baseMountPoint = addVolumeToExporterPod(podManifest, basePVC)
+			}
+			addDeltaEnvironmentVariables(&podManifest.Spec.Containers[0], pvc, i, baseMountPoint)
+		}
 	}
 
 	// Add token and certs ENV variables
@@ -1151,6 +1149,52 @@ func (ctrl *VMExportController) getVmFromExport(vmExport *exportv1.VirtualMachin
 	return nil, nil
 }
 
+func addVolumeToExporterPod(podManifest *corev1.Pod, pvc *corev1.PersistentVolumeClaim) string {
+	var mountPoint string
+	volumeName := strings.ReplaceAll(pvc.Name, ".", "-")
+	if types.IsPVCBlock(pvc.Spec.VolumeMode) {
+		mountPoint = fmt.Sprintf("%s/%s", blockVolumeMountPath, volumeName)
+		podManifest.Spec.Containers[0].VolumeDevices = append(podManifest.Spec.Containers[0].VolumeDevices, corev1.VolumeDevice{
+			Name:       volumeName,
+			DevicePath: mountPoint,
+		})
+	} else {
+		mountPoint = fmt.Sprintf("%s/%s", fileSystemMountPath, volumeName)
+		podManifest.Spec.Containers[0].VolumeMounts = append(podManifest.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      volumeName,
+			ReadOnly:  true,
+			MountPath: mountPoint,
+		})
+	}
+	podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: pvc.Name,
+			},
+		},
+	})
+	return mountPoint
+}
+
+// addDeltaEnvironmentVariables exposes the changed extents of a volume, without a base
+// mount point the whole volume is reported as changed
+func addDeltaEnvironmentVariables(exportContainer *corev1.Container, pvc *corev1.PersistentVolumeClaim, index int, baseMountPoint string) {
+	if baseMountPoint != "" {
+		exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_BASE_PATH", index),
+			Value: baseMountPoint,
+		})
+	}
+	exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+		Name:  fmt.Sprintf("VOLUME%d_EXPORT_EXTENTS_URI", index),
+		Value: extentsURI(pvc),
+	}, corev1.EnvVar{
+		Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_DELTA_URI", index),
+		Value: rawDeltaURI(pvc),
+	})
+}
+
 func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *corev1.Container, pvc *corev1.PersistentVolumeClaim, index int, mountPoint string) {
 	exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
 		Name:  fmt.Sprintf("VOLUME%d_EXPORT_PATH", index),
@@ -1163,6 +1207,9 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 		}, corev1.EnvVar{
 			Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 			Value: rawGzipURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+			Value: qcow2URI(pvc),
 		})
 	} else {
 		if ctrl.isKubevirtContentType(pvc) {
@@ -1172,6 +1219,9 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 			}, corev1.EnvVar{
 				Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 				Value: rawGzipURI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+				Value: qcow2URI(pvc),
 			})
 		} else {
 			exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
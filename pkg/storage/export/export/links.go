@@ -125,6 +125,12 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 				Url:    scheme + path.Join(hostAndBase, volumeInfo.RawGzURI),
 			})
 		}
+		if volumeInfo.Qcow2URI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.Qcow2URI),
+			})
+		}
 		if volumeInfo.DirURI != "" {
 			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.Dir,
@@ -137,6 +143,18 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 				Url:    scheme + path.Join(hostAndBase, volumeInfo.ArchiveURI),
 			})
 		}
+		if volumeInfo.ExtentsURI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.ChangedExtents,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.ExtentsURI),
+			})
+		}
+		if volumeInfo.RawDeltaURI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtRawDelta,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.RawDeltaURI),
+			})
+		}
 
 		if len(ev.Formats) == 0 {
 			log.Log.Warningf("No formats found for volume %s", pvc.Name)
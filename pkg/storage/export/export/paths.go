@@ -10,11 +10,15 @@ import (
 
 // VolumeInfo contains paths for a volume
 type VolumeInfo struct {
-	Path       string
-	ArchiveURI string
-	DirURI     string
-	RawURI     string
-	RawGzURI   string
+	Path        string
+	BasePath    string
+	ArchiveURI  string
+	DirURI      string
+	RawURI      string
+	RawGzURI    string
+	Qcow2URI    string
+	ExtentsURI  string
+	RawDeltaURI string
 }
 
 // ServerPaths contains static paths and per-volume paths
@@ -53,11 +57,15 @@ func CreateServerPaths(env map[string]string) *ServerPaths {
 		if strings.HasSuffix(k, "_EXPORT_PATH") {
 			envPrefix := strings.TrimSuffix(k, "_EXPORT_PATH")
 			vi := VolumeInfo{
-				Path:       v,
-				ArchiveURI: env[envPrefix+"_EXPORT_ARCHIVE_URI"],
-				DirURI:     env[envPrefix+"_EXPORT_DIR_URI"],
-				RawURI:     env[envPrefix+"_EXPORT_RAW_URI"],
-				RawGzURI:   env[envPrefix+"_EXPORT_RAW_GZIP_URI"],
+				Path:        v,
+				BasePath:    env[envPrefix+"_EXPORT_BASE_PATH"],
+				ArchiveURI:  env[envPrefix+"_EXPORT_ARCHIVE_URI"],
+				DirURI:      env[envPrefix+"_EXPORT_DIR_URI"],
+				RawURI:      env[envPrefix+"_EXPORT_RAW_URI"],
+				RawGzURI:    env[envPrefix+"_EXPORT_RAW_GZIP_URI"],
+				Qcow2URI:    env[envPrefix+"_EXPORT_QCOW2_URI"],
+				ExtentsURI:  env[envPrefix+"_EXPORT_EXTENTS_URI"],
+				RawDeltaURI: env[envPrefix+"_EXPORT_RAW_DELTA_URI"],
 			}
 			result.Volumes = append(result.Volumes, vi)
 		}
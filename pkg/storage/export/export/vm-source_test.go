@@ -388,6 +388,10 @@ var _ = Describe("PVC source", func() {
 			Format: exportv1.KubeVirtGz,
 			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[0]),
 		})
+		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.KubeVirtQcow2,
+			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[0]),
+		})
 		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
 			Format: exportv1.Dir,
 			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/dir", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[1]),
@@ -396,7 +400,7 @@ var _ = Describe("PVC source", func() {
 			Format: exportv1.ArchiveGz,
 			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.tar.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[1]),
 		})
-		verifyLinksInternal(vmExport, exportVolumeFormats...)
+		verifyLinksInternal(vmExport, len(volumeNames), exportVolumeFormats...)
 	}
 
 	DescribeTable("Should create VM export, when VM is stopped", func(createVMFunc func() *virtv1.VirtualMachine, contentType1, contentType2 string, verifyFunc func(vmExport *exportv1.VirtualMachineExport, exportName, namespace string, volumeNames ...string)) {
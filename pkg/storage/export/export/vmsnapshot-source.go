@@ -86,6 +86,9 @@ func (ctrl *VMExportController) getPVCFromSourceVMSnapshot(vmExport *exportv1.Vi
 			return &sourceVolumes{}, err
 		}
 		if len(pvcs) == restoreableSnapshots && restoreableSnapshots > 0 {
+			if vmExport.Spec.BaseSnapshotName != nil {
+				return ctrl.getBasePVCsFromVMSnapshot(vmExport, vmSnapshot, pvcs)
+			}
 			return &sourceVolumes{
 				volumes:          pvcs,
 				inUse:            false,
@@ -129,7 +132,7 @@ func (ctrl *VMExportController) handlePVCsForVirtualMachineSnapshot(vmExport *ex
 			totalVolumes = len(content.Status.VolumeSnapshotStatus)
 
 			for _, volumeBackup := range content.Spec.VolumeBackups {
-				if pvc, err := ctrl.getOrCreatePVCFromSnapshot(vmExport, &volumeBackup, sourceVm); err != nil {
+				if pvc, err := ctrl.getOrCreatePVCFromSnapshot(vmExport, getRestorePVCName(vmExport, &volumeBackup), &volumeBackup, sourceVm); err != nil {
 					return nil, 0, err
 				} else {
 					pvcs = append(pvcs, pvc)
@@ -140,12 +143,94 @@ func (ctrl *VMExportController) handlePVCsForVirtualMachineSnapshot(vmExport *ex
 	return pvcs, totalVolumes, err
 }
 
-func (ctrl *VMExportController) getOrCreatePVCFromSnapshot(vmExport *exportv1.VirtualMachineExport, volumeBackup *snapshotv1.VolumeBackup, sourceVm *snapshotv1.VirtualMachine) (*corev1.PersistentVolumeClaim, error) {
+// getBasePVCsFromVMSnapshot restores the volumes of the base snapshot that match a volume
+// of the exported snapshot, volumes without a match are exported as entirely changed
+func (ctrl *VMExportController) getBasePVCsFromVMSnapshot(vmExport *exportv1.VirtualMachineExport, vmSnapshot *snapshotv1.VirtualMachineSnapshot, pvcs []*corev1.PersistentVolumeClaim) (*sourceVolumes, error) {
+	baseName := *vmExport.Spec.BaseSnapshotName
+	baseSnapshot, exists, err := ctrl.getVmSnapshot(vmExport.Namespace, baseName)
+	if err != nil {
+		return &sourceVolumes{}, err
+	}
+	if !exists {
+		return &sourceVolumes{
+			volumes:          nil,
+			inUse:            false,
+			isPopulated:      false,
+			availableMessage: fmt.Sprintf("Base VirtualMachineSnapshot %s/%s does not exist", vmExport.Namespace, baseName)}, nil
+	}
+	if baseSnapshot.Spec.Source.Name != vmSnapshot.Spec.Source.Name {
+		return &sourceVolumes{
+			volumes:          nil,
+			inUse:            false,
+			isPopulated:      false,
+			availableMessage: fmt.Sprintf("Base VirtualMachineSnapshot %s/%s is not a snapshot of VirtualMachine %s", vmExport.Namespace, baseName, vmSnapshot.Spec.Source.Name)}, nil
+	}
+	if baseSnapshot.Status == nil || baseSnapshot.Status.ReadyToUse == nil || !*baseSnapshot.Status.ReadyToUse ||
+		baseSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+		return &sourceVolumes{
+			volumes:          nil,
+			inUse:            false,
+			isPopulated:      false,
+			availableMessage: fmt.Sprintf("Base VirtualMachineSnapshot %s/%s is not ready to use", vmExport.Namespace, baseName)}, nil
+	}
+	baseContent, exists, err := ctrl.getVmSnapshotContent(baseSnapshot.Namespace, *baseSnapshot.Status.VirtualMachineSnapshotContentName)
+	if err != nil {
+		return &sourceVolumes{}, err
+	}
+	if !exists {
+		return &sourceVolumes{
+			volumes:          nil,
+			inUse:            false,
+			isPopulated:      false,
+			availableMessage: fmt.Sprintf("Base VirtualMachineSnapshot %s/%s is not ready to use", vmExport.Namespace, baseName)}, nil
+	}
+	content, exists, err := ctrl.getVmSnapshotContent(vmSnapshot.Namespace, *vmSnapshot.Status.VirtualMachineSnapshotContentName)
+	if err != nil || !exists {
+		return &sourceVolumes{}, err
+	}
+
+	baseVolumes := make(map[string]*corev1.PersistentVolumeClaim)
+	for _, volumeBackup := range content.Spec.VolumeBackups {
+		baseBackup := getVolumeBackup(baseContent, volumeBackup.VolumeName)
+		if baseBackup == nil {
+			continue
+		}
+		basePVC, err := ctrl.getOrCreatePVCFromSnapshot(vmExport, getBaseRestorePVCName(vmExport, baseBackup), baseBackup, baseContent.Spec.Source.VirtualMachine)
+		if err != nil {
+			return &sourceVolumes{}, err
+		}
+		baseVolumes[getRestorePVCName(vmExport, &volumeBackup)] = basePVC
+	}
+	return &sourceVolumes{
+		volumes:          pvcs,
+		baseVolumes:      baseVolumes,
+		inUse:            false,
+		isPopulated:      true,
+		availableMessage: ""}, nil
+}
+
+func getVolumeBackup(content *snapshotv1.VirtualMachineSnapshotContent, volumeName string) *snapshotv1.VolumeBackup {
+	for i := range content.Spec.VolumeBackups {
+		if content.Spec.VolumeBackups[i].VolumeName == volumeName {
+			return &content.Spec.VolumeBackups[i]
+		}
+	}
+	return nil
+}
+
+func getRestorePVCName(vmExport *exportv1.VirtualMachineExport, volumeBackup *snapshotv1.VolumeBackup) string {
+	return fmt.Sprintf("%s-%s", vmExport.Name, volumeBackup.PersistentVolumeClaim.Name)
+}
+
+func getBaseRestorePVCName(vmExport *exportv1.VirtualMachineExport, volumeBackup *snapshotv1.VolumeBackup) string {
+	return fmt.Sprintf("%s-base-%s", vmExport.Name, volumeBackup.PersistentVolumeClaim.Name)
+}
+
+func (ctrl *VMExportController) getOrCreatePVCFromSnapshot(vmExport *exportv1.VirtualMachineExport, restorePVCName string, volumeBackup *snapshotv1.VolumeBackup, sourceVm *snapshotv1.VirtualMachine) (*corev1.PersistentVolumeClaim, error) {
 	if volumeBackup.VolumeSnapshotName == nil {
 		log.Log.Errorf("VolumeSnapshot name missing %+v", volumeBackup)
 		return nil, fmt.Errorf("missing VolumeSnapshot name")
 	}
-	restorePVCName := fmt.Sprintf("%s-%s", vmExport.Name, volumeBackup.PersistentVolumeClaim.Name)
 
 	if pvc, exists, err := ctrl.getPvc(vmExport.Namespace, restorePVCName); err != nil {
 		return nil, err
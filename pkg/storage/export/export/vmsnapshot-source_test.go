@@ -542,6 +542,97 @@ var _ = Describe("VMSnapshot source", func() {
 		Expect(retry).To(BeEquivalentTo(0))
 	})
 
+	It("Should create restored base PVCs when a base VMSnapshot is set", func() {
+		testVMExport := createSnapshotVMExport()
+		testVMExport.Spec.BaseSnapshotName = pointer.P("base-snapshot")
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			return true, vmExport, nil
+		})
+
+		createdPVCs := []string{}
+		k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			create, ok := action.(testing.CreateAction)
+			Expect(ok).To(BeTrue())
+			pvc, ok := create.GetObject().(*k8sv1.PersistentVolumeClaim)
+			Expect(ok).To(BeTrue())
+			Expect(pvc.Spec.DataSource).ToNot(BeNil())
+			createdPVCs = append(createdPVCs, pvc.Name)
+			return true, pvc, nil
+		})
+		expectExporterCreate(k8sClient, k8sv1.PodPending)
+
+		vmSnapshot := createTestVMSnapshot(true)
+		vmSnapshot.Spec.Source.Name = "test-vm"
+		baseSnapshot := createTestVMSnapshot(true)
+		baseSnapshot.Name = "base-snapshot"
+		baseSnapshot.Spec.Source.Name = "test-vm"
+		baseSnapshot.Status.VirtualMachineSnapshotContentName = pointer.P("base-snapshot-content")
+		pvcInformer.GetStore().Add(createRestoredPVC("test-test-snapshot"))
+		vmSnapshotInformer.GetStore().Add(vmSnapshot)
+		vmSnapshotInformer.GetStore().Add(baseSnapshot)
+		vmSnapshotContentInformer.GetStore().Add(createTestVMSnapshotContent("snapshot-content"))
+		vmSnapshotContentInformer.GetStore().Add(createTestVMSnapshotContent("base-snapshot-content"))
+		fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot(testVolumesnapshotName))
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+		Expect(createdPVCs).To(ConsistOf("test-base-test-snapshot"))
+	})
+
+	DescribeTable("Should not create restored base PVCs when the base VMSnapshot", func(updateBase func(*snapshotv1.VirtualMachineSnapshot), expectedMessage string) {
+		testVMExport := createSnapshotVMExport()
+		testVMExport.Spec.BaseSnapshotName = pointer.P("base-snapshot")
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			verifyLinksEmpty(vmExport)
+			volumeCreateConditionSet := false
+			for _, condition := range vmExport.Status.Conditions {
+				if condition.Type == exportv1.ConditionVolumesCreated {
+					volumeCreateConditionSet = true
+					Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+					Expect(condition.Message).To(Equal(expectedMessage))
+				}
+			}
+			Expect(volumeCreateConditionSet).To(BeTrue())
+			return true, vmExport, nil
+		})
+		k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Fail("unexpected create persistentvolumeclaims called")
+			return true, nil, nil
+		})
+
+		vmSnapshot := createTestVMSnapshot(true)
+		vmSnapshot.Spec.Source.Name = "test-vm"
+		baseSnapshot := createTestVMSnapshot(true)
+		baseSnapshot.Name = "base-snapshot"
+		baseSnapshot.Spec.Source.Name = "test-vm"
+		updateBase(baseSnapshot)
+		pvcInformer.GetStore().Add(createRestoredPVC("test-test-snapshot"))
+		vmSnapshotInformer.GetStore().Add(vmSnapshot)
+		if baseSnapshot.Name != "" {
+			vmSnapshotInformer.GetStore().Add(baseSnapshot)
+		}
+		vmSnapshotContentInformer.GetStore().Add(createTestVMSnapshotContent("snapshot-content"))
+		fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot(testVolumesnapshotName))
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	},
+		Entry("does not exist", func(s *snapshotv1.VirtualMachineSnapshot) { s.Name = "" },
+			fmt.Sprintf("Base VirtualMachineSnapshot %s/base-snapshot does not exist", testNamespace)),
+		Entry("is of another VM", func(s *snapshotv1.VirtualMachineSnapshot) { s.Spec.Source.Name = "other-vm" },
+			fmt.Sprintf("Base VirtualMachineSnapshot %s/base-snapshot is not a snapshot of VirtualMachine test-vm", testNamespace)),
+		Entry("is not ready", func(s *snapshotv1.VirtualMachineSnapshot) { s.Status.ReadyToUse = pointer.P(false) },
+			fmt.Sprintf("Base VirtualMachineSnapshot %s/base-snapshot is not ready to use", testNamespace)),
+	)
+
 	It("Should update status with correct links from snapshot with kubevirt content type", func() {
 		testVMExport := createSnapshotVMExport()
 		restoreName := fmt.Sprintf("%s-%s", testVMExport.Name, testVolumesnapshotName)
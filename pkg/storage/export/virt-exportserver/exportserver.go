@@ -86,6 +86,9 @@ type ExportServerConfig struct {
 	DirHandler         func(string, string) http.Handler
 	FileHandler        func(string) http.Handler
 	GzipHandler        func(string) http.Handler
+	Qcow2Handler       func(string) http.Handler
+	ExtentsHandler     func(string, string) http.Handler
+	RawDeltaHandler    func(string, string) http.Handler
 	VmHandler          func([]export.VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
 
@@ -127,6 +130,11 @@ func (s *exportServer) initHandler() {
 		if hasPermissions := s.PermissionChecker(vi.Path); !hasPermissions {
 			golog.Fatalf("unable to manipulate %s's contents, exiting", vi.Path)
 		}
+		if vi.BasePath != "" {
+			if hasPermissions := s.PermissionChecker(vi.BasePath); !hasPermissions {
+				golog.Fatalf("unable to manipulate %s's contents, exiting", vi.BasePath)
+			}
+		}
 		for path, handler := range s.getHandlerMap(vi) {
 			log.Log.Infof("Handling path %s\n", path)
 			mux.Handle(path, tokenChecker(s.TokenGetter, handler))
@@ -184,6 +192,27 @@ func (s *exportServer) getHandlerMap(vi export.VolumeInfo) map[string]http.Handl
 		result[vi.RawGzURI] = s.GzipHandler(p)
 	}
 
+	if vi.Qcow2URI != "" {
+		result[vi.Qcow2URI] = s.Qcow2Handler(p)
+	}
+
+	if vi.ExtentsURI != "" || vi.RawDeltaURI != "" {
+		basePath := ""
+		if vi.BasePath != "" {
+			basePath, err = volumeImagePath(vi.BasePath)
+			if err != nil {
+				log.Log.Reason(err).Errorf("error statting %s", vi.BasePath)
+				return result
+			}
+		}
+		if vi.ExtentsURI != "" {
+			result[vi.ExtentsURI] = s.ExtentsHandler(p, basePath)
+		}
+		if vi.RawDeltaURI != "" {
+			result[vi.RawDeltaURI] = s.RawDeltaHandler(p, basePath)
+		}
+	}
+
 	return result
 }
 
@@ -243,6 +272,18 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.GzipHandler = gzipHandler
 	}
 
+	if es.Qcow2Handler == nil {
+		es.Qcow2Handler = qcow2Handler
+	}
+
+	if es.ExtentsHandler == nil {
+		es.ExtentsHandler = extentsHandler
+	}
+
+	if es.RawDeltaHandler == nil {
+		es.RawDeltaHandler = rawDeltaHandler
+	}
+
 	if es.VmHandler == nil {
 		es.VmHandler = vmHandler
 	}
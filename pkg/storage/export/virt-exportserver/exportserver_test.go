@@ -63,6 +63,15 @@ func newTestServer(token string) *exportServer {
 		GzipHandler: func(string) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		Qcow2Handler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		ExtentsHandler: func(string, string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		RawDeltaHandler: func(string, string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		VmHandler: func([]export.VolumeInfo, func() (string, error), func() (*v1.ConfigMap, error)) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
@@ -125,6 +134,26 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", BasePath: "/tmp", ExtentsURI: "/volume/v1/extents.json"},
+			"/volume/v1/extents.json",
+		),
+		Entry("raw delta URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", BasePath: "/tmp", RawDeltaURI: "/volume/v1/disk.delta"},
+			"/volume/v1/disk.delta",
+		),
+		Entry("raw delta URI without base",
+			"",
+			&export.VolumeInfo{Path: "/tmp", RawDeltaURI: "/volume/v1/disk.delta"},
+			"/volume/v1/disk.delta",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -0,0 +1,316 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"sync"
+
+	"golang.org/x/sys/unix"
+
+	"kubevirt.io/client-go/log"
+)
+
+// ExtentBlockSize is the granularity at which a volume is compared against its base
+const ExtentBlockSize int64 = 64 * 1024
+
+// Extent is a contiguous byte range of a volume
+type Extent struct {
+	Offset int64 `json:"offset"`
+	Length int64 `json:"length"`
+}
+
+// ChangedExtents lists the extents of a volume that differ from the base volume.
+// The raw-delta stream contains the data of these extents, concatenated in order.
+type ChangedExtents struct {
+	// Size is the size in bytes of the exported volume
+	Size int64 `json:"size"`
+	// BlockSize is the granularity of the comparison, every extent is aligned to it
+	BlockSize int64 `json:"blockSize"`
+	// Extents are the changed byte ranges, sorted by offset and not overlapping
+	Extents []Extent `json:"extents"`
+}
+
+// DataLength returns the amount of bytes covered by the changed extents
+func (ce *ChangedExtents) DataLength() int64 {
+	var length int64
+	for _, e := range ce.Extents {
+		length += e.Length
+	}
+	return length
+}
+
+// maxConcurrentComparisons bounds the disk bandwidth spent by the server, every
+// comparison reads both the volume and its base entirely
+const maxConcurrentComparisons = 2
+
+type changedExtentsEntry struct {
+	lock    sync.Mutex
+	extents *ChangedExtents
+}
+
+// changedExtentsCache memoizes the changed extents per volume, the volumes
+// are restored from snapshots and do not change while the server runs.
+// Every volume is locked separately, so that the requests of a volume do not
+// wait for the comparison of another one.
+type changedExtentsCache struct {
+	lock        sync.Mutex
+	entries     map[string]*changedExtentsEntry
+	comparisons chan struct{}
+}
+
+var extentsCache = newChangedExtentsCache()
+
+func newChangedExtentsCache() *changedExtentsCache {
+	return &changedExtentsCache{
+		entries:     map[string]*changedExtentsEntry{},
+		comparisons: make(chan struct{}, maxConcurrentComparisons),
+	}
+}
+
+func (c *changedExtentsCache) entry(key string) *changedExtentsEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		e = &changedExtentsEntry{}
+		c.entries[key] = e
+	}
+	return e
+}
+
+func (c *changedExtentsCache) get(filePath, basePath string) (*ChangedExtents, error) {
+	e := c.entry(filePath + ":" + basePath)
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.extents != nil {
+		return e.extents, nil
+	}
+
+	c.comparisons <- struct{}{}
+	defer func() { <-c.comparisons }()
+	ce, err := computeChangedExtents(filePath, basePath, ExtentBlockSize)
+	if err != nil {
+		return nil, err
+	}
+	e.extents = ce
+	return ce, nil
+}
+
+// volumeImagePath returns the path of the disk image of a mounted volume
+func volumeImagePath(volumePath string) (string, error) {
+	fi, err := os.Stat(volumePath)
+	if err != nil {
+		return "", err
+	}
+	if fi.IsDir() {
+		return path.Join(volumePath, "disk.img"), nil
+	}
+	return volumePath, nil
+}
+
+func imageSize(f *os.File) (int64, error) {
+	// Seeking works for both regular files and block devices
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	_, err = f.Seek(0, io.SeekStart)
+	return size, err
+}
+
+func readBlockAt(f *os.File, buf []byte, offset int64) (int, error) {
+	n, err := f.ReadAt(buf, offset)
+	if errors.Is(err, io.EOF) {
+		return n, nil
+	}
+	return n, err
+}
+
+// nextData returns the offset of the first allocated byte of the file at or after offset.
+// Files which do not report their allocation, like block devices, are considered fully allocated.
+func nextData(f *os.File, offset int64) int64 {
+	dataOffset, err := f.Seek(offset, unix.SEEK_DATA)
+	if errors.Is(err, unix.ENXIO) {
+		return math.MaxInt64
+	}
+	if err != nil {
+		return offset
+	}
+	return dataOffset
+}
+
+// nextHole returns the offset of the first unallocated byte of the file at or after offset.
+// Files which do not report their allocation, like block devices, have no hole.
+func nextHole(f *os.File, offset int64) int64 {
+	holeOffset, err := f.Seek(offset, unix.SEEK_HOLE)
+	if err != nil {
+		return math.MaxInt64
+	}
+	return holeOffset
+}
+
+// computeChangedExtents compares the image at filePath block by block with the
+// image at basePath. Without a base image, the whole image is considered changed.
+// The ranges which are unallocated in both images read as zeros and are skipped,
+// so that the cost of the comparison follows the allocated data of sparse images.
+func computeChangedExtents(filePath, basePath string, blockSize int64) (*ChangedExtents, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	size, err := imageSize(f)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ChangedExtents{
+		Size:      size,
+		BlockSize: blockSize,
+		Extents:   []Extent{},
+	}
+
+	if basePath == "" {
+		if size > 0 {
+			result.Extents = append(result.Extents, Extent{Offset: 0, Length: size})
+		}
+		return result, nil
+	}
+
+	base, err := os.Open(basePath)
+	if err != nil {
+		return nil, err
+	}
+	defer base.Close()
+	baseSize, err := imageSize(base)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, blockSize)
+	baseBuf := make([]byte, blockSize)
+	var current *Extent
+	for offset := int64(0); offset < size; {
+		dataOffset := min(nextData(f, offset), nextData(base, offset), size, baseSize)
+		if skipTo := dataOffset - dataOffset%blockSize; skipTo > offset {
+			current = nil
+			offset = skipTo
+			continue
+		}
+
+		n, err := readBlockAt(f, buf, offset)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			break
+		}
+		baseN, err := readBlockAt(base, baseBuf, offset)
+		if err != nil {
+			return nil, err
+		}
+		if baseN == n && bytes.Equal(buf[:n], baseBuf[:n]) {
+			current = nil
+		} else if current != nil {
+			current.Length += int64(n)
+		} else {
+			result.Extents = append(result.Extents, Extent{Offset: offset, Length: int64(n)})
+			current = &result.Extents[len(result.Extents)-1]
+		}
+		offset += int64(n)
+	}
+	return result, nil
+}
+
+func extentsHandler(filePath, basePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		ce, err := extentsCache.get(filePath, basePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error computing changed extents of %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		data, err := json.Marshal(ce)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		n, err := w.Write(data)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing changed extents")
+			return
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
+func rawDeltaHandler(filePath, basePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		ce, err := extentsCache.get(filePath, basePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error computing changed extents of %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.FormatInt(ce.DataLength(), 10))
+		var total int64
+		for _, e := range ce.Extents {
+			n, err := io.Copy(w, io.NewSectionReader(f, e.Offset, e.Length))
+			total += n
+			if err != nil {
+				log.Log.Reason(err).Error("error writing response body")
+				return
+			}
+			if n != e.Length {
+				log.Log.Errorf("extent at offset %d of %s is truncated", e.Offset, filePath)
+				return
+			}
+		}
+		log.Log.Infof("Wrote %d bytes\n", total)
+	})
+}
@@ -0,0 +1,195 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("changed extents", func() {
+	const blockSize = 4
+
+	var (
+		tempDir string
+	)
+
+	writeImage := func(name string, data []byte) string {
+		p := filepath.Join(tempDir, name)
+		Expect(os.WriteFile(p, data, 0600)).To(Succeed())
+		return p
+	}
+
+	BeforeEach(func() {
+		tempDir = GinkgoT().TempDir()
+		extentsCache = newChangedExtentsCache()
+	})
+
+	DescribeTable("should compute", func(base, target []byte, expected []Extent) {
+		basePath := writeImage("base.img", base)
+		targetPath := writeImage("disk.img", target)
+
+		ce, err := computeChangedExtents(targetPath, basePath, blockSize)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ce.Size).To(Equal(int64(len(target))))
+		Expect(ce.BlockSize).To(Equal(int64(blockSize)))
+		Expect(ce.Extents).To(Equal(expected))
+	},
+		Entry("no extents for identical images",
+			[]byte("aaaabbbbcccc"), []byte("aaaabbbbcccc"), []Extent{}),
+		Entry("a single changed block",
+			[]byte("aaaabbbbcccc"), []byte("aaaaXbbbcccc"), []Extent{{Offset: 4, Length: 4}}),
+		Entry("merged adjacent changed blocks",
+			[]byte("aaaabbbbcccc"), []byte("XaaabbbXcccc"), []Extent{{Offset: 0, Length: 8}}),
+		Entry("separate changed blocks",
+			[]byte("aaaabbbbcccc"), []byte("Xaaabbbbccc0"), []Extent{{Offset: 0, Length: 4}, {Offset: 8, Length: 4}}),
+		Entry("a grown image",
+			[]byte("aaaabbbb"), []byte("aaaabbbbccccdd"), []Extent{{Offset: 8, Length: 6}}),
+		Entry("a shrunk image",
+			[]byte("aaaabbbbcccc"), []byte("aaaabb"), []Extent{{Offset: 4, Length: 2}}),
+	)
+
+	It("should report the whole image without base", func() {
+		targetPath := writeImage("disk.img", []byte("aaaabbbbcc"))
+
+		ce, err := computeChangedExtents(targetPath, "", blockSize)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ce.Extents).To(Equal([]Extent{{Offset: 0, Length: 10}}))
+	})
+
+	It("should compare sparse images", func() {
+		const imageSize = ExtentBlockSize * 64
+		writeSparseImage := func(name string, data []byte, offset int64) string {
+			p := filepath.Join(tempDir, name)
+			f, err := os.Create(p)
+			Expect(err).ToNot(HaveOccurred())
+			defer f.Close()
+			Expect(f.Truncate(imageSize)).To(Succeed())
+			_, err = f.WriteAt(data, offset)
+			Expect(err).ToNot(HaveOccurred())
+			return p
+		}
+		basePath := writeSparseImage("base.img", []byte("aaaa"), ExtentBlockSize*10)
+		targetPath := writeSparseImage("disk.img", []byte("aaXa"), ExtentBlockSize*10)
+
+		ce, err := computeChangedExtents(targetPath, basePath, ExtentBlockSize)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ce.Size).To(Equal(imageSize))
+		Expect(ce.Extents).To(Equal([]Extent{{Offset: ExtentBlockSize * 10, Length: ExtentBlockSize}}))
+	})
+
+	It("should memoize the changed extents per volume", func() {
+		basePath := writeImage("base.img", []byte("aaaa"))
+		targetPath := writeImage("disk.img", []byte("aaXa"))
+
+		ce, err := extentsCache.get(targetPath, basePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.Remove(targetPath)).To(Succeed())
+		cached, err := extentsCache.get(targetPath, basePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cached).To(BeIdenticalTo(ce))
+
+		_, err = extentsCache.get(targetPath, "")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail if the base is missing", func() {
+		targetPath := writeImage("disk.img", []byte("aaaabbbbcc"))
+
+		_, err := computeChangedExtents(targetPath, filepath.Join(tempDir, "missing"), blockSize)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should resolve the image of a filesystem volume", func() {
+		p, err := volumeImagePath(tempDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p).To(Equal(filepath.Join(tempDir, "disk.img")))
+
+		targetPath := writeImage("disk.img", []byte("a"))
+		p, err = volumeImagePath(targetPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p).To(Equal(targetPath))
+	})
+
+	Context("handlers", func() {
+		var (
+			base, target []byte
+		)
+
+		BeforeEach(func() {
+			base = bytes.Repeat([]byte{'a'}, int(ExtentBlockSize*4))
+			target = bytes.Clone(base)
+			target[1] = 'X'
+			target[ExtentBlockSize*2+5] = 'Y'
+		})
+
+		get := func(handler http.Handler) (*http.Response, []byte) {
+			httpServer := httptest.NewServer(handler)
+			defer httpServer.Close()
+
+			res, err := http.Get(httpServer.URL)
+			Expect(err).ToNot(HaveOccurred())
+			defer res.Body.Close()
+			out, err := io.ReadAll(res.Body)
+			Expect(err).ToNot(HaveOccurred())
+			return res, out
+		}
+
+		It("should serve the changed extents", func() {
+			basePath := writeImage("base.img", base)
+			targetPath := writeImage("disk.img", target)
+
+			res, out := get(extentsHandler(targetPath, basePath))
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			Expect(res.Header.Get("Content-Type")).To(Equal("application/json"))
+			ce := &ChangedExtents{}
+			Expect(json.Unmarshal(out, ce)).To(Succeed())
+			Expect(ce.Size).To(Equal(int64(len(target))))
+			Expect(ce.Extents).To(Equal([]Extent{
+				{Offset: 0, Length: ExtentBlockSize},
+				{Offset: ExtentBlockSize * 2, Length: ExtentBlockSize},
+			}))
+		})
+
+		It("should serve the data of the changed extents", func() {
+			basePath := writeImage("base.img", base)
+			targetPath := writeImage("disk.img", target)
+
+			res, out := get(rawDeltaHandler(targetPath, basePath))
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			Expect(res.ContentLength).To(Equal(ExtentBlockSize * 2))
+			expected := append(bytes.Clone(target[:ExtentBlockSize]), target[ExtentBlockSize*2:ExtentBlockSize*3]...)
+			Expect(out).To(Equal(expected))
+		})
+
+		It("should fail if the image does not exist", func() {
+			res, _ := get(rawDeltaHandler(filepath.Join(tempDir, "missing"), ""))
+			Expect(res.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
@@ -0,0 +1,296 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	qcow2Magic         uint32 = 0x514649fb
+	qcow2Version       uint32 = 3
+	qcow2ClusterBits   uint32 = 16
+	qcow2ClusterSize   int64  = 1 << qcow2ClusterBits
+	qcow2RefcountOrder uint32 = 4
+	qcow2HeaderLength  uint32 = 104
+	// qcow2Copied marks L1 and L2 entries of clusters with a refcount of exactly one
+	qcow2Copied uint64 = 1 << 63
+
+	qcow2L2Entries       = qcow2ClusterSize / 8
+	qcow2RefcountEntries = qcow2ClusterSize / 2
+)
+
+// qcow2Layout describes a qcow2 image containing only the allocated clusters of a raw
+// image. The metadata is laid out in front of the data, so the image can be written
+// sequentially: header, refcount table, refcount blocks, L1 table, L2 tables and data.
+type qcow2Layout struct {
+	size int64
+	// clusters are the ranges of guest clusters that are allocated, sorted and not overlapping
+	clusters []clusterRange
+	// l2Tables maps L1 indexes to the host cluster of their L2 table
+	l2Tables map[int64]int64
+
+	dataClusters           int64
+	refcountTableClusters  int64
+	refcountBlocks         int64
+	l1Entries              int64
+	l1Clusters             int64
+	totalClusters          int64
+	refcountTableOffset    int64
+	l1TableOffset          int64
+	firstL2Cluster         int64
+	firstDataCluster       int64
+	firstRefcountBlockHost int64
+}
+
+// clusterRange is a range of guest clusters, from first up to end excluded
+type clusterRange struct {
+	first int64
+	end   int64
+}
+
+func divRoundUp(n, d int64) int64 {
+	return (n + d - 1) / d
+}
+
+// allocatedClusters returns the guest clusters containing allocated data, according to
+// the allocation reported by the file, without reading the image.
+// Files which do not report their allocation, like block devices, are fully allocated.
+func allocatedClusters(f *os.File, size int64) []clusterRange {
+	var ranges []clusterRange
+	for offset := int64(0); offset < size; {
+		dataOffset := nextData(f, offset)
+		if dataOffset >= size {
+			break
+		}
+		holeOffset := min(nextHole(f, dataOffset), size)
+		r := clusterRange{first: dataOffset / qcow2ClusterSize, end: divRoundUp(holeOffset, qcow2ClusterSize)}
+		// Extents which are not cluster aligned may share a cluster
+		if last := len(ranges) - 1; last >= 0 && ranges[last].end >= r.first {
+			ranges[last].end = max(ranges[last].end, r.end)
+		} else {
+			ranges = append(ranges, r)
+		}
+		offset = holeOffset
+	}
+	return ranges
+}
+
+// newQcow2Layout computes where every part of the qcow2 image is going to be placed.
+func newQcow2Layout(clusters []clusterRange, size int64) *qcow2Layout {
+	guestClusters := divRoundUp(size, qcow2ClusterSize)
+	l := &qcow2Layout{
+		size:      size,
+		clusters:  clusters,
+		l2Tables:  map[int64]int64{},
+		l1Entries: divRoundUp(guestClusters, qcow2L2Entries),
+	}
+
+	for _, r := range clusters {
+		l.dataClusters += r.end - r.first
+		for i := r.first / qcow2L2Entries; i <= (r.end-1)/qcow2L2Entries; i++ {
+			l.l2Tables[i] = 0
+		}
+	}
+
+	l.l1Clusters = max(divRoundUp(l.l1Entries*8, qcow2ClusterSize), 1)
+	l2Count := int64(len(l.l2Tables))
+	// The refcount structures cover themselves, grow them until they fit
+	l.refcountBlocks, l.refcountTableClusters = 1, 1
+	for {
+		l.totalClusters = 1 + l.refcountTableClusters + l.refcountBlocks + l.l1Clusters + l2Count + l.dataClusters
+		blocks := divRoundUp(l.totalClusters, qcow2RefcountEntries)
+		tableClusters := divRoundUp(blocks*8, qcow2ClusterSize)
+		if blocks == l.refcountBlocks && tableClusters == l.refcountTableClusters {
+			break
+		}
+		l.refcountBlocks, l.refcountTableClusters = max(blocks, l.refcountBlocks), max(tableClusters, l.refcountTableClusters)
+	}
+
+	l.refcountTableOffset = qcow2ClusterSize
+	l.firstRefcountBlockHost = 1 + l.refcountTableClusters
+	l.l1TableOffset = (l.firstRefcountBlockHost + l.refcountBlocks) * qcow2ClusterSize
+	l.firstL2Cluster = l.l1TableOffset/qcow2ClusterSize + l.l1Clusters
+	l.firstDataCluster = l.firstL2Cluster + l2Count
+
+	next := l.firstL2Cluster
+	for i := int64(0); i < l.l1Entries; i++ {
+		if _, ok := l.l2Tables[i]; ok {
+			l.l2Tables[i] = next
+			next++
+		}
+	}
+	return l
+}
+
+// imageLength returns the size in bytes of the qcow2 image
+func (l *qcow2Layout) imageLength() int64 {
+	return l.totalClusters * qcow2ClusterSize
+}
+
+func (l *qcow2Layout) header() []byte {
+	h := make([]byte, qcow2ClusterSize)
+	be := binary.BigEndian
+	be.PutUint32(h[0:], qcow2Magic)
+	be.PutUint32(h[4:], qcow2Version)
+	// No backing file
+	be.PutUint32(h[20:], qcow2ClusterBits)
+	be.PutUint64(h[24:], uint64(l.size))
+	// No encryption
+	be.PutUint32(h[36:], uint32(l.l1Entries))
+	be.PutUint64(h[40:], uint64(l.l1TableOffset))
+	be.PutUint64(h[48:], uint64(l.refcountTableOffset))
+	be.PutUint32(h[56:], uint32(l.refcountTableClusters))
+	// No snapshots and no feature bits
+	be.PutUint32(h[96:], qcow2RefcountOrder)
+	be.PutUint32(h[100:], qcow2HeaderLength)
+	// The header extension area is terminated by the zeroed end marker
+	return h
+}
+
+// writeMetadata writes everything up to the first data cluster
+func (l *qcow2Layout) writeMetadata(w io.Writer) error {
+	if _, err := w.Write(l.header()); err != nil {
+		return err
+	}
+
+	be := binary.BigEndian
+	refcountTable := make([]byte, l.refcountTableClusters*qcow2ClusterSize)
+	for i := int64(0); i < l.refcountBlocks; i++ {
+		be.PutUint64(refcountTable[i*8:], uint64((l.firstRefcountBlockHost+i)*qcow2ClusterSize))
+	}
+	if _, err := w.Write(refcountTable); err != nil {
+		return err
+	}
+
+	refcountBlock := make([]byte, qcow2ClusterSize)
+	for i := int64(0); i < l.refcountBlocks; i++ {
+		clear(refcountBlock)
+		for j := int64(0); j < qcow2RefcountEntries && i*qcow2RefcountEntries+j < l.totalClusters; j++ {
+			be.PutUint16(refcountBlock[j*2:], 1)
+		}
+		if _, err := w.Write(refcountBlock); err != nil {
+			return err
+		}
+	}
+
+	l1Table := make([]byte, l.l1Clusters*qcow2ClusterSize)
+	for i := int64(0); i < l.l1Entries; i++ {
+		if host, ok := l.l2Tables[i]; ok {
+			be.PutUint64(l1Table[i*8:], uint64(host*qcow2ClusterSize)|qcow2Copied)
+		}
+	}
+	if _, err := w.Write(l1Table); err != nil {
+		return err
+	}
+
+	// The L2 tables are written in the order of their L1 index, as the allocated clusters are
+	l2Table := make([]byte, qcow2ClusterSize)
+	l2Index := int64(-1)
+	writeL2Table := func() error {
+		if l2Index < 0 {
+			return nil
+		}
+		_, err := w.Write(l2Table)
+		return err
+	}
+	host := l.firstDataCluster
+	for _, r := range l.clusters {
+		for cluster := r.first; cluster < r.end; cluster++ {
+			if cluster/qcow2L2Entries != l2Index {
+				if err := writeL2Table(); err != nil {
+					return err
+				}
+				clear(l2Table)
+				l2Index = cluster / qcow2L2Entries
+			}
+			be.PutUint64(l2Table[cluster%qcow2L2Entries*8:], uint64(host*qcow2ClusterSize)|qcow2Copied)
+			host++
+		}
+	}
+	return writeL2Table()
+}
+
+// writeData copies the allocated clusters of the raw image, in guest order
+func (l *qcow2Layout) writeData(w io.Writer, f *os.File) error {
+	buf := make([]byte, qcow2ClusterSize)
+	for _, r := range l.clusters {
+		for cluster := r.first; cluster < r.end; cluster++ {
+			n, err := readBlockAt(f, buf, cluster*qcow2ClusterSize)
+			if err != nil {
+				return err
+			}
+			// The image may have been truncated or not be cluster aligned, pad the last cluster
+			clear(buf[n:])
+			if _, err := w.Write(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// qcow2Handler streams the qcow2 image, the layout is computed from the allocation of
+// the raw image so the response starts without reading the image beforehand.
+func qcow2Handler(filePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		size, err := imageSize(f)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error getting the size of %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		layout := newQcow2Layout(allocatedClusters(f, size), size)
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.FormatInt(layout.imageLength(), 10))
+		bw := bufio.NewWriterSize(w, int(qcow2ClusterSize))
+		if err := layout.writeMetadata(bw); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+			return
+		}
+		if err := layout.writeData(bw, f); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+			return
+		}
+		if err := bw.Flush(); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+			return
+		}
+		log.Log.Infof("Wrote %d bytes\n", layout.imageLength())
+	})
+}
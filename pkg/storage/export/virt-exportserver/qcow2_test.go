@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// readQcow2 converts a qcow2 image created by the qcow2 handler back to raw,
+// verifying the refcount of every cluster on the way
+func readQcow2(image []byte) []byte {
+	be := binary.BigEndian
+	ExpectWithOffset(1, be.Uint32(image[0:])).To(Equal(qcow2Magic))
+	ExpectWithOffset(1, be.Uint32(image[4:])).To(Equal(qcow2Version))
+	ExpectWithOffset(1, be.Uint32(image[20:])).To(Equal(qcow2ClusterBits))
+	ExpectWithOffset(1, be.Uint32(image[96:])).To(Equal(qcow2RefcountOrder))
+	ExpectWithOffset(1, int64(len(image))%qcow2ClusterSize).To(BeZero())
+	size := int64(be.Uint64(image[24:]))
+	l1Size := int64(be.Uint32(image[36:]))
+	l1Offset := int64(be.Uint64(image[40:]))
+	refcountTableOffset := int64(be.Uint64(image[48:]))
+	refcountTableClusters := int64(be.Uint32(image[56:]))
+
+	clusters := int64(len(image)) / qcow2ClusterSize
+	for cluster := int64(0); cluster < clusters; cluster++ {
+		tableEntry := refcountTableOffset + cluster/qcow2RefcountEntries*8
+		ExpectWithOffset(1, tableEntry).To(BeNumerically("<", refcountTableOffset+refcountTableClusters*qcow2ClusterSize))
+		block := int64(be.Uint64(image[tableEntry:]))
+		ExpectWithOffset(1, block).ToNot(BeZero())
+		ExpectWithOffset(1, be.Uint16(image[block+cluster%qcow2RefcountEntries*2:])).To(BeEquivalentTo(1), "cluster %d", cluster)
+	}
+
+	raw := make([]byte, size)
+	for i := int64(0); i < l1Size; i++ {
+		l2Entry := be.Uint64(image[l1Offset+i*8:])
+		if l2Entry == 0 {
+			continue
+		}
+		ExpectWithOffset(1, l2Entry&qcow2Copied).ToNot(BeZero())
+		l2Offset := int64(l2Entry &^ qcow2Copied)
+		for j := int64(0); j < qcow2L2Entries; j++ {
+			dataEntry := be.Uint64(image[l2Offset+j*8:])
+			if dataEntry == 0 {
+				continue
+			}
+			dataOffset := int64(dataEntry &^ qcow2Copied)
+			guestOffset := (i*qcow2L2Entries + j) * qcow2ClusterSize
+			copy(raw[guestOffset:], image[dataOffset:dataOffset+qcow2ClusterSize])
+		}
+	}
+	return raw
+}
+
+var _ = Describe("qcow2", func() {
+	var (
+		tempDir string
+	)
+
+	BeforeEach(func() {
+		tempDir = GinkgoT().TempDir()
+	})
+
+	// writeSparse only allocates the clusters of the raw image containing data
+	writeSparse := func(raw []byte) string {
+		filePath := filepath.Join(tempDir, "disk.img")
+		f, err := os.Create(filePath)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		Expect(f.Truncate(int64(len(raw)))).To(Succeed())
+		for offset := int64(0); offset < int64(len(raw)); offset += qcow2ClusterSize {
+			cluster := raw[offset:min(offset+qcow2ClusterSize, int64(len(raw)))]
+			if bytes.Count(cluster, []byte{0}) != len(cluster) {
+				_, err := f.WriteAt(cluster, offset)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}
+		return filePath
+	}
+
+	convertFile := func(filePath string) []byte {
+		httpServer := httptest.NewServer(qcow2Handler(filePath))
+		defer httpServer.Close()
+
+		res, err := http.Get(httpServer.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		out, err := io.ReadAll(res.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.ContentLength).To(Equal(int64(len(out))))
+		return out
+	}
+
+	DescribeTable("should convert a raw image", func(raw []byte, expectedDataClusters int64) {
+		filePath := writeSparse(raw)
+		image := convertFile(filePath)
+		Expect(readQcow2(image)).To(Equal(raw))
+
+		f, err := os.Open(filePath)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		layout := newQcow2Layout(allocatedClusters(f, int64(len(raw))), int64(len(raw)))
+		Expect(layout.dataClusters).To(Equal(expectedDataClusters))
+		Expect(int64(len(image))).To(Equal(layout.imageLength()))
+	},
+		Entry("that is empty", []byte{}, int64(0)),
+		Entry("that only contains zeroes", make([]byte, qcow2ClusterSize*4), int64(0)),
+		Entry("that is fully allocated", bytes.Repeat([]byte{'a'}, int(qcow2ClusterSize*3)), int64(3)),
+		Entry("that is not cluster aligned", bytes.Repeat([]byte{'a'}, int(qcow2ClusterSize+100)), int64(2)),
+		Entry("that is sparse", func() []byte {
+			raw := make([]byte, qcow2ClusterSize*qcow2L2Entries*2+qcow2ClusterSize)
+			raw[5] = 'a'
+			raw[qcow2ClusterSize*qcow2L2Entries+7] = 'b'
+			raw[len(raw)-1] = 'c'
+			return raw
+		}(), int64(3)),
+	)
+
+	It("should convert the allocated clusters containing zeroes", func() {
+		raw := make([]byte, qcow2ClusterSize*2)
+		filePath := filepath.Join(tempDir, "disk.img")
+		Expect(os.WriteFile(filePath, raw, 0600)).To(Succeed())
+		Expect(readQcow2(convertFile(filePath))).To(Equal(raw))
+	})
+
+	It("should only allocate L2 tables for ranges containing data", func() {
+		clusters := []clusterRange{{first: qcow2L2Entries, end: qcow2L2Entries + 1}}
+
+		layout := newQcow2Layout(clusters, qcow2ClusterSize*qcow2L2Entries*3)
+		Expect(layout.l1Entries).To(Equal(int64(3)))
+		Expect(layout.l2Tables).To(HaveLen(1))
+		Expect(layout.l2Tables).To(HaveKey(int64(1)))
+	})
+
+	It("should fail if the image does not exist", func() {
+		httpServer := httptest.NewServer(qcow2Handler(filepath.Join(tempDir, "missing")))
+		defer httpServer.Close()
+
+		res, err := http.Get(httpServer.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusInternalServerError))
+	})
+})
@@ -113,7 +113,19 @@ var restoreAnnotationsToDelete = []string{
 	"k8s.io/CloneOf",
 }
 
+func getVolumeRestoreOverride(vmRestore *snapshotv1.VirtualMachineRestore, volumeName string) *snapshotv1.VolumeRestoreOverride {
+	for i, override := range vmRestore.Spec.VolumeRestoreOverrides {
+		if override.VolumeName == volumeName {
+			return &vmRestore.Spec.VolumeRestoreOverrides[i]
+		}
+	}
+	return nil
+}
+
 func restorePVCName(vmRestore *snapshotv1.VirtualMachineRestore, name string) string {
+	if override := getVolumeRestoreOverride(vmRestore, name); override != nil && override.RestoreName != "" {
+		return override.RestoreName
+	}
 	return fmt.Sprintf("restore-%s-%s", vmRestore.UID, name)
 }
 
@@ -121,6 +133,31 @@ func restoreDVName(vmRestore *snapshotv1.VirtualMachineRestore, name string) str
 	return restorePVCName(vmRestore, name)
 }
 
+// isVolumeScopedRestore returns true if only some volumes of the snapshot are restored,
+// in which case the spec of the target is kept
+func isVolumeScopedRestore(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return len(vmRestore.Spec.Volumes) > 0
+}
+
+// isStandaloneVolumeRestore returns true if the restored volumes are not attached to the target
+func isStandaloneVolumeRestore(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return isVolumeScopedRestore(vmRestore) &&
+		vmRestore.Spec.VolumeRestoreMode != nil &&
+		*vmRestore.Spec.VolumeRestoreMode == snapshotv1.VolumeRestoreModeStandalone
+}
+
+func shouldRestoreVolume(vmRestore *snapshotv1.VirtualMachineRestore, volumeName string) bool {
+	if !isVolumeScopedRestore(vmRestore) {
+		return true
+	}
+	for _, name := range vmRestore.Spec.Volumes {
+		if name == volumeName {
+			return true
+		}
+	}
+	return false
+}
+
 func vmRestoreFailed(vmRestore *snapshotv1.VirtualMachineRestore) bool {
 	return vmRestore.Status != nil &&
 		hasConditionType(vmRestore.Status.Conditions, snapshotv1.ConditionFailure)
@@ -377,9 +414,13 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 		return false, err
 	}
 
+	if err := checkVolumesInSnapshot(vmRestore, content); err != nil {
+		return false, err
+	}
+
 	var restores []snapshotv1.VolumeRestore
 	for _, vb := range content.Spec.VolumeBackups {
-		if noRestore.Has(vb.VolumeName) {
+		if noRestore.Has(vb.VolumeName) || !shouldRestoreVolume(vmRestore, vb.VolumeName) {
 			continue
 		}
 
@@ -432,6 +473,9 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 				return false, err
 			}
 			createdPVC = true
+		} else if pvc.Annotations[RestoreNameAnnotation] != vmRestore.Name {
+			// the name of the restored PVC may be overridden, make sure not to take over an unrelated PVC
+			return false, fmt.Errorf("PVC %s/%s already exists and is not restored by %s", pvc.Namespace, pvc.Name, vmRestore.Name)
 		} else if pvc.Status.Phase == corev1.ClaimPending {
 			bindingMode, err := ctrl.getBindingMode(pvc)
 			if err != nil {
@@ -489,7 +533,7 @@ func (t *vmRestoreTarget) UpdateDoneRestore() error {
 }
 
 func (t *vmRestoreTarget) UpdateRestoreInProgress() error {
-	if !t.Exists() || hasLastRestoreAnnotation(t.vmRestore, t.vm) {
+	if !t.Exists() || hasLastRestoreAnnotation(t.vmRestore, t.vm) || isStandaloneVolumeRestore(t.vmRestore) {
 		return nil
 	}
 
@@ -523,7 +567,7 @@ func (t *vmRestoreTarget) Stop() error {
 }
 
 func (t *vmRestoreTarget) Ready() (bool, error) {
-	if !t.Exists() {
+	if !t.Exists() || isStandaloneVolumeRestore(t.vmRestore) {
 		return true, nil
 	}
 
@@ -543,6 +587,9 @@ func (t *vmRestoreTarget) Reconcile() (bool, error) {
 	if t.Exists() && hasLastRestoreAnnotation(t.vmRestore, t.vm) {
 		return false, nil
 	}
+	if isStandaloneVolumeRestore(t.vmRestore) {
+		return false, nil
+	}
 	snapshotVM, err := t.getSnapshotVM()
 	if err != nil {
 		return false, err
@@ -556,9 +603,18 @@ func (t *vmRestoreTarget) Reconcile() (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	if isVolumeScopedRestore(t.vmRestore) {
+		restoredVM, err = t.generateVolumeRestoredVMSpec(restoredVM)
+		if err != nil {
+			return false, err
+		}
+	}
 	if updated, err := t.reconcileDataVolumes(restoredVM); updated || err != nil {
 		return updated, err
 	}
+	if isVolumeScopedRestore(t.vmRestore) {
+		return t.reconcileSpec(restoredVM)
+	}
 	// Reconcile backend storage PVC since it's not part of the VM/VMI spec
 	if ready, err := t.reconcileBackendVolume(snapshotVM); !ready || err != nil {
 		return !ready, err
@@ -794,6 +850,9 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 
 					dv := snapshotVM.Spec.DataVolumeTemplates[templateIndex].DeepCopy()
 					dv.Name = *vr.DataVolumeName
+					if override := getVolumeRestoreOverride(t.vmRestore, vr.VolumeName); override != nil && override.StorageClassName != nil {
+						setDataVolumeTemplateStorageClass(dv, override.StorageClassName)
+					}
 					newTemplates[templateIndex] = *dv
 
 					nv.DataVolume.Name = *vr.DataVolumeName
@@ -812,8 +871,18 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 				}
 			}
 		} else if nv.MemoryDump != nil {
-			// don't restore memory dump volume in the new spec
-			continue
+			if nv.MemoryDump.Format != kubevirtv1.MemoryDumpFormatSaveState {
+				// don't restore memory dump volume in the new spec
+				continue
+			}
+			// the saved memory state is used to resume the restored vm
+			for _, vr := range t.vmRestore.Status.Restores {
+				if vr.VolumeName != nv.Name {
+					continue
+				}
+				nv.MemoryDump.ClaimName = vr.PersistentVolumeClaimName
+				nv.MemoryDump.Hotpluggable = false
+			}
 		}
 		newVolumes = append(newVolumes, *nv)
 	}
@@ -855,8 +924,10 @@ func (t *vmRestoreTarget) reconcileSpec(restoredVM *kubevirtv1.VirtualMachine) (
 	log.Log.Object(t.vmRestore).V(3).Info("Reconcile new VM spec")
 
 	var err error
-	if err = t.restoreInstancetypeControllerRevisions(restoredVM); err != nil {
-		return false, err
+	if !isVolumeScopedRestore(t.vmRestore) {
+		if err = t.restoreInstancetypeControllerRevisions(restoredVM); err != nil {
+			return false, err
+		}
 	}
 
 	if !t.Exists() {
@@ -881,6 +952,75 @@ func (t *vmRestoreTarget) reconcileSpec(restoredVM *kubevirtv1.VirtualMachine) (
 	return true, nil
 }
 
+// generateVolumeRestoredVMSpec takes the restored volumes from the fully restored VM
+// and applies them to the current spec of the target
+func (t *vmRestoreTarget) generateVolumeRestoredVMSpec(fullyRestoredVM *kubevirtv1.VirtualMachine) (*kubevirtv1.VirtualMachine, error) {
+	if !t.Exists() {
+		return nil, fmt.Errorf("target VM %s/%s has to exist to restore volumes in place", t.vmRestore.Namespace, t.vmRestore.Spec.Target.Name)
+	}
+
+	newVM := t.vm.DeepCopy()
+	newSpec := &newVM.Spec.Template.Spec
+	for _, vr := range t.vmRestore.Status.Restores {
+		volumeIndex := findVolumeIndex(vr.VolumeName, newSpec.Volumes)
+		if volumeIndex < 0 {
+			return nil, fmt.Errorf("volume %s does not exist in target VM %s/%s", vr.VolumeName, newVM.Namespace, newVM.Name)
+		}
+		restoredIndex := findVolumeIndex(vr.VolumeName, fullyRestoredVM.Spec.Template.Spec.Volumes)
+		if restoredIndex < 0 {
+			return nil, fmt.Errorf("volume %s was not restored", vr.VolumeName)
+		}
+
+		// the data volume template of the replaced volume is dropped, so the data volume is deleted like in a full restore
+		if oldDataVolume := newSpec.Volumes[volumeIndex].DataVolume; oldDataVolume != nil {
+			newVM.Spec.DataVolumeTemplates = removeDataVolumeTemplate(newVM.Spec.DataVolumeTemplates, oldDataVolume.Name)
+		}
+
+		restoredVolume := fullyRestoredVM.Spec.Template.Spec.Volumes[restoredIndex]
+		newSpec.Volumes[volumeIndex] = restoredVolume
+		if restoredVolume.DataVolume != nil {
+			for _, dvt := range fullyRestoredVM.Spec.DataVolumeTemplates {
+				if dvt.Name == restoredVolume.DataVolume.Name {
+					newVM.Spec.DataVolumeTemplates = append(newVM.Spec.DataVolumeTemplates, dvt)
+					break
+				}
+			}
+		}
+	}
+	setLastRestoreAnnotation(t.vmRestore, newVM)
+
+	return newVM, nil
+}
+
+func findVolumeIndex(volumeName string, volumes []kubevirtv1.Volume) int {
+	for i, volume := range volumes {
+		if volume.Name == volumeName {
+			return i
+		}
+	}
+	return -1
+}
+
+func removeDataVolumeTemplate(templates []kubevirtv1.DataVolumeTemplateSpec, name string) []kubevirtv1.DataVolumeTemplateSpec {
+	var newTemplates []kubevirtv1.DataVolumeTemplateSpec
+	for _, template := range templates {
+		if template.Name != name {
+			newTemplates = append(newTemplates, template)
+		}
+	}
+	return newTemplates
+}
+
+// setDataVolumeTemplateStorageClass keeps the template consistent with the restored PVC it adopts
+func setDataVolumeTemplateStorageClass(dv *kubevirtv1.DataVolumeTemplateSpec, storageClassName *string) {
+	if dv.Spec.PVC != nil {
+		dv.Spec.PVC.StorageClassName = storageClassName
+	}
+	if dv.Spec.Storage != nil {
+		dv.Spec.Storage.StorageClassName = storageClassName
+	}
+}
+
 func findDVTemplateIndex(dvName string, vm *snapshotv1.VirtualMachine) int {
 	templateIndex := -1
 	for i, dvt := range vm.Spec.DataVolumeTemplates {
@@ -1356,7 +1496,11 @@ func (ctrl *VMRestoreController) createRestorePVC(
 	if err != nil {
 		return err
 	}
-	target.Own(pvc)
+	applyVolumeRestoreOverride(pvc, getVolumeRestoreOverride(vmRestore, volumeRestore.VolumeName))
+	// standalone volumes outlive the target
+	if !isStandaloneVolumeRestore(vmRestore) {
+		target.Own(pvc)
+	}
 
 	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmRestore.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
 	if err != nil {
@@ -1366,6 +1510,28 @@ func (ctrl *VMRestoreController) createRestorePVC(
 	return nil
 }
 
+func applyVolumeRestoreOverride(pvc *corev1.PersistentVolumeClaim, override *snapshotv1.VolumeRestoreOverride) {
+	if override == nil {
+		return
+	}
+
+	if override.StorageClassName != nil {
+		pvc.Spec.StorageClassName = override.StorageClassName
+	}
+	if len(override.Labels) > 0 && pvc.Labels == nil {
+		pvc.Labels = make(map[string]string)
+	}
+	for key, value := range override.Labels {
+		pvc.Labels[key] = value
+	}
+	if len(override.Annotations) > 0 && pvc.Annotations == nil {
+		pvc.Annotations = make(map[string]string)
+	}
+	for key, value := range override.Annotations {
+		pvc.Annotations[key] = value
+	}
+}
+
 func CreateRestorePVCDef(restorePVCName string, volumeSnapshot *vsv1.VolumeSnapshot, volumeBackup *snapshotv1.VolumeBackup) (*corev1.PersistentVolumeClaim, error) {
 	if volumeBackup == nil || volumeBackup.VolumeSnapshotName == nil {
 		return nil, fmt.Errorf("VolumeSnapshot name missing %+v", volumeBackup)
@@ -1471,7 +1637,7 @@ func updateRestoreCondition(r *snapshotv1.VirtualMachineRestore, c snapshotv1.Co
 }
 
 // Returns a set of volumes not for restore
-// Currently only memory dump volumes should not be restored
+// Currently only memory dump volumes which don't hold a saved memory state should not be restored
 func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.VirtualMachineSnapshotContent) (sets.String, error) {
 	noRestore := sets.NewString()
 
@@ -1481,7 +1647,7 @@ func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.Virtua
 	}
 
 	for _, volume := range volumes {
-		if volume.MemoryDump != nil {
+		if volume.MemoryDump != nil && volume.MemoryDump.Format != kubevirtv1.MemoryDumpFormatSaveState {
 			noRestore.Insert(volume.Name)
 		}
 	}
@@ -1489,6 +1655,23 @@ func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.Virtua
 	return noRestore, nil
 }
 
+// checkVolumesInSnapshot makes sure all the volumes of a volume scoped restore can be restored
+func checkVolumesInSnapshot(vmRestore *snapshotv1.VirtualMachineRestore, content *snapshotv1.VirtualMachineSnapshotContent) error {
+	for _, volumeName := range vmRestore.Spec.Volumes {
+		found := false
+		for _, vb := range content.Spec.VolumeBackups {
+			if vb.VolumeName == volumeName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("volume %s is not part of VMSnapshot %s", volumeName, vmRestore.Spec.VirtualMachineSnapshotName)
+		}
+	}
+	return nil
+}
+
 func getRestoreVolumeBackup(volName string, content *snapshotv1.VirtualMachineSnapshotContent) (*snapshotv1.VolumeBackup, error) {
 	for _, vb := range content.Spec.VolumeBackups {
 		if vb.VolumeName == volName {
@@ -774,6 +774,302 @@ var _ = Describe("Restore controller", func() {
 				Expect(*calls).To(Equal(1))
 			})
 
+			Context("with volume restore overrides", func() {
+				const restoreName = "dr-disk1"
+
+				var override snapshotv1.VolumeRestoreOverride
+
+				BeforeEach(func() {
+					override = snapshotv1.VolumeRestoreOverride{
+						VolumeName:       diskName,
+						RestoreName:      restoreName,
+						StorageClassName: pointer.P("cheap"),
+						Labels:           map[string]string{"tier": "dr"},
+						Annotations:      map[string]string{"dr.example.com/test": "true"},
+					}
+				})
+
+				addOverriddenVolumeRestores := func(r *snapshotv1.VirtualMachineRestore) {
+					addVolumeRestores(r)
+					r.Status.Restores[0].PersistentVolumeClaimName = restoreName
+					r.Status.Restores[0].DataVolumeName = pointer.P(restoreName)
+				}
+
+				It("should update restore status with the overridden PVC name", func() {
+					r := createRestoreWithOwner()
+					r.Spec.VolumeRestoreOverrides = []snapshotv1.VolumeRestoreOverride{override}
+					vm := createModifiedVM()
+					rc := r.DeepCopy()
+					rc.ResourceVersion = "1"
+					rc.Status = &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: pointer.P(false),
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+							newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+						},
+					}
+					addInitialVolumeRestores(rc)
+					rc.Status.Restores[0].PersistentVolumeClaimName = restoreName
+					vmSource.Add(vm)
+					expectUpdateVMRestoreInProgress(vm)
+					updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, rc)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should create restore PVCs with the overrides", func() {
+					r := createRestoreWithOwner()
+					r.Spec.VolumeRestoreOverrides = []snapshotv1.VolumeRestoreOverride{override}
+					vm := createModifiedVM()
+					r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: pointer.P(false),
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+							newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+						},
+					}
+					vmSource.Add(vm)
+					addOverriddenVolumeRestores(r)
+					pvcSize := resource.MustParse("2Gi")
+					vs := createVolumeSnapshot(r.Status.Restores[0].VolumeSnapshotName, pvcSize)
+					fakeVolumeSnapshotProvider.Add(vs)
+					expectUpdateVMRestoreInProgress(vm)
+
+					calls := 0
+					k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						create, ok := action.(testing.CreateAction)
+						Expect(ok).To(BeTrue())
+
+						pvc := create.GetObject().(*corev1.PersistentVolumeClaim)
+						Expect(pvc.Name).To(Equal(restoreName))
+						Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal("cheap")))
+						Expect(pvc.Labels).To(HaveKeyWithValue("tier", "dr"))
+						Expect(pvc.Annotations).To(HaveKeyWithValue("dr.example.com/test", "true"))
+						Expect(pvc.Annotations).To(HaveKeyWithValue(RestoreNameAnnotation, r.Name))
+
+						calls++
+						return true, pvc, nil
+					})
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+					Expect(calls).To(Equal(1))
+				})
+
+				It("should not take over an existing PVC with the overridden name", func() {
+					r := createRestoreWithOwner()
+					r.Spec.VolumeRestoreOverrides = []snapshotv1.VolumeRestoreOverride{override}
+					r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: pointer.P(false),
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+							newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+						},
+					}
+					addOverriddenVolumeRestores(r)
+					const errMsg = "PVC default/dr-disk1 already exists and is not restored by restore"
+					rc := r.DeepCopy()
+					rc.ResourceVersion = "1"
+					rc.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, errMsg),
+						newReadyCondition(corev1.ConditionFalse, errMsg),
+					}
+
+					vm := createModifiedVM()
+					vmSource.Add(vm)
+					pvc := getRestorePVCs(r)[0]
+					pvc.Annotations = nil
+					pvc.Status.Phase = corev1.ClaimBound
+					pvcSource.Add(&pvc)
+
+					expectUpdateVMRestoreInProgress(vm)
+					updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, rc)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should set the overridden storage class in the restored data volume template", func() {
+					r := createRestoreWithOwner()
+					r.Spec.VolumeRestoreOverrides = []snapshotv1.VolumeRestoreOverride{override}
+					r.Status = &snapshotv1.VirtualMachineRestoreStatus{}
+					addOverriddenVolumeRestores(r)
+
+					vm := createSnapshotVM()
+					snapshotVM := &snapshotv1.VirtualMachine{
+						ObjectMeta: vm.ObjectMeta,
+						Spec:       vm.Spec,
+					}
+					target := &vmRestoreTarget{
+						controller: controller,
+						vmRestore:  r,
+						vm:         createModifiedVM(),
+					}
+					restoredVM, err := target.generateRestoredVMSpec(snapshotVM)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(restoredVM.Spec.DataVolumeTemplates).ToNot(BeEmpty())
+					dv := restoredVM.Spec.DataVolumeTemplates[0]
+					Expect(dv.Name).To(Equal(restoreName))
+					if dv.Spec.PVC != nil {
+						Expect(dv.Spec.PVC.StorageClassName).To(HaveValue(Equal("cheap")))
+					}
+					if dv.Spec.Storage != nil {
+						Expect(dv.Spec.Storage.StorageClassName).To(HaveValue(Equal("cheap")))
+					}
+				})
+			})
+
+			Context("restoring volumes", func() {
+				It("should fail if a volume is not part of the snapshot", func() {
+					r := createRestoreWithOwner()
+					r.Spec.Volumes = []string{"missing"}
+					r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: pointer.P(false),
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+							newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+						},
+					}
+					const errMsg = "volume missing is not part of VMSnapshot snapshot"
+					rc := r.DeepCopy()
+					rc.ResourceVersion = "1"
+					rc.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, errMsg),
+						newReadyCondition(corev1.ConditionFalse, errMsg),
+					}
+
+					vm := createModifiedVM()
+					vmSource.Add(vm)
+					expectUpdateVMRestoreInProgress(vm)
+					updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, rc)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				Context("standalone", func() {
+					var r *snapshotv1.VirtualMachineRestore
+
+					BeforeEach(func() {
+						r = createRestoreWithOwner()
+						r.Spec.Volumes = []string{diskName}
+						r.Spec.VolumeRestoreMode = pointer.P(snapshotv1.VolumeRestoreModeStandalone)
+						r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+							Complete: pointer.P(false),
+							Conditions: []snapshotv1.Condition{
+								newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+								newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+							},
+						}
+						addInitialVolumeRestores(r)
+
+						// the target keeps running
+						vm := createModifiedVM()
+						vmSource.Add(vm)
+						vmiSource.Add(&kubevirtv1.VirtualMachineInstance{
+							ObjectMeta: metav1.ObjectMeta{Name: vm.Name, Namespace: vm.Namespace},
+						})
+					})
+
+					It("should create PVCs which are not owned by the target", func() {
+						pvcSize := resource.MustParse("2Gi")
+						fakeVolumeSnapshotProvider.Add(createVolumeSnapshot(r.Status.Restores[0].VolumeSnapshotName, pvcSize))
+
+						calls := 0
+						k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+							create, ok := action.(testing.CreateAction)
+							Expect(ok).To(BeTrue())
+
+							pvc := create.GetObject().(*corev1.PersistentVolumeClaim)
+							Expect(pvc.Name).To(Equal(r.Status.Restores[0].PersistentVolumeClaimName))
+							Expect(pvc.OwnerReferences).To(BeEmpty())
+
+							calls++
+							return true, pvc, nil
+						})
+						addVirtualMachineRestore(r)
+						controller.processVMRestoreWorkItem()
+						Expect(calls).To(Equal(1))
+						Expect(kubevirtClient.Actions()).ToNot(ContainElement(HaveField("Resource.Resource", "virtualmachines")))
+					})
+
+					It("should complete without updating the target", func() {
+						ur := r.DeepCopy()
+						ur.ResourceVersion = "1"
+						ur.Status.Complete = pointer.P(true)
+						ur.Status.RestoreTime = timeFunc()
+						ur.Status.Conditions = []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionFalse, "Operation complete"),
+							newReadyCondition(corev1.ConditionTrue, "Operation complete"),
+						}
+						updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, ur)
+
+						for _, pvc := range getRestorePVCs(r) {
+							pvc.Status.Phase = corev1.ClaimBound
+							pvcSource.Add(&pvc)
+						}
+
+						addVirtualMachineRestore(r)
+						controller.processVMRestoreWorkItem()
+						testutils.ExpectEvent(recorder, "VirtualMachineRestoreComplete")
+						Expect(*updateStatusCalls).To(Equal(1))
+						Expect(kubevirtClient.Actions()).ToNot(ContainElement(HaveField("Resource.Resource", "virtualmachines")))
+					})
+				})
+
+				It("in place - should only replace the restored volumes of the target", func() {
+					const otherDiskName = "disk2"
+					otherVolume := kubevirtv1.Volume{
+						Name: otherDiskName,
+						VolumeSource: kubevirtv1.VolumeSource{
+							PersistentVolumeClaim: &kubevirtv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: "other-pvc",
+								},
+							},
+						},
+					}
+
+					r := createRestoreWithOwner()
+					r.Spec.Volumes = []string{diskName}
+					r.Status = &snapshotv1.VirtualMachineRestoreStatus{}
+					addVolumeRestores(r)
+
+					vm := createSnapshotVM()
+					vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, otherVolume)
+					snapshotVM := &snapshotv1.VirtualMachine{
+						ObjectMeta: vm.ObjectMeta,
+						Spec:       vm.Spec,
+					}
+
+					targetVM := createModifiedVM()
+					targetVM.Spec.RunStrategy = pointer.P(kubevirtv1.RunStrategyAlways)
+					targetVM.Spec.Template.Spec.Volumes = append(targetVM.Spec.Template.Spec.Volumes, *otherVolume.DeepCopy())
+					targetVM.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim.ClaimName = "current-pvc"
+
+					target := &vmRestoreTarget{
+						controller: controller,
+						vmRestore:  r,
+						vm:         targetVM,
+					}
+					restoredVM, err := target.generateRestoredVMSpec(snapshotVM)
+					Expect(err).ToNot(HaveOccurred())
+					restoredVM, err = target.generateVolumeRestoredVMSpec(restoredVM)
+					Expect(err).ToNot(HaveOccurred())
+
+					// the rest of the spec of the target is kept
+					Expect(restoredVM.Spec.RunStrategy).To(HaveValue(Equal(kubevirtv1.RunStrategyAlways)))
+					Expect(restoredVM.Spec.Template.Spec.Domain.Resources).To(Equal(targetVM.Spec.Template.Spec.Domain.Resources))
+					Expect(restoredVM.Spec.Template.Spec.Volumes).To(HaveLen(2))
+					Expect(restoredVM.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal(r.Status.Restores[0].PersistentVolumeClaimName))
+					Expect(restoredVM.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim.ClaimName).To(Equal("current-pvc"))
+					Expect(restoredVM.Spec.DataVolumeTemplates).To(HaveLen(1))
+					Expect(restoredVM.Spec.DataVolumeTemplates[0].Name).To(Equal(r.Status.Restores[0].PersistentVolumeClaimName))
+					Expect(restoredVM.Annotations).To(HaveKeyWithValue(lastRestoreAnnotation, getRestoreAnnotationValue(r)))
+				})
+			})
+
 			It("should wait for bound", func() {
 				r := createRestoreWithOwner()
 				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
@@ -1227,6 +1523,72 @@ var _ = Describe("Restore controller", func() {
 				)
 			})
 
+			Context("with memory dump volumes", func() {
+				It("should restore a saved memory state as a non hotpluggable volume", func() {
+					r := createRestoreWithOwner()
+					addVolumeRestores(r)
+					r.Status.Restores = append(r.Status.Restores, snapshotv1.VolumeRestore{
+						VolumeName:                "memory-state",
+						PersistentVolumeClaimName: "restore-uid-memory-state",
+					})
+
+					vm := createSnapshotVM()
+					snapshotVM := &snapshotv1.VirtualMachine{
+						ObjectMeta: vm.ObjectMeta,
+						Spec:       vm.Spec,
+					}
+					snapshotVM.Spec.Template.Spec.Volumes = append(snapshotVM.Spec.Template.Spec.Volumes,
+						kubevirtv1.Volume{
+							Name: "memory-state",
+							VolumeSource: kubevirtv1.VolumeSource{
+								MemoryDump: &kubevirtv1.MemoryDumpVolumeSource{
+									PersistentVolumeClaimVolumeSource: kubevirtv1.PersistentVolumeClaimVolumeSource{
+										PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+											ClaimName: "memory-state",
+										},
+										Hotpluggable: true,
+									},
+									Format: kubevirtv1.MemoryDumpFormatSaveState,
+								},
+							},
+						},
+						kubevirtv1.Volume{
+							Name: "memory-dump",
+							VolumeSource: kubevirtv1.VolumeSource{
+								MemoryDump: &kubevirtv1.MemoryDumpVolumeSource{
+									PersistentVolumeClaimVolumeSource: kubevirtv1.PersistentVolumeClaimVolumeSource{
+										PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+											ClaimName: "memory-dump",
+										},
+										Hotpluggable: true,
+									},
+								},
+							},
+						},
+					)
+
+					target := &vmRestoreTarget{
+						controller: controller,
+						vmRestore:  r,
+						vm:         createModifiedVM(),
+					}
+					restoredVM, err := target.generateRestoredVMSpec(snapshotVM)
+					Expect(err).ToNot(HaveOccurred())
+
+					var memoryVolumes []kubevirtv1.Volume
+					for _, volume := range restoredVM.Spec.Template.Spec.Volumes {
+						if volume.MemoryDump != nil {
+							memoryVolumes = append(memoryVolumes, volume)
+						}
+					}
+					Expect(memoryVolumes).To(HaveLen(1))
+					Expect(memoryVolumes[0].Name).To(Equal("memory-state"))
+					Expect(memoryVolumes[0].MemoryDump.ClaimName).To(Equal("restore-uid-memory-state"))
+					Expect(memoryVolumes[0].MemoryDump.Hotpluggable).To(BeFalse())
+					Expect(memoryVolumes[0].MemoryDump.Format).To(Equal(kubevirtv1.MemoryDumpFormatSaveState))
+				})
+			})
+
 			Context("target VM is different than source VM", func() {
 
 				It("should be able to restore to a new VM", func() {
@@ -0,0 +1,446 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	"github.com/robfig/cron/v3"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	snapshotScheduleLabel = "snapshot.kubevirt.io/schedule"
+
+	scheduleTimeFormat = "20060102150405"
+
+	scheduledSnapshotCreateEvent = "SuccessfulVirtualMachineSnapshotCreate"
+
+	scheduledSnapshotCreateFailedEvent = "FailedVirtualMachineSnapshotCreate"
+
+	scheduledSnapshotDeleteEvent = "SuccessfulVirtualMachineSnapshotDelete"
+
+	scheduleDisabledReason = "Schedule disabled"
+
+	scheduleActiveReason = "Schedule active"
+)
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotSchedule %s/%s", schedule.Namespace, schedule.Name)
+
+	if schedule.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	scheduleOut := schedule.DeepCopy()
+	if scheduleOut.Status == nil {
+		scheduleOut.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+	}
+
+	cronSchedule, err := cron.ParseStandard(schedule.Spec.Schedule)
+	if err != nil {
+		return 0, ctrl.invalidSchedule(schedule, scheduleOut, fmt.Sprintf("Invalid schedule: %v", err))
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&schedule.Spec.Selector)
+	if err != nil {
+		return 0, ctrl.invalidSchedule(schedule, scheduleOut, fmt.Sprintf("Invalid selector: %v", err))
+	}
+
+	now := currentTime().UTC()
+	vms := ctrl.selectedVMs(schedule.Namespace, selector)
+	createErrors := map[string]error{}
+	created := map[string]string{}
+
+	if schedule.Spec.Disabled != nil && *schedule.Spec.Disabled {
+		scheduleOut.Status.NextScheduleTime = nil
+		scheduleOut.Status.Conditions = updateCondition(scheduleOut.Status.Conditions, newReadyCondition(corev1.ConditionFalse, scheduleDisabledReason), true)
+	} else {
+		lastScheduleTime := schedule.CreationTimestamp.UTC()
+		if schedule.Status != nil && schedule.Status.LastScheduleTime != nil {
+			lastScheduleTime = schedule.Status.LastScheduleTime.UTC()
+		}
+
+		if scheduleTime := mostRecentScheduleTime(cronSchedule, lastScheduleTime, now); scheduleTime != nil {
+			for _, vm := range vms {
+				name, err := ctrl.createScheduledSnapshot(schedule, vm, *scheduleTime)
+				if err != nil {
+					createErrors[vm.Name] = err
+					continue
+				}
+				created[vm.Name] = name
+			}
+			// Retry the missing snapshots before moving on to the next schedule time
+			if len(createErrors) == 0 {
+				scheduleOut.Status.LastScheduleTime = &metav1.Time{Time: *scheduleTime}
+			}
+		}
+
+		if err := ctrl.pruneScheduledSnapshots(schedule, now); err != nil {
+			return 0, err
+		}
+
+		scheduleOut.Status.NextScheduleTime = &metav1.Time{Time: cronSchedule.Next(now)}
+		scheduleOut.Status.Conditions = updateCondition(scheduleOut.Status.Conditions, newReadyCondition(corev1.ConditionTrue, scheduleActiveReason), true)
+	}
+
+	scheduleOut.Status.VirtualMachines = ctrl.vmStatuses(schedule, vms, created, createErrors, now)
+
+	if err := ctrl.updateScheduleStatus(schedule, scheduleOut); err != nil {
+		return 0, err
+	}
+
+	if len(createErrors) > 0 {
+		var errs []error
+		for _, err := range createErrors {
+			errs = append(errs, err)
+		}
+		return 0, utilerrors.NewAggregate(errs)
+	}
+
+	if scheduleOut.Status.NextScheduleTime == nil {
+		return 0, nil
+	}
+
+	return scheduleOut.Status.NextScheduleTime.Sub(now), nil
+}
+
+func (ctrl *VMSnapshotScheduleController) invalidSchedule(schedule, scheduleOut *snapshotv1.VirtualMachineSnapshotSchedule, reason string) error {
+	scheduleOut.Status.NextScheduleTime = nil
+	scheduleOut.Status.Conditions = updateCondition(scheduleOut.Status.Conditions, newReadyCondition(corev1.ConditionFalse, reason), true)
+	return ctrl.updateScheduleStatus(schedule, scheduleOut)
+}
+
+func (ctrl *VMSnapshotScheduleController) updateScheduleStatus(schedule, scheduleOut *snapshotv1.VirtualMachineSnapshotSchedule) error {
+	if equality.Semantic.DeepEqual(schedule.Status, scheduleOut.Status) {
+		return nil
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshotSchedule(scheduleOut.Namespace).UpdateStatus(context.Background(), scheduleOut, metav1.UpdateOptions{})
+	return err
+}
+
+// mostRecentScheduleTime returns the latest time the schedule was due after lastScheduleTime,
+// nil if it was not due since then. Missed intermediate times are skipped.
+func mostRecentScheduleTime(cronSchedule cron.Schedule, lastScheduleTime, now time.Time) *time.Time {
+	t := cronSchedule.Next(lastScheduleTime)
+	if t.IsZero() || t.After(now) {
+		return nil
+	}
+
+	for {
+		next := cronSchedule.Next(t)
+		if next.IsZero() || next.After(now) {
+			return &t
+		}
+		t = next
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) selectedVMs(namespace string, selector labels.Selector) []*kubevirtv1.VirtualMachine {
+	var vms []*kubevirtv1.VirtualMachine
+	for _, obj := range ctrl.VMInformer.GetStore().List() {
+		vm, ok := obj.(*kubevirtv1.VirtualMachine)
+		if !ok || vm.Namespace != namespace || vm.DeletionTimestamp != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(vm.Labels)) {
+			vms = append(vms, vm)
+		}
+	}
+
+	sort.Slice(vms, func(i, j int) bool {
+		return vms[i].Name < vms[j].Name
+	})
+
+	return vms
+}
+
+// scheduledSnapshotName returns a name unique per schedule, VM and schedule time.
+// Long schedule and VM names are truncated and hashed, keeping the name valid.
+func scheduledSnapshotName(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmName string, scheduleTime time.Time) string {
+	return naming.GetName(fmt.Sprintf("%s-%s", schedule.Name, vmName), scheduleTime.UTC().Format(scheduleTimeFormat), validation.DNS1123SubdomainMaxLength)
+}
+
+func (ctrl *VMSnapshotScheduleController) createScheduledSnapshot(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vm *kubevirtv1.VirtualMachine, scheduleTime time.Time) (string, error) {
+	vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      scheduledSnapshotName(schedule, vm.Name, scheduleTime),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				snapshotScheduleLabel: schedule.Name,
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     vm.Name,
+			},
+			FailureDeadline: schedule.Spec.FailureDeadline,
+		},
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		return vmSnapshot.Name, nil
+	}
+	if err != nil {
+		ctrl.Recorder.Eventf(
+			schedule,
+			corev1.EventTypeWarning,
+			scheduledSnapshotCreateFailedEvent,
+			"Error creating VirtualMachineSnapshot %s: %v",
+			vmSnapshot.Name,
+			err,
+		)
+		return "", fmt.Errorf("failed to create VirtualMachineSnapshot %s: %w", vmSnapshot.Name, err)
+	}
+
+	ctrl.Recorder.Eventf(
+		schedule,
+		corev1.EventTypeNormal,
+		scheduledSnapshotCreateEvent,
+		"Successfully created VirtualMachineSnapshot %s",
+		vmSnapshot.Name,
+	)
+
+	return vmSnapshot.Name, nil
+}
+
+// scheduledSnapshots returns the snapshots created by the schedule grouped by VM, newest first
+func (ctrl *VMSnapshotScheduleController) scheduledSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule) map[string][]*snapshotv1.VirtualMachineSnapshot {
+	result := map[string][]*snapshotv1.VirtualMachineSnapshot{}
+	for _, obj := range ctrl.VMSnapshotInformer.GetStore().List() {
+		vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+		if !ok || vmSnapshot.Namespace != schedule.Namespace || vmSnapshot.Labels[snapshotScheduleLabel] != schedule.Name {
+			continue
+		}
+		vmName := vmSnapshot.Spec.Source.Name
+		result[vmName] = append(result[vmName], vmSnapshot)
+	}
+
+	for _, vmSnapshots := range result {
+		sort.Slice(vmSnapshots, func(i, j int) bool {
+			ti, tj := vmSnapshots[i].CreationTimestamp, vmSnapshots[j].CreationTimestamp
+			if ti.Equal(&tj) {
+				return vmSnapshots[i].Name > vmSnapshots[j].Name
+			}
+			return tj.Before(&ti)
+		})
+	}
+
+	return result
+}
+
+func (ctrl *VMSnapshotScheduleController) pruneScheduledSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule, now time.Time) error {
+	if schedule.Spec.Retention == nil {
+		return nil
+	}
+
+	for _, vmSnapshots := range ctrl.scheduledSnapshots(schedule) {
+		for _, vmSnapshot := range snapshotsToPrune(vmSnapshots, schedule.Spec.Retention, now) {
+			err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			ctrl.Recorder.Eventf(
+				schedule,
+				corev1.EventTypeNormal,
+				scheduledSnapshotDeleteEvent,
+				"Successfully deleted VirtualMachineSnapshot %s",
+				vmSnapshot.Name,
+			)
+		}
+	}
+
+	return nil
+}
+
+// snapshotsToPrune applies the retention rules to the snapshots of a single VM, sorted newest first.
+// The Keep rules only select succeeded snapshots, when none is set every succeeded snapshot is selected.
+// Failed snapshots are pruned once a newer snapshot succeeded, snapshots still in progress are never pruned.
+func snapshotsToPrune(vmSnapshots []*snapshotv1.VirtualMachineSnapshot, retention *snapshotv1.VirtualMachineSnapshotScheduleRetention, now time.Time) []*snapshotv1.VirtualMachineSnapshot {
+	var succeeded []*snapshotv1.VirtualMachineSnapshot
+	for _, vmSnapshot := range vmSnapshots {
+		if vmSnapshotPhase(vmSnapshot) == snapshotv1.Succeeded {
+			succeeded = append(succeeded, vmSnapshot)
+		}
+	}
+
+	keep := map[string]bool{}
+	if retention.KeepLast == nil && retention.KeepHourly == nil && retention.KeepDaily == nil &&
+		retention.KeepWeekly == nil && retention.KeepMonthly == nil {
+		for _, vmSnapshot := range succeeded {
+			keep[vmSnapshot.Name] = true
+		}
+	}
+	keepBuckets(succeeded, retention.KeepLast, keep, func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) string {
+		return vmSnapshot.Name
+	})
+	keepBuckets(succeeded, retention.KeepHourly, keep, timeBucket("2006010215"))
+	keepBuckets(succeeded, retention.KeepDaily, keep, timeBucket("20060102"))
+	keepBuckets(succeeded, retention.KeepWeekly, keep, func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) string {
+		year, week := vmSnapshot.CreationTimestamp.UTC().ISOWeek()
+		return fmt.Sprintf("%d-%d", year, week)
+	})
+	keepBuckets(succeeded, retention.KeepMonthly, keep, timeBucket("200601"))
+
+	var result []*snapshotv1.VirtualMachineSnapshot
+	newerSucceeded := false
+	for _, vmSnapshot := range vmSnapshots {
+		phase := vmSnapshotPhase(vmSnapshot)
+		if vmSnapshot.DeletionTimestamp != nil || (phase != snapshotv1.Succeeded && phase != snapshotv1.Failed) {
+			continue
+		}
+
+		tooOld := retention.MaxAge != nil && now.Sub(vmSnapshot.CreationTimestamp.Time) > retention.MaxAge.Duration
+		switch {
+		case tooOld:
+			result = append(result, vmSnapshot)
+		case phase == snapshotv1.Succeeded && !keep[vmSnapshot.Name]:
+			result = append(result, vmSnapshot)
+		case phase == snapshotv1.Failed && newerSucceeded:
+			result = append(result, vmSnapshot)
+		}
+
+		if phase == snapshotv1.Succeeded {
+			newerSucceeded = true
+		}
+	}
+
+	return result
+}
+
+// keepBuckets keeps the newest snapshot of each of the count most recent buckets
+func keepBuckets(vmSnapshots []*snapshotv1.VirtualMachineSnapshot, count *int32, keep map[string]bool, bucket func(*snapshotv1.VirtualMachineSnapshot) string) {
+	if count == nil {
+		return
+	}
+
+	var kept int32
+	lastBucket := ""
+	for _, vmSnapshot := range vmSnapshots {
+		if kept >= *count {
+			return
+		}
+		b := bucket(vmSnapshot)
+		if b == lastBucket {
+			continue
+		}
+		lastBucket = b
+		keep[vmSnapshot.Name] = true
+		kept++
+	}
+}
+
+func timeBucket(layout string) func(*snapshotv1.VirtualMachineSnapshot) string {
+	return func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) string {
+		return vmSnapshot.CreationTimestamp.UTC().Format(layout)
+	}
+}
+
+func vmSnapshotPhase(vmSnapshot *snapshotv1.VirtualMachineSnapshot) snapshotv1.VirtualMachineSnapshotPhase {
+	if vmSnapshot.Status == nil {
+		return snapshotv1.PhaseUnset
+	}
+	return vmSnapshot.Status.Phase
+}
+
+func (ctrl *VMSnapshotScheduleController) vmStatuses(
+	schedule *snapshotv1.VirtualMachineSnapshotSchedule,
+	vms []*kubevirtv1.VirtualMachine,
+	created map[string]string,
+	createErrors map[string]error,
+	now time.Time,
+) []snapshotv1.VirtualMachineSnapshotScheduleVMStatus {
+	previous := map[string]snapshotv1.VirtualMachineSnapshotScheduleVMStatus{}
+	if schedule.Status != nil {
+		for _, vmStatus := range schedule.Status.VirtualMachines {
+			previous[vmStatus.Name] = vmStatus
+		}
+	}
+
+	scheduledSnapshots := ctrl.scheduledSnapshots(schedule)
+	var result []snapshotv1.VirtualMachineSnapshotScheduleVMStatus
+	for _, vm := range vms {
+		vmStatus := snapshotv1.VirtualMachineSnapshotScheduleVMStatus{Name: vm.Name}
+		vmSnapshots := scheduledSnapshots[vm.Name]
+
+		if len(vmSnapshots) > 0 {
+			vmStatus.LastSnapshotName = pointer.P(vmSnapshots[0].Name)
+			if vmSnapshotPhase(vmSnapshots[0]) == snapshotv1.Failed {
+				vmStatus.Error = vmSnapshots[0].Status.Error
+			}
+		}
+		if name, ok := created[vm.Name]; ok {
+			vmStatus.LastSnapshotName = pointer.P(name)
+			vmStatus.Error = nil
+		}
+
+		for _, vmSnapshot := range vmSnapshots {
+			if vmSnapshotPhase(vmSnapshot) != snapshotv1.Succeeded {
+				continue
+			}
+			vmStatus.LastSuccessfulSnapshotName = pointer.P(vmSnapshot.Name)
+			vmStatus.LastSuccessTime = vmSnapshot.Status.CreationTime
+			break
+		}
+
+		if err, ok := createErrors[vm.Name]; ok {
+			vmStatus.Error = &snapshotv1.Error{
+				Message: pointer.P(err.Error()),
+			}
+			// Only bump the time when the error changes, to avoid needless status updates
+			if prev, ok := previous[vm.Name]; ok && prev.Error != nil && prev.Error.Message != nil &&
+				*prev.Error.Message == err.Error() {
+				vmStatus.Error.Time = prev.Error.Time
+			} else {
+				vmStatus.Error.Time = &metav1.Time{Time: now}
+			}
+		}
+
+		result = append(result, vmStatus)
+	}
+
+	return result
+}
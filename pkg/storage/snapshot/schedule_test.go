@@ -0,0 +1,425 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/robfig/cron/v3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot schedule controller", func() {
+	const (
+		testNamespace = "default"
+		scheduleName  = "nightly"
+		day           = 24 * time.Hour
+	)
+
+	var (
+		now = time.Date(2024, time.March, 10, 12, 30, 0, 0, time.UTC)
+
+		controller      *VMSnapshotScheduleController
+		scheduleStore   cache.Store
+		vmSnapshotStore cache.Store
+		vmStore         cache.Store
+		kubevirtClient  *kubevirtfake.Clientset
+		recorder        *record.FakeRecorder
+	)
+
+	createVM := func(name string, labels map[string]string) *kubevirtv1.VirtualMachine {
+		return &kubevirtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				Labels:    labels,
+			},
+		}
+	}
+
+	createSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              scheduleName,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.Time{Time: now.Add(-2 * time.Hour)},
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "true"},
+				},
+				Schedule: "0 * * * *",
+			},
+		}
+	}
+
+	createScheduledSnapshot := func(name, vmName string, created time.Time, phase snapshotv1.VirtualMachineSnapshotPhase) *snapshotv1.VirtualMachineSnapshot {
+		return &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.Time{Time: created},
+				Labels: map[string]string{
+					snapshotScheduleLabel: scheduleName,
+				},
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				Phase:        phase,
+				CreationTime: &metav1.Time{Time: created},
+			},
+		}
+	}
+
+	createdSnapshots := func() []*snapshotv1.VirtualMachineSnapshot {
+		var result []*snapshotv1.VirtualMachineSnapshot
+		for _, action := range kubevirtClient.Actions() {
+			if action.GetVerb() == "create" && action.GetResource().Resource == "virtualmachinesnapshots" {
+				result = append(result, action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot))
+			}
+		}
+		return result
+	}
+
+	deletedSnapshots := func() []string {
+		var result []string
+		for _, action := range kubevirtClient.Actions() {
+			if action.GetVerb() == "delete" && action.GetResource().Resource == "virtualmachinesnapshots" {
+				result = append(result, action.(testing.DeleteAction).GetName())
+			}
+		}
+		return result
+	}
+
+	updatedStatus := func() *snapshotv1.VirtualMachineSnapshotScheduleStatus {
+		var status *snapshotv1.VirtualMachineSnapshotScheduleStatus
+		for _, action := range kubevirtClient.Actions() {
+			if action.GetVerb() == "update" && action.GetSubresource() == "status" {
+				status = action.(testing.UpdateAction).GetObject().(*snapshotv1.VirtualMachineSnapshotSchedule).Status
+			}
+		}
+		return status
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+
+		scheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmSnapshotInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		vmInformer, _ := testutils.NewFakeInformerFor(&kubevirtv1.VirtualMachine{})
+		scheduleStore = scheduleInformer.GetStore()
+		vmSnapshotStore = vmSnapshotInformer.GetStore()
+		vmStore = vmInformer.GetStore()
+
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: scheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			Recorder:                   recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotSchedule(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace)).AnyTimes()
+		kubevirtClient.Fake.PrependReactor("update", "virtualmachinesnapshotschedules", func(action testing.Action) (bool, runtime.Object, error) {
+			return true, action.(testing.UpdateAction).GetObject(), nil
+		})
+
+		currentTime = func() *metav1.Time {
+			return &metav1.Time{Time: now}
+		}
+	})
+
+	AfterEach(func() {
+		currentTime = func() *metav1.Time {
+			t := metav1.Now()
+			return &t
+		}
+	})
+
+	Context("updateVMSnapshotSchedule", func() {
+		BeforeEach(func() {
+			Expect(vmStore.Add(createVM("vm1", map[string]string{"backup": "true"}))).To(Succeed())
+			Expect(vmStore.Add(createVM("vm2", map[string]string{"backup": "true"}))).To(Succeed())
+			Expect(vmStore.Add(createVM("vm3", nil))).To(Succeed())
+		})
+
+		It("should snapshot the selected VMs at the most recent schedule time", func() {
+			schedule := createSchedule()
+			Expect(scheduleStore.Add(schedule)).To(Succeed())
+
+			requeue, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(Equal(30 * time.Minute))
+
+			snapshots := createdSnapshots()
+			Expect(snapshots).To(HaveLen(2))
+			for i, vmName := range []string{"vm1", "vm2"} {
+				Expect(snapshots[i].Name).To(Equal(fmt.Sprintf("nightly-%s-20240310120000", vmName)))
+				Expect(snapshots[i].Labels).To(HaveKeyWithValue(snapshotScheduleLabel, scheduleName))
+				Expect(snapshots[i].Spec.Source.Name).To(Equal(vmName))
+				Expect(snapshots[i].Spec.Source.Kind).To(Equal("VirtualMachine"))
+			}
+			testutils.ExpectEvent(recorder, scheduledSnapshotCreateEvent)
+
+			status := updatedStatus()
+			Expect(status).ToNot(BeNil())
+			Expect(status.LastScheduleTime.Time).To(Equal(time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)))
+			Expect(status.NextScheduleTime.Time).To(Equal(time.Date(2024, time.March, 10, 13, 0, 0, 0, time.UTC)))
+			Expect(status.VirtualMachines).To(HaveLen(2))
+			Expect(*status.VirtualMachines[0].LastSnapshotName).To(Equal("nightly-vm1-20240310120000"))
+			Expect(status.Conditions).To(ContainElement(And(
+				HaveField("Type", snapshotv1.ConditionReady),
+				HaveField("Status", corev1.ConditionTrue),
+			)))
+		})
+
+		It("should truncate the snapshot names of long schedule and VM names", func() {
+			longVMName := strings.Repeat("v", validation.DNS1123SubdomainMaxLength)
+			Expect(vmStore.Add(createVM(longVMName, map[string]string{"backup": "true"}))).To(Succeed())
+			schedule := createSchedule()
+			schedule.Name = strings.Repeat("s", validation.DNS1123SubdomainMaxLength)
+
+			_, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+
+			snapshots := createdSnapshots()
+			Expect(snapshots).To(HaveLen(3))
+			names := map[string]struct{}{}
+			for _, snapshot := range snapshots {
+				Expect(validation.IsDNS1123Subdomain(snapshot.Name)).To(BeEmpty())
+				Expect(snapshot.Name).To(HaveSuffix("-20240310120000"))
+				names[snapshot.Name] = struct{}{}
+			}
+			Expect(names).To(HaveLen(3))
+		})
+
+		It("should not snapshot the VMs before the schedule is due", func() {
+			schedule := createSchedule()
+			schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)},
+			}
+
+			requeue, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(Equal(30 * time.Minute))
+			Expect(createdSnapshots()).To(BeEmpty())
+		})
+
+		It("should treat already existing snapshots as created", func() {
+			kubevirtClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, nil, k8serrors.NewAlreadyExists(snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots").GroupResource(), "")
+			})
+
+			_, err := controller.updateVMSnapshotSchedule(createSchedule())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedStatus().LastScheduleTime).ToNot(BeNil())
+		})
+
+		It("should retry and report snapshots failing to be created", func() {
+			kubevirtClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (bool, runtime.Object, error) {
+				if action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot).Spec.Source.Name == "vm2" {
+					return true, nil, fmt.Errorf("boom")
+				}
+				return false, nil, nil
+			})
+
+			_, err := controller.updateVMSnapshotSchedule(createSchedule())
+			Expect(err).To(HaveOccurred())
+			testutils.ExpectEvent(recorder, scheduledSnapshotCreateEvent)
+			testutils.ExpectEvent(recorder, scheduledSnapshotCreateFailedEvent)
+
+			status := updatedStatus()
+			Expect(status.LastScheduleTime).To(BeNil())
+			Expect(status.VirtualMachines[0].Error).To(BeNil())
+			Expect(*status.VirtualMachines[1].Error.Message).To(ContainSubstring("boom"))
+		})
+
+		It("should not create snapshots when disabled", func() {
+			schedule := createSchedule()
+			schedule.Spec.Disabled = pointer.P(true)
+
+			requeue, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(BeZero())
+			Expect(createdSnapshots()).To(BeEmpty())
+
+			status := updatedStatus()
+			Expect(status.NextScheduleTime).To(BeNil())
+			Expect(status.Conditions).To(ContainElement(And(
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", scheduleDisabledReason),
+			)))
+		})
+
+		It("should report an invalid schedule", func() {
+			schedule := createSchedule()
+			schedule.Spec.Schedule = "every now and then"
+
+			requeue, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(BeZero())
+			Expect(createdSnapshots()).To(BeEmpty())
+			Expect(updatedStatus().Conditions).To(ContainElement(And(
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", ContainSubstring("Invalid schedule")),
+			)))
+		})
+
+		It("should prune snapshots according to the retention", func() {
+			schedule := createSchedule()
+			schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				KeepLast: pointer.P(int32(2)),
+			}
+			schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)},
+			}
+			for i := 1; i <= 3; i++ {
+				Expect(vmSnapshotStore.Add(createScheduledSnapshot(fmt.Sprintf("s%d", i), "vm1", now.Add(-time.Duration(i)*time.Hour), snapshotv1.Succeeded))).To(Succeed())
+			}
+			Expect(vmSnapshotStore.Add(createScheduledSnapshot("s4", "vm1", now.Add(-4*time.Hour), snapshotv1.InProgress))).To(Succeed())
+			kubevirtClient.Fake.PrependReactor("delete", "virtualmachinesnapshots", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, nil, nil
+			})
+
+			_, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deletedSnapshots()).To(ConsistOf("s3"))
+			testutils.ExpectEvent(recorder, scheduledSnapshotDeleteEvent)
+
+			status := updatedStatus()
+			Expect(*status.VirtualMachines[0].LastSnapshotName).To(Equal("s1"))
+			Expect(*status.VirtualMachines[0].LastSuccessfulSnapshotName).To(Equal("s1"))
+		})
+	})
+
+	DescribeTable("mostRecentScheduleTime", func(last, expected *time.Time) {
+		cronSchedule, err := cron.ParseStandard("0 * * * *")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mostRecentScheduleTime(cronSchedule, *last, now)).To(Equal(expected))
+	},
+		Entry("should return nil if not due", pointer.P(now.Add(-10*time.Minute)), nil),
+		Entry("should return the due time", pointer.P(now.Add(-40*time.Minute)), pointer.P(time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC))),
+		Entry("should skip missed times", pointer.P(now.Add(-50*time.Hour)), pointer.P(time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC))),
+	)
+
+	Context("snapshotsToPrune", func() {
+		snapshotsAt := func(phase snapshotv1.VirtualMachineSnapshotPhase, ages ...time.Duration) []*snapshotv1.VirtualMachineSnapshot {
+			var result []*snapshotv1.VirtualMachineSnapshot
+			for i, age := range ages {
+				result = append(result, createScheduledSnapshot(fmt.Sprintf("s%d", i), "vm1", now.Add(-age), phase))
+			}
+			return result
+		}
+
+		names := func(vmSnapshots []*snapshotv1.VirtualMachineSnapshot) []string {
+			var result []string
+			for _, vmSnapshot := range vmSnapshots {
+				result = append(result, vmSnapshot.Name)
+			}
+			return result
+		}
+
+		DescribeTable("should keep the snapshots selected by", func(retention *snapshotv1.VirtualMachineSnapshotScheduleRetention, expected ...string) {
+			vmSnapshots := snapshotsAt(snapshotv1.Succeeded, 0, time.Hour, 2*time.Hour, day, day+time.Hour, 8*day, 40*day)
+			Expect(names(snapshotsToPrune(vmSnapshots, retention, now))).To(ConsistOf(expected))
+		},
+			Entry("keepLast", &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				KeepLast: pointer.P(int32(3)),
+			}, "s3", "s4", "s5", "s6"),
+			Entry("keepDaily", &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				KeepDaily: pointer.P(int32(2)),
+			}, "s1", "s2", "s4", "s5", "s6"),
+			Entry("keepWeekly", &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				KeepWeekly: pointer.P(int32(2)),
+			}, "s1", "s2", "s3", "s4", "s6"),
+			Entry("keepMonthly", &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				KeepMonthly: pointer.P(int32(5)),
+			}, "s1", "s2", "s3", "s4", "s5"),
+			Entry("a combination of keep rules", &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				KeepLast:  pointer.P(int32(1)),
+				KeepDaily: pointer.P(int32(2)),
+			}, "s1", "s2", "s4", "s5", "s6"),
+			Entry("maxAge only", &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				MaxAge: &metav1.Duration{Duration: 2 * day},
+			}, "s5", "s6"),
+			Entry("keep rules and maxAge", &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				KeepLast: pointer.P(int32(10)),
+				MaxAge:   &metav1.Duration{Duration: 2 * time.Hour},
+			}, "s3", "s4", "s5", "s6"),
+		)
+
+		It("should never prune snapshots in progress", func() {
+			vmSnapshots := snapshotsAt(snapshotv1.InProgress, time.Hour, 2*time.Hour)
+			retention := &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				KeepLast: pointer.P(int32(0)),
+				MaxAge:   &metav1.Duration{Duration: time.Minute},
+			}
+			Expect(snapshotsToPrune(vmSnapshots, retention, now)).To(BeEmpty())
+		})
+
+		It("should prune failed snapshots once a newer snapshot succeeded", func() {
+			vmSnapshots := []*snapshotv1.VirtualMachineSnapshot{
+				createScheduledSnapshot("failed-new", "vm1", now, snapshotv1.Failed),
+				createScheduledSnapshot("succeeded", "vm1", now.Add(-time.Hour), snapshotv1.Succeeded),
+				createScheduledSnapshot("failed-old", "vm1", now.Add(-2*time.Hour), snapshotv1.Failed),
+			}
+			retention := &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				KeepLast: pointer.P(int32(1)),
+			}
+			Expect(names(snapshotsToPrune(vmSnapshots, retention, now))).To(ConsistOf("failed-old"))
+		})
+	})
+})
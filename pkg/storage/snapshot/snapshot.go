@@ -32,7 +32,9 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 
+	clone "kubevirt.io/api/clone/v1beta1"
 	kubevirtv1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/log"
@@ -141,6 +143,12 @@ func vmSnapshotDeadlineExceeded(vmSnapshot *snapshotv1.VirtualMachineSnapshot) b
 	return timeUntilDeadline(vmSnapshot) < 0
 }
 
+// GetCloneSnapshotName returns the name of the snapshot a clone takes of its source,
+// and of its copy in the target namespace of the clone
+func GetCloneSnapshotName(vmCloneUID types.UID) string {
+	return fmt.Sprintf("tmp-snapshot-%s", string(vmCloneUID))
+}
+
 func GetVMSnapshotContentName(vmSnapshot *snapshotv1.VirtualMachineSnapshot) string {
 	if vmSnapshot.Status != nil && vmSnapshot.Status.VirtualMachineSnapshotContentName != nil {
 		return *vmSnapshot.Status.VirtualMachineSnapshotContentName
@@ -201,15 +209,31 @@ func (ctrl *VMSnapshotController) updateVMSnapshot(vmSnapshot *snapshotv1.Virtua
 				} else {
 					// create content if does not exist
 					if content == nil {
-						if err := ctrl.createContent(vmSnapshot); err != nil {
+						saved, err := source.SaveMemoryState()
+						if err != nil {
+							return 0, err
+						}
+						if !saved {
+							retry = snapshotRetryInterval
+						} else if err := ctrl.createContent(vmSnapshot); err != nil {
 							return 0, err
 						}
 					}
 				}
 				canRemoveFinalizer = false
 			} else {
-				if canUnlockSource(vmSnapshot, content) {
-					if _, err := source.Unlock(); err != nil {
+				groupReleased, err := ctrl.memberGroupReleased(vmSnapshot)
+				if err != nil {
+					return 0, err
+				}
+				if canUnlockSource(vmSnapshot, content) && groupReleased {
+					released, err := source.ReleaseMemoryState()
+					if err != nil {
+						return 0, err
+					}
+					if !released {
+						retry = snapshotRetryInterval
+					} else if _, err := source.Unlock(); err != nil {
 						return 0, err
 					}
 				}
@@ -364,6 +388,7 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 	}
 
 	contentCreated := vmSnapshotContentCreated(content)
+	_, isGroupMember := vmSnapshotGroupName(vmSnapshot)
 
 	for _, volumeBackup := range content.Spec.VolumeBackups {
 		if volumeBackup.VolumeSnapshotName == nil {
@@ -398,6 +423,20 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 				continue
 			}
 
+			if !didFreeze && isGroupMember {
+				// members of a group are frozen together by the group
+				frozen, err := ctrl.memberGroupFrozen(vmSnapshot)
+				if err != nil {
+					return 0, err
+				}
+				if !frozen {
+					log.Log.V(3).Infof("Waiting for group of %s/%s to freeze", vmSnapshot.Namespace, vmSnapshot.Name)
+					return snapshotRetryInterval, nil
+				}
+
+				didFreeze = true
+			}
+
 			if !didFreeze {
 				source, err := ctrl.getSnapshotSource(vmSnapshot)
 				if err != nil {
@@ -481,9 +520,12 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 	if created && contentCpy.Status.CreationTime == nil {
 		contentCpy.Status.CreationTime = currentTime()
 
-		err = ctrl.unfreezeSource(vmSnapshot)
-		if err != nil {
-			return 0, err
+		// the group unfreezes its members once all of them are created
+		if !isGroupMember {
+			err = ctrl.unfreezeSource(vmSnapshot)
+			if err != nil {
+				return 0, err
+			}
 		}
 	}
 
@@ -574,6 +616,16 @@ func (ctrl *VMSnapshotController) createVolumeSnapshot(
 }
 
 func (ctrl *VMSnapshotController) getSnapshotSource(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (snapshotSource, error) {
+	isCloneCopy, err := ctrl.isTransferredByClone(vmSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	if isCloneCopy {
+		// copies of snapshots created by clones in their target namespace
+		// only carry the content of the original snapshot
+		return nil, nil
+	}
+
 	switch vmSnapshot.Spec.Source.Kind {
 	case "VirtualMachine":
 		vm, err := ctrl.getVM(vmSnapshot)
@@ -595,6 +647,29 @@ func (ctrl *VMSnapshotController) getSnapshotSource(vmSnapshot *snapshotv1.Virtu
 	return nil, fmt.Errorf("unknown source %+v", vmSnapshot.Spec.Source)
 }
 
+// isTransferredByClone checks whether the snapshot is the copy a clone transfers into its target namespace.
+// Anyone can annotate a snapshot, so the clone the annotation refers to has to target the namespace
+// of the snapshot, and the snapshot has to carry the name the clone gives to its copy.
+func (ctrl *VMSnapshotController) isTransferredByClone(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (bool, error) {
+	key, exists := vmSnapshot.Annotations[clone.VirtualMachineCloneAnnotation]
+	if !exists {
+		return false, nil
+	}
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil || namespace == "" || namespace == vmSnapshot.Namespace {
+		return false, nil
+	}
+
+	vmClone, err := ctrl.Client.VirtualMachineClone(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return vmClone.Spec.TargetNamespace == vmSnapshot.Namespace && GetCloneSnapshotName(vmClone.UID) == vmSnapshot.Name, nil
+}
+
 func (ctrl *VMSnapshotController) createContent(vmSnapshot *snapshotv1.VirtualMachineSnapshot) error {
 	source, err := ctrl.getSnapshotSource(vmSnapshot)
 	if err != nil {
@@ -812,7 +887,7 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 				updateSnapshotCondition(vmSnapshotCpy, newProgressingCondition(corev1.ConditionFalse, "Source not locked"))
 			}
 
-			indications, err := updateVMSnapshotIndications(source)
+			indications, err := updateVMSnapshotIndications(vmSnapshotCpy, source)
 			if err != nil {
 				return vmSnapshot, err
 			}
@@ -847,7 +922,7 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 	return vmSnapshot, nil
 }
 
-func updateVMSnapshotIndications(source snapshotSource) ([]snapshotv1.Indication, error) {
+func updateVMSnapshotIndications(vmSnapshot *snapshotv1.VirtualMachineSnapshot, source snapshotSource) ([]snapshotv1.Indication, error) {
 	var indications []snapshotv1.Indication
 	online, err := source.Online()
 	if err != nil {
@@ -867,6 +942,10 @@ func updateVMSnapshotIndications(source snapshotSource) ([]snapshotv1.Indication
 		} else {
 			indications = append(indications, snapshotv1.VMSnapshotNoGuestAgentIndication)
 		}
+
+		if includeMemory(vmSnapshot) {
+			indications = append(indications, snapshotv1.VMSnapshotMemoryIndication)
+		}
 	}
 	return indications, nil
 }
@@ -71,6 +71,7 @@ type VMSnapshotController struct {
 
 	VMSnapshotInformer        cache.SharedIndexInformer
 	VMSnapshotContentInformer cache.SharedIndexInformer
+	VMSnapshotGroupInformer   cache.SharedIndexInformer
 	VMInformer                cache.SharedIndexInformer
 	VMIInformer               cache.SharedIndexInformer
 	StorageClassInformer      cache.SharedIndexInformer
@@ -90,6 +91,7 @@ type VMSnapshotController struct {
 	crdQueue               workqueue.TypedRateLimitingInterface[string]
 	vmSnapshotStatusQueue  workqueue.TypedRateLimitingInterface[string]
 	vmQueue                workqueue.TypedRateLimitingInterface[string]
+	vmSnapshotGroupQueue   workqueue.TypedRateLimitingInterface[string]
 
 	dynamicInformerMap map[string]*dynamicInformer
 	eventHandlerMap    map[string]cache.ResourceEventHandlerFuncs
@@ -122,6 +124,10 @@ func (ctrl *VMSnapshotController) Init() error {
 		workqueue.DefaultTypedControllerRateLimiter[string](),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-vm"},
 	)
+	ctrl.vmSnapshotGroupQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-vmsnapshotgroup"},
+	)
 
 	ctrl.dynamicInformerMap = map[string]*dynamicInformer{
 		volumeSnapshotCRD:      {informerFunc: controller.VolumeSnapshotInformer},
@@ -164,6 +170,17 @@ func (ctrl *VMSnapshotController) Init() error {
 		return err
 	}
 
+	_, err = ctrl.VMSnapshotGroupInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotGroup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotGroup(newObj) },
+		},
+		ctrl.ResyncPeriod,
+	)
+	if err != nil {
+		return err
+	}
+
 	_, err = ctrl.VMInformer.AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    ctrl.handleVM,
@@ -237,6 +254,7 @@ func (ctrl *VMSnapshotController) Run(threadiness int, stopCh <-chan struct{}) e
 	defer ctrl.crdQueue.ShutDown()
 	defer ctrl.vmSnapshotStatusQueue.ShutDown()
 	defer ctrl.vmQueue.ShutDown()
+	defer ctrl.vmSnapshotGroupQueue.ShutDown()
 
 	log.Log.Info("Starting snapshot controller.")
 	defer log.Log.Info("Shutting down snapshot controller.")
@@ -245,6 +263,7 @@ func (ctrl *VMSnapshotController) Run(threadiness int, stopCh <-chan struct{}) e
 		stopCh,
 		ctrl.VMSnapshotInformer.HasSynced,
 		ctrl.VMSnapshotContentInformer.HasSynced,
+		ctrl.VMSnapshotGroupInformer.HasSynced,
 		ctrl.VMInformer.HasSynced,
 		ctrl.VMIInformer.HasSynced,
 		ctrl.CRDInformer.HasSynced,
@@ -273,6 +292,7 @@ func (ctrl *VMSnapshotController) Run(threadiness int, stopCh <-chan struct{}) e
 		go wait.Until(ctrl.vmSnapshotContentWorker, time.Second, stopCh)
 		go wait.Until(ctrl.vmSnapshotStatusWorker, time.Second, stopCh)
 		go wait.Until(ctrl.vmWorker, time.Second, stopCh)
+		go wait.Until(ctrl.vmSnapshotGroupWorker, time.Second, stopCh)
 	}
 
 	<-stopCh
@@ -311,6 +331,11 @@ func (ctrl *VMSnapshotController) vmWorker() {
 	}
 }
 
+func (ctrl *VMSnapshotController) vmSnapshotGroupWorker() {
+	for ctrl.processVMSnapshotGroupWorkItem() {
+	}
+}
+
 func (ctrl *VMSnapshotController) processVMSnapshotWorkItem() bool {
 	return watchutil.ProcessWorkItem(ctrl.vmSnapshotQueue, func(key string) (time.Duration, error) {
 		log.Log.V(3).Infof("vmSnapshot worker processing key [%s]", key)
@@ -347,6 +372,24 @@ func (ctrl *VMSnapshotController) processVMSnapshotContentWorkItem() bool {
 	})
 }
 
+func (ctrl *VMSnapshotController) processVMSnapshotGroupWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmSnapshotGroupQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotGroup worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		group, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotGroup)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMSnapshotGroup(group.DeepCopy())
+	})
+}
+
 func (ctrl *VMSnapshotController) processCRDWorkItem() bool {
 	return watchutil.ProcessWorkItem(ctrl.crdQueue, func(key string) (time.Duration, error) {
 		log.Log.V(3).Infof("CRD worker processing key [%s]", key)
@@ -437,6 +480,28 @@ func (ctrl *VMSnapshotController) handleVMSnapshot(obj interface{}) {
 		}
 		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
 		ctrl.vmSnapshotQueue.Add(objName)
+
+		if groupName, ok := vmSnapshotGroupName(vmSnapshot); ok {
+			k := cacheKeyFunc(vmSnapshot.Namespace, groupName)
+			log.Log.V(3).Infof("enqueued vmsnapshotgroup %q for sync", k)
+			ctrl.vmSnapshotGroupQueue.Add(k)
+		}
+	}
+}
+
+func (ctrl *VMSnapshotController) handleVMSnapshotGroup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if group, ok := obj.(*snapshotv1.VirtualMachineSnapshotGroup); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(group)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, group)
+			return
+		}
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmSnapshotGroupQueue.Add(objName)
 	}
 }
 
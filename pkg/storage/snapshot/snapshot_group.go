@@ -0,0 +1,479 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	snapshotGroupMemberCreateEvent = "SuccessfulVirtualMachineSnapshotCreate"
+
+	snapshotGroupFreezeEvent = "SuccessfulVirtualMachineSnapshotGroupFreeze"
+
+	snapshotGroupFreezeFailedEvent = "FailedVirtualMachineSnapshotGroupFreeze"
+
+	snapshotGroupUnfreezeEvent = "SuccessfulVirtualMachineSnapshotGroupUnfreeze"
+
+	snapshotGroupNoMembersError = "no VirtualMachines match the selector"
+
+	snapshotGroupMemberFailedError = "member snapshot failed"
+)
+
+func vmSnapshotGroupName(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (string, bool) {
+	if vmSnapshot == nil {
+		return "", false
+	}
+	groupName, ok := vmSnapshot.Labels[snapshotv1.VirtualMachineSnapshotGroupLabel]
+	return groupName, ok
+}
+
+func vmSnapshotGroupFailed(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return group.Status != nil && group.Status.Phase == snapshotv1.Failed
+}
+
+func vmSnapshotGroupFrozen(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return group.Status != nil && group.Status.FreezeTime != nil
+}
+
+func vmSnapshotGroupCreated(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return group.Status != nil && group.Status.CreationTime != nil
+}
+
+// vmSnapshotGroupReleased returns true once the group no longer needs the sources of its members to stay frozen
+func vmSnapshotGroupReleased(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return group == nil || group.DeletionTimestamp != nil || vmSnapshotGroupCreated(group) || vmSnapshotGroupFailed(group)
+}
+
+func getGroupFailureDeadline(group *snapshotv1.VirtualMachineSnapshotGroup) time.Duration {
+	failureDeadline := snapshotv1.DefaultFailureDeadline
+	if group.Spec.FailureDeadline != nil {
+		failureDeadline = group.Spec.FailureDeadline.Duration
+	}
+
+	return failureDeadline
+}
+
+func timeUntilGroupDeadline(group *snapshotv1.VirtualMachineSnapshotGroup) time.Duration {
+	failureDeadline := getGroupFailureDeadline(group)
+	// No Deadline set by user
+	if failureDeadline == 0 {
+		return failureDeadline
+	}
+	deadline := group.CreationTimestamp.Add(failureDeadline)
+	return time.Until(deadline)
+}
+
+func vmSnapshotGroupDeadlineExceeded(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return getGroupFailureDeadline(group) != 0 && timeUntilGroupDeadline(group) < 0
+}
+
+func vmSnapshotGroupMemberName(group *snapshotv1.VirtualMachineSnapshotGroup, vmName string) string {
+	return fmt.Sprintf("%s-%s", group.Name, vmName)
+}
+
+func (ctrl *VMSnapshotController) updateVMSnapshotGroup(group *snapshotv1.VirtualMachineSnapshotGroup) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotGroup %s/%s", group.Namespace, group.Name)
+
+	// members are owned by the group and are garbage collected with it,
+	// their content controller unfreezes the sources when they terminate
+	if group.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	groupCpy := group.DeepCopy()
+	if groupCpy.Status == nil {
+		groupCpy.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+			Phase:      snapshotv1.InProgress,
+			ReadyToUse: pointer.P(false),
+		}
+	}
+
+	if groupCpy.Status.Members == nil && !vmSnapshotGroupFailed(groupCpy) {
+		members, err := ctrl.selectVMSnapshotGroupMembers(group)
+		if err != nil {
+			return 0, ctrl.failVMSnapshotGroup(group, groupCpy, nil, fmt.Sprintf("Invalid selector: %v", err))
+		}
+		if len(members) == 0 {
+			return 0, ctrl.failVMSnapshotGroup(group, groupCpy, nil, snapshotGroupNoMembersError)
+		}
+		groupCpy.Status.Members = members
+	}
+
+	vmSnapshots, err := ctrl.ensureVMSnapshotGroupMembers(groupCpy)
+	if err != nil {
+		return 0, err
+	}
+
+	var retry time.Duration
+	switch {
+	case vmSnapshotGroupFailed(groupCpy) || vmSnapshotGroupCreated(groupCpy):
+	case vmSnapshotGroupDeadlineExceeded(groupCpy):
+		return 0, ctrl.failVMSnapshotGroup(group, groupCpy, vmSnapshots, vmSnapshotDeadlineExceededError)
+	case vmSnapshotGroupMemberFailed(vmSnapshots):
+		return 0, ctrl.failVMSnapshotGroup(group, groupCpy, vmSnapshots, snapshotGroupMemberFailedError)
+	case !vmSnapshotGroupFrozen(groupCpy):
+		retry, err = ctrl.freezeVMSnapshotGroup(groupCpy, vmSnapshots)
+		if err != nil {
+			return 0, err
+		}
+	default:
+		created := true
+		for _, vmSnapshot := range vmSnapshots {
+			if vmSnapshot == nil || vmSnapshot.Status == nil || vmSnapshot.Status.CreationTime == nil {
+				created = false
+			}
+		}
+
+		if created {
+			ctrl.unfreezeVMSnapshotGroup(groupCpy, vmSnapshots)
+			groupCpy.Status.CreationTime = currentTime()
+			ctrl.enqueueVMSnapshotGroupMembers(groupCpy)
+		}
+	}
+
+	updateVMSnapshotGroupMemberStatus(groupCpy, vmSnapshots)
+
+	if err := ctrl.updateVMSnapshotGroupStatus(group, groupCpy); err != nil {
+		return 0, err
+	}
+
+	if retry == 0 && !vmSnapshotGroupCreated(groupCpy) && !vmSnapshotGroupFailed(groupCpy) {
+		retry = timeUntilGroupDeadline(groupCpy)
+	}
+
+	return retry, nil
+}
+
+func (ctrl *VMSnapshotController) selectVMSnapshotGroupMembers(group *snapshotv1.VirtualMachineSnapshotGroup) ([]snapshotv1.VirtualMachineSnapshotGroupMember, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&group.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []snapshotv1.VirtualMachineSnapshotGroupMember{}
+	for _, obj := range ctrl.VMInformer.GetStore().List() {
+		vm, ok := obj.(*kubevirtv1.VirtualMachine)
+		if !ok || vm.Namespace != group.Namespace || vm.DeletionTimestamp != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(vm.Labels)) {
+			members = append(members, snapshotv1.VirtualMachineSnapshotGroupMember{
+				VirtualMachineName:         vm.Name,
+				VirtualMachineSnapshotName: vmSnapshotGroupMemberName(group, vm.Name),
+			})
+		}
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].VirtualMachineName < members[j].VirtualMachineName
+	})
+
+	return members, nil
+}
+
+// ensureVMSnapshotGroupMembers creates the missing member snapshots as long as the group is
+// progressing and returns the members' VirtualMachineSnapshots, nil for those that do not exist
+func (ctrl *VMSnapshotController) ensureVMSnapshotGroupMembers(group *snapshotv1.VirtualMachineSnapshotGroup) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	var vmSnapshots []*snapshotv1.VirtualMachineSnapshot
+	for _, member := range group.Status.Members {
+		obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(group.Namespace, member.VirtualMachineSnapshotName))
+		if err != nil {
+			return nil, err
+		}
+
+		if exists {
+			vmSnapshots = append(vmSnapshots, obj.(*snapshotv1.VirtualMachineSnapshot))
+			continue
+		}
+
+		if !vmSnapshotGroupFrozen(group) && !vmSnapshotGroupFailed(group) {
+			if err := ctrl.createVMSnapshotGroupMember(group, member); err != nil {
+				return nil, err
+			}
+		}
+		vmSnapshots = append(vmSnapshots, nil)
+	}
+
+	return vmSnapshots, nil
+}
+
+func (ctrl *VMSnapshotController) createVMSnapshotGroupMember(group *snapshotv1.VirtualMachineSnapshotGroup, member snapshotv1.VirtualMachineSnapshotGroupMember) error {
+	vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      member.VirtualMachineSnapshotName,
+			Namespace: group.Namespace,
+			Labels: map[string]string{
+				snapshotv1.VirtualMachineSnapshotGroupLabel: group.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(group, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshotGroup")),
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     member.VirtualMachineName,
+			},
+			DeletionPolicy:  group.Spec.DeletionPolicy,
+			FailureDeadline: group.Spec.FailureDeadline,
+		},
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ctrl.Recorder.Eventf(
+		group,
+		corev1.EventTypeNormal,
+		snapshotGroupMemberCreateEvent,
+		"Successfully created VirtualMachineSnapshot %s",
+		vmSnapshot.Name,
+	)
+
+	return nil
+}
+
+// freezeVMSnapshotGroup freezes the sources of all members once every member locked its source
+// and created its content. The members' volumes are only snapshotted once FreezeTime is set.
+func (ctrl *VMSnapshotController) freezeVMSnapshotGroup(group *snapshotv1.VirtualMachineSnapshotGroup, vmSnapshots []*snapshotv1.VirtualMachineSnapshot) (time.Duration, error) {
+	var sources []snapshotSource
+	for _, vmSnapshot := range vmSnapshots {
+		if vmSnapshot == nil {
+			return snapshotRetryInterval, nil
+		}
+
+		source, err := ctrl.getSnapshotSource(vmSnapshot)
+		if err != nil {
+			return 0, err
+		}
+		if source == nil || !source.Locked() {
+			return snapshotRetryInterval, nil
+		}
+
+		content, err := ctrl.getContent(vmSnapshot)
+		if err != nil {
+			return 0, err
+		}
+		if content == nil {
+			return snapshotRetryInterval, nil
+		}
+
+		sources = append(sources, source)
+	}
+
+	for i, source := range sources {
+		if err := source.Freeze(); err != nil {
+			for _, frozen := range sources[:i] {
+				if err := frozen.Unfreeze(); err != nil {
+					log.Log.Warningf("Failed to unfreeze member of group %s/%s: %v", group.Namespace, group.Name, err)
+				}
+			}
+
+			ctrl.Recorder.Eventf(
+				group,
+				corev1.EventTypeWarning,
+				snapshotGroupFreezeFailedEvent,
+				"Error freezing VirtualMachineSnapshot %s: %v",
+				vmSnapshots[i].Name,
+				err,
+			)
+			group.Status.Error = &snapshotv1.Error{
+				Time:    currentTime(),
+				Message: pointer.P(err.Error()),
+			}
+			return snapshotRetryInterval, nil
+		}
+	}
+
+	group.Status.Error = nil
+	group.Status.FreezeTime = currentTime()
+	ctrl.Recorder.Eventf(
+		group,
+		corev1.EventTypeNormal,
+		snapshotGroupFreezeEvent,
+		"Successfully froze %d VirtualMachines",
+		len(sources),
+	)
+
+	for _, vmSnapshot := range vmSnapshots {
+		ctrl.vmSnapshotContentQueue.Add(cacheKeyFunc(vmSnapshot.Namespace, GetVMSnapshotContentName(vmSnapshot)))
+	}
+
+	return 0, nil
+}
+
+func (ctrl *VMSnapshotController) unfreezeVMSnapshotGroup(group *snapshotv1.VirtualMachineSnapshotGroup, vmSnapshots []*snapshotv1.VirtualMachineSnapshot) {
+	for _, vmSnapshot := range vmSnapshots {
+		if vmSnapshot == nil {
+			continue
+		}
+
+		if err := ctrl.unfreezeSource(vmSnapshot); err != nil {
+			log.Log.Warningf("Failed to unfreeze source of %s/%s: %v", vmSnapshot.Namespace, vmSnapshot.Name, err)
+		}
+	}
+
+	ctrl.Recorder.Event(
+		group,
+		corev1.EventTypeNormal,
+		snapshotGroupUnfreezeEvent,
+		"Successfully unfroze the VirtualMachines",
+	)
+}
+
+func (ctrl *VMSnapshotController) failVMSnapshotGroup(group, groupCpy *snapshotv1.VirtualMachineSnapshotGroup, vmSnapshots []*snapshotv1.VirtualMachineSnapshot, reason string) error {
+	if vmSnapshotGroupFrozen(groupCpy) {
+		ctrl.unfreezeVMSnapshotGroup(groupCpy, vmSnapshots)
+	}
+	updateVMSnapshotGroupMemberStatus(groupCpy, vmSnapshots)
+
+	groupCpy.Status.Phase = snapshotv1.Failed
+	groupCpy.Status.ReadyToUse = pointer.P(false)
+	groupCpy.Status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: pointer.P(reason),
+	}
+	groupCpy.Status.Conditions = updateCondition(groupCpy.Status.Conditions, newProgressingCondition(corev1.ConditionFalse, reason), true)
+	groupCpy.Status.Conditions = updateCondition(groupCpy.Status.Conditions, newFailureCondition(corev1.ConditionTrue, reason), true)
+	groupCpy.Status.Conditions = updateCondition(groupCpy.Status.Conditions, newReadyCondition(corev1.ConditionFalse, "Operation failed"), true)
+	ctrl.enqueueVMSnapshotGroupMembers(groupCpy)
+
+	return ctrl.updateVMSnapshotGroupStatus(group, groupCpy)
+}
+
+func vmSnapshotGroupMemberFailed(vmSnapshots []*snapshotv1.VirtualMachineSnapshot) bool {
+	for _, vmSnapshot := range vmSnapshots {
+		if vmSnapshotFailed(vmSnapshot) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ctrl *VMSnapshotController) enqueueVMSnapshotGroupMembers(group *snapshotv1.VirtualMachineSnapshotGroup) {
+	for _, member := range group.Status.Members {
+		ctrl.vmSnapshotQueue.Add(cacheKeyFunc(group.Namespace, member.VirtualMachineSnapshotName))
+	}
+}
+
+func updateVMSnapshotGroupMemberStatus(group *snapshotv1.VirtualMachineSnapshotGroup, vmSnapshots []*snapshotv1.VirtualMachineSnapshot) {
+	ready := len(vmSnapshots) > 0
+	for i, vmSnapshot := range vmSnapshots {
+		memberReady := vmSnapshot != nil && VmSnapshotReady(vmSnapshot)
+		group.Status.Members[i].ReadyToUse = pointer.P(memberReady)
+		ready = ready && memberReady
+	}
+
+	if vmSnapshotGroupFailed(group) {
+		return
+	}
+
+	group.Status.ReadyToUse = pointer.P(ready)
+	if vmSnapshotGroupCreated(group) && ready {
+		group.Status.Phase = snapshotv1.Succeeded
+		group.Status.Conditions = updateCondition(group.Status.Conditions, newProgressingCondition(corev1.ConditionFalse, "Operation complete"), true)
+		group.Status.Conditions = updateCondition(group.Status.Conditions, newReadyCondition(corev1.ConditionTrue, "Operation complete"), true)
+		return
+	}
+
+	group.Status.Phase = snapshotv1.InProgress
+	switch {
+	case vmSnapshotGroupCreated(group):
+		group.Status.Conditions = updateCondition(group.Status.Conditions, newProgressingCondition(corev1.ConditionTrue, "Waiting for member snapshots to be ready"), true)
+	case vmSnapshotGroupFrozen(group):
+		group.Status.Conditions = updateCondition(group.Status.Conditions, newProgressingCondition(corev1.ConditionTrue, "Members frozen and volumes being snapshotted"), true)
+	case group.Status.Error != nil:
+		group.Status.Conditions = updateCondition(group.Status.Conditions, newProgressingCondition(corev1.ConditionFalse, "In error state"), true)
+	default:
+		group.Status.Conditions = updateCondition(group.Status.Conditions, newProgressingCondition(corev1.ConditionTrue, "Waiting for members to lock their source"), true)
+	}
+	group.Status.Conditions = updateCondition(group.Status.Conditions, newReadyCondition(corev1.ConditionFalse, "Not ready"), true)
+}
+
+func (ctrl *VMSnapshotController) updateVMSnapshotGroupStatus(group, groupCpy *snapshotv1.VirtualMachineSnapshotGroup) error {
+	if equality.Semantic.DeepEqual(group.Status, groupCpy.Status) {
+		return nil
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshotGroup(groupCpy.Namespace).UpdateStatus(context.Background(), groupCpy, metav1.UpdateOptions{})
+	return err
+}
+
+func (ctrl *VMSnapshotController) getVMSnapshotGroup(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (*snapshotv1.VirtualMachineSnapshotGroup, error) {
+	groupName, ok := vmSnapshotGroupName(vmSnapshot)
+	if !ok {
+		return nil, nil
+	}
+
+	obj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(cacheKeyFunc(vmSnapshot.Namespace, groupName))
+	if !exists || err != nil {
+		return nil, err
+	}
+
+	return obj.(*snapshotv1.VirtualMachineSnapshotGroup), nil
+}
+
+// memberGroupFrozen returns true while the group of the snapshot holds its members frozen
+func (ctrl *VMSnapshotController) memberGroupFrozen(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (bool, error) {
+	group, err := ctrl.getVMSnapshotGroup(vmSnapshot)
+	if err != nil || group == nil {
+		return false, err
+	}
+
+	return vmSnapshotGroupFrozen(group) && !vmSnapshotGroupReleased(group), nil
+}
+
+// memberGroupReleased returns true if the source of the snapshot does not have to stay
+// locked for its group, which is the case for snapshots that are not a member of any group
+func (ctrl *VMSnapshotController) memberGroupReleased(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (bool, error) {
+	if _, ok := vmSnapshotGroupName(vmSnapshot); !ok {
+		return true, nil
+	}
+
+	group, err := ctrl.getVMSnapshotGroup(vmSnapshot)
+	if err != nil {
+		return false, err
+	}
+
+	return vmSnapshotGroupReleased(group), nil
+}
@@ -30,6 +30,7 @@ import (
 	framework "k8s.io/client-go/tools/cache/testing"
 	"k8s.io/client-go/tools/record"
 
+	clone "kubevirt.io/api/clone/v1beta1"
 	v1 "kubevirt.io/api/core/v1"
 	instancetypeapi "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
@@ -274,6 +275,8 @@ var _ = Describe("Snapshot controlleer", func() {
 		var vmSnapshotInformer cache.SharedIndexInformer
 		var vmSnapshotContentSource *framework.FakeControllerSource
 		var vmSnapshotContentInformer cache.SharedIndexInformer
+		var vmSnapshotGroupSource *framework.FakeControllerSource
+		var vmSnapshotGroupInformer cache.SharedIndexInformer
 		var vmInformer cache.SharedIndexInformer
 		var vmSource *framework.FakeControllerSource
 		var vmiInformer cache.SharedIndexInformer
@@ -312,6 +315,7 @@ var _ = Describe("Snapshot controlleer", func() {
 		syncCaches := func(stop chan struct{}) {
 			go vmSnapshotInformer.Run(stop)
 			go vmSnapshotContentInformer.Run(stop)
+			go vmSnapshotGroupInformer.Run(stop)
 			go vmInformer.Run(stop)
 			go storageClassInformer.Run(stop)
 			go storageProfileInformer.Run(stop)
@@ -325,6 +329,7 @@ var _ = Describe("Snapshot controlleer", func() {
 				stop,
 				vmSnapshotInformer.HasSynced,
 				vmSnapshotContentInformer.HasSynced,
+				vmSnapshotGroupInformer.HasSynced,
 				vmInformer.HasSynced,
 				storageClassInformer.HasSynced,
 				storageProfileInformer.HasSynced,
@@ -346,6 +351,7 @@ var _ = Describe("Snapshot controlleer", func() {
 
 			vmSnapshotInformer, vmSnapshotSource = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
 			vmSnapshotContentInformer, vmSnapshotContentSource = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshotContent{}, virtcontroller.GetVirtualMachineSnapshotContentInformerIndexers())
+			vmSnapshotGroupInformer, vmSnapshotGroupSource = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
 			crInformer, crSource = testutils.NewFakeInformerWithIndexersFor(&appsv1.ControllerRevision{}, virtcontroller.GetControllerRevisionInformerIndexers())
 			vmInformer, vmSource = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
 			vmiInformer, vmiSource = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, virtcontroller.GetVMIInformerIndexers())
@@ -365,6 +371,7 @@ var _ = Describe("Snapshot controlleer", func() {
 				Client:                    virtClient,
 				VMSnapshotInformer:        vmSnapshotInformer,
 				VMSnapshotContentInformer: vmSnapshotContentInformer,
+				VMSnapshotGroupInformer:   vmSnapshotGroupInformer,
 				VMInformer:                vmInformer,
 				VMIInformer:               vmiInformer,
 				PodInformer:               podInformer,
@@ -408,6 +415,7 @@ var _ = Describe("Snapshot controlleer", func() {
 			k8sClient = k8sfake.NewSimpleClientset()
 			virtClient.EXPECT().StorageV1().Return(k8sClient.StorageV1()).AnyTimes()
 			virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+			virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
 
 			k8sClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
 				Expect(action).To(BeNil())
@@ -469,6 +477,30 @@ var _ = Describe("Snapshot controlleer", func() {
 			}
 		}
 
+		DescribeTable("should only treat the snapshots a clone transferred as clone copies", func(annotation, targetNamespace, snapshotName string, expected bool) {
+			const sourceNamespace = "source-ns"
+			cloneClient := kubevirtfake.NewSimpleClientset(&clone.VirtualMachineClone{
+				ObjectMeta: metav1.ObjectMeta{Name: "clone", Namespace: sourceNamespace, UID: "clone-uid"},
+				Spec:       clone.VirtualMachineCloneSpec{TargetNamespace: targetNamespace},
+			})
+			virtClient.EXPECT().VirtualMachineClone(sourceNamespace).
+				Return(cloneClient.CloneV1beta1().VirtualMachineClones(sourceNamespace)).AnyTimes()
+
+			vmSnapshot := createVMSnapshot()
+			vmSnapshot.Name = snapshotName
+			vmSnapshot.Annotations = map[string]string{clone.VirtualMachineCloneAnnotation: annotation}
+
+			isCloneCopy, err := controller.isTransferredByClone(vmSnapshot)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isCloneCopy).To(Equal(expected))
+		},
+			Entry("transferred by the clone", "source-ns/clone", testNamespace, "tmp-snapshot-clone-uid", true),
+			Entry("not named after the clone", "source-ns/clone", testNamespace, vmSnapshotName, false),
+			Entry("of a clone into another namespace", "source-ns/clone", "other-ns", "tmp-snapshot-clone-uid", false),
+			Entry("of a missing clone", "source-ns/missing", testNamespace, "tmp-snapshot-clone-uid", false),
+			Entry("of a clone in the same namespace", testNamespace+"/clone", testNamespace, "tmp-snapshot-clone-uid", false),
+		)
+
 		Context("with VolumeSnapshot and VolumeSnapshotContent informers", func() {
 
 			BeforeEach(func() {
@@ -615,6 +647,194 @@ var _ = Describe("Snapshot controlleer", func() {
 				controller.processVMSnapshotWorkItem()
 			})
 
+			Context("with memory state", func() {
+				var vm *v1.VirtualMachine
+				var vmi *v1.VirtualMachineInstance
+
+				pausedCondition := v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstancePaused,
+					Status: corev1.ConditionTrue,
+				}
+
+				BeforeEach(func() {
+					vm = createLockedVM()
+					vmi = createVMI(vm)
+					vmi.Spec.Domain.Resources.Requests = corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					}
+				})
+
+				addSources := func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) {
+					vmSource.Add(vm)
+					if vmi != nil {
+						vmiSource.Add(vmi)
+					}
+					storageClassSource.Add(createStorageClass())
+					pvcs := createPersistentVolumeClaims()
+					for i := range pvcs {
+						pvcSource.Add(&pvcs[i])
+					}
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+					addVirtualMachineSnapshot(vmSnapshot)
+				}
+
+				createMemoryVMSnapshotInProgress := func() *snapshotv1.VirtualMachineSnapshot {
+					vmSnapshot := createVMSnapshotInProgress()
+					vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+					return vmSnapshot
+				}
+
+				expectMemoryVMSnapshotUpdateStatus := func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) *int {
+					updatedSnapshot := vmSnapshot.DeepCopy()
+					updatedSnapshot.ResourceVersion = "1"
+					updatedSnapshot.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Source locked and operation in progress"),
+						newReadyCondition(corev1.ConditionFalse, "Not ready"),
+					}
+					updatedSnapshot.Status.Indications = []snapshotv1.Indication{
+						snapshotv1.VMSnapshotOnlineSnapshotIndication,
+						snapshotv1.VMSnapshotNoGuestAgentIndication,
+						snapshotv1.VMSnapshotMemoryIndication,
+					}
+					return expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
+				}
+
+				It("should create the memory state PVC and pause the VMI", func() {
+					vmSnapshot := createMemoryVMSnapshotInProgress()
+
+					expectedSize := resource.MustParse("1Gi")
+					expectedSize.Add(resource.MustParse("100Mi"))
+					pvcCreates := 0
+					k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						create, ok := action.(testing.CreateAction)
+						Expect(ok).To(BeTrue())
+
+						pvc := create.GetObject().(*corev1.PersistentVolumeClaim)
+						Expect(pvc.Name).To(Equal(memoryStateClaimName(vmSnapshot)))
+						Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal(storageClassName)))
+						Expect(pvc.Spec.AccessModes).To(ConsistOf(corev1.ReadWriteOnce))
+						Expect(pvc.Spec.Resources.Requests.Storage().Cmp(expectedSize)).To(BeNumerically(">", 0))
+						Expect(pvc.OwnerReferences).To(HaveLen(1))
+						Expect(pvc.OwnerReferences[0].Name).To(Equal(vmSnapshot.Name))
+
+						pvcCreates++
+						return true, pvc, nil
+					})
+
+					annotationPatch, err := patch.New(
+						patch.WithAdd("/metadata/annotations", map[string]string{sourcePausedAnnotation: vmSnapshot.Name}),
+					).GeneratePayload()
+					Expect(err).ToNot(HaveOccurred())
+					vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, annotationPatch, metav1.PatchOptions{}).Return(vmi, nil).Times(1)
+					vmiInterface.EXPECT().Pause(context.Background(), vmi.Name, &v1.PauseOptions{}).Return(nil).Times(1)
+					updateStatusCalls := expectMemoryVMSnapshotUpdateStatus(vmSnapshot)
+
+					addSources(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					Expect(pvcCreates).To(Equal(1))
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should request saving the memory state once the VMI is paused", func() {
+					vmSnapshot := createMemoryVMSnapshotInProgress()
+					vmi.Annotations = map[string]string{sourcePausedAnnotation: vmSnapshot.Name}
+					vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{pausedCondition}
+					pvcSource.Add(&corev1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      memoryStateClaimName(vmSnapshot),
+							Namespace: testNamespace,
+						},
+					})
+
+					vmUpdate := vm.DeepCopy()
+					vmUpdate.ResourceVersion = "1"
+					vmUpdate.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: memoryStateClaimName(vmSnapshot),
+						Phase:     v1.MemoryDumpAssociating,
+						Format:    v1.MemoryDumpFormatSaveState,
+					}
+					vmInterface.EXPECT().UpdateStatus(context.Background(), vmUpdate, metav1.UpdateOptions{}).Return(vmUpdate, nil).Times(1)
+					updateStatusCalls := expectMemoryVMSnapshotUpdateStatus(vmSnapshot)
+
+					addSources(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should not create content while the memory state is being saved", func() {
+					vmSnapshot := createMemoryVMSnapshotInProgress()
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: memoryStateClaimName(vmSnapshot),
+						Phase:     v1.MemoryDumpInProgress,
+						Format:    v1.MemoryDumpFormatSaveState,
+					}
+					updateStatusCalls := expectMemoryVMSnapshotUpdateStatus(vmSnapshot)
+
+					addSources(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should unpause the VMI and remove the memory dump request before unlocking", func() {
+					vmSnapshot := createVMSnapshotSuccess()
+					vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: memoryStateClaimName(vmSnapshot),
+						Phase:     v1.MemoryDumpCompleted,
+						Format:    v1.MemoryDumpFormatSaveState,
+					}
+					vmi.Annotations = map[string]string{sourcePausedAnnotation: vmSnapshot.Name}
+					vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{pausedCondition}
+
+					annotationPath := "/metadata/annotations/" + patch.EscapeJSONPointer(sourcePausedAnnotation)
+					annotationPatch, err := patch.New(
+						patch.WithTest(annotationPath, vmSnapshot.Name),
+						patch.WithRemove(annotationPath),
+					).GeneratePayload()
+					Expect(err).ToNot(HaveOccurred())
+					vmiInterface.EXPECT().Unpause(context.Background(), vmi.Name, &v1.UnpauseOptions{}).Return(nil).Times(1)
+					vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, annotationPatch, metav1.PatchOptions{}).Return(vmi, nil).Times(1)
+
+					vmUpdate := vm.DeepCopy()
+					vmUpdate.ResourceVersion = "1"
+					vmUpdate.Status.MemoryDumpRequest.Remove = true
+					vmInterface.EXPECT().UpdateStatus(context.Background(), vmUpdate, metav1.UpdateOptions{}).Return(vmUpdate, nil).Times(1)
+
+					addSources(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+				})
+
+				It("should delete the memory state PVC and unlock once the memory dump is dissociated", func() {
+					vmSnapshot := createVMSnapshotSuccess()
+					vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+					vmi = nil
+
+					pvcDeletes := 0
+					k8sClient.Fake.PrependReactor("delete", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						del, ok := action.(testing.DeleteAction)
+						Expect(ok).To(BeTrue())
+						Expect(del.GetName()).To(Equal(memoryStateClaimName(vmSnapshot)))
+						pvcDeletes++
+						return true, nil, nil
+					})
+
+					updatedVM := vm.DeepCopy()
+					updatedVM.Finalizers = []string{}
+					updatedVM.ResourceVersion = "1"
+					patchBytes, err := patch.GenerateTestReplacePatch("/metadata/finalizers", []string{"snapshot.kubevirt.io/snapshot-source-protection"}, []string{})
+					Expect(err).ToNot(HaveOccurred())
+					vmInterface.EXPECT().Patch(context.Background(), updatedVM.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}).Return(updatedVM, nil).Times(1)
+
+					statusUpdate := updatedVM.DeepCopy()
+					statusUpdate.Status.SnapshotInProgress = nil
+					vmInterface.EXPECT().UpdateStatus(context.Background(), statusUpdate, metav1.UpdateOptions{}).Return(statusUpdate, nil).Times(1)
+
+					addSources(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					Expect(pvcDeletes).To(Equal(1))
+				})
+			})
+
 			It("cleanup when VirtualMachineSnapshot is deleted", func() {
 				vmSnapshot := createVMSnapshotSuccess()
 				vmSnapshot.DeletionTimestamp = timeFunc()
@@ -2334,6 +2554,302 @@ var _ = Describe("Snapshot controlleer", func() {
 				)
 
 			})
+
+			Context("with a VirtualMachineSnapshotGroup", func() {
+				const groupName = "app"
+
+				BeforeEach(func() {
+					virtClient.EXPECT().VirtualMachineSnapshotGroup(testNamespace).
+						Return(vmSnapshotClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace)).AnyTimes()
+					for _, resource := range []string{"virtualmachinesnapshotgroups", "virtualmachinesnapshots"} {
+						vmSnapshotClient.Fake.PrependReactor("*", resource, testing.ObjectReaction(vmSnapshotClient.Tracker()))
+					}
+				})
+
+				createGroup := func(memberVMs ...string) *snapshotv1.VirtualMachineSnapshotGroup {
+					group := &snapshotv1.VirtualMachineSnapshotGroup{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              groupName,
+							Namespace:         testNamespace,
+							UID:               "group-uid",
+							CreationTimestamp: timeStamp,
+						},
+						Spec: snapshotv1.VirtualMachineSnapshotGroupSpec{
+							Selector: metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "db"},
+							},
+							FailureDeadline: noFailureDeadline,
+						},
+					}
+					if len(memberVMs) > 0 {
+						group.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+							Phase:      snapshotv1.InProgress,
+							ReadyToUse: pointer.P(false),
+						}
+						for _, vmName := range memberVMs {
+							group.Status.Members = append(group.Status.Members, snapshotv1.VirtualMachineSnapshotGroupMember{
+								VirtualMachineName:         vmName,
+								VirtualMachineSnapshotName: groupName + "-" + vmName,
+							})
+						}
+					}
+					return group
+				}
+
+				createMemberVM := func(name string) *v1.VirtualMachine {
+					vm := createVirtualMachine(testNamespace, name)
+					vm.Labels["app"] = "db"
+					vm.Finalizers = []string{sourceFinalizer}
+					vm.Status.SnapshotInProgress = pointer.P(groupName + "-" + name)
+					vmSource.Add(vm)
+
+					vmi := createVMI(vm)
+					vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+						Type:          v1.VirtualMachineInstanceAgentConnected,
+						LastProbeTime: metav1.Now(),
+						Status:        corev1.ConditionTrue,
+					})
+					vmiSource.Add(vmi)
+					return vm
+				}
+
+				createMember := func(vmName string) *snapshotv1.VirtualMachineSnapshot {
+					vmSnapshot := createVirtualMachineSnapshot(testNamespace, groupName+"-"+vmName, vmName)
+					vmSnapshot.UID = types.UID(vmName + "-snapshot-uid")
+					vmSnapshot.Labels = map[string]string{snapshotv1.VirtualMachineSnapshotGroupLabel: groupName}
+					vmSnapshot.Finalizers = []string{vmSnapshotFinalizer}
+					vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+						ReadyToUse:                        pointer.P(false),
+						SourceUID:                         &vmUID,
+						Phase:                             snapshotv1.InProgress,
+						VirtualMachineSnapshotContentName: pointer.P(vmName + "-content"),
+					}
+					vmSnapshotSource.Add(vmSnapshot)
+
+					vmSnapshotContentSource.Add(&snapshotv1.VirtualMachineSnapshotContent{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      vmName + "-content",
+							Namespace: testNamespace,
+						},
+						Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+							VirtualMachineSnapshotName: &vmSnapshot.Name,
+						},
+					})
+					return vmSnapshot
+				}
+
+				addGroup := func(group *snapshotv1.VirtualMachineSnapshotGroup) {
+					vmSnapshotGroupSource.Add(group)
+					_, err := vmSnapshotClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace).Create(context.Background(), group, metav1.CreateOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					syncCaches(stop)
+				}
+
+				getGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+					group, err := vmSnapshotClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace).Get(context.Background(), groupName, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					return group
+				}
+
+				It("should create a member snapshot for every selected VM", func() {
+					group := createGroup()
+					createMemberVM("db")
+					createMemberVM("web")
+					other := createVirtualMachine(testNamespace, "other")
+					vmSource.Add(other)
+					addGroup(group)
+
+					_, err := controller.updateVMSnapshotGroup(group)
+					Expect(err).ToNot(HaveOccurred())
+					testutils.ExpectEvent(recorder, snapshotGroupMemberCreateEvent)
+					testutils.ExpectEvent(recorder, snapshotGroupMemberCreateEvent)
+
+					for _, vmName := range []string{"db", "web"} {
+						vmSnapshot, err := vmSnapshotClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).Get(context.Background(), groupName+"-"+vmName, metav1.GetOptions{})
+						Expect(err).ToNot(HaveOccurred())
+						Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotv1.VirtualMachineSnapshotGroupLabel, groupName))
+						Expect(vmSnapshot.OwnerReferences).To(HaveLen(1))
+						Expect(vmSnapshot.OwnerReferences[0].Name).To(Equal(groupName))
+						Expect(vmSnapshot.Spec.Source.Name).To(Equal(vmName))
+						Expect(vmSnapshot.Spec.FailureDeadline).To(Equal(noFailureDeadline))
+					}
+
+					updatedGroup := getGroup()
+					Expect(updatedGroup.Status.Phase).To(Equal(snapshotv1.InProgress))
+					expectedMembers := createGroup("db", "web").Status.Members
+					for i := range expectedMembers {
+						expectedMembers[i].ReadyToUse = pointer.P(false)
+					}
+					Expect(updatedGroup.Status.Members).To(Equal(expectedMembers))
+					Expect(updatedGroup.Status.FreezeTime).To(BeNil())
+				})
+
+				It("should fail when no VM matches the selector", func() {
+					group := createGroup()
+					vmSource.Add(createVirtualMachine(testNamespace, "other"))
+					addGroup(group)
+
+					_, err := controller.updateVMSnapshotGroup(group)
+					Expect(err).ToNot(HaveOccurred())
+
+					updatedGroup := getGroup()
+					Expect(updatedGroup.Status.Phase).To(Equal(snapshotv1.Failed))
+					Expect(*updatedGroup.Status.Error.Message).To(Equal(snapshotGroupNoMembersError))
+				})
+
+				It("should wait for all members to lock their source before freezing", func() {
+					group := createGroup("db", "web")
+					createMemberVM("db")
+					createMember("db")
+					web := createVirtualMachine(testNamespace, "web")
+					web.Labels["app"] = "db"
+					vmSource.Add(web)
+					createMember("web")
+					addGroup(group)
+
+					retry, err := controller.updateVMSnapshotGroup(group)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(retry).To(Equal(snapshotRetryInterval))
+					Expect(getGroup().Status.FreezeTime).To(BeNil())
+				})
+
+				It("should freeze all members at once", func() {
+					group := createGroup("db", "web")
+					createMemberVM("db")
+					createMemberVM("web")
+					createMember("db")
+					createMember("web")
+					addGroup(group)
+
+					vmiInterface.EXPECT().Freeze(context.Background(), "db", 0*time.Second).Return(nil).Times(1)
+					vmiInterface.EXPECT().Freeze(context.Background(), "web", 0*time.Second).Return(nil).Times(1)
+
+					_, err := controller.updateVMSnapshotGroup(group)
+					Expect(err).ToNot(HaveOccurred())
+					testutils.ExpectEvent(recorder, snapshotGroupFreezeEvent)
+
+					updatedGroup := getGroup()
+					Expect(updatedGroup.Status.FreezeTime).To(Equal(timeFunc()))
+					Expect(updatedGroup.Status.Error).To(BeNil())
+				})
+
+				It("should unfreeze the frozen members when freezing a member fails", func() {
+					group := createGroup("db", "web")
+					createMemberVM("db")
+					createMemberVM("web")
+					createMember("db")
+					createMember("web")
+					addGroup(group)
+
+					vmiInterface.EXPECT().Freeze(context.Background(), "db", 0*time.Second).Return(nil).Times(1)
+					vmiInterface.EXPECT().Freeze(context.Background(), "web", 0*time.Second).Return(fmt.Errorf("freeze failed")).Times(1)
+					vmiInterface.EXPECT().Unfreeze(context.Background(), "db").Return(nil).Times(1)
+
+					retry, err := controller.updateVMSnapshotGroup(group)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(retry).To(Equal(snapshotRetryInterval))
+					testutils.ExpectEvent(recorder, snapshotGroupFreezeFailedEvent)
+
+					updatedGroup := getGroup()
+					Expect(updatedGroup.Status.FreezeTime).To(BeNil())
+					Expect(*updatedGroup.Status.Error.Message).To(Equal("freeze failed"))
+				})
+
+				DescribeTable("should unfreeze all members once every member was created", func(ready bool, expectedPhase snapshotv1.VirtualMachineSnapshotPhase) {
+					group := createGroup("db", "web")
+					group.Status.FreezeTime = timeFunc()
+					createMemberVM("db")
+					createMemberVM("web")
+					for _, vmName := range []string{"db", "web"} {
+						vmSnapshot := createMember(vmName)
+						vmSnapshot.Status.CreationTime = timeFunc()
+						vmSnapshot.Status.ReadyToUse = pointer.P(ready)
+						vmSnapshotSource.Modify(vmSnapshot)
+					}
+					addGroup(group)
+
+					vmiInterface.EXPECT().Unfreeze(context.Background(), "db").Return(nil).Times(1)
+					vmiInterface.EXPECT().Unfreeze(context.Background(), "web").Return(nil).Times(1)
+
+					_, err := controller.updateVMSnapshotGroup(group)
+					Expect(err).ToNot(HaveOccurred())
+					testutils.ExpectEvent(recorder, snapshotGroupUnfreezeEvent)
+
+					updatedGroup := getGroup()
+					Expect(updatedGroup.Status.CreationTime).To(Equal(timeFunc()))
+					Expect(*updatedGroup.Status.ReadyToUse).To(Equal(ready))
+					Expect(updatedGroup.Status.Phase).To(Equal(expectedPhase))
+					for _, member := range updatedGroup.Status.Members {
+						Expect(*member.ReadyToUse).To(Equal(ready))
+					}
+				},
+					Entry("and mark the group succeeded when all members are ready", true, snapshotv1.Succeeded),
+					Entry("and keep the group in progress until the members are ready", false, snapshotv1.InProgress),
+				)
+
+				It("should unfreeze all members and fail when a member failed", func() {
+					group := createGroup("db", "web")
+					group.Status.FreezeTime = timeFunc()
+					createMemberVM("db")
+					createMemberVM("web")
+					createMember("db")
+					failed := createMember("web")
+					failed.Status.Phase = snapshotv1.Failed
+					vmSnapshotSource.Modify(failed)
+					addGroup(group)
+
+					vmiInterface.EXPECT().Unfreeze(context.Background(), "db").Return(nil).Times(1)
+					vmiInterface.EXPECT().Unfreeze(context.Background(), "web").Return(nil).Times(1)
+
+					_, err := controller.updateVMSnapshotGroup(group)
+					Expect(err).ToNot(HaveOccurred())
+
+					updatedGroup := getGroup()
+					Expect(updatedGroup.Status.Phase).To(Equal(snapshotv1.Failed))
+					Expect(*updatedGroup.Status.Error.Message).To(Equal(snapshotGroupMemberFailedError))
+				})
+
+				It("should not snapshot the volumes of a member before the group froze", func() {
+					group := createGroup("db")
+					createMemberVM("db")
+					vmSnapshot := createMember("db")
+					addGroup(group)
+
+					content := createVirtualMachineSnapshotContent(vmSnapshot, createVM(), createPersistentVolumeClaims())
+
+					retry, err := controller.updateVMSnapshotContent(content)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(retry).To(Equal(snapshotRetryInterval))
+				})
+
+				It("should keep the source of a created member locked until the group unfroze", func() {
+					group := createGroup("db")
+					group.Status.FreezeTime = timeFunc()
+					createMemberVM("db")
+					vmSnapshot := createMember("db")
+					vmSnapshot.Status.CreationTime = timeFunc()
+					vmSnapshot.Status.ReadyToUse = pointer.P(true)
+					vmSnapshot.Status.Phase = snapshotv1.Succeeded
+					vmSnapshotSource.Modify(vmSnapshot)
+					addGroup(group)
+
+					source, err := controller.getSnapshotSource(vmSnapshot)
+					Expect(err).ToNot(HaveOccurred())
+
+					released, err := controller.memberGroupReleased(vmSnapshot)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(released).To(BeFalse())
+					Expect(source.Locked()).To(BeTrue())
+
+					group.Status.CreationTime = timeFunc()
+					vmSnapshotGroupSource.Modify(group)
+					Eventually(func() bool {
+						released, err := controller.memberGroupReleased(vmSnapshot)
+						Expect(err).ToNot(HaveOccurred())
+						return released
+					}).Should(BeTrue())
+				})
+			})
 		})
 
 		Context("without VolumeSnapshot and VolumeSnapshotClass informers", func() {
@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -32,11 +33,13 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	kubevirtv1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
@@ -47,6 +50,9 @@ import (
 
 const (
 	sourceFinalizer = "snapshot.kubevirt.io/snapshot-source-protection"
+
+	// sourcePausedAnnotation marks a VMI paused by the snapshot controller to save its memory state
+	sourcePausedAnnotation = "snapshot.kubevirt.io/paused-by-snapshot"
 )
 
 type snapshotSource interface {
@@ -59,6 +65,8 @@ type snapshotSource interface {
 	Frozen() (bool, error)
 	Freeze() error
 	Unfreeze() error
+	SaveMemoryState() (bool, error)
+	ReleaseMemoryState() (bool, error)
 	Spec() (snapshotv1.SourceSpec, error)
 	PersistentVolumeClaims() (map[string]string, error)
 }
@@ -337,6 +345,11 @@ func (s *vmSnapshotSource) Freeze() error {
 		return fmt.Errorf("attempting to freeze unlocked VM")
 	}
 
+	if includeMemory(s.snapshot) {
+		// the vmi is kept paused while its memory state is saved
+		return nil
+	}
+
 	exists, err := s.GuestAgent()
 	if !exists || err != nil {
 		return err
@@ -360,6 +373,10 @@ func (s *vmSnapshotSource) Unfreeze() error {
 		return nil
 	}
 
+	if includeMemory(s.snapshot) {
+		return s.unpause()
+	}
+
 	exists, err := s.GuestAgent()
 	if !exists || err != nil {
 		return err
@@ -376,6 +393,241 @@ func (s *vmSnapshotSource) Unfreeze() error {
 	return nil
 }
 
+// SaveMemoryState pauses the vmi and dumps its memory state to a PVC through
+// a memory dump request. The vmi stays paused until the volumes are snapshotted.
+func (s *vmSnapshotSource) SaveMemoryState() (bool, error) {
+	if !includeMemory(s.snapshot) {
+		return true, nil
+	}
+
+	vmi, exists, err := s.controller.getVMI(s.vm)
+	if err != nil || !exists {
+		return !exists, err
+	}
+
+	claimName := memoryStateClaimName(s.snapshot)
+	request := s.vm.Status.MemoryDumpRequest
+	if request != nil && request.ClaimName == claimName {
+		switch request.Phase {
+		case kubevirtv1.MemoryDumpCompleted:
+			return true, nil
+		case kubevirtv1.MemoryDumpFailed:
+			return false, fmt.Errorf("saving memory state of vm %s failed: %s", s.vm.Name, request.Message)
+		}
+		return false, nil
+	}
+	if request != nil && request.Phase != kubevirtv1.MemoryDumpCompleted && request.Phase != kubevirtv1.MemoryDumpFailed {
+		log.Log.V(3).Infof("Memory dump of vm %s to %s in progress", s.vm.Name, request.ClaimName)
+		return false, nil
+	}
+
+	if err := s.ensureMemoryStatePVC(vmi, claimName); err != nil {
+		return false, err
+	}
+
+	paused, err := s.pause(vmi)
+	if err != nil || !paused {
+		return false, err
+	}
+
+	log.Log.V(3).Infof("Saving memory state of vm %s to %s", s.vm.Name, claimName)
+
+	vmCopy := s.vm.DeepCopy()
+	vmCopy.Status.MemoryDumpRequest = &kubevirtv1.VirtualMachineMemoryDumpRequest{
+		ClaimName: claimName,
+		Phase:     kubevirtv1.MemoryDumpAssociating,
+		Format:    kubevirtv1.MemoryDumpFormatSaveState,
+	}
+	vmCopy, err = s.controller.Client.VirtualMachine(vmCopy.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return false, err
+	}
+	s.vm = vmCopy
+
+	return false, nil
+}
+
+// ReleaseMemoryState resumes the vmi, dissociates the memory dump request and
+// removes the memory state PVC once its volume snapshot was taken
+func (s *vmSnapshotSource) ReleaseMemoryState() (bool, error) {
+	if !includeMemory(s.snapshot) {
+		return true, nil
+	}
+
+	if err := s.unpause(); err != nil {
+		return false, err
+	}
+
+	claimName := memoryStateClaimName(s.snapshot)
+	request := s.vm.Status.MemoryDumpRequest
+	if request != nil && request.ClaimName == claimName {
+		if request.Remove {
+			return false, nil
+		}
+
+		vmCopy := s.vm.DeepCopy()
+		vmCopy.Status.MemoryDumpRequest.Remove = true
+		vmCopy, err := s.controller.Client.VirtualMachine(vmCopy.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return false, err
+		}
+		s.vm = vmCopy
+
+		return false, nil
+	}
+
+	err := s.controller.Client.CoreV1().PersistentVolumeClaims(s.vm.Namespace).Delete(context.Background(), claimName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *vmSnapshotSource) ensureMemoryStatePVC(vmi *kubevirtv1.VirtualMachineInstance, claimName string) error {
+	_, exists, err := s.controller.PVCInformer.GetStore().GetByKey(cacheKeyFunc(s.vm.Namespace, claimName))
+	if err != nil || exists {
+		return err
+	}
+
+	storageClassName, err := s.memoryStateStorageClass()
+	if err != nil {
+		return err
+	}
+
+	size, err := storagetypes.GetSizeIncludingDefaultFSOverhead(utils.CalcExpectedMemoryDumpSize(vmi))
+	if err != nil {
+		return err
+	}
+
+	volumeMode := corev1.PersistentVolumeFilesystem
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      claimName,
+			Namespace: s.vm.Namespace,
+			Labels: map[string]string{
+				snapshotSourceNameLabel: s.vm.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(s.snapshot, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshot")),
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			VolumeMode:       &volumeMode,
+			StorageClassName: &storageClassName,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: *size,
+				},
+			},
+		},
+	}
+
+	_, err = s.controller.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// memoryStateStorageClass returns the storage class of the first snapshottable
+// volume so the memory state can be snapshotted along with the disks
+func (s *vmSnapshotSource) memoryStateStorageClass() (string, error) {
+	pvcs, err := s.PersistentVolumeClaims()
+	if err != nil {
+		return "", err
+	}
+
+	volumeNames := make([]string, 0, len(pvcs))
+	for volumeName := range pvcs {
+		volumeNames = append(volumeNames, volumeName)
+	}
+	sort.Strings(volumeNames)
+
+	for _, volumeName := range volumeNames {
+		pvc, err := s.controller.getSnapshotPVC(s.vm.Namespace, pvcs[volumeName])
+		if err != nil {
+			return "", err
+		}
+		if pvc != nil {
+			return *pvc.Spec.StorageClassName, nil
+		}
+	}
+
+	return "", fmt.Errorf("vm %s has no snapshottable volume to store its memory state", s.vm.Name)
+}
+
+func (s *vmSnapshotSource) pause(vmi *kubevirtv1.VirtualMachineInstance) (bool, error) {
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if condManager.HasCondition(vmi, kubevirtv1.VirtualMachineInstancePaused) {
+		return true, nil
+	}
+
+	if vmi.Annotations[sourcePausedAnnotation] == s.snapshot.Name {
+		// pause requested, wait for the vmi to report it
+		return false, nil
+	}
+
+	annotationPatch := patch.WithAdd(fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(sourcePausedAnnotation)), s.snapshot.Name)
+	if vmi.Annotations == nil {
+		annotationPatch = patch.WithAdd("/metadata/annotations", map[string]string{sourcePausedAnnotation: s.snapshot.Name})
+	}
+	payload, err := patch.New(annotationPatch).GeneratePayload()
+	if err != nil {
+		return false, err
+	}
+	if _, err := s.controller.Client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, payload, metav1.PatchOptions{}); err != nil {
+		return false, err
+	}
+
+	log.Log.V(3).Infof("Pausing vm %s to save its memory state", s.vm.Name)
+
+	if err := s.controller.Client.VirtualMachineInstance(vmi.Namespace).Pause(context.Background(), vmi.Name, &kubevirtv1.PauseOptions{}); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// unpause resumes the vmi only if it was paused by this snapshot
+func (s *vmSnapshotSource) unpause() error {
+	vmi, exists, err := s.controller.getVMI(s.vm)
+	if err != nil || !exists {
+		return err
+	}
+
+	if vmi.Annotations[sourcePausedAnnotation] != s.snapshot.Name {
+		return nil
+	}
+
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if condManager.HasCondition(vmi, kubevirtv1.VirtualMachineInstancePaused) {
+		log.Log.V(3).Infof("Unpausing vm %s after saving its memory state", s.vm.Name)
+
+		err := s.controller.Client.VirtualMachineInstance(vmi.Namespace).Unpause(context.Background(), vmi.Name, &kubevirtv1.UnpauseOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	annotationPath := fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(sourcePausedAnnotation))
+	payload, err := patch.New(
+		patch.WithTest(annotationPath, s.snapshot.Name),
+		patch.WithRemove(annotationPath),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = s.controller.Client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, payload, metav1.PatchOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
 func (s *vmSnapshotSource) PersistentVolumeClaims() (map[string]string, error) {
 	volumes, err := storageutils.GetVolumes(s.vm, s.controller.Client, storageutils.WithAllVolumes)
 	if err != nil {
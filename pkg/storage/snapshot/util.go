@@ -112,6 +112,14 @@ func getFailureDeadline(vmSnapshot *snapshotv1.VirtualMachineSnapshot) time.Dura
 	return failureDeadline
 }
 
+func includeMemory(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	return vmSnapshot.Spec.IncludeMemory != nil && *vmSnapshot.Spec.IncludeMemory
+}
+
+func memoryStateClaimName(vmSnapshot *snapshotv1.VirtualMachineSnapshot) string {
+	return fmt.Sprintf("vmsnapshot-%s-memory", vmSnapshot.UID)
+}
+
 func timeUntilDeadline(vmSnapshot *snapshotv1.VirtualMachineSnapshot) time.Duration {
 	failureDeadline := getFailureDeadline(vmSnapshot)
 	// No Deadline set by user
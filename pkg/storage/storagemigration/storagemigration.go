@@ -0,0 +1,415 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storagemigration
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	volumemig "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-migration"
+)
+
+const (
+	storageMigrationStartedEvent      = "StorageMigrationStarted"
+	storageMigrationSucceededEvent    = "StorageMigrationSucceeded"
+	storageMigrationFailedEvent       = "StorageMigrationFailed"
+	storageMigrationSourceDeleteEvent = "SourceVolumeDeleted"
+)
+
+// destinationSuffix matches the suffix added to the claims by an earlier storage migration
+var destinationSuffix = regexp.MustCompile(`-mig-[a-z0-9]{5}$`)
+
+var currentTime = func() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
+
+func destinationClaimName(storageMigration *migrationsv1.VirtualMachineStorageMigration, claimName string) string {
+	return fmt.Sprintf("%s-mig-%s", destinationSuffix.ReplaceAllString(claimName, ""), string(storageMigration.UID)[:5])
+}
+
+func (ctrl *VMStorageMigrationController) updateVMStorageMigration(storageMigration *migrationsv1.VirtualMachineStorageMigration) error {
+	if storageMigration.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if storageMigration.Status == nil {
+		storageMigration.Status = &migrationsv1.VirtualMachineStorageMigrationStatus{
+			Phase: migrationsv1.StorageMigrationPending,
+		}
+		return ctrl.updateStatus(storageMigration)
+	}
+
+	switch storageMigration.Status.Phase {
+	case migrationsv1.StorageMigrationSucceeded, migrationsv1.StorageMigrationFailed:
+		return nil
+	}
+
+	vm, err := ctrl.getVM(storageMigration.Namespace, storageMigration.Spec.VMName)
+	if err != nil {
+		return err
+	}
+	if vm == nil {
+		return ctrl.fail(storageMigration, fmt.Sprintf("VirtualMachine %s does not exist", storageMigration.Spec.VMName))
+	}
+
+	vmi, err := ctrl.getVMI(storageMigration.Namespace, storageMigration.Spec.VMName)
+	if err != nil {
+		return err
+	}
+
+	if storageMigration.Status.Phase == migrationsv1.StorageMigrationMigrating {
+		return ctrl.trackMigration(storageMigration, vm, vmi)
+	}
+	return ctrl.startMigration(storageMigration, vm, vmi)
+}
+
+// startMigration validates the migration of the volumes and creates the destination volumes
+func (ctrl *VMStorageMigrationController) startMigration(storageMigration *migrationsv1.VirtualMachineStorageMigration, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || !vmi.IsRunning() {
+		return ctrl.fail(storageMigration, fmt.Sprintf("VirtualMachine %s is not running", vm.Name))
+	}
+	if volumemig.IsVolumeMigrating(vmi) {
+		return ctrl.fail(storageMigration, fmt.Sprintf("VirtualMachine %s is already migrating its volumes", vm.Name))
+	}
+
+	if unknown := unknownVolumes(storageMigration.Spec.Volumes, vm); len(unknown) > 0 {
+		return ctrl.fail(storageMigration, fmt.Sprintf("VirtualMachine %s has no volumes named %s", vm.Name, strings.Join(unknown, ", ")))
+	}
+
+	volumes, err := ctrl.volumesToMigrate(storageMigration, vm)
+	if err != nil {
+		return err
+	}
+	if len(volumes) == 0 {
+		return ctrl.fail(storageMigration, fmt.Sprintf("no volume of VirtualMachine %s needs to be migrated to storage class %s", vm.Name, storageMigration.Spec.StorageClassName))
+	}
+
+	vmCopy := vm.DeepCopy()
+	for _, volume := range volumes {
+		ctrl.replaceVolume(storageMigration, vmCopy, volume)
+	}
+	if err := volumemig.ValidateVolumes(vmi, vmCopy); err != nil {
+		return ctrl.fail(storageMigration, err.Error())
+	}
+
+	templates := map[string]struct{}{}
+	for _, template := range vm.Spec.DataVolumeTemplates {
+		templates[template.Name] = struct{}{}
+	}
+	for _, volume := range volumes {
+		// The VM controller creates the DataVolumes of the templates
+		if _, isTemplate := templates[volume.SourceClaimName]; isTemplate {
+			continue
+		}
+		if err := ctrl.createDestinationDataVolume(storageMigration, vm, volume); err != nil {
+			return err
+		}
+	}
+
+	ctrl.Recorder.Eventf(storageMigration, k8sv1.EventTypeNormal, storageMigrationStartedEvent,
+		"Started migrating %d volumes of VirtualMachine %s to storage class %s", len(volumes), vm.Name, storageMigration.Spec.StorageClassName)
+
+	storageMigration.Status.Phase = migrationsv1.StorageMigrationMigrating
+	storageMigration.Status.Volumes = volumes
+	storageMigration.Status.StartTimestamp = currentTime()
+	return ctrl.updateStatus(storageMigration)
+}
+
+// volumesToMigrate lists the volumes of the VM which are requested and not yet on the target storage class
+func (ctrl *VMStorageMigrationController) volumesToMigrate(storageMigration *migrationsv1.VirtualMachineStorageMigration, vm *virtv1.VirtualMachine) ([]migrationsv1.StorageMigrationVolume, error) {
+	requested := map[string]struct{}{}
+	for _, name := range storageMigration.Spec.Volumes {
+		requested[name] = struct{}{}
+	}
+
+	var volumes []migrationsv1.StorageMigrationVolume
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if _, ok := requested[volume.Name]; len(requested) > 0 && !ok {
+			continue
+		}
+		claimName := storagetypes.PVCNameFromVirtVolume(&volume)
+		if claimName == "" {
+			continue
+		}
+		pvc, err := ctrl.getPVC(vm.Namespace, claimName)
+		if err != nil {
+			return nil, err
+		}
+		if pvc != nil && pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName == storageMigration.Spec.StorageClassName {
+			continue
+		}
+		volumes = append(volumes, migrationsv1.StorageMigrationVolume{
+			VolumeName:           volume.Name,
+			SourceClaimName:      claimName,
+			DestinationClaimName: destinationClaimName(storageMigration, claimName),
+		})
+	}
+	return volumes, nil
+}
+
+// unknownVolumes lists the requested volume names which are not volumes of the VM
+func unknownVolumes(names []string, vm *virtv1.VirtualMachine) []string {
+	vmVolumes := map[string]struct{}{}
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		vmVolumes[volume.Name] = struct{}{}
+	}
+
+	var unknown []string
+	for _, name := range names {
+		if _, ok := vmVolumes[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
+// replaceVolume points the volume of the VM to the destination DataVolume, a
+// DataVolume template of the source is replaced by a template of the destination
+func (ctrl *VMStorageMigrationController) replaceVolume(storageMigration *migrationsv1.VirtualMachineStorageMigration, vm *virtv1.VirtualMachine, volume migrationsv1.StorageMigrationVolume) {
+	for i, v := range vm.Spec.Template.Spec.Volumes {
+		if v.Name != volume.VolumeName {
+			continue
+		}
+		vm.Spec.Template.Spec.Volumes[i].VolumeSource = virtv1.VolumeSource{
+			DataVolume: &virtv1.DataVolumeSource{
+				Name: volume.DestinationClaimName,
+			},
+		}
+	}
+
+	for i, template := range vm.Spec.DataVolumeTemplates {
+		if template.Name != volume.SourceClaimName {
+			continue
+		}
+		vm.Spec.DataVolumeTemplates[i] = virtv1.DataVolumeTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        volume.DestinationClaimName,
+				Labels:      template.Labels,
+				Annotations: template.Annotations,
+			},
+			Spec: ctrl.destinationDataVolumeSpec(storageMigration, vm.Namespace, volume.SourceClaimName),
+		}
+	}
+}
+
+// destinationDataVolumeSpec returns the spec of a blank DataVolume on the target
+// storage class with the size and volume mode of the source claim
+func (ctrl *VMStorageMigrationController) destinationDataVolumeSpec(storageMigration *migrationsv1.VirtualMachineStorageMigration, namespace, sourceClaimName string) cdiv1.DataVolumeSpec {
+	spec := cdiv1.DataVolumeSpec{
+		Source: &cdiv1.DataVolumeSource{
+			Blank: &cdiv1.DataVolumeBlankImage{},
+		},
+		Storage: &cdiv1.StorageSpec{
+			StorageClassName: pointer.P(storageMigration.Spec.StorageClassName),
+		},
+	}
+
+	pvc, err := ctrl.getPVC(namespace, sourceClaimName)
+	if err != nil || pvc == nil {
+		return spec
+	}
+	spec.Storage.VolumeMode = pvc.Spec.VolumeMode
+	if size, ok := pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]; ok {
+		spec.Storage.Resources.Requests = k8sv1.ResourceList{
+			k8sv1.ResourceStorage: size,
+		}
+	}
+	return spec
+}
+
+func (ctrl *VMStorageMigrationController) createDestinationDataVolume(storageMigration *migrationsv1.VirtualMachineStorageMigration, vm *virtv1.VirtualMachine, volume migrationsv1.StorageMigrationVolume) error {
+	dv := &cdiv1.DataVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volume.DestinationClaimName,
+			Namespace: vm.Namespace,
+		},
+		Spec: ctrl.destinationDataVolumeSpec(storageMigration, vm.Namespace, volume.SourceClaimName),
+	}
+
+	_, err := ctrl.Client.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Create(context.Background(), dv, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create destination DataVolume %s: %v", dv.Name, err)
+	}
+	return nil
+}
+
+func (ctrl *VMStorageMigrationController) patchVMVolumes(vm, updatedVM *virtv1.VirtualMachine) error {
+	patchSet := patch.New(
+		patch.WithTest("/spec/template/spec/volumes", vm.Spec.Template.Spec.Volumes),
+		patch.WithReplace("/spec/template/spec/volumes", updatedVM.Spec.Template.Spec.Volumes),
+	)
+	if !equality.Semantic.DeepEqual(vm.Spec.DataVolumeTemplates, updatedVM.Spec.DataVolumeTemplates) {
+		patchSet.AddOption(
+			patch.WithTest("/spec/dataVolumeTemplates", vm.Spec.DataVolumeTemplates),
+			patch.WithReplace("/spec/dataVolumeTemplates", updatedVM.Spec.DataVolumeTemplates),
+		)
+	}
+	patchSet.AddOption(patch.WithAdd("/spec/updateVolumesStrategy", virtv1.UpdateVolumesStrategyMigration))
+
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = ctrl.Client.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// trackMigration follows the volume migration of the VM until the VMI runs on
+// all the destination volumes, and deletes the sources if requested
+func (ctrl *VMStorageMigrationController) trackMigration(storageMigration *migrationsv1.VirtualMachineStorageMigration, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.IsFinal() {
+		return ctrl.fail(storageMigration, fmt.Sprintf("VirtualMachine %s stopped during the volume migration", vm.Name))
+	}
+
+	vmCopy := vm.DeepCopy()
+	vmClaims := claimsByVolume(vm.Spec.Template.Spec.Volumes)
+	for _, volume := range storageMigration.Status.Volumes {
+		switch vmClaims[volume.VolumeName] {
+		case volume.DestinationClaimName:
+		case volume.SourceClaimName:
+			ctrl.replaceVolume(storageMigration, vmCopy, volume)
+		default:
+			return ctrl.fail(storageMigration, fmt.Sprintf("volume %s of VirtualMachine %s no longer references the destination %s", volume.VolumeName, vm.Name, volume.DestinationClaimName))
+		}
+	}
+	// Trigger the volume migration by updating the volumes of the VM
+	// with the Migration update volumes strategy
+	if !equality.Semantic.DeepEqual(vm.Spec, vmCopy.Spec) {
+		return ctrl.patchVMVolumes(vm, vmCopy)
+	}
+
+	conditionManager := controller.NewVirtualMachineConditionManager()
+	if conditionManager.HasConditionWithStatus(vm, virtv1.VirtualMachineManualRecoveryRequired, k8sv1.ConditionTrue) {
+		return ctrl.fail(storageMigration, fmt.Sprintf("the volume migration of VirtualMachine %s failed and requires a manual recovery", vm.Name))
+	}
+
+	vmiConditionManager := controller.NewVirtualMachineInstanceConditionManager()
+	if cond := vmiConditionManager.GetCondition(vmi, virtv1.VirtualMachineInstanceVolumesChange); cond != nil &&
+		cond.Status == k8sv1.ConditionFalse && cond.Reason == virtv1.VirtualMachineInstanceReasonVolumesChangeCancellation {
+		return ctrl.fail(storageMigration, fmt.Sprintf("the volume migration of VirtualMachine %s was canceled", vm.Name))
+	}
+
+	vmiClaims := claimsByVolume(vmi.Spec.Volumes)
+	for _, volume := range storageMigration.Status.Volumes {
+		if vmiClaims[volume.VolumeName] != volume.DestinationClaimName {
+			return nil
+		}
+	}
+	if len(vmi.Status.MigratedVolumes) > 0 {
+		return nil
+	}
+
+	if storageMigration.Spec.SourceVolumePolicy == migrationsv1.SourceVolumePolicyDelete {
+		for i, volume := range storageMigration.Status.Volumes {
+			if volume.SourceDeleted {
+				continue
+			}
+			if err := ctrl.deleteSource(vm.Namespace, volume.SourceClaimName); err != nil {
+				return err
+			}
+			ctrl.Recorder.Eventf(storageMigration, k8sv1.EventTypeNormal, storageMigrationSourceDeleteEvent,
+				"Deleted source volume %s of volume %s", volume.SourceClaimName, volume.VolumeName)
+			storageMigration.Status.Volumes[i].SourceDeleted = true
+		}
+	}
+
+	ctrl.Recorder.Eventf(storageMigration, k8sv1.EventTypeNormal, storageMigrationSucceededEvent,
+		"Migrated the volumes of VirtualMachine %s to storage class %s", vm.Name, storageMigration.Spec.StorageClassName)
+
+	storageMigration.Status.Phase = migrationsv1.StorageMigrationSucceeded
+	storageMigration.Status.EndTimestamp = currentTime()
+	return ctrl.updateStatus(storageMigration)
+}
+
+// deleteSource deletes the source DataVolume and claim of a migrated volume
+func (ctrl *VMStorageMigrationController) deleteSource(namespace, claimName string) error {
+	err := ctrl.Client.CdiClient().CdiV1beta1().DataVolumes(namespace).Delete(context.Background(), claimName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete source DataVolume %s: %v", claimName, err)
+	}
+	err = ctrl.Client.CoreV1().PersistentVolumeClaims(namespace).Delete(context.Background(), claimName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete source PersistentVolumeClaim %s: %v", claimName, err)
+	}
+	return nil
+}
+
+func claimsByVolume(volumes []virtv1.Volume) map[string]string {
+	claims := map[string]string{}
+	for _, volume := range volumes {
+		if claimName := storagetypes.PVCNameFromVirtVolume(&volume); claimName != "" {
+			claims[volume.Name] = claimName
+		}
+	}
+	return claims
+}
+
+func (ctrl *VMStorageMigrationController) fail(storageMigration *migrationsv1.VirtualMachineStorageMigration, message string) error {
+	log.Log.Object(storageMigration).Errorf("Storage migration failed: %s", message)
+	ctrl.Recorder.Event(storageMigration, k8sv1.EventTypeWarning, storageMigrationFailedEvent, message)
+
+	storageMigration.Status.Phase = migrationsv1.StorageMigrationFailed
+	storageMigration.Status.Message = message
+	storageMigration.Status.EndTimestamp = currentTime()
+	return ctrl.updateStatus(storageMigration)
+}
+
+func (ctrl *VMStorageMigrationController) updateStatus(storageMigration *migrationsv1.VirtualMachineStorageMigration) error {
+	_, err := ctrl.Client.VirtualMachineStorageMigration(storageMigration.Namespace).UpdateStatus(context.Background(), storageMigration, metav1.UpdateOptions{})
+	return err
+}
+
+func (ctrl *VMStorageMigrationController) getVM(namespace, name string) (*virtv1.VirtualMachine, error) {
+	obj, exists, err := ctrl.VMInformer.GetStore().GetByKey(controller.NamespacedKey(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*virtv1.VirtualMachine), nil
+}
+
+func (ctrl *VMStorageMigrationController) getVMI(namespace, name string) (*virtv1.VirtualMachineInstance, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(controller.NamespacedKey(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*virtv1.VirtualMachineInstance), nil
+}
+
+func (ctrl *VMStorageMigrationController) getPVC(namespace, name string) (*k8sv1.PersistentVolumeClaim, error) {
+	return storagetypes.GetPersistentVolumeClaimFromCache(namespace, name, ctrl.PVCInformer.GetStore())
+}
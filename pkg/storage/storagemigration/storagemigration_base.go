@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storagemigration
+
+import (
+	"fmt"
+	"time"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+// VMStorageMigrationController moves the volumes of running VMs to another storage class
+type VMStorageMigrationController struct {
+	Client kubecli.KubevirtClient
+
+	VMStorageMigrationInformer cache.SharedIndexInformer
+	VMInformer                 cache.SharedIndexInformer
+	VMIInformer                cache.SharedIndexInformer
+	PVCInformer                cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmStorageMigrationQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the storage migration controller
+func (ctrl *VMStorageMigrationController) Init() error {
+	ctrl.vmStorageMigrationQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-storage-migration"},
+	)
+
+	_, err := ctrl.VMStorageMigrationInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMStorageMigration,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMStorageMigration(newObj) },
+			DeleteFunc: ctrl.handleVMStorageMigration,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMOrVMI,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMOrVMI(newObj) },
+			DeleteFunc: ctrl.handleVMOrVMI,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMIInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMOrVMI,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMOrVMI(newObj) },
+			DeleteFunc: ctrl.handleVMOrVMI,
+		},
+	)
+	return err
+}
+
+// Run the controller
+func (ctrl *VMStorageMigrationController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmStorageMigrationQueue.ShutDown()
+
+	log.Log.Info("Starting storage migration controller.")
+	defer log.Log.Info("Shutting down storage migration controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMStorageMigrationInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+		ctrl.PVCInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmStorageMigrationWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMStorageMigrationController) vmStorageMigrationWorker() {
+	for ctrl.processVMStorageMigrationWorkItem() {
+	}
+}
+
+func (ctrl *VMStorageMigrationController) processVMStorageMigrationWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmStorageMigrationQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmStorageMigration worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMStorageMigrationInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		storageMigration, ok := storeObj.(*migrationsv1.VirtualMachineStorageMigration)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return 0, ctrl.updateVMStorageMigration(storageMigration.DeepCopy())
+	})
+}
+
+func (ctrl *VMStorageMigrationController) handleVMStorageMigration(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if storageMigration, ok := obj.(*migrationsv1.VirtualMachineStorageMigration); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(storageMigration)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, storageMigration)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.vmStorageMigrationQueue.Add(objName)
+	}
+}
+
+// handleVMOrVMI enqueues the storage migrations of a VM whenever the VM or its VMI change
+func (ctrl *VMStorageMigrationController) handleVMOrVMI(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Errorf("failed to get key from object: %v, %v", err, obj)
+		return
+	}
+
+	keys, err := ctrl.VMStorageMigrationInformer.GetIndexer().IndexKeys("vm", key)
+	if err != nil {
+		log.Log.Errorf("failed to get storage migrations of %s: %v", key, err)
+		return
+	}
+
+	for _, k := range keys {
+		log.Log.V(3).Infof("enqueued %q for sync", k)
+		ctrl.vmStorageMigrationQueue.Add(k)
+	}
+}
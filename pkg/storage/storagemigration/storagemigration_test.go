@@ -0,0 +1,499 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storagemigration
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Storage migration controller", func() {
+	const (
+		testNamespace = "default"
+		vmName        = "testvm"
+		sourceClass   = "slow"
+		targetClass   = "fast"
+	)
+
+	var (
+		now = time.Date(2024, time.March, 10, 12, 30, 0, 0, time.UTC)
+
+		ctrl              *VMStorageMigrationController
+		storageMigrations cache.Store
+		vmStore           cache.Store
+		vmiStore          cache.Store
+		pvcStore          cache.Store
+		kubevirtClient    *kubevirtfake.Clientset
+		cdiClient         *cdifake.Clientset
+		k8sClient         *k8sfake.Clientset
+		recorder          *record.FakeRecorder
+	)
+
+	newPVC := func(name, storageClass string) *k8sv1.PersistentVolumeClaim {
+		return &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Spec: k8sv1.PersistentVolumeClaimSpec{
+				StorageClassName: pointer.P(storageClass),
+				VolumeMode:       pointer.P(k8sv1.PersistentVolumeFilesystem),
+				Resources: k8sv1.VolumeResourceRequirements{
+					Requests: k8sv1.ResourceList{
+						k8sv1.ResourceStorage: resource.MustParse("10Gi"),
+					},
+				},
+			},
+		}
+	}
+
+	newVM := func() *virtv1.VirtualMachine {
+		return &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vmName,
+				Namespace: testNamespace,
+			},
+			Spec: virtv1.VirtualMachineSpec{
+				DataVolumeTemplates: []virtv1.DataVolumeTemplateSpec{{
+					ObjectMeta: metav1.ObjectMeta{Name: "rootdisk-dv"},
+				}},
+				Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+					Spec: virtv1.VirtualMachineInstanceSpec{
+						Volumes: []virtv1.Volume{
+							{
+								Name: "rootdisk",
+								VolumeSource: virtv1.VolumeSource{
+									DataVolume: &virtv1.DataVolumeSource{Name: "rootdisk-dv"},
+								},
+							},
+							{
+								Name: "datadisk",
+								VolumeSource: virtv1.VolumeSource{
+									PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+										PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "data"},
+									},
+								},
+							},
+							{
+								Name: "cloudinit",
+								VolumeSource: virtv1.VolumeSource{
+									CloudInitNoCloud: &virtv1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	newVMI := func(vm *virtv1.VirtualMachine) *virtv1.VirtualMachineInstance {
+		return &virtv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vm.Name,
+				Namespace: vm.Namespace,
+			},
+			Spec: *vm.Spec.Template.Spec.DeepCopy(),
+			Status: virtv1.VirtualMachineInstanceStatus{
+				Phase: virtv1.Running,
+			},
+		}
+	}
+
+	newStorageMigration := func(phase migrationsv1.VirtualMachineStorageMigrationPhase) *migrationsv1.VirtualMachineStorageMigration {
+		storageMigration := &migrationsv1.VirtualMachineStorageMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "migrate-storage",
+				Namespace: testNamespace,
+				UID:       "abcdef-1234",
+			},
+			Spec: migrationsv1.VirtualMachineStorageMigrationSpec{
+				VMName:           vmName,
+				StorageClassName: targetClass,
+			},
+		}
+		if phase != "" {
+			storageMigration.Status = &migrationsv1.VirtualMachineStorageMigrationStatus{Phase: phase}
+		}
+		return storageMigration
+	}
+
+	migratingVolumes := func() []migrationsv1.StorageMigrationVolume {
+		return []migrationsv1.StorageMigrationVolume{
+			{VolumeName: "rootdisk", SourceClaimName: "rootdisk-dv", DestinationClaimName: "rootdisk-dv-mig-abcde"},
+			{VolumeName: "datadisk", SourceClaimName: "data", DestinationClaimName: "data-mig-abcde"},
+		}
+	}
+
+	updatedStatus := func() *migrationsv1.VirtualMachineStorageMigrationStatus {
+		var status *migrationsv1.VirtualMachineStorageMigrationStatus
+		for _, action := range kubevirtClient.Actions() {
+			if action.GetVerb() == "update" && action.GetSubresource() == "status" {
+				status = action.(testing.UpdateAction).GetObject().(*migrationsv1.VirtualMachineStorageMigration).Status
+			}
+		}
+		return status
+	}
+
+	vmPatches := func() []patch.PatchOperation {
+		var patches []patch.PatchOperation
+		for _, action := range kubevirtClient.Actions() {
+			if action.GetVerb() == "patch" && action.GetResource().Resource == "virtualmachines" {
+				var ops []patch.PatchOperation
+				Expect(json.Unmarshal(action.(testing.PatchAction).GetPatch(), &ops)).To(Succeed())
+				patches = append(patches, ops...)
+			}
+		}
+		return patches
+	}
+
+	createdDataVolumes := func() []*cdiv1.DataVolume {
+		var dataVolumes []*cdiv1.DataVolume
+		for _, action := range cdiClient.Actions() {
+			if action.GetVerb() == "create" {
+				dataVolumes = append(dataVolumes, action.(testing.CreateAction).GetObject().(*cdiv1.DataVolume))
+			}
+		}
+		return dataVolumes
+	}
+
+	BeforeEach(func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(mockCtrl)
+
+		storageMigrationInformer, _ := testutils.NewFakeInformerWithIndexersFor(&migrationsv1.VirtualMachineStorageMigration{}, controller.GetVirtualMachineStorageMigrationInformerIndexers())
+		vmInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		storageMigrations = storageMigrationInformer.GetStore()
+		vmStore = vmInformer.GetStore()
+		vmiStore = vmiInformer.GetStore()
+		pvcStore = pvcInformer.GetStore()
+
+		recorder = record.NewFakeRecorder(100)
+
+		ctrl = &VMStorageMigrationController{
+			Client:                     virtClient,
+			VMStorageMigrationInformer: storageMigrationInformer,
+			VMInformer:                 vmInformer,
+			VMIInformer:                vmiInformer,
+			PVCInformer:                pvcInformer,
+			Recorder:                   recorder,
+		}
+		Expect(ctrl.Init()).To(Succeed())
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		cdiClient = cdifake.NewSimpleClientset()
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineStorageMigration(testNamespace).
+			Return(kubevirtClient.MigrationsV1alpha1().VirtualMachineStorageMigrations(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(testNamespace).
+			Return(kubevirtClient.KubevirtV1().VirtualMachines(testNamespace)).AnyTimes()
+		virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		kubevirtClient.Fake.PrependReactor("update", "virtualmachinestoragemigrations", func(action testing.Action) (bool, runtime.Object, error) {
+			return true, action.(testing.UpdateAction).GetObject(), nil
+		})
+		kubevirtClient.Fake.PrependReactor("patch", "virtualmachines", func(action testing.Action) (bool, runtime.Object, error) {
+			return true, nil, nil
+		})
+
+		Expect(pvcStore.Add(newPVC("rootdisk-dv", sourceClass))).To(Succeed())
+		Expect(pvcStore.Add(newPVC("data", sourceClass))).To(Succeed())
+
+		currentTime = func() *metav1.Time {
+			return &metav1.Time{Time: now}
+		}
+	})
+
+	AfterEach(func() {
+		currentTime = func() *metav1.Time {
+			t := metav1.Now()
+			return &t
+		}
+	})
+
+	It("should enqueue the storage migrations of a VM", func() {
+		storageMigration := newStorageMigration("")
+		Expect(storageMigrations.Add(storageMigration)).To(Succeed())
+
+		ctrl.handleVMOrVMI(newVMI(newVM()))
+		Expect(ctrl.vmStorageMigrationQueue.Len()).To(Equal(1))
+		key, _ := ctrl.vmStorageMigrationQueue.Get()
+		Expect(key).To(Equal(testNamespace + "/migrate-storage"))
+	})
+
+	It("should initialize the status", func() {
+		Expect(ctrl.updateVMStorageMigration(newStorageMigration(""))).To(Succeed())
+		Expect(updatedStatus().Phase).To(Equal(migrationsv1.StorageMigrationPending))
+	})
+
+	Context("a pending storage migration", func() {
+		var vm *virtv1.VirtualMachine
+
+		BeforeEach(func() {
+			vm = newVM()
+			Expect(vmStore.Add(vm)).To(Succeed())
+		})
+
+		It("should create the destination volumes", func() {
+			Expect(vmiStore.Add(newVMI(vm))).To(Succeed())
+
+			Expect(ctrl.updateVMStorageMigration(newStorageMigration(migrationsv1.StorageMigrationPending))).To(Succeed())
+			testutils.ExpectEvent(recorder, storageMigrationStartedEvent)
+
+			status := updatedStatus()
+			Expect(status.Phase).To(Equal(migrationsv1.StorageMigrationMigrating))
+			Expect(status.StartTimestamp.Time).To(Equal(now))
+			Expect(status.Volumes).To(Equal(migratingVolumes()))
+
+			// The DataVolume of the template is created by the VM controller
+			dataVolumes := createdDataVolumes()
+			Expect(dataVolumes).To(HaveLen(1))
+			Expect(dataVolumes[0].Name).To(Equal("data-mig-abcde"))
+			Expect(dataVolumes[0].Spec.Source.Blank).ToNot(BeNil())
+			Expect(*dataVolumes[0].Spec.Storage.StorageClassName).To(Equal(targetClass))
+			Expect(dataVolumes[0].Spec.Storage.Resources.Requests).To(HaveKeyWithValue(k8sv1.ResourceStorage, resource.MustParse("10Gi")))
+			Expect(vmPatches()).To(BeEmpty())
+		})
+
+		It("should only migrate the selected volumes", func() {
+			Expect(vmiStore.Add(newVMI(vm))).To(Succeed())
+			storageMigration := newStorageMigration(migrationsv1.StorageMigrationPending)
+			storageMigration.Spec.Volumes = []string{"datadisk"}
+
+			Expect(ctrl.updateVMStorageMigration(storageMigration)).To(Succeed())
+			testutils.ExpectEvent(recorder, storageMigrationStartedEvent)
+			Expect(updatedStatus().Volumes).To(Equal(migratingVolumes()[1:]))
+		})
+
+		It("should fail when a selected volume is not a volume of the VM", func() {
+			Expect(vmiStore.Add(newVMI(vm))).To(Succeed())
+			storageMigration := newStorageMigration(migrationsv1.StorageMigrationPending)
+			storageMigration.Spec.Volumes = []string{"datadisk", "typo"}
+
+			Expect(ctrl.updateVMStorageMigration(storageMigration)).To(Succeed())
+			testutils.ExpectEvent(recorder, storageMigrationFailedEvent)
+
+			status := updatedStatus()
+			Expect(status.Phase).To(Equal(migrationsv1.StorageMigrationFailed))
+			Expect(status.Message).To(ContainSubstring("has no volumes named typo"))
+			Expect(createdDataVolumes()).To(BeEmpty())
+		})
+
+		DescribeTable("should fail", func(update func(), message string) {
+			update()
+
+			Expect(ctrl.updateVMStorageMigration(newStorageMigration(migrationsv1.StorageMigrationPending))).To(Succeed())
+			testutils.ExpectEvent(recorder, storageMigrationFailedEvent)
+
+			status := updatedStatus()
+			Expect(status.Phase).To(Equal(migrationsv1.StorageMigrationFailed))
+			Expect(status.Message).To(ContainSubstring(message))
+			Expect(createdDataVolumes()).To(BeEmpty())
+		},
+			Entry("when the VM does not exist", func() {
+				Expect(vmStore.Delete(vm)).To(Succeed())
+			}, "does not exist"),
+			Entry("when the VM is not running", func() {}, "is not running"),
+			Entry("when the VM is already migrating its volumes", func() {
+				vmi := newVMI(vm)
+				vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{{
+					Type:   virtv1.VirtualMachineInstanceVolumesChange,
+					Status: k8sv1.ConditionTrue,
+				}}
+				Expect(vmiStore.Add(vmi)).To(Succeed())
+			}, "already migrating"),
+			Entry("when all volumes are on the target storage class", func() {
+				Expect(vmiStore.Add(newVMI(vm))).To(Succeed())
+				Expect(pvcStore.Update(newPVC("rootdisk-dv", targetClass))).To(Succeed())
+				Expect(pvcStore.Update(newPVC("data", targetClass))).To(Succeed())
+			}, "no volume"),
+			Entry("when a volume cannot be migrated", func() {
+				vmi := newVMI(vm)
+				vmi.Spec.Domain.Devices.Disks = []virtv1.Disk{{
+					Name:       "datadisk",
+					DiskDevice: virtv1.DiskDevice{LUN: &virtv1.LunTarget{}},
+				}}
+				Expect(vmiStore.Add(vmi)).To(Succeed())
+			}, "datadisk"),
+		)
+	})
+
+	Context("a migrating storage migration", func() {
+		var (
+			vm               *virtv1.VirtualMachine
+			vmi              *virtv1.VirtualMachineInstance
+			storageMigration *migrationsv1.VirtualMachineStorageMigration
+		)
+
+		migratedVM := func() *virtv1.VirtualMachine {
+			migrated := vm.DeepCopy()
+			for _, volume := range storageMigration.Status.Volumes {
+				ctrl.replaceVolume(storageMigration, migrated, volume)
+			}
+			return migrated
+		}
+
+		BeforeEach(func() {
+			vm = newVM()
+			vmi = newVMI(vm)
+			storageMigration = newStorageMigration(migrationsv1.StorageMigrationMigrating)
+			storageMigration.Status.Volumes = migratingVolumes()
+		})
+
+		It("should update the volumes of the VM", func() {
+			Expect(vmStore.Add(vm)).To(Succeed())
+			Expect(vmiStore.Add(vmi)).To(Succeed())
+
+			Expect(ctrl.updateVMStorageMigration(storageMigration)).To(Succeed())
+			Expect(updatedStatus()).To(BeNil())
+
+			patches := vmPatches()
+			Expect(patches).To(ContainElement(HaveField("Path", "/spec/updateVolumesStrategy")))
+			Expect(patches).To(ContainElement(HaveField("Path", "/spec/dataVolumeTemplates")))
+
+			migrated := migratedVM()
+			Expect(migrated.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal("rootdisk-dv-mig-abcde"))
+			Expect(migrated.Spec.Template.Spec.Volumes[1].DataVolume.Name).To(Equal("data-mig-abcde"))
+			Expect(migrated.Spec.Template.Spec.Volumes[2].CloudInitNoCloud).ToNot(BeNil())
+			Expect(migrated.Spec.DataVolumeTemplates[0].Name).To(Equal("rootdisk-dv-mig-abcde"))
+			Expect(*migrated.Spec.DataVolumeTemplates[0].Spec.Storage.StorageClassName).To(Equal(targetClass))
+		})
+
+		It("should wait for the volume migration to complete", func() {
+			Expect(vmStore.Add(migratedVM())).To(Succeed())
+			vmi.Status.MigratedVolumes = []virtv1.StorageMigratedVolumeInfo{{VolumeName: "datadisk"}}
+			Expect(vmiStore.Add(vmi)).To(Succeed())
+
+			Expect(ctrl.updateVMStorageMigration(storageMigration)).To(Succeed())
+			Expect(updatedStatus()).To(BeNil())
+			Expect(vmPatches()).To(BeEmpty())
+		})
+
+		It("should succeed once the VMI runs on the destination volumes", func() {
+			migrated := migratedVM()
+			Expect(vmStore.Add(migrated)).To(Succeed())
+			Expect(vmiStore.Add(newVMI(migrated))).To(Succeed())
+
+			Expect(ctrl.updateVMStorageMigration(storageMigration)).To(Succeed())
+			testutils.ExpectEvent(recorder, storageMigrationSucceededEvent)
+
+			status := updatedStatus()
+			Expect(status.Phase).To(Equal(migrationsv1.StorageMigrationSucceeded))
+			Expect(status.EndTimestamp.Time).To(Equal(now))
+			Expect(status.Volumes).To(Equal(migratingVolumes()))
+		})
+
+		It("should delete the source volumes when requested", func() {
+			storageMigration.Spec.SourceVolumePolicy = migrationsv1.SourceVolumePolicyDelete
+			migrated := migratedVM()
+			Expect(vmStore.Add(migrated)).To(Succeed())
+			Expect(vmiStore.Add(newVMI(migrated))).To(Succeed())
+			_, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Create(context.Background(), newPVC("data", sourceClass), metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(ctrl.updateVMStorageMigration(storageMigration)).To(Succeed())
+			testutils.ExpectEvent(recorder, storageMigrationSourceDeleteEvent)
+			testutils.ExpectEvent(recorder, storageMigrationSourceDeleteEvent)
+			testutils.ExpectEvent(recorder, storageMigrationSucceededEvent)
+
+			status := updatedStatus()
+			Expect(status.Phase).To(Equal(migrationsv1.StorageMigrationSucceeded))
+			for _, volume := range status.Volumes {
+				Expect(volume.SourceDeleted).To(BeTrue())
+			}
+			pvcs, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcs.Items).To(BeEmpty())
+		})
+
+		DescribeTable("should fail", func(update func(), message string) {
+			update()
+
+			Expect(ctrl.updateVMStorageMigration(storageMigration)).To(Succeed())
+			testutils.ExpectEvent(recorder, storageMigrationFailedEvent)
+
+			status := updatedStatus()
+			Expect(status.Phase).To(Equal(migrationsv1.StorageMigrationFailed))
+			Expect(status.Message).To(ContainSubstring(message))
+		},
+			Entry("when the VM stopped", func() {
+				Expect(vmStore.Add(migratedVM())).To(Succeed())
+			}, "stopped"),
+			Entry("when the volumes of the VM changed", func() {
+				changed := vm.DeepCopy()
+				changed.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim.ClaimName = "other"
+				Expect(vmStore.Add(changed)).To(Succeed())
+				Expect(vmiStore.Add(vmi)).To(Succeed())
+			}, "no longer references"),
+			Entry("when the volume migration requires a manual recovery", func() {
+				migrated := migratedVM()
+				migrated.Status.Conditions = []virtv1.VirtualMachineCondition{{
+					Type:   virtv1.VirtualMachineManualRecoveryRequired,
+					Status: k8sv1.ConditionTrue,
+				}}
+				Expect(vmStore.Add(migrated)).To(Succeed())
+				Expect(vmiStore.Add(vmi)).To(Succeed())
+			}, "manual recovery"),
+			Entry("when the volume migration was canceled", func() {
+				Expect(vmStore.Add(migratedVM())).To(Succeed())
+				vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{{
+					Type:   virtv1.VirtualMachineInstanceVolumesChange,
+					Status: k8sv1.ConditionFalse,
+					Reason: virtv1.VirtualMachineInstanceReasonVolumesChangeCancellation,
+				}}
+				Expect(vmiStore.Add(vmi)).To(Succeed())
+			}, "canceled"),
+		)
+	})
+
+	It("should not touch finished storage migrations", func() {
+		Expect(ctrl.updateVMStorageMigration(newStorageMigration(migrationsv1.StorageMigrationSucceeded))).To(Succeed())
+		Expect(kubevirtClient.Actions()).To(BeEmpty())
+	})
+})
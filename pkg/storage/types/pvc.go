@@ -278,6 +278,14 @@ func IsHotplugVolume(vol *virtv1.Volume) bool {
 	return false
 }
 
+// IsSavedStateVolume returns true if the volume holds a saved memory state
+// the VMI is resumed from when it starts
+func IsSavedStateVolume(vol *virtv1.Volume) bool {
+	return vol != nil && vol.MemoryDump != nil &&
+		!vol.MemoryDump.Hotpluggable &&
+		vol.MemoryDump.Format == virtv1.MemoryDumpFormatSaveState
+}
+
 func GetVolumesByName(vmiSpec *virtv1.VirtualMachineInstanceSpec) map[string]*virtv1.Volume {
 	volumes := map[string]*virtv1.Volume{}
 	for _, vol := range vmiSpec.Volumes {
@@ -91,6 +91,17 @@ func MigrationFailed(vmi *v1.VirtualMachineInstance) bool {
 	return false
 }
 
+// IsCrossClusterMigrationSource returns true if the VMI is migrated to another cluster
+func IsCrossClusterMigrationSource(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Status.MigrationState != nil && vmi.Status.MigrationState.TargetVirtualMachineInstanceUID != ""
+}
+
+// IsMigrationReceiver returns true if the VMI waits to be received by a cross-cluster migration
+func IsMigrationReceiver(vmi *v1.VirtualMachineInstance) bool {
+	_, exists := vmi.Annotations[v1.MigrationReceiverAnnotation]
+	return exists
+}
+
 func VMIEvictionStrategy(clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachineInstance) *v1.EvictionStrategy {
 	if vmi != nil && vmi.Spec.EvictionStrategy != nil {
 		return vmi.Spec.EvictionStrategy
@@ -126,3 +126,58 @@ func (m *manager) GetCurrent() (*x509.CertPool, error) {
 
 	return pool, nil
 }
+
+type remoteCAManager struct {
+	local  ClientCAManager
+	remote ClientCAManager
+	store  cache.Store
+	key    string
+}
+
+// NewRemoteCAManager returns the CAs of the cluster together with the CAs of remote clusters,
+// listed in the optional configmap. The configmap is ignored when it does not exist.
+func NewRemoteCAManager(localCAManager ClientCAManager, configMapCache cache.Store, namespace string, configMapName string) ClientCAManager {
+	return &remoteCAManager{
+		local:  localCAManager,
+		remote: NewCAManager(configMapCache, namespace, configMapName),
+		store:  configMapCache,
+		key:    namespace + "/" + configMapName,
+	}
+}
+
+func (m *remoteCAManager) GetCurrentRaw() ([]byte, error) {
+	localRaw, err := m.local.GetCurrentRaw()
+	if err != nil {
+		return nil, err
+	}
+	if _, exists, err := m.store.GetByKey(m.key); err != nil {
+		return nil, err
+	} else if !exists {
+		return localRaw, nil
+	}
+	remoteRaw, err := m.remote.GetCurrentRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make([]byte, 0, len(localRaw)+len(remoteRaw)+1)
+	raw = append(raw, localRaw...)
+	raw = append(raw, '\n')
+	return append(raw, remoteRaw...), nil
+}
+
+func (m *remoteCAManager) GetCurrent() (*x509.CertPool, error) {
+	raw, err := m.GetCurrentRaw()
+	if err != nil {
+		return nil, err
+	}
+	certs, err := cert.ParseCertsPEM(raw)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return pool, nil
+}
@@ -93,3 +93,73 @@ var _ = Describe("CaManager", func() {
 		Expect(cert.Subjects()[0]).To(ContainSubstring("first"))
 	})
 })
+
+var _ = Describe("RemoteCaManager", func() {
+
+	const (
+		namespace       = "kubevirt"
+		localName       = "kubevirt-ca"
+		remoteName      = "kubevirt-migration-remote-ca"
+		caBundleKey     = "ca-bundle"
+		resourceVersion = "1"
+	)
+
+	var manager ClientCAManager
+	var store cache.Store
+
+	newCAConfigMap := func(name, caName string) *v1.ConfigMap {
+		ca, err := triple.NewCA(caName, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		return &v1.ConfigMap{
+			ObjectMeta: v12.ObjectMeta{
+				Name:            name,
+				Namespace:       namespace,
+				ResourceVersion: resourceVersion,
+			},
+			Data: map[string]string{
+				caBundleKey: string(cert.EncodeCertPEM(ca.Cert)),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		store = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+		Expect(store.Add(newCAConfigMap(localName, "local"))).To(Succeed())
+		manager = NewRemoteCAManager(NewCAManager(store, namespace, localName), store, namespace, remoteName)
+	})
+
+	It("should only load the local CA when there is no remote CA", func() {
+		pool, err := manager.GetCurrent()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pool.Subjects()).To(HaveLen(1))
+		Expect(pool.Subjects()[0]).To(ContainSubstring("local"))
+	})
+
+	It("should load the local and the remote CAs", func() {
+		Expect(store.Add(newCAConfigMap(remoteName, "remote"))).To(Succeed())
+		pool, err := manager.GetCurrent()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pool.Subjects()).To(HaveLen(2))
+		Expect(pool.Subjects()[0]).To(ContainSubstring("local"))
+		Expect(pool.Subjects()[1]).To(ContainSubstring("remote"))
+	})
+
+	It("should stop trusting the remote CA once the config map is removed", func() {
+		remote := newCAConfigMap(remoteName, "remote")
+		Expect(store.Add(remote)).To(Succeed())
+		_, err := manager.GetCurrent()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(store.Delete(remote)).To(Succeed())
+		pool, err := manager.GetCurrent()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pool.Subjects()).To(HaveLen(1))
+	})
+
+	It("should fail on an invalid remote CA", func() {
+		remote := newCAConfigMap(remoteName, "remote")
+		remote.Data[caBundleKey] = "garbage"
+		Expect(store.Add(remote)).To(Succeed())
+		_, err := manager.GetCurrent()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -69,32 +69,14 @@ func (m *mockCAManager) GetCurrentRaw() ([]byte, error) {
 var _ = Describe("TLS", func() {
 
 	var caManager kvtls.ClientCAManager
+	var caBundle []byte
 	var certmanagers map[string]certificate.Manager
 	var clusterConfig *virtconfig.ClusterConfig
 	var kubeVirtStore cache.Store
 
 	BeforeEach(func() {
 		// Bootstrap TLS for kubevirt
-		certmanagers = map[string]certificate.Manager{}
-		caSecrets := components.NewCACertSecrets("whatever")
-		var caSecret *k8sv1.Secret
-		for _, ca := range caSecrets {
-			if ca.Name == components.KubeVirtCASecretName {
-				caSecret = ca
-			}
-		}
-
-		secrets := components.NewCertSecrets("install_namespace", "operator_namespace")
-		Expect(components.PopulateSecretWithCertificate(caSecret, nil, &v1.Duration{Duration: 1 * time.Hour})).To(Succeed())
-		caCert, err := components.LoadCertificates(caSecret)
-		Expect(err).ToNot(HaveOccurred())
-		for _, secret := range secrets {
-			Expect(components.PopulateSecretWithCertificate(secret, caCert, &v1.Duration{Duration: 1 * time.Hour})).To(Succeed())
-			crt, err := components.LoadCertificates(secret)
-			certmanagers[secret.Name] = &mockCertManager{crt: crt}
-			Expect(err).ToNot(HaveOccurred())
-		}
-		caBundle := cert.EncodeCertPEM(caCert.Leaf)
+		caBundle, certmanagers = newClusterCertificates()
 		caManager = &mockCAManager{caBundle: caBundle}
 
 		kv := &v12.KubeVirt{
@@ -363,4 +345,81 @@ var _ = Describe("TLS", func() {
 			},
 		),
 	)
+
+	DescribeTable("on virt-handler with a remote cluster should", func(trustRemoteCA bool, errStr string) {
+		const (
+			namespace             = "kubevirt"
+			remoteCAConfigMapName = "kubevirt-migration-remote-ca"
+		)
+		remoteCABundle, remoteCertManagers := newClusterCertificates()
+
+		// each cluster trusts its own CA and, if configured, the CA of the other cluster
+		newCAManager := func(caBundle, remoteCABundle []byte) kvtls.ClientCAManager {
+			store := cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+			Expect(store.Add(newCAConfigMap(namespace, components.KubeVirtCASecretName, caBundle))).To(Succeed())
+			if trustRemoteCA {
+				Expect(store.Add(newCAConfigMap(namespace, remoteCAConfigMapName, remoteCABundle))).To(Succeed())
+			}
+			return kvtls.NewRemoteCAManager(kvtls.NewCAManager(store, namespace, components.KubeVirtCASecretName), store, namespace, remoteCAConfigMapName)
+		}
+		serverCAManager := newCAManager(caBundle, remoteCABundle)
+		clientCAManager := newCAManager(remoteCABundle, caBundle)
+
+		serverTLSConfig := kvtls.SetupTLSForVirtHandlerServer(serverCAManager, certmanagers[components.VirtHandlerServerCertSecretName], false, clusterConfig)
+		clientTLSConfig := kvtls.SetupTLSForVirtHandlerClients(clientCAManager, remoteCertManagers[components.VirtHandlerCertSecretName], false)
+		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, "hello")
+		}))
+		srv.TLS = serverTLSConfig
+		srv.StartTLS()
+		defer srv.Close()
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLSConfig}}
+		resp, err := client.Get(srv.URL)
+		if errStr != "" {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(errStr))
+			return
+		}
+		Expect(err).ToNot(HaveOccurred())
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(strings.TrimSpace(string(body))).To(Equal("hello"))
+	},
+		Entry("connect when the clusters trust the CA of each other", true, ""),
+		Entry("fail when the clusters do not trust the CA of each other", false, "x509: certificate signed by unknown authority"),
+	)
 })
+
+func newClusterCertificates() ([]byte, map[string]certificate.Manager) {
+	certmanagers := map[string]certificate.Manager{}
+	var caSecret *k8sv1.Secret
+	for _, ca := range components.NewCACertSecrets("whatever") {
+		if ca.Name == components.KubeVirtCASecretName {
+			caSecret = ca
+		}
+	}
+
+	Expect(components.PopulateSecretWithCertificate(caSecret, nil, &v1.Duration{Duration: 1 * time.Hour})).To(Succeed())
+	caCert, err := components.LoadCertificates(caSecret)
+	Expect(err).ToNot(HaveOccurred())
+	for _, secret := range components.NewCertSecrets("install_namespace", "operator_namespace") {
+		Expect(components.PopulateSecretWithCertificate(secret, caCert, &v1.Duration{Duration: 1 * time.Hour})).To(Succeed())
+		crt, err := components.LoadCertificates(secret)
+		Expect(err).ToNot(HaveOccurred())
+		certmanagers[secret.Name] = &mockCertManager{crt: crt}
+	}
+	return cert.EncodeCertPEM(caCert.Leaf), certmanagers
+}
+
+func newCAConfigMap(namespace, name string, caBundle []byte) *k8sv1.ConfigMap {
+	return &k8sv1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			ResourceVersion: "1",
+		},
+		Data: map[string]string{
+			components.CABundleKey: string(caBundle),
+		},
+	}
+}
@@ -125,6 +125,8 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 	vmsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots")
 	vmscGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotcontents")
 	vmrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores")
+	vmssGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotschedules")
+	vmsgGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotgroups")
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: snapshotv1.SchemeGroupVersion.Group, Version: snapshotv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -146,6 +148,16 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, vmssGVR, &snapshotv1.VirtualMachineSnapshotSchedule{}, "VirtualMachineSnapshotSchedule", &snapshotv1.VirtualMachineSnapshotScheduleList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmsgGVR, &snapshotv1.VirtualMachineSnapshotGroup{}, "VirtualMachineSnapshotGroup", &snapshotv1.VirtualMachineSnapshotGroupList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(vmsGVR)
 	if err != nil {
 		panic(err)
@@ -175,6 +187,7 @@ func exportApiServiceDefinitions() []*restful.WebService {
 
 func migrationPoliciesApiServiceDefinitions() []*restful.WebService {
 	mpGVR := migrationsv1.SchemeGroupVersion.WithResource(migrations.ResourceMigrationPolicies)
+	vmsmGVR := migrationsv1.SchemeGroupVersion.WithResource(migrations.ResourceVirtualMachineStorageMigrations)
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: migrationsv1.SchemeGroupVersion.Group, Version: migrationsv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -186,6 +199,11 @@ func migrationPoliciesApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, vmsmGVR, &migrationsv1.VirtualMachineStorageMigration{}, migrationsv1.VirtualMachineStorageMigrationKind.Kind, &migrationsv1.VirtualMachineStorageMigrationList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(mpGVR)
 	if err != nil {
 		panic(err)
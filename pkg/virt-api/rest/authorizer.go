@@ -42,6 +42,10 @@ const (
 	groupHeader           = "X-Remote-Group"
 	userExtraHeaderPrefix = "X-Remote-Extra-"
 
+	// requesterAttribute holds the user an authorized request was made by,
+	// as the spec of a SubjectAccessReview without attributes
+	requesterAttribute = "requester"
+
 	namespacedResourceAttributesMinParts  = 9
 	namespacedResourceBaseAttributesParts = 7
 )
@@ -345,12 +349,23 @@ func (a *authorizor) Authorize(req *restful.Request) (bool, string, error) {
 	}
 
 	if result.Status.Allowed {
+		req.SetAttribute(requesterAttribute, authv1.SubjectAccessReviewSpec{
+			User:   r.Spec.User,
+			Groups: r.Spec.Groups,
+			Extra:  r.Spec.Extra,
+		})
 		return true, "", nil
 	}
 
 	return false, result.Status.Reason, nil
 }
 
+// getRequester returns the user an authorized request was made by
+func getRequester(req *restful.Request) (authv1.SubjectAccessReviewSpec, bool) {
+	requester, ok := req.Attribute(requesterAttribute).(authv1.SubjectAccessReviewSpec)
+	return requester, ok
+}
+
 func NewAuthorizorFromClient(client authclientv1.SubjectAccessReviewInterface) VirtApiAuthorizor {
 	return &authorizor{
 		userHeaders:             []string{userHeader},
@@ -44,8 +44,7 @@ var _ = Describe("Authorizer", func() {
 		)
 
 		BeforeEach(func() {
-			req = &restful.Request{}
-			req.Request = &http.Request{}
+			req = restful.NewRequest(&http.Request{})
 			req.Request.URL = &url.URL{}
 			req.Request.Header = make(map[string][]string)
 			req.Request.Header[userHeader] = []string{"user"}
@@ -132,6 +131,28 @@ var _ = Describe("Authorizer", func() {
 					Expect(err).ToNot(HaveOccurred())
 					Expect(result).To(BeTrue())
 				})
+
+				It("should record the user of an authorized request", func() {
+					allowedFn = allowed(true)
+					_, _, err := app.Authorize(req)
+					Expect(err).ToNot(HaveOccurred())
+
+					requester, ok := getRequester(req)
+					Expect(ok).To(BeTrue())
+					Expect(requester.User).To(Equal("user"))
+					Expect(requester.Groups).To(Equal([]string{"userGroup"}))
+					Expect(requester.Extra).To(HaveKeyWithValue("test", authv1.ExtraValue{"userExtraValue"}))
+					Expect(requester.ResourceAttributes).To(BeNil())
+				})
+
+				It("should not record the user of an unauthorized request", func() {
+					allowedFn = allowed(false)
+					_, _, err := app.Authorize(req)
+					Expect(err).ToNot(HaveOccurred())
+
+					_, ok := getRequester(req)
+					Expect(ok).To(BeFalse())
+				})
 			})
 
 			Context("with namespaced base resource", func() {
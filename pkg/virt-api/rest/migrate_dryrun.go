@@ -0,0 +1,320 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	restful "github.com/emicklei/go-restful/v3"
+	authv1 "k8s.io/api/authorization/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// MigrationCheckVMRequestHandler reports whether the VM could be live migrated, without creating a migration.
+// The report only contains aggregated results, it does not reveal the nodes of the cluster.
+func (app *SubresourceAPIApp) MigrationCheckVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if _, statusErr := app.fetchVirtualMachine(name, namespace); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	if vmi.Status.Phase != v1.Running {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotRunning)), response)
+		return
+	}
+
+	// The migration is created with the client of virt-api, so the access of the requester
+	// is reviewed first. The migration carries no options which are subject to further access checks.
+	allowed, statusErr := app.isMigrationCreateAllowed(request, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	var admissionErr error
+	if !allowed {
+		admissionErr = fmt.Errorf("the user is not allowed to create virtualmachineinstancemigrations in namespace %s", namespace)
+	} else {
+		_, admissionErr = app.virtCli.VirtualMachineInstanceMigration(namespace).Create(context.Background(), &v1.VirtualMachineInstanceMigration{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				GenerateName: "kubevirt-migrate-vm-",
+			},
+			Spec: v1.VirtualMachineInstanceMigrationSpec{
+				VMIName: name,
+			},
+		}, k8smetav1.CreateOptions{DryRun: []string{k8smetav1.DryRunAll}})
+	}
+
+	report, statusErr := app.dryRunMigration(vmi, admissionErr)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if err := response.WriteHeaderAndJson(http.StatusOK, report, restful.MIME_JSON); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+// isMigrationCreateAllowed reviews whether the user the request was made by is allowed to create migrations
+func (app *SubresourceAPIApp) isMigrationCreateAllowed(request *restful.Request, namespace string) (bool, *errors.StatusError) {
+	requester, ok := getRequester(request)
+	if !ok {
+		return false, errors.NewInternalError(fmt.Errorf("unable to identify the user of the request"))
+	}
+
+	sar := &authv1.SubjectAccessReview{Spec: requester}
+	sar.Spec.ResourceAttributes = &authv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "create",
+		Group:     v1.VirtualMachineInstanceMigrationGroupVersionKind.Group,
+		Resource:  "virtualmachineinstancemigrations",
+	}
+	result, err := app.virtCli.AuthorizationV1().SubjectAccessReviews().Create(context.Background(), sar, k8smetav1.CreateOptions{})
+	if err != nil {
+		return false, errors.NewInternalError(fmt.Errorf("unable to review the access of user %s: %v", requester.User, err))
+	}
+	return result.Status.Allowed, nil
+}
+
+// dryRunMigration evaluates whether the VMI could be live migrated without creating a migration.
+// admissionErr is the outcome of creating the migration in dry-run mode.
+func (app *SubresourceAPIApp) dryRunMigration(vmi *v1.VirtualMachineInstance, admissionErr error) (*v1.MigrationDryRunReport, *errors.StatusError) {
+	pods, err := app.virtCli.CoreV1().Pods(vmi.Namespace).List(context.Background(), k8smetav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", v1.CreatedByLabel, vmi.UID),
+		FieldSelector: fmt.Sprintf("spec.nodeName=%s,status.phase=%s", vmi.Status.NodeName, k8sv1.PodRunning),
+	})
+	if err != nil {
+		return nil, errors.NewInternalError(fmt.Errorf("unable to list virt-launcher pods: %v", err))
+	}
+
+	var sourcePod *k8sv1.Pod
+	if len(pods.Items) > 0 {
+		sourcePod = &pods.Items[0]
+	}
+
+	// The nodes are served from the cache of the API server
+	nodeList, err := app.virtCli.CoreV1().Nodes().List(context.Background(), k8smetav1.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return nil, errors.NewInternalError(fmt.Errorf("unable to list nodes: %v", err))
+	}
+	var nodes []*k8sv1.Node
+	for i := range nodeList.Items {
+		nodes = append(nodes, &nodeList.Items[i])
+	}
+
+	return newMigrationDryRunReport(vmi, sourcePod, nodes, admissionErr), nil
+}
+
+func newMigrationDryRunReport(vmi *v1.VirtualMachineInstance, sourcePod *k8sv1.Pod, nodes []*k8sv1.Node, admissionErr error) *v1.MigrationDryRunReport {
+	report := &v1.MigrationDryRunReport{Migratable: true}
+	addCheck := func(check v1.MigrationDryRunCheck) {
+		if !check.Passed {
+			report.Migratable = false
+		}
+		report.Checks = append(report.Checks, check)
+	}
+
+	addCheck(checkLiveMigratable(vmi))
+	addCheck(checkMigrationStorage(vmi))
+	addCheck(checkMigrationHostDevices(vmi))
+
+	if sourcePod == nil {
+		addCheck(v1.MigrationDryRunCheck{
+			Type:    v1.MigrationDryRunCheckScheduling,
+			Message: "unable to find the running virt-launcher pod of the VMI",
+		})
+	} else {
+		nodeSelector := map[string]string{}
+		for key, value := range sourcePod.Spec.NodeSelector {
+			nodeSelector[key] = value
+		}
+
+		cpuCheck := checkMigrationCPUModel(vmi, nodeSelector, nodes)
+		addCheck(cpuCheck)
+		if cpuCheck.Passed {
+			addCheck(checkMigrationScheduling(vmi, nodeSelector, nodes))
+		}
+	}
+
+	admissionCheck := v1.MigrationDryRunCheck{Type: v1.MigrationDryRunCheckAdmission, Passed: admissionErr == nil}
+	if admissionErr != nil {
+		admissionCheck.Message = admissionErr.Error()
+	}
+	addCheck(admissionCheck)
+
+	return report
+}
+
+func checkLiveMigratable(vmi *v1.VirtualMachineInstance) v1.MigrationDryRunCheck {
+	check := v1.MigrationDryRunCheck{Type: v1.MigrationDryRunCheckLiveMigratable}
+	cond := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceIsMigratable)
+	switch {
+	case cond == nil:
+		check.Message = "the LiveMigratable condition is not reported yet"
+	case cond.Status != k8sv1.ConditionTrue:
+		check.Message = fmt.Sprintf("%s: %s", cond.Reason, cond.Message)
+	default:
+		check.Passed = true
+	}
+	return check
+}
+
+func checkMigrationStorage(vmi *v1.VirtualMachineInstance) v1.MigrationDryRunCheck {
+	check := v1.MigrationDryRunCheck{Type: v1.MigrationDryRunCheckStorage, Passed: true}
+	// Volumes are the first thing evaluated for the LiveMigratable condition,
+	// any other reason implies that the volumes are migratable
+	cond := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceIsMigratable)
+	if cond != nil && cond.Status != k8sv1.ConditionTrue && cond.Reason == v1.VirtualMachineInstanceReasonDisksNotMigratable {
+		check.Passed = false
+		check.Message = cond.Message
+	}
+	return check
+}
+
+func checkMigrationHostDevices(vmi *v1.VirtualMachineInstance) v1.MigrationDryRunCheck {
+	check := v1.MigrationDryRunCheck{Type: v1.MigrationDryRunCheckHostDevices, Passed: true}
+
+	var devices []string
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		devices = append(devices, hostDevice.Name)
+	}
+	for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
+		devices = append(devices, gpu.Name)
+	}
+	if len(devices) > 0 {
+		check.Passed = false
+		check.Message = fmt.Sprintf("VMI uses PCI host devices: %s", strings.Join(devices, ", "))
+	}
+	return check
+}
+
+// checkMigrationCPUModel adds the CPU model constraints of the migration target pod
+// to nodeSelector and verifies that another node satisfies them
+func checkMigrationCPUModel(vmi *v1.VirtualMachineInstance, nodeSelector map[string]string, nodes []*k8sv1.Node) v1.MigrationDryRunCheck {
+	check := v1.MigrationDryRunCheck{Type: v1.MigrationDryRunCheckCPUModel}
+
+	if cpu := vmi.Spec.Domain.CPU; cpu != nil && cpu.Model == v1.CPUModeHostModel {
+		if err := addHostModelNodeSelector(vmi, nodeSelector, nodes); err != nil {
+			check.Message = err.Error()
+			return check
+		}
+	}
+
+	cpuLabels := map[string]string{}
+	for key, value := range nodeSelector {
+		if strings.HasPrefix(key, v1.CPUModelLabel) || strings.HasPrefix(key, v1.CPUFeatureLabel) || strings.HasPrefix(key, v1.SupportedHostModelMigrationCPU) {
+			cpuLabels[key] = value
+		}
+	}
+
+	for _, node := range nodes {
+		if node.Name != vmi.Status.NodeName && labels.SelectorFromSet(cpuLabels).Matches(labels.Set(node.Labels)) {
+			check.Passed = true
+			return check
+		}
+	}
+
+	check.Message = "no other node supports the CPU model and features required by the VMI"
+	return check
+}
+
+// addHostModelNodeSelector mirrors how the migration controller pins a host-model
+// VMI to nodes supporting the CPU model of the source node
+func addHostModelNodeSelector(vmi *v1.VirtualMachineInstance, nodeSelector map[string]string, nodes []*k8sv1.Node) error {
+	for key := range nodeSelector {
+		if strings.HasPrefix(key, v1.SupportedHostModelMigrationCPU) {
+			// The VMI already migrated and inherited the constraints
+			return nil
+		}
+	}
+
+	var sourceNode *k8sv1.Node
+	for _, node := range nodes {
+		if node.Name == vmi.Status.NodeName {
+			sourceNode = node
+			break
+		}
+	}
+	if sourceNode == nil {
+		return fmt.Errorf("the node running the VMI does not exist")
+	}
+
+	hostModelFound := false
+	for key, value := range sourceNode.Labels {
+		if strings.HasPrefix(key, v1.HostModelCPULabel) {
+			nodeSelector[v1.SupportedHostModelMigrationCPU+strings.TrimPrefix(key, v1.HostModelCPULabel)] = value
+			hostModelFound = true
+		}
+		if strings.HasPrefix(key, v1.HostModelRequiredFeaturesLabel) {
+			nodeSelector[v1.CPUFeatureLabel+strings.TrimPrefix(key, v1.HostModelRequiredFeaturesLabel)] = value
+		}
+	}
+	if !hostModelFound {
+		return fmt.Errorf("the node running the VMI does not report its host model CPU")
+	}
+	return nil
+}
+
+// checkMigrationScheduling verifies that another ready node satisfies the node selector of the
+// migration target pod. Affinity, taints and resources are left to the scheduler, which evaluates
+// them when the migration starts.
+func checkMigrationScheduling(vmi *v1.VirtualMachineInstance, nodeSelector map[string]string, nodes []*k8sv1.Node) v1.MigrationDryRunCheck {
+	check := v1.MigrationDryRunCheck{Type: v1.MigrationDryRunCheckScheduling}
+	for _, node := range nodes {
+		if node.Name == vmi.Status.NodeName || node.Spec.Unschedulable || !isNodeReady(node) {
+			continue
+		}
+		if labels.SelectorFromSet(nodeSelector).Matches(labels.Set(node.Labels)) {
+			check.Passed = true
+			check.Message = "affinity, taints and resource availability are evaluated by the scheduler when the migration starts"
+			return check
+		}
+	}
+	check.Message = "no other ready node satisfies the node selector of the VMI"
+	return check
+}
+
+func isNodeReady(node *k8sv1.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == k8sv1.NodeReady {
+			return cond.Status == k8sv1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+)
+
+var _ = Describe("Migration dry-run report", func() {
+	const sourceNode = "source"
+
+	var (
+		vmi       *v1.VirtualMachineInstance
+		sourcePod *k8sv1.Pod
+	)
+
+	newNode := func(name string, nodeLabels map[string]string) *k8sv1.Node {
+		return &k8sv1.Node{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: name, Labels: nodeLabels},
+			Status: k8sv1.NodeStatus{
+				Conditions: []k8sv1.NodeCondition{{Type: k8sv1.NodeReady, Status: k8sv1.ConditionTrue}},
+			},
+		}
+	}
+
+	findCheck := func(report *v1.MigrationDryRunReport, checkType v1.MigrationDryRunCheckType) v1.MigrationDryRunCheck {
+		for _, check := range report.Checks {
+			if check.Type == checkType {
+				return check
+			}
+		}
+		Fail("check " + string(checkType) + " not found")
+		return v1.MigrationDryRunCheck{}
+	}
+
+	BeforeEach(func() {
+		vmi = api.NewMinimalVMI("testvmi")
+		vmi.Status.NodeName = sourceNode
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+			{Type: v1.VirtualMachineInstanceIsMigratable, Status: k8sv1.ConditionTrue},
+		}
+		sourcePod = &k8sv1.Pod{Spec: k8sv1.PodSpec{NodeName: sourceNode}}
+	})
+
+	It("should report a migratable VMI", func() {
+		nodes := []*k8sv1.Node{newNode(sourceNode, nil), newNode("node01", nil)}
+
+		report := newMigrationDryRunReport(vmi, sourcePod, nodes, nil)
+		Expect(report.Migratable).To(BeTrue())
+		Expect(report.Checks).To(HaveLen(6))
+	})
+
+	It("should report the LiveMigratable condition reason", func() {
+		vmi.Status.Conditions[0].Status = k8sv1.ConditionFalse
+		vmi.Status.Conditions[0].Reason = v1.VirtualMachineInstanceReasonDisksNotMigratable
+		vmi.Status.Conditions[0].Message = "cannot migrate VMI: PVC disk0 is not shared"
+
+		report := newMigrationDryRunReport(vmi, sourcePod, []*k8sv1.Node{newNode("node01", nil)}, nil)
+		Expect(report.Migratable).To(BeFalse())
+		Expect(findCheck(report, v1.MigrationDryRunCheckLiveMigratable).Passed).To(BeFalse())
+		Expect(findCheck(report, v1.MigrationDryRunCheckStorage)).To(Equal(v1.MigrationDryRunCheck{
+			Type:    v1.MigrationDryRunCheckStorage,
+			Message: "cannot migrate VMI: PVC disk0 is not shared",
+		}))
+	})
+
+	It("should report PCI host devices", func() {
+		vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: "gpu1"}}
+
+		report := newMigrationDryRunReport(vmi, sourcePod, []*k8sv1.Node{newNode("node01", nil)}, nil)
+		Expect(report.Migratable).To(BeFalse())
+		Expect(findCheck(report, v1.MigrationDryRunCheckHostDevices).Message).To(Equal("VMI uses PCI host devices: gpu1"))
+	})
+
+	Context("with host-model CPU", func() {
+		const hostModelLabel = v1.HostModelCPULabel + "Skylake"
+
+		BeforeEach(func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
+		})
+
+		It("should pass when another node supports the source host model", func() {
+			nodes := []*k8sv1.Node{
+				newNode(sourceNode, map[string]string{hostModelLabel: "true"}),
+				newNode("node01", map[string]string{v1.SupportedHostModelMigrationCPU + "Skylake": "true"}),
+			}
+
+			report := newMigrationDryRunReport(vmi, sourcePod, nodes, nil)
+			Expect(report.Migratable).To(BeTrue())
+		})
+
+		It("should fail without revealing the CPU model when no other node supports the host model", func() {
+			nodes := []*k8sv1.Node{
+				newNode(sourceNode, map[string]string{hostModelLabel: "true"}),
+				newNode("node01", map[string]string{v1.SupportedHostModelMigrationCPU + "Haswell": "true"}),
+			}
+
+			report := newMigrationDryRunReport(vmi, sourcePod, nodes, nil)
+			Expect(report.Migratable).To(BeFalse())
+			check := findCheck(report, v1.MigrationDryRunCheckCPUModel)
+			Expect(check.Message).To(Equal("no other node supports the CPU model and features required by the VMI"))
+			Expect(check.Message).ToNot(ContainSubstring("Skylake"))
+		})
+	})
+
+	Context("scheduling", func() {
+		It("should skip unschedulable and not ready nodes", func() {
+			cordoned := newNode("cordoned", nil)
+			cordoned.Spec.Unschedulable = true
+			notReady := newNode("notready", nil)
+			notReady.Status.Conditions[0].Status = k8sv1.ConditionFalse
+
+			report := newMigrationDryRunReport(vmi, sourcePod, []*k8sv1.Node{cordoned, notReady}, nil)
+			Expect(report.Migratable).To(BeFalse())
+			check := findCheck(report, v1.MigrationDryRunCheckScheduling)
+			Expect(check.Passed).To(BeFalse())
+			Expect(check.Message).ToNot(ContainSubstring("cordoned"))
+		})
+
+		It("should honor the node selector", func() {
+			sourcePod.Spec.NodeSelector = map[string]string{"zone": "a"}
+
+			report := newMigrationDryRunReport(vmi, sourcePod, []*k8sv1.Node{newNode("wrongzone", map[string]string{"zone": "b"})}, nil)
+			Expect(findCheck(report, v1.MigrationDryRunCheckScheduling).Passed).To(BeFalse())
+
+			report = newMigrationDryRunReport(vmi, sourcePod, []*k8sv1.Node{newNode("match", map[string]string{"zone": "a"})}, nil)
+			Expect(findCheck(report, v1.MigrationDryRunCheckScheduling).Passed).To(BeTrue())
+		})
+
+		It("should fail when the source pod cannot be found", func() {
+			report := newMigrationDryRunReport(vmi, nil, []*k8sv1.Node{newNode("node01", nil)}, nil)
+			Expect(report.Migratable).To(BeFalse())
+			Expect(findCheck(report, v1.MigrationDryRunCheckScheduling).Message).To(ContainSubstring("unable to find the running virt-launcher pod"))
+		})
+	})
+})
@@ -68,6 +68,7 @@ const (
 	vmiNotPaused                             = "VMI is not paused"
 	vmiGuestAgentErr                         = "VMI does not have guest agent connected"
 	vmiNoAttestationErr                      = "Attestation not requested for VMI"
+	vmiNotMigrating                          = "VMI is not migrating"
 	prepConnectionErrFmt                     = "Cannot prepare connection %s"
 	getRequestErrFmt                         = "Cannot GET request %s"
 	pvcVolumeModeErr                         = "pvc should be filesystem pvc"
@@ -980,6 +981,24 @@ func (app *SubresourceAPIApp) FilesystemList(request *restful.Request, response
 	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceFileSystemList{})
 }
 
+// MigrationProgress handles the subresource for providing the progress of the ongoing VMI migration
+func (app *SubresourceAPIApp) MigrationProgress(request *restful.Request, response *restful.Response) {
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi == nil || vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		if vmi.Status.MigrationState == nil || vmi.Status.MigrationState.Completed {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotMigrating))
+		}
+		return nil
+	}
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.MigrationProgressURI(vmi)
+	}
+
+	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceMigrationProgress{})
+}
+
 func generateVMVolumeRequestPatch(vm *v1.VirtualMachine, volumeRequest *v1.VirtualMachineVolumeRequest) ([]byte, error) {
 	vmCopy := vm.DeepCopy()
 
@@ -1535,6 +1554,13 @@ func (app *SubresourceAPIApp) MemoryDumpVMRequestHandler(request *restful.Reques
 		return
 	}
 
+	switch memoryDumpReq.Format {
+	case "", v1.MemoryDumpFormatRaw, v1.MemoryDumpFormatSaveState:
+	default:
+		writeError(errors.NewBadRequest(fmt.Sprintf("unsupported memory dump format %s", memoryDumpReq.Format)), response)
+		return
+	}
+
 	memoryDumpReq.Phase = v1.MemoryDumpAssociating
 	isRemoveRequest := false
 	if err := app.vmMemoryDumpRequestPatchStatus(name, namespace, memoryDumpReq, isRemoveRequest); err != nil {
@@ -41,6 +41,7 @@ import (
 	"github.com/onsi/gomega/ghttp"
 	gomegatypes "github.com/onsi/gomega/types"
 
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -1927,6 +1928,116 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("with default", &v1.MigrateOptions{}),
 			Entry("with dry-run option", &v1.MigrateOptions{DryRun: getDryRunOption()}),
 		)
+
+	})
+
+	Context("Subresource api - migration check", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		newNode := func(name string) *k8sv1.Node {
+			return &k8sv1.Node{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: name, Labels: map[string]string{v1.NodeSchedulable: "true"}},
+				Status: k8sv1.NodeStatus{
+					Conditions: []k8sv1.NodeCondition{{Type: k8sv1.NodeReady, Status: k8sv1.ConditionTrue}},
+				},
+			}
+		}
+
+		var migrationCreateAllowed bool
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+			request.SetAttribute(requesterAttribute, authv1.SubjectAccessReviewSpec{User: "user", Groups: []string{"group"}})
+
+			migrationCreateAllowed = true
+			virtClient.EXPECT().AuthorizationV1().Return(kubeClient.AuthorizationV1()).AnyTimes()
+			kubeClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (bool, runtime.Object, error) {
+				sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+				Expect(sar.Spec.User).To(Equal("user"))
+				Expect(sar.Spec.Groups).To(Equal([]string{"group"}))
+				Expect(sar.Spec.ResourceAttributes).To(Equal(&authv1.ResourceAttributes{
+					Namespace: k8smetav1.NamespaceDefault,
+					Verb:      "create",
+					Group:     v1.VirtualMachineInstanceMigrationGroupVersionKind.Group,
+					Resource:  "virtualmachineinstancemigrations",
+				}))
+				sar.Status.Allowed = migrationCreateAllowed
+				return true, sar, nil
+			})
+
+			vmi = api.NewMinimalVMI(testVMName)
+			vmi.UID = "vmi-uid"
+			vmi.Status.Phase = v1.Running
+			vmi.Status.NodeName = "node01"
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{Type: v1.VirtualMachineInstanceIsMigratable, Status: k8sv1.ConditionTrue},
+			}
+
+			kubeClient.Fake.PrependReactor("list", "nodes", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, &k8sv1.NodeList{Items: []k8sv1.Node{*newNode("node01"), *newNode("node02")}}, nil
+			})
+
+			kubeClient.Fake.PrependReactor("list", "pods", func(action testing.Action) (bool, runtime.Object, error) {
+				restrictions := action.(testing.ListAction).GetListRestrictions()
+				Expect(restrictions.Labels.String()).To(Equal(v1.CreatedByLabel + "=vmi-uid"))
+				Expect(restrictions.Fields.String()).To(Equal("spec.nodeName=node01,status.phase=Running"))
+				return true, &k8sv1.PodList{Items: []k8sv1.Pod{{
+					ObjectMeta: k8smetav1.ObjectMeta{Labels: map[string]string{v1.CreatedByLabel: "vmi-uid"}},
+					Spec:       k8sv1.PodSpec{NodeName: "node01", NodeSelector: map[string]string{v1.NodeSchedulable: "true"}},
+					Status:     k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+				}}}, nil
+			})
+
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(&v1.VirtualMachine{}, nil)
+			vmiClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(vmi, nil)
+		})
+
+		decodeReport := func() *v1.MigrationDryRunReport {
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			report := &v1.MigrationDryRunReport{}
+			Expect(json.NewDecoder(recorder.Body).Decode(report)).To(Succeed())
+			return report
+		}
+
+		It("should report a feasible migration without creating it", func() {
+			migrateClient.EXPECT().Create(context.Background(), gomock.Any(), gomock.Any()).Do(
+				func(ctx context.Context, obj interface{}, opts k8smetav1.CreateOptions) {
+					Expect(opts.DryRun).To(Equal(getDryRunOption()))
+				}).Return(&v1.VirtualMachineInstanceMigration{}, nil)
+
+			app.MigrationCheckVMRequestHandler(request, response)
+
+			report := decodeReport()
+			Expect(report.Migratable).To(BeTrue())
+			Expect(recorder.Body.String()).ToNot(ContainSubstring("node02"))
+		})
+
+		It("should report an admission failure", func() {
+			migrateClient.EXPECT().Create(context.Background(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("denied"))
+
+			app.MigrationCheckVMRequestHandler(request, response)
+
+			report := decodeReport()
+			Expect(report.Migratable).To(BeFalse())
+			Expect(report.Checks).To(ContainElement(v1.MigrationDryRunCheck{
+				Type:    v1.MigrationDryRunCheckAdmission,
+				Message: "denied",
+			}))
+		})
+
+		It("should report that the user is not allowed to migrate without creating the migration", func() {
+			migrationCreateAllowed = false
+
+			app.MigrationCheckVMRequestHandler(request, response)
+
+			report := decodeReport()
+			Expect(report.Migratable).To(BeFalse())
+			Expect(report.Checks).To(ContainElement(v1.MigrationDryRunCheck{
+				Type:    v1.MigrationDryRunCheckAdmission,
+				Message: "the user is not allowed to create virtualmachineinstancemigrations in namespace default",
+			}))
+		})
 	})
 
 	Context("Subresource api - Guest OS Info", func() {
@@ -1993,6 +2104,63 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		)
 	})
 
+	Context("Subresource api - Migration progress", func() {
+		withMigrationState := func(completed bool) func(vmi *v1.VirtualMachineInstance) {
+			return func(vmi *v1.VirtualMachineInstance) {
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+					MigrationUID: "1234",
+					Completed:    completed,
+				}
+			}
+		}
+
+		It("should return the progress of a running migration", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/migration/progress"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, v1.VirtualMachineInstanceMigrationProgress{MigrationUID: "1234"}),
+				),
+			)
+			response.SetRequestAccepts(restful.MIME_JSON)
+
+			expectVMI(Running, UnPaused, withMigrationState(false))
+			app.MigrationProgress(request, response)
+			Expect(response.Error()).ToNot(HaveOccurred())
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		DescribeTable("should fail when the VMI is not migrating", func(migrationState func(vmi *v1.VirtualMachineInstance)) {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+
+			vmi := &v1.VirtualMachineInstance{Status: v1.VirtualMachineInstanceStatus{Phase: v1.Running}}
+			migrationState(vmi)
+
+			vmiClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(vmi, nil)
+
+			app.MigrationProgress(request, response)
+
+			Expect(response.Error()).To(HaveOccurred())
+			Expect(response.StatusCode()).To(Equal(http.StatusInternalServerError))
+			Expect(response.Error().Error()).To(ContainSubstring("VMI is not migrating"))
+		},
+			Entry("without a migration state", func(*v1.VirtualMachineInstance) {}),
+			Entry("with a completed migration", withMigrationState(true)),
+		)
+
+		It("should fail when the VMI is not running", func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+
+			vmiClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(&v1.VirtualMachineInstance{}, nil)
+
+			app.MigrationProgress(request, response)
+
+			Expect(response.Error()).To(HaveOccurred())
+			Expect(response.Error().Error()).To(ContainSubstring("VMI is not running"))
+		})
+	})
+
 	Context("StateChange JSON", func() {
 		It("should create a stop request if status exists", func() {
 			uid := uuid.NewUUID()
@@ -0,0 +1,54 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// isRequestAllowed reviews whether the user of the admission request is allowed to access the resource
+func isRequestAllowed(ctx context.Context, kubeClient kubernetes.Interface, request *admissionv1.AdmissionRequest, attributes *authv1.ResourceAttributes) (bool, error) {
+	extra := map[string]authv1.ExtraValue{}
+	for key, value := range request.UserInfo.Extra {
+		extra[key] = authv1.ExtraValue(value)
+	}
+
+	sar := &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:               request.UserInfo.Username,
+			Groups:             request.UserInfo.Groups,
+			UID:                request.UserInfo.UID,
+			Extra:              extra,
+			ResourceAttributes: attributes,
+		},
+	}
+
+	response, err := kubeClient.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to review access to %s in namespace %s: %v", attributes.Resource, attributes.Namespace, err)
+	}
+	return response.Status.Allowed, nil
+}
@@ -25,33 +25,51 @@ import (
 	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubevirt"
 
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 type MigrationCreateAdmitter struct {
-	virtClient kubevirt.Interface
+	clusterConfig *virtconfig.ClusterConfig
+	virtClient    kubevirt.Interface
+	kubeClient    kubernetes.Interface
+	// the namespace KubeVirt is installed in, it holds the connection secrets of cross-cluster migrations
+	kubevirtNamespace string
 }
 
-func NewMigrationCreateAdmitter(virtClient kubevirt.Interface) *MigrationCreateAdmitter {
+func NewMigrationCreateAdmitter(clusterConfig *virtconfig.ClusterConfig, virtClient kubevirt.Interface, kubeClient kubernetes.Interface, kubevirtNamespace string) *MigrationCreateAdmitter {
 	return &MigrationCreateAdmitter{
-		virtClient: virtClient,
+		clusterConfig:     clusterConfig,
+		virtClient:        virtClient,
+		kubeClient:        kubeClient,
+		kubevirtNamespace: kubevirtNamespace,
 	}
 }
 
-func isMigratable(vmi *v1.VirtualMachineInstance) error {
+func isMigratable(vmi *v1.VirtualMachineInstance, migration *v1.VirtualMachineInstanceMigration) error {
 	for _, c := range vmi.Status.Conditions {
 		if c.Type == v1.VirtualMachineInstanceIsMigratable &&
 			c.Status == k8sv1.ConditionFalse {
+			// Volumes are copied when migrating to another cluster
+			if migration.Spec.SendTo != nil && c.Reason == v1.VirtualMachineInstanceReasonDisksNotMigratable {
+				continue
+			}
 			return fmt.Errorf("Cannot migrate VMI, Reason: %s, Message: %s", c.Reason, c.Message)
 		}
 	}
@@ -96,6 +114,42 @@ func (admitter *MigrationCreateAdmitter) Admit(ctx context.Context, ar *admissio
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes, err = admitter.validatePriorityAnnotations(ctx, ar.Request, migration)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	if migration.IsCrossCluster() && !admitter.clusterConfig.CrossClusterLiveMigrationEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("cross-cluster live migration requires the %s feature gate", featuregate.CrossClusterLiveMigration))
+	}
+
+	if migration.Spec.SendTo != nil {
+		causes, err := admitter.validateConnectionSecretAccess(ctx, ar.Request, migration.Spec.SendTo)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		if len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
+	}
+
+	if migration.Spec.Priority != nil && !admitter.clusterConfig.MigrationPriorityQueueEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("migration priority requires the %s feature gate", featuregate.MigrationPriorityQueue))
+	}
+
+	if migration.Spec.Priority != nil && *migration.Spec.Priority != v1.MigrationPriorityUserTriggered {
+		causes, err := admitter.validatePriorityAccess(ctx, ar.Request, *migration.Spec.Priority)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		if len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
+	}
+
 	vmi, err := admitter.virtClient.KubevirtV1().VirtualMachineInstances(migration.Namespace).Get(ctx, migration.Spec.VMIName, metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		// ensure VMI exists for the migration
@@ -109,8 +163,20 @@ func (admitter *MigrationCreateAdmitter) Admit(ctx context.Context, ar *admissio
 		return webhookutils.ToAdmissionResponseError(fmt.Errorf("Cannot migrate VMI in finalized state."))
 	}
 
+	// Only VMIs waiting for a cross-cluster migration can receive one
+	if isReceiver := migrations.IsMigrationReceiver(vmi); isReceiver != (migration.Spec.Receive != nil) {
+		if isReceiver {
+			return webhookutils.ToAdmissionResponseError(fmt.Errorf("Cannot migrate VMI %s, it waits to be received from another cluster", vmi.Name))
+		}
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("Cannot receive VMI %s, it is missing the %s annotation", vmi.Name, v1.MigrationReceiverAnnotation))
+	}
+
+	if causes := validateTargetNodeSelectorConflicts(k8sfield.NewPath("spec", "nodeSelector"), vmi, &migration.Spec); len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	// Reject migration jobs for non-migratable VMIs
-	err = isMigratable(vmi)
+	err = isMigratable(vmi, migration)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
@@ -122,11 +188,249 @@ func (admitter *MigrationCreateAdmitter) Admit(ctx context.Context, ar *admissio
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
+	// Reject migration jobs which can't be scheduled to any node
+	err = ensureTargetNodesExist(ctx, admitter.kubeClient, &migration.Spec)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
 	reviewResponse := admissionv1.AdmissionResponse{}
 	reviewResponse.Allowed = true
 	return &reviewResponse
 }
 
+// validatePriorityAnnotations ensures that only the users allowed to request a system priority mark migrations
+// as evacuation or workload update migrations, the migration controller grants them a system priority
+func (admitter *MigrationCreateAdmitter) validatePriorityAnnotations(ctx context.Context, request *admissionv1.AdmissionRequest, migration *v1.VirtualMachineInstanceMigration) ([]metav1.StatusCause, error) {
+	var annotations []string
+	for _, annotation := range []string{v1.EvacuationMigrationAnnotation, v1.WorkloadUpdateMigrationAnnotation} {
+		if _, exists := migration.Annotations[annotation]; exists {
+			annotations = append(annotations, annotation)
+		}
+	}
+	if len(annotations) == 0 {
+		return nil, nil
+	}
+
+	allowed, err := admitter.isSystemPriorityAllowed(ctx, request)
+	if err != nil || allowed {
+		return nil, err
+	}
+	var causes []metav1.StatusCause
+	for _, annotation := range annotations {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("User %s is not allowed to set the %s annotation", request.UserInfo.Username, annotation),
+			Field:   k8sfield.NewPath("metadata", "annotations").Key(annotation).String(),
+		})
+	}
+	return causes, nil
+}
+
+// validateConnectionSecretAccess ensures that the creator of a send migration is allowed to get the
+// connection secret, virt-controller uses the secret on behalf of the creator. Cluster admins grant
+// access to the connection secrets in the KubeVirt namespace to the users allowed to use them.
+func (admitter *MigrationCreateAdmitter) validateConnectionSecretAccess(ctx context.Context, request *admissionv1.AdmissionRequest, sendTo *v1.VirtualMachineInstanceMigrationSendTo) ([]metav1.StatusCause, error) {
+	secretName := sendTo.ConnectionSecretRef.Name
+	allowed, err := isRequestAllowed(ctx, admitter.kubeClient, request, &authv1.ResourceAttributes{
+		Namespace: admitter.kubevirtNamespace,
+		Verb:      "get",
+		Resource:  "secrets",
+		Name:      secretName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("User %s is not allowed to get the connection secret %s in namespace %s", request.UserInfo.Username, secretName, admitter.kubevirtNamespace),
+			Field:   k8sfield.NewPath("spec", "sendTo", "connectionSecretRef", "name").String(),
+		}}, nil
+	}
+	return nil, nil
+}
+
+// isSystemPriorityAllowed checks whether the user of the request may grant a migration a system priority
+func (admitter *MigrationCreateAdmitter) isSystemPriorityAllowed(ctx context.Context, request *admissionv1.AdmissionRequest) (bool, error) {
+	if _, isKubeVirtServiceAccount := webhooks.KubeVirtServiceAccounts(admitter.kubevirtNamespace)[request.UserInfo.Username]; isKubeVirtServiceAccount {
+		return true, nil
+	}
+	return isRequestAllowed(ctx, admitter.kubeClient, request, &authv1.ResourceAttributes{
+		Namespace: admitter.kubevirtNamespace,
+		Verb:      "update",
+		Group:     v1.GroupVersion.Group,
+		Resource:  "kubevirts",
+	})
+}
+
+// validatePriorityAccess restricts the priorities taking precedence over, or yielding to, the migrations
+// of other users to the KubeVirt components and to the users allowed to update the KubeVirt resource
+func (admitter *MigrationCreateAdmitter) validatePriorityAccess(ctx context.Context, request *admissionv1.AdmissionRequest, priority v1.MigrationPriority) ([]metav1.StatusCause, error) {
+	allowed, err := admitter.isSystemPriorityAllowed(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("User %s is not allowed to request the %s migration priority", request.UserInfo.Username, priority),
+			Field:   k8sfield.NewPath("spec", "priority").String(),
+		}}, nil
+	}
+	return nil, nil
+}
+
+// validateTargetNodeSelectorConflicts rejects a migration node selector requiring
+// another value for a label than the node selector of the VMI does
+func validateTargetNodeSelectorConflicts(field *k8sfield.Path, vmi *v1.VirtualMachineInstance, spec *v1.VirtualMachineInstanceMigrationSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for key, value := range spec.NodeSelector {
+		if vmiValue, exists := vmi.Spec.NodeSelector[key]; exists && vmiValue != value {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("node selector %s=%s conflicts with the node selector %s=%s of the VMI", key, value, key, vmiValue),
+				Field:   field.Key(key).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func ensureTargetNodesExist(ctx context.Context, kubeClient kubernetes.Interface, spec *v1.VirtualMachineInstanceMigrationSpec) error {
+	var requiredNodeSelector *k8sv1.NodeSelector
+	if spec.Affinity != nil && spec.Affinity.NodeAffinity != nil {
+		requiredNodeSelector = spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	}
+	if len(spec.NodeSelector) == 0 && requiredNodeSelector == nil {
+		return nil
+	}
+
+	terms := []k8sv1.NodeSelectorTerm{{}}
+	if requiredNodeSelector != nil {
+		terms = requiredNodeSelector.NodeSelectorTerms
+	}
+
+	// Every term is looked up separately, so that only the nodes it selects are listed
+	for _, term := range terms {
+		// An empty term of the node affinity matches no node
+		if requiredNodeSelector != nil && len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			continue
+		}
+		listOptions, err := nodeSelectorTermListOptions(spec.NodeSelector, term)
+		if err != nil {
+			return err
+		}
+		nodes, err := kubeClient.CoreV1().Nodes().List(ctx, listOptions)
+		if err != nil {
+			return err
+		}
+		if requiredNodeSelector == nil && len(nodes.Items) > 0 {
+			return nil
+		}
+		// The match fields are not all supported by the field selectors of nodes, they are evaluated here
+		for i := range nodes.Items {
+			matches, err := nodeMatchesNodeSelector(&nodes.Items[i], &k8sv1.NodeSelector{NodeSelectorTerms: []k8sv1.NodeSelectorTerm{term}})
+			if err != nil {
+				return err
+			}
+			if matches {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("no node matches the node selector and node affinity of the migration")
+}
+
+// nodeSelectorTermListOptions selects the nodes matching the node selector and the labels of the term.
+// A term selecting a single node by name only lists that node. The nodes are served from the cache of
+// the API server, a node added in the meantime is caught up by a later request.
+func nodeSelectorTermListOptions(nodeSelector map[string]string, term k8sv1.NodeSelectorTerm) (metav1.ListOptions, error) {
+	labelSelector, err := nodeSelectorRequirementsAsSelector(term.MatchExpressions)
+	if err != nil {
+		return metav1.ListOptions{}, err
+	}
+	for key, value := range nodeSelector {
+		requirement, err := labels.NewRequirement(key, selection.Equals, []string{value})
+		if err != nil {
+			return metav1.ListOptions{}, err
+		}
+		labelSelector = labelSelector.Add(*requirement)
+	}
+
+	listOptions := metav1.ListOptions{
+		LabelSelector:   labelSelector.String(),
+		ResourceVersion: "0",
+	}
+	for _, field := range term.MatchFields {
+		if field.Key == metav1.ObjectNameField && field.Operator == k8sv1.NodeSelectorOpIn && len(field.Values) == 1 {
+			listOptions.FieldSelector = fields.OneTermEqualSelector(metav1.ObjectNameField, field.Values[0]).String()
+		}
+	}
+	return listOptions, nil
+}
+
+func nodeMatchesNodeSelector(node *k8sv1.Node, nodeSelector *k8sv1.NodeSelector) (bool, error) {
+	if nodeSelector == nil {
+		return true, nil
+	}
+
+	// Node selector terms are ORed
+	for _, term := range nodeSelector.NodeSelectorTerms {
+		// An empty term matches no node
+		if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			continue
+		}
+
+		labelSelector, err := nodeSelectorRequirementsAsSelector(term.MatchExpressions)
+		if err != nil {
+			return false, err
+		}
+		fieldSelector, err := nodeSelectorRequirementsAsSelector(term.MatchFields)
+		if err != nil {
+			return false, err
+		}
+
+		if labelSelector.Matches(labels.Set(node.Labels)) &&
+			fieldSelector.Matches(labels.Set{"metadata.name": node.Name}) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func nodeSelectorRequirementsAsSelector(requirements []k8sv1.NodeSelectorRequirement) (labels.Selector, error) {
+	selector := labels.NewSelector()
+	for _, requirement := range requirements {
+		var op selection.Operator
+		switch requirement.Operator {
+		case k8sv1.NodeSelectorOpIn:
+			op = selection.In
+		case k8sv1.NodeSelectorOpNotIn:
+			op = selection.NotIn
+		case k8sv1.NodeSelectorOpExists:
+			op = selection.Exists
+		case k8sv1.NodeSelectorOpDoesNotExist:
+			op = selection.DoesNotExist
+		case k8sv1.NodeSelectorOpGt:
+			op = selection.GreaterThan
+		case k8sv1.NodeSelectorOpLt:
+			op = selection.LessThan
+		default:
+			return nil, fmt.Errorf("%q is not a valid node selector operator", requirement.Operator)
+		}
+
+		r, err := labels.NewRequirement(requirement.Key, op, requirement.Values)
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*r)
+	}
+	return selector, nil
+}
+
 func getAdmissionReviewMigration(ar *admissionv1.AdmissionReview) (new *v1.VirtualMachineInstanceMigration, old *v1.VirtualMachineInstanceMigration, err error) {
 
 	if !webhookutils.ValidateRequestResource(ar.Request.Resource, webhooks.MigrationGroupVersionResource.Group, webhooks.MigrationGroupVersionResource.Resource) {
@@ -165,5 +469,117 @@ func ValidateVirtualMachineInstanceMigrationSpec(field *k8sfield.Path, spec *v1.
 		})
 	}
 
+	if spec.SendTo != nil && spec.Receive != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "sendTo and receive are mutually exclusive",
+			Field:   field.Child("sendTo").String(),
+		})
+	}
+
+	if spec.SendTo != nil {
+		if spec.SendTo.MigrationID == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "migrationID is missing",
+				Field:   field.Child("sendTo", "migrationID").String(),
+			})
+		}
+		if spec.SendTo.ConnectionSecretRef.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "connectionSecretRef name is missing",
+				Field:   field.Child("sendTo", "connectionSecretRef", "name").String(),
+			})
+		}
+	}
+
+	if spec.Receive != nil && spec.Receive.MigrationID == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "migrationID is missing",
+			Field:   field.Child("receive", "migrationID").String(),
+		})
+	}
+
+	if spec.Receive != nil && (len(spec.NodeSelector) > 0 || spec.Affinity != nil) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "nodeSelector and affinity have to be set on the receiving VMI",
+			Field:   field.Child("receive").String(),
+		})
+	}
+
+	if spec.Priority != nil {
+		switch *spec.Priority {
+		case v1.MigrationPrioritySystemCritical, v1.MigrationPriorityUserTriggered, v1.MigrationPrioritySystemMaintenance:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("priority %s is not supported", *spec.Priority),
+				Field:   field.Child("priority").String(),
+			})
+		}
+	}
+
+	causes = append(causes, ValidateMigrationRetryPolicy(field.Child("retryPolicy"), spec.RetryPolicy)...)
+	causes = append(causes, validateMigrationRetryFallbacks(field.Child("retryPolicy"), spec.RetryPolicy)...)
+
+	return causes
+}
+
+// validateMigrationRetryFallbacks rejects the fallbacks relaxing the migration
+// configuration, they bypass the configuration of the cluster admin and are only
+// accepted from the cluster-scoped migration policies.
+func validateMigrationRetryFallbacks(field *k8sfield.Path, retryPolicy *v1.MigrationRetryPolicy) []metav1.StatusCause {
+	if retryPolicy == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	if retryPolicy.AllowPostCopyOnRetry != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "allowPostCopyOnRetry can only be set in the retry policy of a MigrationPolicy",
+			Field:   field.Child("allowPostCopyOnRetry").String(),
+		})
+	}
+	if retryPolicy.BandwidthPerMigrationOnRetry != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "bandwidthPerMigrationOnRetry can only be set in the retry policy of a MigrationPolicy",
+			Field:   field.Child("bandwidthPerMigrationOnRetry").String(),
+		})
+	}
+	return causes
+}
+
+func ValidateMigrationRetryPolicy(field *k8sfield.Path, retryPolicy *v1.MigrationRetryPolicy) []metav1.StatusCause {
+	if retryPolicy == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	if retryPolicy.MaxAttempts != nil && *retryPolicy.MaxAttempts < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be greater than zero",
+			Field:   field.Child("maxAttempts").String(),
+		})
+	}
+	if retryPolicy.InitialBackoffSeconds != nil && *retryPolicy.InitialBackoffSeconds < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be negative",
+			Field:   field.Child("initialBackoffSeconds").String(),
+		})
+	}
+	if retryPolicy.BandwidthPerMigrationOnRetry != nil && retryPolicy.BandwidthPerMigrationOnRetry.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be negative",
+			Field:   field.Child("bandwidthPerMigrationOnRetry").String(),
+		})
+	}
 	return causes
 }
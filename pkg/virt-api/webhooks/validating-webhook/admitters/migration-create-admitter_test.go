@@ -22,23 +22,34 @@ package admitters_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks/validating-webhook/admitters"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 var _ = Describe("Validating MigrationCreate Admitter", func() {
+	config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
 	It("should reject Migration spec on create when another VMI migration is in-flight", func() {
 		vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
 		inFlightMigration := &v1.VirtualMachineInstanceMigration{
@@ -60,7 +71,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			},
 		}
 		virtClient := kubevirtfake.NewSimpleClientset(vmi, inFlightMigration)
-		migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+		migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(config, virtClient, newSARClient(true), kubevirtNamespace)
 		ar, err := newAdmissionReviewForVMIMCreation(migration)
 		Expect(err).ToNot(HaveOccurred())
 
@@ -80,7 +91,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			}
 
 			virtClient := kubevirtfake.NewSimpleClientset()
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(config, virtClient, newSARClient(true), kubevirtNamespace)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -102,7 +113,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				},
 			}
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(config, virtClient, newSARClient(true), kubevirtNamespace)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -128,7 +139,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			}
 
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(config, virtClient, newSARClient(true), kubevirtNamespace)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -150,7 +161,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			}
 
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(config, virtClient, newSARClient(true), kubevirtNamespace)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -183,7 +194,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				},
 			}
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(config, virtClient, newSARClient(true), kubevirtNamespace)
 
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
@@ -193,6 +204,356 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			Expect(resp.Result.Message).To(ContainSubstring("DisksNotLiveMigratable"))
 		})
 
+		DescribeTable("should validate the target node constraints", func(nodeSelector map[string]string, affinity *k8sv1.Affinity, allowed bool) {
+			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+			vmi.Status.Phase = v1.Running
+
+			migration := &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: vmi.Namespace,
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName:      vmi.Name,
+					NodeSelector: nodeSelector,
+					Affinity:     affinity,
+				},
+			}
+			node := &k8sv1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "node01",
+					Labels: map[string]string{"zone": "east"},
+				},
+			}
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(config, kubevirtfake.NewSimpleClientset(vmi), newSARClient(true, node), kubevirtNamespace)
+
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(resp.Result.Message).To(ContainSubstring("no node matches"))
+			}
+		},
+			Entry("with a node selector matching a node", map[string]string{"zone": "east"}, nil, true),
+			Entry("with a node selector matching no node", map[string]string{"zone": "west"}, nil, false),
+			Entry("with a node affinity matching a node", nil, newRequiredNodeAffinity(&k8sv1.NodeSelectorRequirement{
+				Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"east", "west"},
+			}, nil), true),
+			Entry("with a node affinity matching no node", nil, newRequiredNodeAffinity(&k8sv1.NodeSelectorRequirement{
+				Key: "zone", Operator: k8sv1.NodeSelectorOpNotIn, Values: []string{"east"},
+			}, nil), false),
+			Entry("with a node affinity matching the node name", nil, newRequiredNodeAffinity(nil, &k8sv1.NodeSelectorRequirement{
+				Key: "metadata.name", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"node01"},
+			}), true),
+			Entry("with a node selector and a node affinity matching different nodes", map[string]string{"zone": "east"}, newRequiredNodeAffinity(nil, &k8sv1.NodeSelectorRequirement{
+				Key: "metadata.name", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"node02"},
+			}), false),
+		)
+
+		It("should only list the nodes selected by the target node constraints", func() {
+			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+			vmi.Status.Phase = v1.Running
+
+			migration := &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: vmi.Namespace,
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName:      vmi.Name,
+					NodeSelector: map[string]string{"zone": "east"},
+					Affinity: newRequiredNodeAffinity(nil, &k8sv1.NodeSelectorRequirement{
+						Key: "metadata.name", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"node01"},
+					}),
+				},
+			}
+			kubeClient := newSARClient(true, &k8sv1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node01", Labels: map[string]string{"zone": "east"}},
+			})
+			var listOptions []metav1.ListOptions
+			kubeClient.Fake.PrependReactor("list", "nodes", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				restrictions := action.(testing.ListAction).GetListRestrictions()
+				listOptions = append(listOptions, metav1.ListOptions{
+					LabelSelector: restrictions.Labels.String(),
+					FieldSelector: restrictions.Fields.String(),
+				})
+				return false, nil, nil
+			})
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(config, kubevirtfake.NewSimpleClientset(vmi), kubeClient, kubevirtNamespace)
+
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(listOptions).To(ConsistOf(metav1.ListOptions{
+				LabelSelector: "zone=east",
+				FieldSelector: "metadata.name=node01",
+			}))
+		})
+
+		It("should reject a node selector conflicting with the VMI", func() {
+			vmi := libvmi.New(
+				libvmi.WithNamespace(k8sv1.NamespaceDefault),
+				libvmi.WithNodeSelectorFor("node01"),
+			)
+			vmi.Status.Phase = v1.Running
+
+			migration := &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: vmi.Namespace,
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName:      vmi.Name,
+					NodeSelector: map[string]string{k8sv1.LabelHostname: "node02"},
+				},
+			}
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(config, kubevirtfake.NewSimpleClientset(vmi), newSARClient(true), kubevirtNamespace)
+
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.nodeSelector[kubernetes.io/hostname]"))
+		})
+
+		Context("across clusters", func() {
+			crossClusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: []string{featuregate.CrossClusterLiveMigration},
+				},
+			})
+
+			newReceiverVMI := func() *v1.VirtualMachineInstance {
+				return libvmi.New(
+					libvmi.WithNamespace(k8sv1.NamespaceDefault),
+					libvmi.WithAnnotation(v1.MigrationReceiverAnnotation, ""),
+				)
+			}
+
+			DescribeTable("should admit", func(clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachineInstance, spec v1.VirtualMachineInstanceMigrationSpec, allowed bool, message string) {
+				spec.VMIName = vmi.Name
+				migration := &v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: vmi.Namespace,
+					},
+					Spec: spec,
+				}
+				migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(clusterConfig, kubevirtfake.NewSimpleClientset(vmi), newSARClient(true), kubevirtNamespace)
+				ar, err := newAdmissionReviewForVMIMCreation(migration)
+				Expect(err).ToNot(HaveOccurred())
+
+				resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(Equal(allowed))
+				if !allowed {
+					Expect(resp.Result.Message).To(ContainSubstring(message))
+				}
+			},
+				Entry("a send migration",
+					crossClusterConfig, libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault)),
+					v1.VirtualMachineInstanceMigrationSpec{SendTo: &v1.VirtualMachineInstanceMigrationSendTo{
+						MigrationID: "id", ConnectionSecretRef: k8sv1.LocalObjectReference{Name: "kubeconfig"},
+					}}, true, ""),
+				Entry("a receive migration",
+					crossClusterConfig, newReceiverVMI(),
+					v1.VirtualMachineInstanceMigrationSpec{Receive: &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "id"}},
+					true, ""),
+				Entry("no send migration without the feature gate",
+					config, libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault)),
+					v1.VirtualMachineInstanceMigrationSpec{SendTo: &v1.VirtualMachineInstanceMigrationSendTo{
+						MigrationID: "id", ConnectionSecretRef: k8sv1.LocalObjectReference{Name: "kubeconfig"},
+					}}, false, "feature gate"),
+				Entry("no send migration without a connection secret",
+					crossClusterConfig, libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault)),
+					v1.VirtualMachineInstanceMigrationSpec{SendTo: &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "id"}},
+					false, "connectionSecretRef name is missing"),
+				Entry("no receive migration without a migration ID",
+					crossClusterConfig, newReceiverVMI(),
+					v1.VirtualMachineInstanceMigrationSpec{Receive: &v1.VirtualMachineInstanceMigrationReceive{}},
+					false, "migrationID is missing"),
+				Entry("no migration sending and receiving at once",
+					crossClusterConfig, newReceiverVMI(),
+					v1.VirtualMachineInstanceMigrationSpec{
+						SendTo: &v1.VirtualMachineInstanceMigrationSendTo{
+							MigrationID: "id", ConnectionSecretRef: k8sv1.LocalObjectReference{Name: "kubeconfig"},
+						},
+						Receive: &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "id"},
+					}, false, "mutually exclusive"),
+				Entry("no receive migration for a VMI without the receiver annotation",
+					crossClusterConfig, libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault)),
+					v1.VirtualMachineInstanceMigrationSpec{Receive: &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "id"}},
+					false, "missing the kubevirt.io/migration-receiver annotation"),
+				Entry("no regular migration for a VMI waiting to be received",
+					crossClusterConfig, newReceiverVMI(),
+					v1.VirtualMachineInstanceMigrationSpec{},
+					false, "waits to be received from another cluster"),
+			)
+
+			It("should reject sending with a connection secret the user is not allowed to get", func() {
+				vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+				migration := &v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: vmi.Namespace,
+					},
+					Spec: v1.VirtualMachineInstanceMigrationSpec{
+						VMIName: vmi.Name,
+						SendTo: &v1.VirtualMachineInstanceMigrationSendTo{
+							MigrationID:         "id",
+							ConnectionSecretRef: k8sv1.LocalObjectReference{Name: "kubeconfig"},
+						},
+					},
+				}
+				kubeClient := newSARClient(false)
+				migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(crossClusterConfig, kubevirtfake.NewSimpleClientset(vmi), kubeClient, kubevirtNamespace)
+				ar, err := newAdmissionReviewForVMIMCreation(migration)
+				Expect(err).ToNot(HaveOccurred())
+
+				resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.sendTo.connectionSecretRef.name"))
+
+				Expect(kubeClient.Actions()).To(HaveLen(1))
+				review := kubeClient.Actions()[0].(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+				Expect(review.Spec.ResourceAttributes).To(Equal(&authv1.ResourceAttributes{
+					Namespace: kubevirtNamespace,
+					Verb:      "get",
+					Resource:  "secrets",
+					Name:      "kubeconfig",
+				}))
+			})
+
+			It("should allow sending a VMI with volumes which are not shared", func() {
+				vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionFalse,
+					Reason: v1.VirtualMachineInstanceReasonDisksNotMigratable,
+				}}
+				migration := &v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: vmi.Namespace,
+					},
+					Spec: v1.VirtualMachineInstanceMigrationSpec{
+						VMIName: vmi.Name,
+						SendTo: &v1.VirtualMachineInstanceMigrationSendTo{
+							MigrationID:         "id",
+							ConnectionSecretRef: k8sv1.LocalObjectReference{Name: "kubeconfig"},
+						},
+					},
+				}
+				migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(crossClusterConfig, kubevirtfake.NewSimpleClientset(vmi), newSARClient(true), kubevirtNamespace)
+				ar, err := newAdmissionReviewForVMIMCreation(migration)
+				Expect(err).ToNot(HaveOccurred())
+
+				resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(BeTrue())
+			})
+		})
+
+		DescribeTable("should admit a migration priority", func(featureGates []string, priority v1.MigrationPriority, username string, sarAllowed, allowed bool, message string) {
+			priorityConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: featureGates,
+				},
+			})
+			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+			migration := &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: vmi.Namespace,
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName:  vmi.Name,
+					Priority: &priority,
+				},
+			}
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(priorityConfig, kubevirtfake.NewSimpleClientset(vmi), newSARClient(sarAllowed), kubevirtNamespace)
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo.Username = username
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(resp.Result.Message).To(ContainSubstring(message))
+			}
+		},
+			Entry("with the feature gate", []string{featuregate.MigrationPriorityQueue}, v1.MigrationPriorityUserTriggered, "user", false, true, ""),
+			Entry("not without the feature gate", nil, v1.MigrationPriorityUserTriggered, "user", false, false, "feature gate"),
+			Entry("not with an unknown value", []string{featuregate.MigrationPriorityQueue}, v1.MigrationPriority("urgent"), "user", false, false, "priority urgent is not supported"),
+			Entry("with system-critical from a KubeVirt administrator", []string{featuregate.MigrationPriorityQueue}, v1.MigrationPrioritySystemCritical, "admin", true, true, ""),
+			Entry("with system-critical from virt-controller", []string{featuregate.MigrationPriorityQueue}, v1.MigrationPrioritySystemCritical, "system:serviceaccount:"+kubevirtNamespace+":kubevirt-controller", false, true, ""),
+			Entry("not with system-critical from another user", []string{featuregate.MigrationPriorityQueue}, v1.MigrationPrioritySystemCritical, "user", false, false, "User user is not allowed to request the system-critical migration priority"),
+			Entry("not with system-maintenance from another user", []string{featuregate.MigrationPriorityQueue}, v1.MigrationPrioritySystemMaintenance, "user", false, false, "User user is not allowed to request the system-maintenance migration priority"),
+		)
+
+		DescribeTable("should admit an annotation granting a system priority", func(annotation, username string, sarAllowed, allowed bool) {
+			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+			migration := &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   vmi.Namespace,
+					Annotations: map[string]string{annotation: ""},
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName: vmi.Name,
+				},
+			}
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(config, kubevirtfake.NewSimpleClientset(vmi), newSARClient(sarAllowed), kubevirtNamespace)
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo.Username = username
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(resp.Result.Details.Causes).To(ConsistOf(metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("User %s is not allowed to set the %s annotation", username, annotation),
+					Field:   fmt.Sprintf("metadata.annotations[%s]", annotation),
+				}))
+			}
+		},
+			Entry("evacuation from virt-controller", v1.EvacuationMigrationAnnotation, "system:serviceaccount:"+kubevirtNamespace+":kubevirt-controller", false, true),
+			Entry("evacuation from a KubeVirt administrator", v1.EvacuationMigrationAnnotation, "admin", true, true),
+			Entry("not evacuation from another user", v1.EvacuationMigrationAnnotation, "user", false, false),
+			Entry("workload update from virt-controller", v1.WorkloadUpdateMigrationAnnotation, "system:serviceaccount:"+kubevirtNamespace+":kubevirt-controller", false, true),
+			Entry("workload update from a KubeVirt administrator", v1.WorkloadUpdateMigrationAnnotation, "admin", true, true),
+			Entry("not workload update from another user", v1.WorkloadUpdateMigrationAnnotation, "user", false, false),
+		)
+
+		DescribeTable("should admit a migration retry policy", func(retryPolicy *v1.MigrationRetryPolicy, allowed bool, message string) {
+			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+			migration := &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: vmi.Namespace,
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName:     vmi.Name,
+					RetryPolicy: retryPolicy,
+				},
+			}
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(config, kubevirtfake.NewSimpleClientset(vmi), newSARClient(true), kubevirtNamespace)
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", message)))
+			}
+		},
+			Entry("with all fields set", &v1.MigrationRetryPolicy{
+				MaxAttempts:           pointer.P(uint32(3)),
+				InitialBackoffSeconds: pointer.P(int64(10)),
+			}, true, ""),
+			Entry("not with zero max attempts", &v1.MigrationRetryPolicy{MaxAttempts: pointer.P(uint32(0))}, false, "spec.retryPolicy.maxAttempts"),
+			Entry("not with a negative backoff", &v1.MigrationRetryPolicy{InitialBackoffSeconds: pointer.P(int64(-1))}, false, "spec.retryPolicy.initialBackoffSeconds"),
+			Entry("not with the post-copy fallback", &v1.MigrationRetryPolicy{AllowPostCopyOnRetry: pointer.P(true)}, false, "spec.retryPolicy.allowPostCopyOnRetry"),
+			Entry("not with the bandwidth fallback", &v1.MigrationRetryPolicy{BandwidthPerMigrationOnRetry: resource.NewScaledQuantity(64, resource.Mega)}, false, "spec.retryPolicy.bandwidthPerMigrationOnRetry"),
+		)
+
 		DescribeTable("should reject documents containing unknown or missing fields for", func(data string, validationResult string, gvr metav1.GroupVersionResource, review func(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse) {
 			input := map[string]interface{}{}
 			json.Unmarshal([]byte(data), &input)
@@ -213,18 +574,48 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				`{"very": "unknown", "spec": { "extremely": "unknown" }}`,
 				`.very in body is a forbidden property, spec.extremely in body is a forbidden property`,
 				webhooks.MigrationGroupVersionResource,
-				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset()).Admit,
+				admitters.NewMigrationCreateAdmitter(config, kubevirtfake.NewSimpleClientset(), newSARClient(true), kubevirtNamespace).Admit,
 			),
 			Entry("Migration update",
 				`{"very": "unknown", "spec": { "extremely": "unknown" }}`,
 				`.very in body is a forbidden property, spec.extremely in body is a forbidden property`,
 				webhooks.MigrationGroupVersionResource,
-				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset()).Admit,
+				admitters.NewMigrationCreateAdmitter(config, kubevirtfake.NewSimpleClientset(), newSARClient(true), kubevirtNamespace).Admit,
 			),
 		)
 	})
 })
 
+const kubevirtNamespace = "kubevirt"
+
+// newSARClient returns a client answering the subject access reviews with allowed
+func newSARClient(allowed bool, objects ...runtime.Object) *k8sfake.Clientset {
+	kubeClient := k8sfake.NewSimpleClientset(objects...)
+	kubeClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+		review := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+		review.Status.Allowed = allowed
+		return true, review, nil
+	})
+	return kubeClient
+}
+
+func newRequiredNodeAffinity(expression, field *k8sv1.NodeSelectorRequirement) *k8sv1.Affinity {
+	term := k8sv1.NodeSelectorTerm{}
+	if expression != nil {
+		term.MatchExpressions = append(term.MatchExpressions, *expression)
+	}
+	if field != nil {
+		term.MatchFields = append(term.MatchFields, *field)
+	}
+	return &k8sv1.Affinity{
+		NodeAffinity: &k8sv1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+				NodeSelectorTerms: []k8sv1.NodeSelectorTerm{term},
+			},
+		},
+	}
+}
+
 func newAdmissionReviewForVMIMCreation(migration *v1.VirtualMachineInstanceMigration) (*admissionv1.AdmissionReview, error) {
 	migrationBytes, err := json.Marshal(migration)
 	if err != nil {
@@ -21,10 +21,12 @@ package admitters
 
 import (
 	"context"
+	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -63,6 +65,24 @@ func ensureSelectorLabelSafe(newMigration *v1.VirtualMachineInstanceMigration, o
 	return []metav1.StatusCause{}
 }
 
+// ensureControllerAnnotationsUnchanged prevents marking an existing migration as an evacuation or workload
+// update migration, which would grant it a system priority
+func ensureControllerAnnotationsUnchanged(newMigration *v1.VirtualMachineInstanceMigration, oldMigration *v1.VirtualMachineInstanceMigration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for _, annotation := range []string{v1.EvacuationMigrationAnnotation, v1.WorkloadUpdateMigrationAnnotation} {
+		newValue, newExists := newMigration.Annotations[annotation]
+		oldValue, oldExists := oldMigration.Annotations[annotation]
+		if newExists != oldExists || newValue != oldValue {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("the %s annotation can't be modified", annotation),
+				Field:   k8sfield.NewPath("metadata", "annotations").Key(annotation).String(),
+			})
+		}
+	}
+	return causes
+}
+
 func (admitter *MigrationUpdateAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	// Get new migration from admission response
 	newMigration, oldMigration, err := getAdmissionReviewMigration(ar)
@@ -90,5 +110,9 @@ func (admitter *MigrationUpdateAdmitter) Admit(_ context.Context, ar *admissionv
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	if causes := ensureControllerAnnotationsUnchanged(newMigration, oldMigration); len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	return validating_webhooks.NewPassingAdmissionResponse()
 }
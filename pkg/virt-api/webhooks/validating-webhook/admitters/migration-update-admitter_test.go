@@ -22,6 +22,7 @@ package admitters_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -227,6 +228,38 @@ var _ = Describe("Validating MigrationUpdate Admitter", func() {
 
 		Expect(resp).To(Equal(allowedAdmissionResponse()))
 	})
+
+	DescribeTable("should reject Migration on update if an annotation granting a system priority is added", func(annotation string) {
+		migration := &v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "somemigration",
+				Namespace: "default",
+				UID:       "1234",
+			},
+			Spec: v1.VirtualMachineInstanceMigrationSpec{
+				VMIName: "testvmi",
+			},
+		}
+
+		newMigration := migration.DeepCopy()
+		newMigration.Annotations = map[string]string{annotation: ""}
+
+		ar, err := newAdmissionReviewForVMIMUpdate(migration, newMigration)
+		Expect(err).ToNot(HaveOccurred())
+
+		admitter := &admitters.MigrationUpdateAdmitter{}
+		resp := admitter.Admit(context.Background(), ar)
+
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("the %s annotation can't be modified", annotation),
+			Field:   fmt.Sprintf("metadata.annotations[%s]", annotation),
+		}))
+	},
+		Entry("evacuation", v1.EvacuationMigrationAnnotation),
+		Entry("workload update", v1.WorkloadUpdateMigrationAnnotation),
+	)
 })
 
 func newAdmissionReviewForVMIMUpdate(oldMigration, newMigration *v1.VirtualMachineInstanceMigration) (*admissionv1.AdmissionReview, error) {
@@ -26,6 +26,7 @@ import (
 
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
@@ -34,15 +35,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 // MigrationPolicyAdmitter validates VirtualMachineSnapshots
 type MigrationPolicyAdmitter struct {
+	clusterConfig *virtconfig.ClusterConfig
 }
 
 // NewMigrationPolicyAdmitter creates a MigrationPolicyAdmitter
-func NewMigrationPolicyAdmitter() *MigrationPolicyAdmitter {
-	return &MigrationPolicyAdmitter{}
+func NewMigrationPolicyAdmitter(clusterConfig *virtconfig.ClusterConfig) *MigrationPolicyAdmitter {
+	return &MigrationPolicyAdmitter{
+		clusterConfig: clusterConfig,
+	}
 }
 
 // Admit validates an AdmissionReview
@@ -87,6 +92,39 @@ func (admitter *MigrationPolicyAdmitter) Admit(_ context.Context, ar *admissionv
 		}
 	}
 
+	if spec.MaxDowntimeMilliseconds != nil && *spec.MaxDowntimeMilliseconds <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be greater than zero",
+			Field:   sourceField.Child("maxDowntimeMilliseconds").String(),
+		})
+	}
+
+	// virt-handler is only attached to the migration network of the cluster
+	if spec.Network != nil && *spec.Network != "" {
+		if clusterNetwork := admitter.clusterConfig.GetMigrationConfiguration().Network; clusterNetwork == nil || *clusterNetwork != *spec.Network {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must be empty or the migration network of the cluster",
+				Field:   sourceField.Child("network").String(),
+			})
+		}
+	}
+
+	if spec.Compression != nil {
+		switch *spec.Compression {
+		case v1.MigrationCompressionXBZRLE, v1.MigrationCompressionZstd:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("must be one of %s, %s", v1.MigrationCompressionXBZRLE, v1.MigrationCompressionZstd),
+				Field:   sourceField.Child("compression").String(),
+			})
+		}
+	}
+
+	causes = append(causes, ValidateMigrationRetryPolicy(sourceField.Child("retryPolicy"), spec.RetryPolicy)...)
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -25,6 +25,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
@@ -38,6 +39,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
 var _ = Describe("Validating MigrationPolicy Admitter", func() {
@@ -45,7 +47,10 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 	var policyName string
 
 	BeforeEach(func() {
-		admitter = &MigrationPolicyAdmitter{}
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			MigrationConfiguration: &v1.MigrationConfiguration{Network: pointer.P("migration-net")},
+		})
+		admitter = NewMigrationPolicyAdmitter(config)
 		policyName = "test-policy"
 	})
 
@@ -64,6 +69,30 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("negative CompletionTimeoutPerGiB",
 			migrationsv1.MigrationPolicySpec{CompletionTimeoutPerGiB: pointer.P(int64(-1))},
 		),
+
+		Entry("zero MaxDowntimeMilliseconds",
+			migrationsv1.MigrationPolicySpec{MaxDowntimeMilliseconds: pointer.P(int64(0))},
+		),
+
+		Entry("network other than the migration network of the cluster",
+			migrationsv1.MigrationPolicySpec{Network: pointer.P("other-net")},
+		),
+
+		Entry("unknown Compression",
+			migrationsv1.MigrationPolicySpec{Compression: pointer.P(v1.MigrationCompression("gzip"))},
+		),
+
+		Entry("zero retry MaxAttempts",
+			migrationsv1.MigrationPolicySpec{RetryPolicy: &v1.MigrationRetryPolicy{MaxAttempts: pointer.P(uint32(0))}},
+		),
+
+		Entry("negative retry InitialBackoffSeconds",
+			migrationsv1.MigrationPolicySpec{RetryPolicy: &v1.MigrationRetryPolicy{InitialBackoffSeconds: pointer.P(int64(-1))}},
+		),
+
+		Entry("negative retry BandwidthPerMigrationOnRetry",
+			migrationsv1.MigrationPolicySpec{RetryPolicy: &v1.MigrationRetryPolicy{BandwidthPerMigrationOnRetry: resource.NewScaledQuantity(-1, resource.Mega)}},
+		),
 	)
 
 	DescribeTable("should accept migration policy with", func(policySpec migrationsv1.MigrationPolicySpec) {
@@ -90,6 +119,34 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 			migrationsv1.MigrationPolicySpec{BandwidthPerMigration: resource.NewScaledQuantity(0, 1)},
 		),
 
+		Entry("greater than zero MaxDowntimeMilliseconds",
+			migrationsv1.MigrationPolicySpec{MaxDowntimeMilliseconds: pointer.P(int64(500))},
+		),
+
+		Entry("zstd Compression",
+			migrationsv1.MigrationPolicySpec{Compression: pointer.P(v1.MigrationCompressionZstd)},
+		),
+
+		Entry("xbzrle Compression",
+			migrationsv1.MigrationPolicySpec{Compression: pointer.P(v1.MigrationCompressionXBZRLE)},
+		),
+
+		Entry("zero ParallelMigrationThreads",
+			migrationsv1.MigrationPolicySpec{ParallelMigrationThreads: pointer.P(uint32(0))},
+		),
+
+		Entry("pod network",
+			migrationsv1.MigrationPolicySpec{Network: pointer.P("")},
+		),
+
+		Entry("migration network of the cluster",
+			migrationsv1.MigrationPolicySpec{Network: pointer.P("migration-net")},
+		),
+
+		Entry("retry policy",
+			migrationsv1.MigrationPolicySpec{RetryPolicy: &v1.MigrationRetryPolicy{MaxAttempts: pointer.P(uint32(5)), AllowPostCopyOnRetry: pointer.P(true)}},
+		),
+
 		Entry("empty spec",
 			migrationsv1.MigrationPolicySpec{},
 		),
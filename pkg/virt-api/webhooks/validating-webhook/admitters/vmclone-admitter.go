@@ -33,8 +33,11 @@ import (
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	clonebase "kubevirt.io/api/clone"
@@ -84,6 +87,20 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ctx context.Context, ar *admi
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
+	var oldClone *clone.VirtualMachineClone
+	if ar.Request.Operation == admissionv1.Update {
+		oldClone = &clone.VirtualMachineClone{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldClone); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		// metadata changes, like the finalizers managed by the clone controller, need no validation
+		if equality.Semantic.DeepEqual(oldClone.Spec, vmClone.Spec) {
+			return &admissionv1.AdmissionResponse{
+				Allowed: true,
+			}
+		}
+	}
+
 	var causes []metav1.StatusCause
 
 	if newCauses := validateFilters(vmClone.Spec.AnnotationFilters, "spec.annotations"); newCauses != nil {
@@ -111,10 +128,20 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ctx context.Context, ar *admi
 		causes = append(causes, newCauses...)
 	}
 
+	if newCauses, err := admitter.validateTargetNamespace(ctx, ar.Request, oldClone, vmClone); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	} else if newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
 	if newCauses := validateNewMacAddresses(vmClone); newCauses != nil {
 		causes = append(causes, newCauses...)
 	}
 
+	if newCauses := validateGuestIdentity(vmClone.Spec.GuestIdentity); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -250,6 +277,7 @@ func validateTarget(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 
 	if source != nil &&
 		target != nil &&
+		!isCrossNamespaceClone(vmClone) &&
 		source.Kind == virtualMachineKind &&
 		target.Kind == virtualMachineKind &&
 		target.Name == source.Name {
@@ -263,6 +291,87 @@ func validateTarget(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	return causes
 }
 
+func isCrossNamespaceClone(vmClone *clone.VirtualMachineClone) bool {
+	return vmClone.Spec.TargetNamespace != "" && vmClone.Spec.TargetNamespace != vmClone.Namespace
+}
+
+// validateTargetNamespace makes sure that the target namespace cannot be changed after creation,
+// and that the requester of a cross namespace clone is allowed to read the source
+// and to create virtual machines in the target namespace.
+func (admitter *VirtualMachineCloneAdmitter) validateTargetNamespace(ctx context.Context, request *admissionv1.AdmissionRequest, oldClone, vmClone *clone.VirtualMachineClone) ([]metav1.StatusCause, error) {
+	field := k8sfield.NewPath("spec", "targetNamespace")
+
+	if oldClone != nil && oldClone.Spec.TargetNamespace != vmClone.Spec.TargetNamespace {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Target namespace cannot be changed",
+			Field:   field.String(),
+		}}, nil
+	}
+
+	if !isCrossNamespaceClone(vmClone) {
+		return nil, nil
+	}
+
+	if errs := validation.IsDNS1123Label(vmClone.Spec.TargetNamespace); len(errs) > 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Target namespace %s is invalid: %s", vmClone.Spec.TargetNamespace, strings.Join(errs, ", ")),
+			Field:   field.String(),
+		}}, nil
+	}
+
+	var causes []metav1.StatusCause
+
+	if source := vmClone.Spec.Source; source != nil && source.APIGroup != nil && source.Name != "" {
+		resource := ""
+		switch source.Kind {
+		case virtualMachineKind:
+			resource = "virtualmachines"
+		case virtualMachineSnapshotKind:
+			resource = "virtualmachinesnapshots"
+		}
+		if resource != "" {
+			allowed, err := isRequestAllowed(ctx, admitter.Client, request, &authv1.ResourceAttributes{
+				Namespace: vmClone.Namespace,
+				Verb:      "get",
+				Group:     *source.APIGroup,
+				Resource:  resource,
+				Name:      source.Name,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("User %s is not allowed to get %s %s in namespace %s", request.UserInfo.Username, source.Kind, source.Name, vmClone.Namespace),
+					Field:   k8sfield.NewPath("spec", "source").String(),
+				})
+			}
+		}
+	}
+
+	allowed, err := isRequestAllowed(ctx, admitter.Client, request, &authv1.ResourceAttributes{
+		Namespace: vmClone.Spec.TargetNamespace,
+		Verb:      "create",
+		Group:     v1.GroupVersion.Group,
+		Resource:  "virtualmachines",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("User %s is not allowed to create VirtualMachines in namespace %s", request.UserInfo.Username, vmClone.Spec.TargetNamespace),
+			Field:   field.String(),
+		})
+	}
+
+	return causes, nil
+}
+
 func validateNewMacAddresses(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -281,6 +390,51 @@ func validateNewMacAddresses(vmClone *clone.VirtualMachineClone) []metav1.Status
 	return causes
 }
 
+func validateGuestIdentity(guestIdentity *clone.VirtualMachineCloneGuestIdentity) []metav1.StatusCause {
+	if guestIdentity == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	field := k8sfield.NewPath("spec", "guestIdentity")
+
+	if guestIdentity.CloudInitInstanceID != nil && *guestIdentity.CloudInitInstanceID == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "cloud-init instance ID must not be empty",
+			Field:   field.Child("cloudInitInstanceID").String(),
+		})
+	}
+
+	generalization := guestIdentity.Generalization
+	if generalization == nil {
+		return causes
+	}
+	generalizationField := field.Child("generalization")
+
+	if generalization.VirtSysprep != nil {
+		for idx, operation := range generalization.VirtSysprep.Operations {
+			if operation == "" || strings.ContainsAny(operation, ", ") {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("virt-sysprep operation %q is invalid", operation),
+					Field:   generalizationField.Child("virtSysprep", "operations").Index(idx).String(),
+				})
+			}
+		}
+	}
+
+	if sysprep := generalization.Sysprep; sysprep != nil && (sysprep.ConfigMap == nil) == (sysprep.Secret == nil) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "exactly one of configMap or secret must be set as sysprep source",
+			Field:   generalizationField.Child("sysprep").String(),
+		})
+	}
+
+	return causes
+}
+
 func doesSliceContainStr(slice []string, str string) (isFound bool) {
 	for _, curSliceStr := range slice {
 		if curSliceStr == str {
@@ -32,10 +32,12 @@ import (
 
 	"github.com/golang/mock/gomock"
 	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/rand"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 
 	clonebase "kubevirt.io/api/clone"
@@ -410,6 +412,94 @@ var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 		)
 	})
 
+	Context("target namespace", func() {
+		const targetNamespace = "target-ns"
+
+		var k8sClient *k8sfake.Clientset
+
+		allowAccess := func(allowed func(attributes *authv1.ResourceAttributes) bool) {
+			k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				review := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+				review.Status.Allowed = allowed(review.Spec.ResourceAttributes)
+				return true, review, nil
+			})
+		}
+
+		BeforeEach(func() {
+			k8sClient = k8sfake.NewSimpleClientset()
+			virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+			vmClone.Spec.TargetNamespace = targetNamespace
+		})
+
+		It("should allow clone when the user has access to both namespaces", func() {
+			allowAccess(func(_ *authv1.ResourceAttributes) bool { return true })
+			admitter.admitAndExpect(vmClone, true)
+		})
+
+		It("should allow the target to have the same name as the source", func() {
+			allowAccess(func(_ *authv1.ResourceAttributes) bool { return true })
+			vmClone.Spec.Target.Name = vmClone.Spec.Source.Name
+			admitter.admitAndExpect(vmClone, true)
+		})
+
+		It("should not review access when the target namespace is the namespace of the clone", func() {
+			vmClone.Spec.TargetNamespace = vmClone.Namespace
+			admitter.admitAndExpect(vmClone, true)
+			Expect(k8sClient.Actions()).To(BeEmpty())
+		})
+
+		It("should reject an invalid target namespace", func() {
+			allowAccess(func(_ *authv1.ResourceAttributes) bool { return true })
+			vmClone.Spec.TargetNamespace = "Invalid_Namespace"
+			admitter.admitAndExpect(vmClone, false)
+		})
+
+		It("should reject clone when the user is not allowed to get the source", func() {
+			allowAccess(func(attributes *authv1.ResourceAttributes) bool {
+				return attributes.Verb != "get"
+			})
+			admitter.admitAndExpect(vmClone, false)
+		})
+
+		It("should reject clone when the user is not allowed to create virtual machines in the target namespace", func() {
+			allowAccess(func(attributes *authv1.ResourceAttributes) bool {
+				return attributes.Namespace != targetNamespace
+			})
+			admitter.admitAndExpect(vmClone, false)
+		})
+
+		It("should reject changing the target namespace", func() {
+			allowAccess(func(_ *authv1.ResourceAttributes) bool { return true })
+			oldClone := vmClone.DeepCopy()
+			oldClone.Spec.TargetNamespace = ""
+
+			ar := createCloneAdmissionReview(vmClone)
+			ar.Request.Operation = admissionv1.Update
+			oldBytes, err := json.Marshal(oldClone)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+
+			resp := admitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", "spec.targetNamespace")))
+		})
+
+		It("should allow metadata updates without reviewing access", func() {
+			oldClone := vmClone.DeepCopy()
+			vmClone.Finalizers = []string{"clone.kubevirt.io/target-namespace-cleanup"}
+
+			ar := createCloneAdmissionReview(vmClone)
+			ar.Request.Operation = admissionv1.Update
+			oldBytes, err := json.Marshal(oldClone)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+
+			resp := admitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(k8sClient.Actions()).To(BeEmpty())
+		})
+	})
+
 	DescribeTable("newMacAddresses", func(mac string, expectAllowed bool) {
 		vmClone.Spec.NewMacAddresses = map[string]string{
 			"default": mac,
@@ -420,6 +510,56 @@ var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 		Entry("invalid mac address", "00:00:00:00:00", false),
 	)
 
+	DescribeTable("guestIdentity", func(guestIdentity *clone.VirtualMachineCloneGuestIdentity, expectAllowed bool) {
+		vmClone.Spec.GuestIdentity = guestIdentity
+		admitter.admitAndExpect(vmClone, expectAllowed)
+	},
+		Entry("empty guest identity", &clone.VirtualMachineCloneGuestIdentity{}, true),
+		Entry("explicit cloud-init instance ID", &clone.VirtualMachineCloneGuestIdentity{
+			CloudInitInstanceID: pointer.P("instance-id"),
+		}, true),
+		Entry("empty cloud-init instance ID", &clone.VirtualMachineCloneGuestIdentity{
+			CloudInitInstanceID: pointer.P(""),
+		}, false),
+		Entry("virt-sysprep with operations", &clone.VirtualMachineCloneGuestIdentity{
+			Generalization: &clone.VirtualMachineCloneGeneralization{
+				VirtSysprep: &clone.VirtSysprepGeneralization{Operations: []string{"machine-id"}},
+			},
+		}, true),
+		Entry("virt-sysprep with default operations", &clone.VirtualMachineCloneGuestIdentity{
+			Generalization: &clone.VirtualMachineCloneGeneralization{
+				VirtSysprep: &clone.VirtSysprepGeneralization{},
+			},
+		}, true),
+		Entry("virt-sysprep with empty operation", &clone.VirtualMachineCloneGuestIdentity{
+			Generalization: &clone.VirtualMachineCloneGeneralization{
+				VirtSysprep: &clone.VirtSysprepGeneralization{Operations: []string{""}},
+			},
+		}, false),
+		Entry("virt-sysprep with operation list", &clone.VirtualMachineCloneGuestIdentity{
+			Generalization: &clone.VirtualMachineCloneGeneralization{
+				VirtSysprep: &clone.VirtSysprepGeneralization{Operations: []string{"machine-id,ssh-hostkeys"}},
+			},
+		}, false),
+		Entry("sysprep from config map", &clone.VirtualMachineCloneGuestIdentity{
+			Generalization: &clone.VirtualMachineCloneGeneralization{
+				Sysprep: &v1.SysprepSource{ConfigMap: &k8sv1.LocalObjectReference{Name: "unattend"}},
+			},
+		}, true),
+		Entry("sysprep without source", &clone.VirtualMachineCloneGuestIdentity{
+			Generalization: &clone.VirtualMachineCloneGeneralization{
+				Sysprep: &v1.SysprepSource{},
+			},
+		}, false),
+		Entry("sysprep with both config map and secret", &clone.VirtualMachineCloneGuestIdentity{
+			Generalization: &clone.VirtualMachineCloneGeneralization{
+				Sysprep: &v1.SysprepSource{
+					ConfigMap: &k8sv1.LocalObjectReference{Name: "unattend"},
+					Secret:    &k8sv1.LocalObjectReference{Name: "unattend"},
+				},
+			},
+		}, false),
+	)
 })
 
 func createCloneAdmissionReview(vmClone *clone.VirtualMachineClone) *admissionv1.AdmissionReview {
@@ -220,6 +220,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
 	causes = append(causes, validatePersistentState(field, spec, config)...)
 	causes = append(causes, validateDownwardMetrics(field, spec, config)...)
+	causes = append(causes, validateMetadataService(field, spec, config)...)
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
 
 	return causes
@@ -272,6 +273,32 @@ func validateDownwardMetrics(field *k8sfield.Path, spec *v1.VirtualMachineInstan
 	return causes
 }
 
+func validateMetadataService(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.MetadataService == nil {
+		return causes
+	}
+
+	if !config.MetadataServiceEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.MetadataService),
+			Field:   field.Child("metadataService").String(),
+		})
+	}
+
+	if vmispec.LookupPodNetwork(spec.Networks) == nil ||
+		!vmispec.IsPodNetworkWithMasqueradeBindingInterface(spec.Networks, spec.Domain.Devices.Interfaces) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "metadata service requires the pod network to use the masquerade binding",
+			Field:   field.Child("metadataService").String(),
+		})
+	}
+
+	return causes
+}
+
 func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -2465,6 +2465,48 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			})
 		})
 	})
+	Context("with metadata service", func() {
+		var vmi *v1.VirtualMachineInstance
+		validate := func() []metav1.StatusCause {
+			return validateMetadataService(k8sfield.NewPath("fake"), &vmi.Spec, config)
+		}
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			vmi.Spec.MetadataService = &v1.MetadataService{}
+		})
+
+		It("should accept the metadata service with masquerade binding", func() {
+			enableFeatureGate(featuregate.MetadataService)
+			Expect(validate()).To(BeEmpty())
+		})
+
+		It("should reject if feature gate is not enabled", func() {
+			Expect(validate()).To(ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   "fake.metadataService",
+				Message: "MetadataService feature gate is not enabled in kubevirt-config",
+			}))
+		})
+
+		DescribeTable("should reject", func(networks []v1.Network, ifaces []v1.Interface) {
+			enableFeatureGate(featuregate.MetadataService)
+			vmi.Spec.Networks = networks
+			vmi.Spec.Domain.Devices.Interfaces = ifaces
+			Expect(validate()).To(ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   "fake.metadataService",
+				Message: "metadata service requires the pod network to use the masquerade binding",
+			}))
+		},
+			Entry("without pod network", nil, nil),
+			Entry("with bridge binding on the pod network",
+				[]v1.Network{*v1.DefaultPodNetwork()}, []v1.Interface{*v1.DefaultBridgeNetworkInterface()}),
+		)
+	})
+
 	Context("with downwardmetrics virtio serial", func() {
 		var vmi *v1.VirtualMachineInstance
 		validate := func() []metav1.StatusCause {
@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
@@ -131,6 +132,10 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 		})
 	}
 
+	causes = append(causes, validateVMPoolUpdateStrategy(field.Child("updateStrategy"), spec.UpdateStrategy)...)
+	causes = append(causes, validateVMPoolRetentionPolicy(field.Child("persistentVolumeClaimRetentionPolicy"), spec.PersistentVolumeClaimRetentionPolicy)...)
+	causes = append(causes, validateVMPoolAutohealing(field.Child("autohealing"), spec.Autohealing)...)
+
 	if ar.Request.Operation == admissionv1.Update {
 		oldPool := &poolv1.VirtualMachinePool{}
 		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldPool); err != nil {
@@ -150,3 +155,134 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 	}
 	return causes
 }
+
+func validateVMPoolUpdateStrategy(field *k8sfield.Path, strategy *poolv1.VirtualMachinePoolUpdateStrategy) []metav1.StatusCause {
+	if strategy == nil {
+		return nil
+	}
+
+	switch strategy.Type {
+	case "", poolv1.VirtualMachinePoolRollingUpdateStrategyType:
+	case poolv1.VirtualMachinePoolOnDeleteStrategyType:
+		if strategy.RollingUpdate != nil {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("rollingUpdate is not allowed with the %s update strategy", strategy.Type),
+				Field:   field.Child("rollingUpdate").String(),
+			}}
+		}
+		return nil
+	default:
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("update strategy %s is not supported", strategy.Type),
+			Field:   field.Child("type").String(),
+		}}
+	}
+
+	if strategy.RollingUpdate == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	maxUnavailable, unavailableCauses := validateIntOrPercent(field.Child("rollingUpdate", "maxUnavailable"), strategy.RollingUpdate.MaxUnavailable, 1)
+	causes = append(causes, unavailableCauses...)
+	maxSurge, surgeCauses := validateIntOrPercent(field.Child("rollingUpdate", "maxSurge"), strategy.RollingUpdate.MaxSurge, 0)
+	causes = append(causes, surgeCauses...)
+
+	if len(causes) == 0 && maxUnavailable == 0 && maxSurge == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxUnavailable and maxSurge can not both be 0",
+			Field:   field.Child("rollingUpdate", "maxUnavailable").String(),
+		})
+	}
+	return causes
+}
+
+// validateIntOrPercent validates a non-negative absolute number or percentage.
+// The returned value is the absolute number, or the percentage of 100.
+func validateIntOrPercent(field *k8sfield.Path, value *intstr.IntOrString, defaultValue int) (int, []metav1.StatusCause) {
+	if value == nil {
+		return defaultValue, nil
+	}
+
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true)
+	if err != nil {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: err.Error(),
+			Field:   field.String(),
+		}}
+	}
+	if scaled < 0 {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be negative", field.String()),
+			Field:   field.String(),
+		}}
+	}
+	return scaled, nil
+}
+
+func validateVMPoolRetentionPolicy(field *k8sfield.Path, policy *poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy) []metav1.StatusCause {
+	if policy == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	causes = append(causes, validateRetentionPolicyType(field.Child("whenDeleted"), policy.WhenDeleted)...)
+	causes = append(causes, validateRetentionPolicyType(field.Child("whenScaled"), policy.WhenScaled)...)
+	return causes
+}
+
+func validateRetentionPolicyType(field *k8sfield.Path, policyType poolv1.PersistentVolumeClaimRetentionPolicyType) []metav1.StatusCause {
+	switch policyType {
+	case "", poolv1.RetainPersistentVolumeClaimRetentionPolicyType, poolv1.DeletePersistentVolumeClaimRetentionPolicyType:
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("retention policy %s is not supported", policyType),
+		Field:   field.String(),
+	}}
+}
+
+func validateVMPoolAutohealing(field *k8sfield.Path, autohealing *poolv1.VirtualMachinePoolAutohealing) []metav1.StatusCause {
+	if autohealing == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	for i, check := range autohealing.HealthChecks {
+		switch check {
+		case poolv1.VirtualMachinePoolReadyHealthCheck, poolv1.VirtualMachinePoolAgentConnectedHealthCheck:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("health check %s is not supported", check),
+				Field:   field.Child("healthChecks").Index(i).String(),
+			})
+		}
+	}
+
+	if autohealing.UnhealthyTimeout != nil && autohealing.UnhealthyTimeout.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "unhealthyTimeout must be greater than 0",
+			Field:   field.Child("unhealthyTimeout").String(),
+		})
+	}
+
+	maxConcurrent, maxConcurrentCauses := validateIntOrPercent(field.Child("maxConcurrentReplacements"), autohealing.MaxConcurrentReplacements, 1)
+	causes = append(causes, maxConcurrentCauses...)
+	if len(maxConcurrentCauses) == 0 && maxConcurrent == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxConcurrentReplacements must be greater than 0",
+			Field:   field.Child("maxConcurrentReplacements").String(),
+		})
+	}
+
+	return causes
+}
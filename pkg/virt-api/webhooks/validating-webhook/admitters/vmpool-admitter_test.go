@@ -22,17 +22,20 @@ package admitters
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "kubevirt.io/api/core/v1"
 	virtv1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 )
@@ -137,8 +140,8 @@ var _ = Describe("Validating Pool Admitter", func() {
 			"spec.selector",
 		}),
 	)
-	It("should accept valid vm spec", func() {
-		pool := &poolv1.VirtualMachinePool{
+	newValidPool := func() *poolv1.VirtualMachinePool {
+		return &poolv1.VirtualMachinePool{
 			Spec: poolv1.VirtualMachinePoolSpec{
 				Selector: &metav1.LabelSelector{
 					MatchLabels: map[string]string{"match": "me"},
@@ -166,6 +169,9 @@ var _ = Describe("Validating Pool Admitter", func() {
 				},
 			},
 		}
+	}
+
+	admitPool := func(pool *poolv1.VirtualMachinePool) *admissionv1.AdmissionResponse {
 		poolBytes, _ := json.Marshal(&pool)
 
 		ar := &admissionv1.AdmissionReview{
@@ -177,7 +183,116 @@ var _ = Describe("Validating Pool Admitter", func() {
 			},
 		}
 
-		resp := poolAdmitter.Admit(context.Background(), ar)
+		return poolAdmitter.Admit(context.Background(), ar)
+	}
+
+	It("should accept valid vm spec", func() {
+		resp := admitPool(newValidPool())
 		Expect(resp.Allowed).To(BeTrue())
 	})
+
+	DescribeTable("should accept a valid update strategy", func(strategy *poolv1.VirtualMachinePoolUpdateStrategy) {
+		pool := newValidPool()
+		pool.Spec.UpdateStrategy = strategy
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeTrue())
+	},
+		Entry("with the default rolling update", &poolv1.VirtualMachinePoolUpdateStrategy{}),
+		Entry("with the OnDelete type", &poolv1.VirtualMachinePoolUpdateStrategy{Type: poolv1.VirtualMachinePoolOnDeleteStrategyType}),
+		Entry("with percentages", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromString("25%")),
+				MaxSurge:       pointer.P(intstr.FromString("10%")),
+				LiveUpdate:     true,
+			},
+		}),
+		Entry("with surge only", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+				MaxSurge:       pointer.P(intstr.FromInt32(1)),
+			},
+		}),
+	)
+
+	DescribeTable("should reject an invalid update strategy", func(strategy *poolv1.VirtualMachinePoolUpdateStrategy, field string) {
+		pool := newValidPool()
+		pool.Spec.UpdateStrategy = strategy
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("with an unknown type", &poolv1.VirtualMachinePoolUpdateStrategy{Type: "Recreate"}, "spec.updateStrategy.type"),
+		Entry("with rollingUpdate on the OnDelete type", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type:          poolv1.VirtualMachinePoolOnDeleteStrategyType,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{},
+		}, "spec.updateStrategy.rollingUpdate"),
+		Entry("with a negative maxSurge", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{MaxSurge: pointer.P(intstr.FromInt32(-1))},
+		}, "spec.updateStrategy.rollingUpdate.maxSurge"),
+		Entry("with an invalid percentage", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{MaxUnavailable: pointer.P(intstr.FromString("ten"))},
+		}, "spec.updateStrategy.rollingUpdate.maxUnavailable"),
+		Entry("with maxUnavailable and maxSurge of 0", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromString("0%")),
+				MaxSurge:       pointer.P(intstr.FromInt32(0)),
+			},
+		}, "spec.updateStrategy.rollingUpdate.maxUnavailable"),
+	)
+
+	It("should accept a valid volume retention policy", func() {
+		pool := newValidPool()
+		pool.Spec.PersistentVolumeClaimRetentionPolicy = &poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy{
+			WhenDeleted: poolv1.DeletePersistentVolumeClaimRetentionPolicyType,
+			WhenScaled:  poolv1.RetainPersistentVolumeClaimRetentionPolicyType,
+		}
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject an unknown volume retention policy", func() {
+		pool := newValidPool()
+		pool.Spec.PersistentVolumeClaimRetentionPolicy = &poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy{
+			WhenScaled: "Archive",
+		}
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.persistentVolumeClaimRetentionPolicy.whenScaled"))
+	})
+
+	It("should accept a valid autohealing configuration", func() {
+		pool := newValidPool()
+		pool.Spec.Autohealing = &poolv1.VirtualMachinePoolAutohealing{
+			HealthChecks: []poolv1.VirtualMachinePoolHealthCheck{
+				poolv1.VirtualMachinePoolReadyHealthCheck,
+				poolv1.VirtualMachinePoolAgentConnectedHealthCheck,
+			},
+			UnhealthyTimeout:          &metav1.Duration{Duration: 10 * time.Minute},
+			MaxConcurrentReplacements: pointer.P(intstr.FromString("20%")),
+		}
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	DescribeTable("should reject an invalid autohealing configuration", func(autohealing *poolv1.VirtualMachinePoolAutohealing, field string) {
+		pool := newValidPool()
+		pool.Spec.Autohealing = autohealing
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("with an unknown health check", &poolv1.VirtualMachinePoolAutohealing{
+			HealthChecks: []poolv1.VirtualMachinePoolHealthCheck{poolv1.VirtualMachinePoolReadyHealthCheck, "Pingable"},
+		}, "spec.autohealing.healthChecks[1]"),
+		Entry("with a zero unhealthy timeout", &poolv1.VirtualMachinePoolAutohealing{
+			UnhealthyTimeout: &metav1.Duration{},
+		}, "spec.autohealing.unhealthyTimeout"),
+		Entry("with zero concurrent replacements", &poolv1.VirtualMachinePoolAutohealing{
+			MaxConcurrentReplacements: pointer.P(intstr.FromInt32(0)),
+		}, "spec.autohealing.maxConcurrentReplacements"),
+	)
 })
@@ -173,3 +173,15 @@ func (config *ClusterConfig) AlignCPUsEnabled() bool {
 func (config *ClusterConfig) NodeRestrictionEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.NodeRestrictionGate)
 }
+
+func (config *ClusterConfig) CrossClusterLiveMigrationEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.CrossClusterLiveMigration)
+}
+
+func (config *ClusterConfig) MigrationPriorityQueueEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.MigrationPriorityQueue)
+}
+
+func (config *ClusterConfig) MetadataServiceEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.MetadataService)
+}
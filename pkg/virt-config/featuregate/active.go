@@ -65,6 +65,24 @@ const (
 	// through the kv.spec.configuration.instancetype.referencePolicy configurable.
 	InstancetypeReferencePolicy = "InstancetypeReferencePolicy"
 
+	// Alpha: v1.5.0
+	//
+	// CrossClusterLiveMigration allows to live migrate VMIs between clusters
+	// through the sendTo and receive sections of VirtualMachineInstanceMigrations.
+	CrossClusterLiveMigration = "CrossClusterLiveMigration"
+
+	// Alpha: v1.5.0
+	//
+	// MigrationPriorityQueue starts pending migrations by priority and
+	// shares the parallel migration limits fairly between namespaces.
+	MigrationPriorityQueue = "MigrationPriorityQueue"
+
+	// Alpha: v1.5.0
+	//
+	// MetadataService allows VMIs to expose an EC2 and OpenStack compatible
+	// metadata service to the guest, on the link-local address 169.254.169.254.
+	MetadataService = "MetadataService"
+
 	VirtIOFSConfigVolumesGate = "EnableVirtioFsConfigVolumes"
 	VirtIOFSStorageVolumeGate = "EnableVirtioFsStorageVolumes"
 )
@@ -93,6 +111,9 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: AlignCPUsGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: NodeRestrictionGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: InstancetypeReferencePolicy, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: CrossClusterLiveMigration, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: MigrationPriorityQueue, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: MetadataService, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: VirtIOFSConfigVolumesGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: VirtIOFSStorageVolumeGate, State: Alpha})
 }
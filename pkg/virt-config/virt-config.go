@@ -477,6 +477,14 @@ func (c *ClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugi
 	return nil
 }
 
+func (c *ClusterConfig) GetInterfaceTrafficFilter() *v1.InterfaceTrafficFilter {
+	networkConfig := c.GetConfig().NetworkConfiguration
+	if networkConfig != nil {
+		return networkConfig.TrafficFilter
+	}
+	return nil
+}
+
 func (config *ClusterConfig) VGADisplayForEFIGuestsEnabled() bool {
 	VGADisplayForEFIGuestsAnnotationExists := false
 	kv := config.GetConfigFromKubeVirtCR()
@@ -155,6 +155,12 @@ func withVMIVolumes(pvcStore cache.Store, vmiSpecVolumes []v1.Volume, vmiVolumeS
 				}
 			}
 
+			if types.IsSavedStateVolume(&volume) {
+				if err := renderer.handleSavedStateVolume(volume, pvcStore); err != nil {
+					return err
+				}
+			}
+
 			if volume.DownwardMetrics != nil {
 				renderer.handleDownwardMetrics(volume)
 			}
@@ -605,6 +611,22 @@ func (vr *VolumeRenderer) handleDataVolume(volume v1.Volume, pvcStore cache.Stor
 	return nil
 }
 
+func (vr *VolumeRenderer) handleSavedStateVolume(volume v1.Volume, pvcStore cache.Store) error {
+	claimName := volume.MemoryDump.ClaimName
+	if err := vr.addPVCToLaunchManifest(pvcStore, volume, claimName); err != nil {
+		return err
+	}
+	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+		Name: volume.Name,
+		VolumeSource: k8sv1.VolumeSource{
+			PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+			},
+		},
+	})
+	return nil
+}
+
 func (vr *VolumeRenderer) handleHostDisk(volume v1.Volume) {
 	var hostPathType k8sv1.HostPathType
 
@@ -288,6 +288,72 @@ var _ = Describe("Container spec renderer", func() {
 		})
 	})
 
+	Context("with a memory dump volume", func() {
+		const (
+			memoryDumpVolumeName = "memory-state"
+		)
+
+		newMemoryDumpVolume := func(hotpluggable bool, format v1.MemoryDumpFormat) v1.Volume {
+			return v1.Volume{
+				Name: memoryDumpVolumeName,
+				VolumeSource: v1.VolumeSource{MemoryDump: &v1.MemoryDumpVolumeSource{
+					PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: memoryDumpVolumeName,
+						},
+						Hotpluggable: hotpluggable,
+					},
+					Format: format,
+				}},
+			}
+		}
+
+		pvcStore := &cache.FakeCustomStore{
+			GetByKeyFunc: func(key string) (item interface{}, exists bool, err error) {
+				return &k8sv1.PersistentVolumeClaim{}, true, nil
+			},
+		}
+
+		It("should mount a saved state volume into the launcher", func() {
+			var err error
+			vsr, err = NewVolumeRenderer(namespace, ephemeralDisk, containerDisk, virtShareDir,
+				withVMIVolumes(pvcStore, []v1.Volume{newMemoryDumpVolume(false, v1.MemoryDumpFormatSaveState)}, nil))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      memoryDumpVolumeName,
+						MountPath: "/var/run/kubevirt-private/vmi-disks/memory-state",
+					})))
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: memoryDumpVolumeName,
+						VolumeSource: k8sv1.VolumeSource{
+							PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: memoryDumpVolumeName,
+							},
+						},
+					})))
+		})
+
+		DescribeTable("should not mount", func(volume v1.Volume) {
+			var err error
+			vsr, err = NewVolumeRenderer(namespace, ephemeralDisk, containerDisk, virtShareDir,
+				withVMIVolumes(pvcStore, []v1.Volume{volume}, nil))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(vsr.Mounts()).To(ConsistOf(defaultVolumeMounts()))
+			Expect(vsr.Volumes()).To(ConsistOf(defaultVolumes()))
+		},
+			Entry("a hotplugged memory dump volume", newMemoryDumpVolume(true, v1.MemoryDumpFormatRaw)),
+			Entry("a hotplugged save state volume", newMemoryDumpVolume(true, v1.MemoryDumpFormatSaveState)),
+		)
+	})
+
 	Context("with Downward API option", func() {
 		const (
 			downwardAPIVolumeName = "downward-then-upward"
@@ -87,6 +87,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/service"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
+	"kubevirt.io/kubevirt/pkg/storage/storagemigration"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/leaderelectionconfig"
@@ -184,8 +185,9 @@ type VirtControllerApp struct {
 	cdiInformer            cache.SharedIndexInformer
 	cdiConfigInformer      cache.SharedIndexInformer
 
-	migrationController *migration.Controller
-	migrationInformer   cache.SharedIndexInformer
+	migrationController             *migration.Controller
+	migrationPolicyStatusController *migration.PolicyStatusController
+	migrationInformer               cache.SharedIndexInformer
 
 	workloadUpdateController *workloadupdater.WorkloadUpdateController
 
@@ -195,6 +197,7 @@ type VirtControllerApp struct {
 	exportController             *export.VMExportController
 	snapshotController           *snapshot.VMSnapshotController
 	restoreController            *snapshot.VMRestoreController
+	snapshotScheduleController   *snapshot.VMSnapshotScheduleController
 	vmExportInformer             cache.SharedIndexInformer
 	routeCache                   cache.Store
 	ingressCache                 cache.Store
@@ -202,6 +205,8 @@ type VirtControllerApp struct {
 	vmSnapshotInformer           cache.SharedIndexInformer
 	vmSnapshotContentInformer    cache.SharedIndexInformer
 	vmRestoreInformer            cache.SharedIndexInformer
+	vmSnapshotScheduleInformer   cache.SharedIndexInformer
+	vmSnapshotGroupInformer      cache.SharedIndexInformer
 	storageClassInformer         cache.SharedIndexInformer
 	allPodInformer               cache.SharedIndexInformer
 	resourceQuotaInformer        cache.SharedIndexInformer
@@ -210,6 +215,9 @@ type VirtControllerApp struct {
 
 	migrationPolicyInformer cache.SharedIndexInformer
 
+	storageMigrationController *storagemigration.VMStorageMigrationController
+	vmStorageMigrationInformer cache.SharedIndexInformer
+
 	vmCloneInformer   cache.SharedIndexInformer
 	vmCloneController *clonecontroller.VMCloneController
 
@@ -242,20 +250,23 @@ type VirtControllerApp struct {
 	reInitChan chan string
 
 	// number of threads for each controller
-	nodeControllerThreads             int
-	vmiControllerThreads              int
-	rsControllerThreads               int
-	poolControllerThreads             int
-	vmControllerThreads               int
-	migrationControllerThreads        int
-	evacuationControllerThreads       int
-	disruptionBudgetControllerThreads int
-	launcherSubGid                    int64
-	exportControllerThreads           int
-	snapshotControllerThreads         int
-	restoreControllerThreads          int
-	snapshotControllerResyncPeriod    time.Duration
-	cloneControllerThreads            int
+	nodeControllerThreads                  int
+	vmiControllerThreads                   int
+	rsControllerThreads                    int
+	poolControllerThreads                  int
+	vmControllerThreads                    int
+	migrationControllerThreads             int
+	migrationPolicyStatusControllerThreads int
+	evacuationControllerThreads            int
+	disruptionBudgetControllerThreads      int
+	launcherSubGid                         int64
+	exportControllerThreads                int
+	snapshotControllerThreads              int
+	restoreControllerThreads               int
+	snapshotScheduleControllerThreads      int
+	storageMigrationControllerThreads      int
+	snapshotControllerResyncPeriod         time.Duration
+	cloneControllerThreads                 int
 
 	caConfigMapName          string
 	promCertFilePath         string
@@ -383,6 +394,8 @@ func Execute() {
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmSnapshotScheduleInformer = app.informerFactory.VirtualMachineSnapshotSchedule()
+	app.vmSnapshotGroupInformer = app.informerFactory.VirtualMachineSnapshotGroup()
 	app.storageClassInformer = app.informerFactory.StorageClass()
 	app.caExportConfigMapInformer = app.informerFactory.KubeVirtExportCAConfigMap()
 	app.exportRouteConfigMapInformer = app.informerFactory.ExportRouteConfigMap()
@@ -423,6 +436,7 @@ func Execute() {
 	}
 	app.ingressCache = app.informerFactory.Ingress().GetStore()
 	app.migrationPolicyInformer = app.informerFactory.MigrationPolicy()
+	app.vmStorageMigrationInformer = app.informerFactory.VirtualMachineStorageMigration()
 
 	app.vmCloneInformer = app.informerFactory.VirtualMachineClone()
 
@@ -460,6 +474,8 @@ func Execute() {
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
+	app.initSnapshotScheduleController()
+	app.initStorageMigrationController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
@@ -573,6 +589,7 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		go vca.poolController.Run(vca.poolControllerThreads, stop)
 		go vca.vmController.Run(vca.vmControllerThreads, stop)
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
+		go vca.migrationPolicyStatusController.Run(vca.migrationPolicyStatusControllerThreads, stop)
 		go func() {
 			if err := vca.snapshotController.Run(vca.snapshotControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the snapshot controller: %v", err)
@@ -583,6 +600,16 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the restore controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.snapshotScheduleController.Run(vca.snapshotScheduleControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot schedule controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.storageMigrationController.Run(vca.storageMigrationControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the storage migration controller: %v", err)
+			}
+		}()
 		go func() {
 			if err := vca.exportController.Run(vca.exportControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the export controller: %v", err)
@@ -692,9 +719,23 @@ func (vca *VirtControllerApp) initCommon() {
 		vca.pdbInformer,
 		vca.migrationPolicyInformer,
 		vca.resourceQuotaInformer,
+		vca.unmanagedSecretInformer,
+		vca.namespaceInformer,
 		vca.vmiRecorder,
 		clientSet,
 		vca.clusterConfig,
+		vca.kubevirtNamespace,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	vca.migrationPolicyStatusController, err = migration.NewPolicyStatusController(
+		vca.clientSet,
+		vca.migrationPolicyInformer,
+		vca.vmiInformer,
+		vca.migrationInformer,
+		vca.namespaceInformer,
 	)
 	if err != nil {
 		panic(err)
@@ -720,6 +761,8 @@ func (vca *VirtControllerApp) initPool() {
 		vca.vmInformer,
 		vca.poolInformer,
 		vca.controllerRevisionInformer,
+		vca.dataVolumeInformer,
+		vca.persistentVolumeClaimInformer,
 		recorder,
 		controller.BurstReplicas)
 	if err != nil {
@@ -820,6 +863,7 @@ func (vca *VirtControllerApp) initSnapshotController() {
 		Client:                    vca.clientSet,
 		VMSnapshotInformer:        vca.vmSnapshotInformer,
 		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
+		VMSnapshotGroupInformer:   vca.vmSnapshotGroupInformer,
 		VMInformer:                vca.vmInformer,
 		VMIInformer:               vca.vmiInformer,
 		StorageClassInformer:      vca.storageClassInformer,
@@ -858,6 +902,35 @@ func (vca *VirtControllerApp) initRestoreController() {
 	}
 }
 
+func (vca *VirtControllerApp) initSnapshotScheduleController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-schedule-controller")
+	vca.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+		Client:                     vca.clientSet,
+		VMSnapshotScheduleInformer: vca.vmSnapshotScheduleInformer,
+		VMSnapshotInformer:         vca.vmSnapshotInformer,
+		VMInformer:                 vca.vmInformer,
+		Recorder:                   recorder,
+	}
+	if err := vca.snapshotScheduleController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initStorageMigrationController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "storage-migration-controller")
+	vca.storageMigrationController = &storagemigration.VMStorageMigrationController{
+		Client:                     vca.clientSet,
+		VMStorageMigrationInformer: vca.vmStorageMigrationInformer,
+		VMInformer:                 vca.vmInformer,
+		VMIInformer:                vca.vmiInformer,
+		PVCInformer:                vca.persistentVolumeClaimInformer,
+		Recorder:                   recorder,
+	}
+	if err := vca.storageMigrationController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initExportController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "export-controller")
 	vca.exportController = &export.VMExportController{
@@ -897,7 +970,7 @@ func (vca *VirtControllerApp) initCloneController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "clone-controller")
 	vca.vmCloneController, err = clonecontroller.NewVmCloneController(
-		vca.clientSet, vca.vmCloneInformer, vca.vmSnapshotInformer, vca.vmRestoreInformer, vca.vmInformer, vca.vmSnapshotContentInformer, vca.persistentVolumeClaimInformer, recorder,
+		vca.clientSet, vca.vmCloneInformer, vca.vmSnapshotInformer, vca.vmRestoreInformer, vca.vmInformer, vca.vmSnapshotContentInformer, vca.persistentVolumeClaimInformer, vca.kvPodInformer, vca.clusterConfig, recorder,
 	)
 	if err != nil {
 		panic(err)
@@ -977,6 +1050,9 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.migrationControllerThreads, "migration-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for migration controller")
 
+	flag.IntVar(&vca.migrationPolicyStatusControllerThreads, "migration-policy-status-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for migration policy status controller")
+
 	flag.IntVar(&vca.evacuationControllerThreads, "evacuation-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for evacuation controller")
 
@@ -992,6 +1068,12 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.restoreControllerThreads, "restore-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for restore controller")
 
+	flag.IntVar(&vca.snapshotScheduleControllerThreads, "snapshot-schedule-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for snapshot schedule controller")
+
+	flag.IntVar(&vca.storageMigrationControllerThreads, "storage-migration-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for storage migration controller")
+
 	flag.IntVar(&vca.exportControllerThreads, "export-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for virtual machine export controller")
 
@@ -56,6 +56,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/rest"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
+	"kubevirt.io/kubevirt/pkg/storage/storagemigration"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
@@ -114,6 +115,9 @@ var _ = Describe("Application", func() {
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmSnapshotScheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmSnapshotGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+		vmStorageMigrationInformer, _ := testutils.NewFakeInformerWithIndexersFor(&migrationsv1.VirtualMachineStorageMigration{}, controller.GetVirtualMachineStorageMigrationInformerIndexers())
 		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
 		configMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		routeConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
@@ -181,14 +185,18 @@ var _ = Describe("Application", func() {
 			pdbInformer,
 			migrationPolicyInformer,
 			resourceQuotaInformer,
+			secretInformer,
+			namespaceInformer,
 			recorder,
 			virtClient,
 			config,
+			"kubevirt",
 		)
 		app.snapshotController = &snapshot.VMSnapshotController{
 			Client:                    virtClient,
 			VMSnapshotInformer:        vmSnapshotInformer,
 			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			VMSnapshotGroupInformer:   vmSnapshotGroupInformer,
 			VMInformer:                vmInformer,
 			VMIInformer:               vmiInformer,
 			PodInformer:               podInformer,
@@ -214,6 +222,23 @@ var _ = Describe("Application", func() {
 			Recorder:                  recorder,
 		}
 		_ = app.restoreController.Init()
+		app.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			Recorder:                   recorder,
+		}
+		_ = app.snapshotScheduleController.Init()
+		app.storageMigrationController = &storagemigration.VMStorageMigrationController{
+			Client:                     virtClient,
+			VMStorageMigrationInformer: vmStorageMigrationInformer,
+			VMInformer:                 vmInformer,
+			VMIInformer:                vmiInformer,
+			PVCInformer:                pvcInformer,
+			Recorder:                   recorder,
+		}
+		_ = app.storageMigrationController.Init()
 		app.exportController = &export.VMExportController{
 			Client:                      virtClient,
 			ManifestRenderer:            services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
@@ -251,6 +276,8 @@ var _ = Describe("Application", func() {
 			vmInformer,
 			vmSnapshotContentInformer,
 			pvcInformer,
+			podInformer,
+			config,
 			recorder,
 		)
 
@@ -57,14 +57,16 @@ const (
 )
 
 type syncInfoType struct {
-	err             error
-	snapshotName    string
-	snapshotReady   bool
-	restoreName     string
-	restoreReady    bool
-	targetVMName    string
-	targetVMCreated bool
-	pvcBound        bool
+	err                 error
+	snapshotName        string
+	snapshotReady       bool
+	restoreName         string
+	restoreReady        bool
+	snapshotTransferred bool
+	targetVMName        string
+	targetVMCreated     bool
+	generalized         bool
+	pvcBound            bool
 
 	isCloneFailing bool
 	failEvent      Event
@@ -97,15 +99,22 @@ func (ctrl *VMCloneController) execute(key string) error {
 		return nil
 	}
 
+	if isCrossNamespace(vmClone) {
+		if done, err := ctrl.syncTargetNamespaceCleanup(vmClone); err != nil || done {
+			return err
+		}
+	}
+
 	if vmClone.Status.Phase == clone.Succeeded {
-		_, vmExists, err := ctrl.vmStore.GetByKey(fmt.Sprintf("%s/%s", vmClone.Namespace, *vmClone.Status.TargetName))
+		targetNamespace := getTargetNamespace(vmClone)
+		_, vmExists, err := ctrl.vmStore.GetByKey(fmt.Sprintf("%s/%s", targetNamespace, *vmClone.Status.TargetName))
 		if err != nil {
 			return err
 		}
 
 		if !vmExists {
 			if vmClone.DeletionTimestamp == nil {
-				logger.V(3).Infof("Deleting vm clone for deleted vm %s/%s", vmClone.Namespace, *vmClone.Status.TargetName)
+				logger.V(3).Infof("Deleting vm clone for deleted vm %s/%s", targetNamespace, *vmClone.Status.TargetName)
 				return ctrl.client.VirtualMachineClone(vmClone.Namespace).Delete(context.Background(), vmClone.Name, v1.DeleteOptions{})
 			}
 			// nothing to process for a vm clone that's being deleted
@@ -218,11 +227,20 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 				return syncInfo
 			}
 
-			syncInfo = ctrl.createRestoreFromVm(vmClone, vm, vmCloneInfo.snapshotName, syncInfo)
+			restoreSnapshotName := vmCloneInfo.snapshotName
+			if isCrossNamespace(vmClone) {
+				syncInfo = ctrl.transferSnapshot(vmClone, vmCloneInfo.snapshot, syncInfo)
+				if syncInfo.isFailingOrError() || !syncInfo.snapshotTransferred {
+					return syncInfo
+				}
+				restoreSnapshotName = generateSnapshotName(vmClone.UID)
+			}
+
+			syncInfo = ctrl.createRestoreFromVm(vmClone, vm, restoreSnapshotName, syncInfo)
 			return syncInfo
 		}
 
-		syncInfo = ctrl.verifyRestoreReady(vmClone, vmCloneInfo.vmClone.Namespace, syncInfo)
+		syncInfo = ctrl.verifyRestoreReady(vmClone, getTargetNamespace(vmClone), syncInfo)
 		if syncInfo.isFailingOrError() || !syncInfo.restoreReady {
 			return syncInfo
 		}
@@ -238,6 +256,17 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 
 		fallthrough
 
+	case clone.GeneralizationInProgress:
+
+		if needsVirtSysprep(vmClone) {
+			syncInfo = ctrl.generalize(vmClone, syncInfo)
+			if syncInfo.isFailingOrError() || !syncInfo.generalized {
+				return syncInfo
+			}
+		}
+
+		fallthrough
+
 	case clone.Succeeded:
 
 		if vmClone.Status.RestoreName != nil {
@@ -251,6 +280,20 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 				return syncInfo
 			}
 
+			if needsVirtSysprep(vmClone) {
+				syncInfo = ctrl.cleanupVirtSysprepPod(vmClone, syncInfo)
+				if syncInfo.isFailingOrError() {
+					return syncInfo
+				}
+			}
+
+			if isCrossNamespace(vmClone) {
+				syncInfo = ctrl.cleanupTransferredSnapshot(vmClone, syncInfo)
+				if syncInfo.isFailingOrError() {
+					return syncInfo
+				}
+			}
+
 			if vmCloneInfo.sourceType == sourceTypeVM {
 				syncInfo = ctrl.cleanupSnapshot(vmClone, syncInfo)
 				if syncInfo.isFailingOrError() {
@@ -316,8 +359,16 @@ func (ctrl *VMCloneController) updateStatus(origClone *clone.VirtualMachineClone
 		}
 
 		if syncInfo.targetVMCreated {
+			if needsVirtSysprep(vmClone) {
+				assignPhase(clone.GeneralizationInProgress)
+			} else {
+				assignPhase(clone.Succeeded)
+			}
+		}
+	}
+	if isInPhase(vmClone, clone.GeneralizationInProgress) {
+		if syncInfo.generalized {
 			assignPhase(clone.Succeeded)
-
 		}
 	}
 	if isInPhase(vmClone, clone.Succeeded) {
@@ -411,14 +462,19 @@ func (ctrl *VMCloneController) getSnapshot(snapshotName string, sourceNamespace
 }
 
 func (ctrl *VMCloneController) createRestoreFromVm(vmClone *clone.VirtualMachineClone, vm *k6tv1.VirtualMachine, snapshotName string, syncInfo syncInfoType) syncInfoType {
-	patches, err := generatePatches(vm, &vmClone.Spec)
+	patches, err := generatePatches(vm, vmClone)
 	if err != nil {
 		retErr := fmt.Errorf("error generating patches for clone %s: %v", vmClone.Name, err)
 		ctrl.recorder.Event(vmClone, corev1.EventTypeWarning, string(RestoreCreationFailed), retErr.Error())
 		syncInfo.setError(retErr)
 		return syncInfo
 	}
-	restore := generateRestore(vmClone.Spec.Target, vm.Name, vmClone.Namespace, vmClone.Name, snapshotName, vmClone.UID, patches)
+	restore := generateRestore(vmClone.Spec.Target, vm.Name, getTargetNamespace(vmClone), vmClone.Name, snapshotName, vmClone.UID, patches)
+	if isCrossNamespace(vmClone) {
+		// owner references cannot cross namespaces
+		restore.OwnerReferences = nil
+		restore.Annotations = getCloneAnnotations(vmClone)
+	}
 	log.Log.Object(vmClone).Infof("creating restore %s for clone %s", restore.Name, vmClone.Name)
 	createdRestore, err := ctrl.client.VirtualMachineRestore(restore.Namespace).Create(context.Background(), restore, v1.CreateOptions{})
 	if err != nil {
@@ -439,8 +495,8 @@ func (ctrl *VMCloneController) createRestoreFromVm(vmClone *clone.VirtualMachine
 	return syncInfo
 }
 
-func (ctrl *VMCloneController) verifyRestoreReady(vmClone *clone.VirtualMachineClone, sourceNamespace string, syncInfo syncInfoType) syncInfoType {
-	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(*vmClone.Status.RestoreName, sourceNamespace))
+func (ctrl *VMCloneController) verifyRestoreReady(vmClone *clone.VirtualMachineClone, targetNamespace string, syncInfo syncInfoType) syncInfoType {
+	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(*vmClone.Status.RestoreName, targetNamespace))
 	if !exists {
 		syncInfo.setError(fmt.Errorf("restore %s is not created yet for clone %s", *vmClone.Status.RestoreName, vmClone.Name))
 		return syncInfo
@@ -467,7 +523,7 @@ func (ctrl *VMCloneController) verifyRestoreReady(vmClone *clone.VirtualMachineC
 func (ctrl *VMCloneController) verifyVmReady(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
 	targetVMInfo := vmClone.Spec.Target
 
-	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, vmClone.Namespace))
+	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, getTargetNamespace(vmClone)))
 	if !exists {
 		syncInfo.setError(fmt.Errorf("target VM %s is not created yet for clone %s", targetVMInfo.Name, vmClone.Name))
 		return syncInfo
@@ -483,7 +539,7 @@ func (ctrl *VMCloneController) verifyVmReady(vmClone *clone.VirtualMachineClone,
 }
 
 func (ctrl *VMCloneController) verifyPVCBound(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
-	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(*vmClone.Status.RestoreName, vmClone.Namespace))
+	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(*vmClone.Status.RestoreName, getTargetNamespace(vmClone)))
 	if !exists {
 		syncInfo.setError(fmt.Errorf("restore %s is not created yet for clone %s", *vmClone.Status.RestoreName, vmClone.Name))
 		return syncInfo
@@ -494,7 +550,7 @@ func (ctrl *VMCloneController) verifyPVCBound(vmClone *clone.VirtualMachineClone
 
 	restore := obj.(*snapshotv1.VirtualMachineRestore)
 	for _, volumeRestore := range restore.Status.Restores {
-		obj, exists, err = ctrl.pvcStore.GetByKey(getKey(volumeRestore.PersistentVolumeClaimName, vmClone.Namespace))
+		obj, exists, err = ctrl.pvcStore.GetByKey(getKey(volumeRestore.PersistentVolumeClaimName, getTargetNamespace(vmClone)))
 		if !exists {
 			syncInfo.setError(fmt.Errorf("PVC %s is not created yet for clone %s", volumeRestore.PersistentVolumeClaimName, vmClone.Name))
 			return syncInfo
@@ -528,7 +584,7 @@ func (ctrl *VMCloneController) cleanupSnapshot(vmClone *clone.VirtualMachineClon
 }
 
 func (ctrl *VMCloneController) cleanupRestore(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
-	err := ctrl.client.VirtualMachineRestore(vmClone.Namespace).Delete(context.Background(), *vmClone.Status.RestoreName, v1.DeleteOptions{})
+	err := ctrl.client.VirtualMachineRestore(getTargetNamespace(vmClone)).Delete(context.Background(), *vmClone.Status.RestoreName, v1.DeleteOptions{})
 	if err != nil && !errors.IsNotFound(err) {
 		syncInfo.setError(fmt.Errorf("cannot clean up restore %s for clone %s", *vmClone.Status.RestoreName, vmClone.Name))
 		return syncInfo
@@ -573,7 +629,7 @@ func (ctrl *VMCloneController) getSource(vmClone *clone.VirtualMachineClone, nam
 	return obj, nil
 }
 
-func (ctrl *VMCloneController) getVmFromSnapshot(snapshot *snapshotv1.VirtualMachineSnapshot) (*k6tv1.VirtualMachine, error) {
+func (ctrl *VMCloneController) getSnapshotContent(snapshot *snapshotv1.VirtualMachineSnapshot) (*snapshotv1.VirtualMachineSnapshotContent, error) {
 	contentName := virtsnapshot.GetVMSnapshotContentName(snapshot)
 	contentKey := getKey(contentName, snapshot.Namespace)
 
@@ -584,7 +640,15 @@ func (ctrl *VMCloneController) getVmFromSnapshot(snapshot *snapshotv1.VirtualMac
 		return nil, err
 	}
 
-	content := contentObj.(*snapshotv1.VirtualMachineSnapshotContent)
+	return contentObj.(*snapshotv1.VirtualMachineSnapshotContent), nil
+}
+
+func (ctrl *VMCloneController) getVmFromSnapshot(snapshot *snapshotv1.VirtualMachineSnapshot) (*k6tv1.VirtualMachine, error) {
+	content, err := ctrl.getSnapshotContent(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
 	contentVmSpec := content.Spec.Source.VirtualMachine
 
 	vm := &k6tv1.VirtualMachine{
@@ -19,6 +19,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 type Event string
@@ -29,14 +30,18 @@ const (
 
 	SnapshotCreated       Event = "SnapshotCreated"
 	SnapshotReady         Event = "SnapshotReady"
+	SnapshotTransferred   Event = "SnapshotTransferred"
 	RestoreCreated        Event = "RestoreCreated"
 	RestoreCreationFailed Event = "RestoreCreationFailed"
 	RestoreReady          Event = "RestoreReady"
 	TargetVMCreated       Event = "TargetVMCreated"
 	PVCBound              Event = "PVCBound"
+	GeneralizationStarted Event = "GeneralizationStarted"
+	Generalized           Event = "Generalized"
 
-	SnapshotDeleted    Event = "SnapshotDeleted"
-	SourceDoesNotExist Event = "SourceDoesNotExist"
+	SnapshotDeleted      Event = "SnapshotDeleted"
+	SourceDoesNotExist   Event = "SourceDoesNotExist"
+	GeneralizationFailed Event = "GeneralizationFailed"
 )
 
 type VMCloneController struct {
@@ -47,13 +52,15 @@ type VMCloneController struct {
 	vmStore              cache.Store
 	snapshotContentStore cache.Store
 	pvcStore             cache.Store
+	podStore             cache.Store
+	clusterConfig        *virtconfig.ClusterConfig
 	recorder             record.EventRecorder
 
 	vmCloneQueue workqueue.TypedRateLimitingInterface[string]
 	hasSynced    func() bool
 }
 
-func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, pvcInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*VMCloneController, error) {
+func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, pvcInformer, podInformer cache.SharedIndexInformer, clusterConfig *virtconfig.ClusterConfig, recorder record.EventRecorder) (*VMCloneController, error) {
 	ctrl := VMCloneController{
 		client:               client,
 		vmCloneIndexer:       vmCloneInformer.GetIndexer(),
@@ -62,6 +69,8 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 		vmStore:              vmInformer.GetStore(),
 		snapshotContentStore: snapshotContentInformer.GetStore(),
 		pvcStore:             pvcInformer.GetStore(),
+		podStore:             podInformer.GetStore(),
+		clusterConfig:        clusterConfig,
 		recorder:             recorder,
 		vmCloneQueue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
 			workqueue.DefaultTypedControllerRateLimiter[string](),
@@ -71,7 +80,7 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 
 	ctrl.hasSynced = func() bool {
 		return vmCloneInformer.HasSynced() && snapshotInformer.HasSynced() && restoreInformer.HasSynced() &&
-			vmInformer.HasSynced() && snapshotInformer.HasSynced() && pvcInformer.HasSynced()
+			vmInformer.HasSynced() && snapshotInformer.HasSynced() && pvcInformer.HasSynced() && podInformer.HasSynced()
 	}
 
 	_, err := vmCloneInformer.AddEventHandler(
@@ -122,6 +131,18 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 		return nil, err
 	}
 
+	_, err = podInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handlePod,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handlePod(newObj) },
+			DeleteFunc: ctrl.handlePod,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = vmInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			DeleteFunc: ctrl.handleDeleteVM,
@@ -225,6 +246,22 @@ func (ctrl *VMCloneController) handleRestore(obj interface{}) {
 	}
 }
 
+func (ctrl *VMCloneController) handlePod(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	pod, ok := obj.(*k8scorev1.Pod)
+	if !ok {
+		log.Log.Errorf(unknownTypeErrFmt, "pod")
+		return
+	}
+
+	if ownedByClone, key := isOwnedByClone(pod); ownedByClone {
+		ctrl.vmCloneQueue.AddRateLimited(key)
+	}
+}
+
 func (ctrl *VMCloneController) handlePVC(obj interface{}) {
 	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
 		obj = unknown.Obj
@@ -344,26 +381,17 @@ func (ctrl *VMCloneController) runWorker() {
 	}
 }
 
-// takes a namespace and returns all vm clone with the specified target vm name
+// takes a namespace and returns all vm clone with the specified target vm, which may be
+// in another namespace than the clones
 func (ctrl *VMCloneController) listVmCloneMatchingVM(namespace, name string) ([]*clone.VirtualMachineClone, error) {
-	return ctrl.filterVmClone(namespace, func(vmClone *clone.VirtualMachineClone) bool {
-		return vmClone.Spec.Target.Name == name
-	})
-}
-
-func (ctrl *VMCloneController) filterVmClone(namespace string, filter func(*clone.VirtualMachineClone) bool) ([]*clone.VirtualMachineClone, error) {
-	objs, err := ctrl.vmCloneIndexer.ByIndex(cache.NamespaceIndex, namespace)
+	objs, err := ctrl.vmCloneIndexer.ByIndex("targetVM", getKey(name, namespace))
 	if err != nil {
 		return nil, err
 	}
 
 	var vmClones []*clone.VirtualMachineClone
 	for _, obj := range objs {
-		vmClone := obj.(*clone.VirtualMachineClone)
-
-		if filter(vmClone) {
-			vmClones = append(vmClones, vmClone)
-		}
+		vmClones = append(vmClones, obj.(*clone.VirtualMachineClone))
 	}
 	return vmClones, nil
 }
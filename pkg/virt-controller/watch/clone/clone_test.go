@@ -26,6 +26,7 @@ import (
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/golang/mock/gomock"
+	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	k8sv1 "k8s.io/api/core/v1"
@@ -40,6 +41,7 @@ import (
 	clone "kubevirt.io/api/clone/v1beta1"
 	virtv1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	snapshotfake "kubevirt.io/client-go/externalsnapshotter/fake"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
@@ -59,6 +61,7 @@ const (
 	testSnapshotName        = "tmp-snapshot-clone-uid"
 	testSnapshotContentName = "vmsnapshot-content-snapshot-UID"
 	testRestoreName         = "tmp-restore-clone-uid"
+	testTargetNamespace     = "target-ns"
 )
 
 var _ = Describe("Clone", func() {
@@ -67,10 +70,12 @@ var _ = Describe("Clone", func() {
 		recorder   *record.FakeRecorder
 		mockQueue  *testutils.MockWorkQueue[string]
 
-		client    *kubevirtfake.Clientset
-		k8sClient *k8sfake.Clientset
-		sourceVM  *virtv1.VirtualMachine
-		vmClone   *clone.VirtualMachineClone
+		client         *kubevirtfake.Clientset
+		k8sClient      *k8sfake.Clientset
+		coreClient     *k8sfake.Clientset
+		snapshotClient *snapshotfake.Clientset
+		sourceVM       *virtv1.VirtualMachine
+		vmClone        *clone.VirtualMachineClone
 	)
 
 	addVM := func(vm *virtv1.VirtualMachine) {
@@ -90,7 +95,7 @@ var _ = Describe("Clone", func() {
 
 	addSnapshot := func(snapshot *snapshotv1.VirtualMachineSnapshot) {
 		var err error
-		snapshot, err = client.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Create(context.TODO(), snapshot, metav1.CreateOptions{})
+		snapshot, err = client.SnapshotV1beta1().VirtualMachineSnapshots(snapshot.Namespace).Create(context.TODO(), snapshot, metav1.CreateOptions{})
 		Expect(err).ToNot(HaveOccurred())
 		err = controller.snapshotStore.Add(snapshot)
 		Expect(err).ToNot(HaveOccurred())
@@ -103,7 +108,7 @@ var _ = Describe("Clone", func() {
 
 	addRestore := func(restore *snapshotv1.VirtualMachineRestore) {
 		var err error
-		restore, err = client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Create(context.TODO(), restore, metav1.CreateOptions{})
+		restore, err = client.SnapshotV1beta1().VirtualMachineRestores(restore.Namespace).Create(context.TODO(), restore, metav1.CreateOptions{})
 		Expect(err).ToNot(HaveOccurred())
 		err = controller.restoreStore.Add(restore)
 		Expect(err).ToNot(HaveOccurred())
@@ -214,6 +219,15 @@ var _ = Describe("Clone", func() {
 		cloneInformer, _ := testutils.NewFakeInformerFor(&clone.VirtualMachineClone{})
 		snapshotContentInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
 		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(&virtv1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: "kubevirt"},
+			Status: virtv1.KubeVirtStatus{
+				ObservedKubeVirtRegistry: "registry:5000",
+				ObservedKubeVirtVersion:  "devel",
+				ObservedDeploymentConfig: "{}",
+			},
+		})
 
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
@@ -226,6 +240,8 @@ var _ = Describe("Clone", func() {
 			vmInformer,
 			snapshotContentInformer,
 			pvcInformer,
+			podInformer,
+			config,
 			recorder)
 		mockQueue = testutils.NewMockWorkQueue(controller.vmCloneQueue)
 		controller.vmCloneQueue = mockQueue
@@ -238,6 +254,12 @@ var _ = Describe("Clone", func() {
 		virtClient.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineRestore(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineSnapshotContent(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineSnapshotContents(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshot(testTargetNamespace).Return(client.SnapshotV1beta1().VirtualMachineSnapshots(testTargetNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineRestore(testTargetNamespace).Return(client.SnapshotV1beta1().VirtualMachineRestores(testTargetNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotContent(testTargetNamespace).Return(client.SnapshotV1beta1().VirtualMachineSnapshotContents(testTargetNamespace)).AnyTimes()
+
+		snapshotClient = snapshotfake.NewSimpleClientset()
+		virtClient.EXPECT().KubernetesSnapshotClient().Return(snapshotClient).AnyTimes()
 
 		k8sClient = k8sfake.NewSimpleClientset()
 		k8sClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
@@ -245,6 +267,9 @@ var _ = Describe("Clone", func() {
 			return true, nil, nil
 		})
 		virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+
+		coreClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(coreClient.CoreV1()).AnyTimes()
 	})
 
 	sanityExecute := func() {
@@ -608,6 +633,402 @@ var _ = Describe("Clone", func() {
 				expectCloneBeInPhase(clone.RestoreInProgress)
 			})
 		})
+
+		Context("with target namespace", func() {
+			const volumeSnapshotName = "vmsnapshot-snapshot-UID-volume-disk"
+
+			var (
+				snapshot        *snapshotv1.VirtualMachineSnapshot
+				snapshotContent *snapshotv1.VirtualMachineSnapshotContent
+			)
+
+			transferredVolumeSnapshotContentName := generateTransferredVolumeSnapshotContentName(testCloneUID, volumeSnapshotName)
+
+			createTransferredSnapshot := func() *snapshotv1.VirtualMachineSnapshot {
+				transferredSnapshot := createVirtualMachineSnapshot(sourceVM)
+				transferredSnapshot.Namespace = testTargetNamespace
+				transferredSnapshot.UID = "transferred-snapshot-UID"
+				transferredSnapshot.Annotations = getCloneAnnotations(vmClone)
+				transferredSnapshot.Status.ReadyToUse = pointer.P(true)
+				return transferredSnapshot
+			}
+
+			createTargetRestore := func() *snapshotv1.VirtualMachineRestore {
+				restore := createVirtualMachineRestore(sourceVM, testSnapshotName)
+				restore.Namespace = testTargetNamespace
+				restore.Annotations = getCloneAnnotations(vmClone)
+				return restore
+			}
+
+			expectFinalizers := func(finalizers ...string) {
+				updatedClone, err := client.CloneV1beta1().VirtualMachineClones(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedClone.Finalizers).To(ConsistOf(finalizers))
+			}
+
+			BeforeEach(func() {
+				vmClone.Spec.TargetNamespace = testTargetNamespace
+				vmClone.Finalizers = []string{targetNamespaceCleanupFinalizer}
+
+				snapshot = createVirtualMachineSnapshot(sourceVM, createOwnerReference(vmClone))
+				snapshot.Status.ReadyToUse = pointer.P(true)
+				snapshotContent = createVirtualMachineSnapshotContent(sourceVM)
+				snapshotContent.Spec.VolumeBackups = []snapshotv1.VolumeBackup{{
+					VolumeName:         "disk",
+					VolumeSnapshotName: pointer.P(volumeSnapshotName),
+				}}
+			})
+
+			It("should add the cleanup finalizer before doing anything", func() {
+				vmClone.Finalizers = nil
+
+				addVM(sourceVM)
+				addClone(vmClone)
+
+				sanityExecute()
+				Expect(recorder.Events).To(BeEmpty())
+				expectFinalizers(targetNamespaceCleanupFinalizer)
+				expectSnapshotDoesNotExist()
+			})
+
+			It("when snapshot is ready - should transfer it to the target namespace", func() {
+				volumeSnapshotContent := &vsv1.VolumeSnapshotContent{
+					ObjectMeta: metav1.ObjectMeta{Name: "snapcontent-disk"},
+					Spec: vsv1.VolumeSnapshotContentSpec{
+						Driver:                  "csi.example.com",
+						VolumeSnapshotClassName: pointer.P("snapshot-class"),
+					},
+					Status: &vsv1.VolumeSnapshotContentStatus{
+						SnapshotHandle: pointer.P("snapshot-handle"),
+					},
+				}
+				volumeSnapshot := &vsv1.VolumeSnapshot{
+					ObjectMeta: metav1.ObjectMeta{Name: volumeSnapshotName, Namespace: metav1.NamespaceDefault},
+					Spec: vsv1.VolumeSnapshotSpec{
+						VolumeSnapshotClassName: pointer.P("snapshot-class"),
+					},
+					Status: &vsv1.VolumeSnapshotStatus{
+						BoundVolumeSnapshotContentName: pointer.P(volumeSnapshotContent.Name),
+					},
+				}
+				_, err := snapshotClient.SnapshotV1().VolumeSnapshotContents().Create(context.TODO(), volumeSnapshotContent, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = snapshotClient.SnapshotV1().VolumeSnapshots(metav1.NamespaceDefault).Create(context.TODO(), volumeSnapshot, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+				vmClone.Status.Phase = clone.SnapshotInProgress
+
+				addVM(sourceVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addSnapshotContent(snapshotContent)
+
+				sanityExecute()
+				expectEvent(SnapshotReady)
+				expectEvent(SnapshotTransferred)
+				expectCloneBeInPhase(clone.RestoreInProgress)
+
+				transferredSnapshot, err := client.SnapshotV1beta1().VirtualMachineSnapshots(testTargetNamespace).Get(context.TODO(), testSnapshotName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(transferredSnapshot.Annotations).To(HaveKeyWithValue(clone.VirtualMachineCloneAnnotation, "default/testclone"))
+				Expect(transferredSnapshot.OwnerReferences).To(BeEmpty())
+				Expect(transferredSnapshot.Spec).To(Equal(snapshot.Spec))
+
+				transferredContents, err := client.SnapshotV1beta1().VirtualMachineSnapshotContents(testTargetNamespace).List(context.TODO(), metav1.ListOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(transferredContents.Items).To(HaveLen(1))
+				Expect(transferredContents.Items[0].Spec.VirtualMachineSnapshotName).To(HaveValue(Equal(testSnapshotName)))
+				Expect(transferredContents.Items[0].Spec.VolumeBackups).To(Equal(snapshotContent.Spec.VolumeBackups))
+
+				transferredVolumeSnapshotContent, err := snapshotClient.SnapshotV1().VolumeSnapshotContents().Get(context.TODO(), transferredVolumeSnapshotContentName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(transferredVolumeSnapshotContent.Spec.DeletionPolicy).To(Equal(vsv1.VolumeSnapshotContentRetain))
+				Expect(transferredVolumeSnapshotContent.Spec.Driver).To(Equal("csi.example.com"))
+				Expect(transferredVolumeSnapshotContent.Spec.Source.SnapshotHandle).To(HaveValue(Equal("snapshot-handle")))
+				Expect(transferredVolumeSnapshotContent.Spec.VolumeSnapshotRef.Namespace).To(Equal(testTargetNamespace))
+				Expect(transferredVolumeSnapshotContent.Spec.VolumeSnapshotRef.Name).To(Equal(volumeSnapshotName))
+
+				transferredVolumeSnapshot, err := snapshotClient.SnapshotV1().VolumeSnapshots(testTargetNamespace).Get(context.TODO(), volumeSnapshotName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(transferredVolumeSnapshot.Spec.Source.VolumeSnapshotContentName).To(HaveValue(Equal(transferredVolumeSnapshotContentName)))
+				Expect(transferredVolumeSnapshot.OwnerReferences).To(HaveLen(1))
+				Expect(transferredVolumeSnapshot.OwnerReferences[0].Kind).To(Equal("VirtualMachineSnapshot"))
+
+				expectRestoreDoesNotExist()
+			})
+
+			It("when the transferred snapshot is ready - should create restore in the target namespace", func() {
+				vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+				vmClone.Status.Phase = clone.RestoreInProgress
+
+				addVM(sourceVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addSnapshotContent(snapshotContent)
+				addSnapshot(createTransferredSnapshot())
+
+				sanityExecute()
+				expectEvent(RestoreCreated)
+				expectCloneBeInPhase(clone.RestoreInProgress)
+
+				restore, err := client.SnapshotV1beta1().VirtualMachineRestores(testTargetNamespace).Get(context.TODO(), testRestoreName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(restore.Spec.VirtualMachineSnapshotName).To(Equal(testSnapshotName))
+				Expect(restore.Spec.Target.Name).To(Equal(vmClone.Spec.Target.Name))
+				Expect(restore.Annotations).To(HaveKeyWithValue(clone.VirtualMachineCloneAnnotation, "default/testclone"))
+				Expect(restore.OwnerReferences).To(BeEmpty())
+			})
+
+			It("when the target VM is created - should move to Succeeded phase", func() {
+				restore := createTargetRestore()
+				restore.Status.Complete = pointer.P(true)
+
+				vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+				vmClone.Status.RestoreName = pointer.P(restore.Name)
+				vmClone.Status.Phase = clone.RestoreInProgress
+
+				targetVM := sourceVM.DeepCopy()
+				targetVM.Name = vmClone.Spec.Target.Name
+				targetVM.Namespace = testTargetNamespace
+
+				addVM(sourceVM)
+				addVM(targetVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addRestore(restore)
+
+				sanityExecute()
+				expectEvent(RestoreReady)
+				expectEvent(TargetVMCreated)
+				expectCloneBeInPhase(clone.Succeeded)
+			})
+
+			It("when all the PVCs are bound - should clean up both namespaces", func() {
+				restore := createTargetRestore()
+				restore.Status.Complete = pointer.P(true)
+				restore.Status.Restores = []snapshotv1.VolumeRestore{
+					{PersistentVolumeClaimName: "restore-pvc"},
+				}
+
+				vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+				vmClone.Status.RestoreName = pointer.P(restore.Name)
+				vmClone.Status.Phase = clone.Succeeded
+				vmClone.Status.TargetName = pointer.P(vmClone.Spec.Target.Name)
+
+				targetVM := sourceVM.DeepCopy()
+				targetVM.Name = vmClone.Spec.Target.Name
+				targetVM.Namespace = testTargetNamespace
+
+				_, err := snapshotClient.SnapshotV1().VolumeSnapshotContents().Create(context.TODO(), &vsv1.VolumeSnapshotContent{
+					ObjectMeta: metav1.ObjectMeta{Name: transferredVolumeSnapshotContentName},
+				}, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				addVM(sourceVM)
+				addVM(targetVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addSnapshotContent(snapshotContent)
+				addSnapshot(createTransferredSnapshot())
+				addRestore(restore)
+				addPVC(createPVC(testTargetNamespace, k8sv1.ClaimBound))
+
+				sanityExecute()
+				expectEvent(PVCBound)
+				expectSnapshotDoesNotExist()
+
+				_, err = client.SnapshotV1beta1().VirtualMachineRestores(testTargetNamespace).Get(context.TODO(), testRestoreName, metav1.GetOptions{})
+				Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+				_, err = client.SnapshotV1beta1().VirtualMachineSnapshots(testTargetNamespace).Get(context.TODO(), testSnapshotName, metav1.GetOptions{})
+				Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+				_, err = snapshotClient.SnapshotV1().VolumeSnapshotContents().Get(context.TODO(), transferredVolumeSnapshotContentName, metav1.GetOptions{})
+				Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+			})
+
+			It("when the clone is deleted - should clean up the target namespace and remove the finalizer", func() {
+				restore := createTargetRestore()
+
+				vmClone.DeletionTimestamp = pointer.P(metav1.Now())
+				vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+				vmClone.Status.RestoreName = pointer.P(restore.Name)
+				vmClone.Status.Phase = clone.RestoreInProgress
+
+				addVM(sourceVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addSnapshotContent(snapshotContent)
+				addSnapshot(createTransferredSnapshot())
+				addRestore(restore)
+
+				sanityExecute()
+				Expect(recorder.Events).To(BeEmpty())
+				expectFinalizers()
+
+				_, err := client.SnapshotV1beta1().VirtualMachineRestores(testTargetNamespace).Get(context.TODO(), testRestoreName, metav1.GetOptions{})
+				Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+				_, err = client.SnapshotV1beta1().VirtualMachineSnapshots(testTargetNamespace).Get(context.TODO(), testSnapshotName, metav1.GetOptions{})
+				Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+			})
+		})
+
+		Context("with virt-sysprep generalization", func() {
+			var (
+				snapshot *snapshotv1.VirtualMachineSnapshot
+				restore  *snapshotv1.VirtualMachineRestore
+				pvc      *k8sv1.PersistentVolumeClaim
+				targetVM *virtv1.VirtualMachine
+			)
+
+			virtSysprepPodName := generateVirtSysprepPodName(testCloneUID)
+
+			addPod := func(pod *k8sv1.Pod) {
+				_, err := coreClient.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(controller.podStore.Add(pod)).To(Succeed())
+			}
+
+			newVirtSysprepPod := func(phase k8sv1.PodPhase) *k8sv1.Pod {
+				return &k8sv1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      virtSysprepPodName,
+						Namespace: metav1.NamespaceDefault,
+					},
+					Status: k8sv1.PodStatus{
+						Phase: phase,
+					},
+				}
+			}
+
+			BeforeEach(func() {
+				vmClone.Spec.GuestIdentity = &clone.VirtualMachineCloneGuestIdentity{
+					Generalization: &clone.VirtualMachineCloneGeneralization{
+						VirtSysprep: &clone.VirtSysprepGeneralization{
+							Operations: []string{"machine-id", "ssh-hostkeys"},
+						},
+					},
+				}
+
+				snapshot = createVirtualMachineSnapshot(sourceVM, createOwnerReference(vmClone))
+				snapshot.Status.ReadyToUse = pointer.P(true)
+
+				pvc = createPVC(sourceVM.Namespace, k8sv1.ClaimPending)
+
+				restore = createVirtualMachineRestore(sourceVM, snapshot.Name, createOwnerReference(vmClone))
+				restore.Status.Complete = pointer.P(true)
+				restore.Status.Restores = []snapshotv1.VolumeRestore{
+					{PersistentVolumeClaimName: pvc.Name},
+				}
+
+				vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+				vmClone.Status.RestoreName = pointer.P(restore.Name)
+				vmClone.Status.TargetName = pointer.P(vmClone.Spec.Target.Name)
+
+				targetVM = sourceVM.DeepCopy()
+				targetVM.Name = vmClone.Spec.Target.Name
+			})
+
+			It("when the target VM is created - should run virt-sysprep on the restored volumes", func() {
+				vmClone.Status.Phase = clone.CreatingTargetVM
+
+				addVM(sourceVM)
+				addVM(targetVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addRestore(restore)
+				addPVC(pvc)
+
+				sanityExecute()
+				expectEvent(TargetVMCreated)
+				expectEvent(GeneralizationStarted)
+				expectCloneBeInPhase(clone.GeneralizationInProgress)
+
+				pod, err := coreClient.CoreV1().Pods(metav1.NamespaceDefault).Get(context.TODO(), virtSysprepPodName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.OwnerReferences).To(HaveLen(1))
+				validateOwnerReference(pod.OwnerReferences[0], vmClone)
+				Expect(pod.Spec.RestartPolicy).To(Equal(k8sv1.RestartPolicyNever))
+				Expect(pod.Spec.Volumes).To(ContainElement(HaveField("PersistentVolumeClaim.ClaimName", pvc.Name)))
+				Expect(pod.Spec.Containers).To(HaveLen(1))
+				Expect(pod.Spec.Containers[0].Image).To(Equal("registry:5000/libguestfs-tools:devel"))
+				Expect(pod.Spec.Containers[0].Command).To(Equal([]string{"virt-sysprep"}))
+				Expect(pod.Spec.Containers[0].Args).To(Equal([]string{
+					"-a", "/disks/disk0/disk.img",
+					"--operations", "machine-id,ssh-hostkeys",
+				}))
+			})
+
+			It("should use the block device of block volumes", func() {
+				vmClone.Status.Phase = clone.GeneralizationInProgress
+				pvc.Spec.VolumeMode = pointer.P(k8sv1.PersistentVolumeBlock)
+
+				addVM(sourceVM)
+				addVM(targetVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addRestore(restore)
+				addPVC(pvc)
+
+				sanityExecute()
+				expectEvent(GeneralizationStarted)
+
+				pod, err := coreClient.CoreV1().Pods(metav1.NamespaceDefault).Get(context.TODO(), virtSysprepPodName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers[0].VolumeDevices).To(ConsistOf(k8sv1.VolumeDevice{Name: "disk0", DevicePath: "/dev/disk0"}))
+				Expect(pod.Spec.Containers[0].Args).To(HaveExactElements("-a", "/dev/disk0", "--operations", "machine-id,ssh-hostkeys"))
+			})
+
+			It("when virt-sysprep succeeded - should move to Succeeded phase", func() {
+				vmClone.Status.Phase = clone.GeneralizationInProgress
+
+				addVM(sourceVM)
+				addVM(targetVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addRestore(restore)
+				addPVC(pvc)
+				addPod(newVirtSysprepPod(k8sv1.PodSucceeded))
+
+				sanityExecute()
+				expectEvent(Generalized)
+				expectCloneBeInPhase(clone.Succeeded)
+			})
+
+			It("when virt-sysprep failed - should fail the clone", func() {
+				vmClone.Status.Phase = clone.GeneralizationInProgress
+
+				addVM(sourceVM)
+				addVM(targetVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addRestore(restore)
+				addPVC(pvc)
+				addPod(newVirtSysprepPod(k8sv1.PodFailed))
+
+				sanityExecute()
+				expectEvent(GeneralizationFailed)
+				expectCloneBeInPhase(clone.Failed)
+			})
+
+			It("when all the PVCs are bound - should delete the virt-sysprep pod", func() {
+				vmClone.Status.Phase = clone.Succeeded
+
+				addVM(sourceVM)
+				addVM(targetVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addRestore(restore)
+				addPVC(createPVC(sourceVM.Namespace, k8sv1.ClaimBound))
+				addPod(newVirtSysprepPod(k8sv1.PodSucceeded))
+
+				sanityExecute()
+				expectEvent(PVCBound)
+				expectSnapshotDoesNotExist()
+
+				_, err := coreClient.CoreV1().Pods(metav1.NamespaceDefault).Get(context.TODO(), virtSysprepPodName, metav1.GetOptions{})
+				Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+			})
+		})
 	})
 
 	Context("generation of target VM", func() {
@@ -907,6 +1328,99 @@ var _ = Describe("Clone", func() {
 			})
 		})
 
+		Context("Guest identity", func() {
+			const sourceHostname = "source-hostname"
+
+			BeforeEach(func() {
+				sourceVM.Spec.Template.Spec.Hostname = sourceHostname
+			})
+
+			It("should generate a cloud-init instance ID and reset the hostname", func() {
+				vmClone.Spec.GuestIdentity = &clone.VirtualMachineCloneGuestIdentity{}
+				addClone(vmClone)
+
+				expectedVM := sourceVM.DeepCopy()
+				expectedVM.Spec.Template.ObjectMeta.Annotations = map[string]string{
+					virtv1.CloudInitInstanceIDAnnotation: string(testCloneUID),
+				}
+				expectedVM.Spec.Template.Spec.Hostname = ""
+
+				sanityExecute()
+				expectVMCreationFromPatches(expectedVM)
+			})
+
+			It("if the cloud-init instance ID is defined in clone spec - should use the one in clone spec", func() {
+				sourceVM.Spec.Template.ObjectMeta.Annotations = map[string]string{"some": "annotation"}
+				vmClone.Spec.GuestIdentity = &clone.VirtualMachineCloneGuestIdentity{
+					CloudInitInstanceID: pointer.P("new-instance-id"),
+				}
+				addClone(vmClone)
+
+				expectedVM := sourceVM.DeepCopy()
+				expectedVM.Spec.Template.ObjectMeta.Annotations[virtv1.CloudInitInstanceIDAnnotation] = "new-instance-id"
+				expectedVM.Spec.Template.Spec.Hostname = ""
+
+				sanityExecute()
+				expectVMCreationFromPatches(expectedVM)
+			})
+
+			It("should attach the sysprep answer file", func() {
+				sysprep := &virtv1.SysprepSource{
+					ConfigMap: &k8sv1.LocalObjectReference{Name: "unattend"},
+				}
+				vmClone.Spec.GuestIdentity = &clone.VirtualMachineCloneGuestIdentity{
+					Generalization: &clone.VirtualMachineCloneGeneralization{Sysprep: sysprep},
+				}
+				addClone(vmClone)
+
+				expectedVM := sourceVM.DeepCopy()
+				expectedVM.Spec.Template.ObjectMeta.Annotations = map[string]string{
+					virtv1.CloudInitInstanceIDAnnotation: string(testCloneUID),
+				}
+				expectedVM.Spec.Template.Spec.Hostname = ""
+				expectedVM.Spec.Template.Spec.Volumes = append(expectedVM.Spec.Template.Spec.Volumes, virtv1.Volume{
+					Name:         sysprepVolumeName,
+					VolumeSource: virtv1.VolumeSource{Sysprep: sysprep},
+				})
+				expectedVM.Spec.Template.Spec.Domain.Devices.Disks = append(expectedVM.Spec.Template.Spec.Domain.Devices.Disks, virtv1.Disk{
+					Name: sysprepVolumeName,
+					DiskDevice: virtv1.DiskDevice{
+						CDRom: &virtv1.CDRomTarget{Bus: virtv1.DiskBusSATA},
+					},
+				})
+
+				sanityExecute()
+				expectVMCreationFromPatches(expectedVM)
+			})
+
+			It("should replace the sysprep answer file of the source", func() {
+				sourceVM.Spec.Template.Spec.Volumes = append(sourceVM.Spec.Template.Spec.Volumes, virtv1.Volume{
+					Name: "sysprep",
+					VolumeSource: virtv1.VolumeSource{Sysprep: &virtv1.SysprepSource{
+						Secret: &k8sv1.LocalObjectReference{Name: "source-unattend"},
+					}},
+				})
+				sysprep := &virtv1.SysprepSource{
+					ConfigMap: &k8sv1.LocalObjectReference{Name: "unattend"},
+				}
+				vmClone.Spec.GuestIdentity = &clone.VirtualMachineCloneGuestIdentity{
+					Generalization: &clone.VirtualMachineCloneGeneralization{Sysprep: sysprep},
+				}
+				addClone(vmClone)
+
+				expectedVM := sourceVM.DeepCopy()
+				expectedVM.Spec.Template.ObjectMeta.Annotations = map[string]string{
+					virtv1.CloudInitInstanceIDAnnotation: string(testCloneUID),
+				}
+				expectedVM.Spec.Template.Spec.Hostname = ""
+				volumes := expectedVM.Spec.Template.Spec.Volumes
+				volumes[len(volumes)-1].Sysprep = sysprep
+
+				sanityExecute()
+				expectVMCreationFromPatches(expectedVM)
+			})
+		})
+
 		Context("Target VM name", func() {
 			expectTargetVMNameExist := func() {
 				restore, err := client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Get(context.TODO(), testRestoreName, metav1.GetOptions{})
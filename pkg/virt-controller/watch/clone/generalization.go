@@ -0,0 +1,271 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	clone "kubevirt.io/api/clone/v1beta1"
+	k6tv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	virtoperatorutil "kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+// A clone generalizes the guest of its target by running virt-sysprep on the restored volumes.
+// The target VM created by the restore is halted, so virt-sysprep runs in a pod of the
+// libguestfs-tools image of the installation before the clone succeeds.
+
+const (
+	virtSysprepApp           = "virt-sysprep"
+	guestfsImageName         = "libguestfs-tools"
+	guestfsAppliancePath     = "/usr/local/lib/guestfs/appliance"
+	guestfsHomeVolumeName    = "guestfs"
+	guestfsHomePath          = "/home/guestfs"
+	guestfsTmpDirVolumeName  = "libguestfs-tmp-dir"
+	guestfsTmpDirPath        = "/tmp/guestfs"
+	virtSysprepDisksDir      = "/disks"
+	virtSysprepDiskImageName = "disk.img"
+)
+
+func needsVirtSysprep(vmClone *clone.VirtualMachineClone) bool {
+	guestIdentity := vmClone.Spec.GuestIdentity
+	return guestIdentity != nil && guestIdentity.Generalization != nil && guestIdentity.Generalization.VirtSysprep != nil
+}
+
+func generateVirtSysprepPodName(vmCloneUID types.UID) string {
+	return fmt.Sprintf("virt-sysprep-%s", string(vmCloneUID))
+}
+
+func (ctrl *VMCloneController) generalize(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+	podName := generateVirtSysprepPodName(vmClone.UID)
+	obj, exists, err := ctrl.podStore.GetByKey(getKey(podName, getTargetNamespace(vmClone)))
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting pod %s from cache for clone %s: %v", podName, vmClone.Name, err))
+		return syncInfo
+	}
+	if !exists {
+		return ctrl.createVirtSysprepPod(vmClone, syncInfo)
+	}
+
+	pod := obj.(*corev1.Pod)
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		ctrl.logAndRecord(vmClone, Generalized, fmt.Sprintf("generalized target VM %s for clone %s", vmClone.Spec.Target.Name, vmClone.Name))
+		syncInfo.generalized = true
+	case corev1.PodFailed:
+		syncInfo.isCloneFailing = true
+		syncInfo.failEvent = GeneralizationFailed
+		syncInfo.failReason = fmt.Sprintf("virt-sysprep pod %s failed: %s", pod.Name, pod.Status.Message)
+	default:
+		log.Log.Object(vmClone).V(defaultVerbosityLevel).Infof("virt-sysprep pod %s for clone %s is not completed yet", pod.Name, vmClone.Name)
+	}
+
+	return syncInfo
+}
+
+func (ctrl *VMCloneController) createVirtSysprepPod(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+	targetNamespace := getTargetNamespace(vmClone)
+	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(*vmClone.Status.RestoreName, targetNamespace))
+	if !exists {
+		syncInfo.setError(fmt.Errorf("restore %s is not created yet for clone %s", *vmClone.Status.RestoreName, vmClone.Name))
+		return syncInfo
+	} else if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting restore %s from cache for clone %s: %v", *vmClone.Status.RestoreName, vmClone.Name, err))
+		return syncInfo
+	}
+	restore := obj.(*snapshotv1.VirtualMachineRestore)
+
+	var pvcs []*corev1.PersistentVolumeClaim
+	for _, volumeRestore := range restore.Status.Restores {
+		obj, exists, err = ctrl.pvcStore.GetByKey(getKey(volumeRestore.PersistentVolumeClaimName, targetNamespace))
+		if !exists {
+			syncInfo.setError(fmt.Errorf("PVC %s is not created yet for clone %s", volumeRestore.PersistentVolumeClaimName, vmClone.Name))
+			return syncInfo
+		} else if err != nil {
+			syncInfo.setError(fmt.Errorf("error getting PVC %s from cache for clone %s: %v", volumeRestore.PersistentVolumeClaimName, vmClone.Name, err))
+			return syncInfo
+		}
+		pvcs = append(pvcs, obj.(*corev1.PersistentVolumeClaim))
+	}
+
+	image, pullPolicy, err := ctrl.getGuestfsImage()
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("cannot get the libguestfs-tools image for clone %s: %v", vmClone.Name, err))
+		return syncInfo
+	}
+
+	pod := generateVirtSysprepPod(vmClone, pvcs, image, pullPolicy)
+	log.Log.Object(vmClone).Infof("creating virt-sysprep pod %s for clone %s", pod.Name, vmClone.Name)
+	_, err = ctrl.client.CoreV1().Pods(pod.Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+	if err != nil {
+		if !errors.IsAlreadyExists(err) {
+			syncInfo.setError(fmt.Errorf("failed creating virt-sysprep pod %s for clone %s: %v", pod.Name, vmClone.Name, err))
+		}
+		return syncInfo
+	}
+	ctrl.logAndRecord(vmClone, GeneralizationStarted, fmt.Sprintf("created virt-sysprep pod %s for clone %s", pod.Name, vmClone.Name))
+
+	return syncInfo
+}
+
+func generateVirtSysprepPod(vmClone *clone.VirtualMachineClone, pvcs []*corev1.PersistentVolumeClaim, image string, pullPolicy corev1.PullPolicy) *corev1.Pod {
+	container := corev1.Container{
+		Name:            virtSysprepApp,
+		Image:           image,
+		ImagePullPolicy: pullPolicy,
+		Command:         []string{virtSysprepApp},
+		// LIBGUESTFS_BACKEND makes libguestfs use qemu directly,
+		// LIBGUESTFS_PATH is the path of the appliance in the image
+		Env: []corev1.EnvVar{
+			{Name: "LIBGUESTFS_BACKEND", Value: "direct"},
+			{Name: "LIBGUESTFS_PATH", Value: guestfsAppliancePath},
+			{Name: "LIBGUESTFS_TMPDIR", Value: guestfsTmpDirPath},
+			{Name: "HOME", Value: guestfsHomePath},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: guestfsTmpDirVolumeName, MountPath: guestfsTmpDirPath},
+			{Name: guestfsHomeVolumeName, MountPath: guestfsHomePath},
+		},
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{
+				services.KvmDevice: resource.MustParse("1"),
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: pointer.P(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		},
+	}
+	volumes := []corev1.Volume{
+		{Name: guestfsTmpDirVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		{Name: guestfsHomeVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+	}
+
+	for i, pvc := range pvcs {
+		volumeName := fmt.Sprintf("disk%d", i)
+		volumes = append(volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvc.Name},
+			},
+		})
+
+		var diskPath string
+		if pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1.PersistentVolumeBlock {
+			diskPath = fmt.Sprintf("/dev/%s", volumeName)
+			container.VolumeDevices = append(container.VolumeDevices, corev1.VolumeDevice{Name: volumeName, DevicePath: diskPath})
+		} else {
+			mountPath := fmt.Sprintf("%s/%s", virtSysprepDisksDir, volumeName)
+			diskPath = fmt.Sprintf("%s/%s", mountPath, virtSysprepDiskImageName)
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: volumeName, MountPath: mountPath})
+		}
+		container.Args = append(container.Args, "-a", diskPath)
+	}
+
+	if operations := vmClone.Spec.GuestIdentity.Generalization.VirtSysprep.Operations; len(operations) > 0 {
+		container.Args = append(container.Args, "--operations", strings.Join(operations, ","))
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      generateVirtSysprepPodName(vmClone.UID),
+			Namespace: getTargetNamespace(vmClone),
+			Labels: map[string]string{
+				k6tv1.AppLabel: virtSysprepApp,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot: pointer.P(true),
+				RunAsUser:    pointer.P(int64(util.NonRootUID)),
+				RunAsGroup:   pointer.P(int64(util.NonRootUID)),
+				FSGroup:      pointer.P(int64(util.NonRootUID)),
+				SeccompProfile: &corev1.SeccompProfile{
+					Type: corev1.SeccompProfileTypeRuntimeDefault,
+				},
+			},
+			Containers: []corev1.Container{container},
+			Volumes:    volumes,
+		},
+	}
+	if isCrossNamespace(vmClone) {
+		pod.Annotations = getCloneAnnotations(vmClone)
+	} else {
+		pod.OwnerReferences = []metav1.OwnerReference{getCloneOwnerReference(vmClone.Name, vmClone.UID)}
+	}
+
+	return pod
+}
+
+// getGuestfsImage returns the libguestfs-tools image of the installation, like virtctl guestfs does.
+func (ctrl *VMCloneController) getGuestfsImage() (string, corev1.PullPolicy, error) {
+	kv := ctrl.clusterConfig.GetConfigFromKubeVirtCR()
+	if kv == nil {
+		return "", "", fmt.Errorf("failed getting KubeVirt config")
+	}
+
+	config := &virtoperatorutil.KubeVirtDeploymentConfig{}
+	if err := json.Unmarshal([]byte(kv.Status.ObservedDeploymentConfig), config); err != nil {
+		return "", "", err
+	}
+	if config.GsImage != "" {
+		return config.GsImage, config.GetImagePullPolicy(), nil
+	}
+
+	image := fmt.Sprintf("%s/%s%s", kv.Status.ObservedKubeVirtRegistry, config.GetImagePrefix(), guestfsImageName)
+	switch {
+	case config.GsSha != "":
+		image = fmt.Sprintf("%s@%s", image, config.GsSha)
+	case kv.Status.ObservedKubeVirtVersion != "":
+		image = fmt.Sprintf("%s:%s", image, kv.Status.ObservedKubeVirtVersion)
+	default:
+		return "", "", fmt.Errorf("neither the digest nor the tag of the libguestfs-tools image is known")
+	}
+
+	return image, config.GetImagePullPolicy(), nil
+}
+
+func (ctrl *VMCloneController) cleanupVirtSysprepPod(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+	podName := generateVirtSysprepPodName(vmClone.UID)
+	err := ctrl.client.CoreV1().Pods(getTargetNamespace(vmClone)).Delete(context.Background(), podName, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		syncInfo.setError(fmt.Errorf("cannot clean up virt-sysprep pod %s for clone %s", podName, vmClone.Name))
+		return syncInfo
+	}
+
+	return syncInfo
+}
@@ -0,0 +1,370 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	clone "kubevirt.io/api/clone/v1beta1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
+)
+
+// A clone into another namespace snapshots the source in the namespace of the clone and
+// restores it in the target namespace. Since a restore can only use a snapshot from its
+// own namespace, the snapshot is transferred into the target namespace first: its content,
+// volume snapshots and controller revisions are copied there. The copied volume snapshots
+// are bound to pre-provisioned volume snapshot contents, which refer to the same snapshots
+// on the storage backend and retain them when deleted.
+
+const targetNamespaceCleanupFinalizer = "clone.kubevirt.io/target-namespace-cleanup"
+
+func getTargetNamespace(vmClone *clone.VirtualMachineClone) string {
+	if vmClone.Spec.TargetNamespace != "" {
+		return vmClone.Spec.TargetNamespace
+	}
+	return vmClone.Namespace
+}
+
+func isCrossNamespace(vmClone *clone.VirtualMachineClone) bool {
+	return getTargetNamespace(vmClone) != vmClone.Namespace
+}
+
+func getCloneAnnotations(vmClone *clone.VirtualMachineClone) map[string]string {
+	return map[string]string{
+		clone.VirtualMachineCloneAnnotation: getKey(vmClone.Name, vmClone.Namespace),
+	}
+}
+
+func generateTransferredVolumeSnapshotContentName(vmCloneUID types.UID, volumeSnapshotName string) string {
+	return fmt.Sprintf("vmclone-%s-%s", string(vmCloneUID), volumeSnapshotName)
+}
+
+// transferSnapshot copies the snapshot into the target namespace of the clone. It is idempotent,
+// the content of the copy is created last, so that its existence marks the end of the transfer.
+func (ctrl *VMCloneController) transferSnapshot(vmClone *clone.VirtualMachineClone, snapshot *snapshotv1.VirtualMachineSnapshot, syncInfo syncInfoType) syncInfoType {
+	targetNamespace := getTargetNamespace(vmClone)
+	name := generateSnapshotName(vmClone.UID)
+
+	obj, exists, err := ctrl.snapshotStore.GetByKey(getKey(name, targetNamespace))
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting snapshot %s/%s from cache for clone %s: %v", targetNamespace, name, vmClone.Name, err))
+		return syncInfo
+	}
+
+	var transferredSnapshot *snapshotv1.VirtualMachineSnapshot
+	if exists {
+		transferredSnapshot = obj.(*snapshotv1.VirtualMachineSnapshot)
+		if virtsnapshot.VmSnapshotReady(transferredSnapshot) {
+			syncInfo.snapshotTransferred = true
+			return syncInfo
+		}
+	} else {
+		transferredSnapshot = &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   targetNamespace,
+				Annotations: getCloneAnnotations(vmClone),
+			},
+			Spec: *snapshot.Spec.DeepCopy(),
+		}
+		transferredSnapshot, err = ctrl.client.VirtualMachineSnapshot(targetNamespace).Create(context.Background(), transferredSnapshot, metav1.CreateOptions{})
+		if err != nil {
+			syncInfo.setError(fmt.Errorf("failed creating snapshot %s/%s for clone %s: %v", targetNamespace, name, vmClone.Name, err))
+			return syncInfo
+		}
+	}
+
+	contentName := virtsnapshot.GetVMSnapshotContentName(transferredSnapshot)
+	_, exists, err = ctrl.snapshotContentStore.GetByKey(getKey(contentName, targetNamespace))
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting snapshot content %s/%s from cache for clone %s: %v", targetNamespace, contentName, vmClone.Name, err))
+		return syncInfo
+	} else if exists {
+		log.Log.Object(vmClone).V(defaultVerbosityLevel).Infof("snapshot %s/%s for clone %s is not ready to use yet", targetNamespace, name, vmClone.Name)
+		return syncInfo
+	}
+
+	content, err := ctrl.getSnapshotContent(snapshot)
+	if err != nil {
+		syncInfo.setError(err)
+		return syncInfo
+	}
+
+	owner := metav1.NewControllerRef(transferredSnapshot, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshot"))
+	if err := ctrl.transferVolumeSnapshots(vmClone, content, owner); err != nil {
+		syncInfo.setError(fmt.Errorf("failed transferring volume snapshots for clone %s: %v", vmClone.Name, err))
+		return syncInfo
+	}
+
+	transferredContent := &snapshotv1.VirtualMachineSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        contentName,
+			Namespace:   targetNamespace,
+			Annotations: getCloneAnnotations(vmClone),
+		},
+		Spec: *content.Spec.DeepCopy(),
+	}
+	transferredContent.Spec.VirtualMachineSnapshotName = &transferredSnapshot.Name
+
+	if vm := transferredContent.Spec.Source.VirtualMachine; vm != nil {
+		if err := ctrl.transferControllerRevisions(vm, snapshot, transferredSnapshot, owner); err != nil {
+			syncInfo.setError(fmt.Errorf("failed transferring controller revisions for clone %s: %v", vmClone.Name, err))
+			return syncInfo
+		}
+	}
+
+	_, err = ctrl.client.VirtualMachineSnapshotContent(targetNamespace).Create(context.Background(), transferredContent, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		syncInfo.setError(fmt.Errorf("failed creating snapshot content %s/%s for clone %s: %v", targetNamespace, contentName, vmClone.Name, err))
+		return syncInfo
+	}
+
+	ctrl.logAndRecord(vmClone, SnapshotTransferred, fmt.Sprintf("transferred snapshot %s to namespace %s for clone %s", snapshot.Name, targetNamespace, vmClone.Name))
+	return syncInfo
+}
+
+func (ctrl *VMCloneController) transferVolumeSnapshots(vmClone *clone.VirtualMachineClone, content *snapshotv1.VirtualMachineSnapshotContent, owner *metav1.OwnerReference) error {
+	targetNamespace := getTargetNamespace(vmClone)
+	snapshotClient := ctrl.client.KubernetesSnapshotClient().SnapshotV1()
+
+	for _, volumeBackup := range content.Spec.VolumeBackups {
+		if volumeBackup.VolumeSnapshotName == nil {
+			continue
+		}
+		name := *volumeBackup.VolumeSnapshotName
+
+		volumeSnapshot, err := snapshotClient.VolumeSnapshots(content.Namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if volumeSnapshot.Status == nil || volumeSnapshot.Status.BoundVolumeSnapshotContentName == nil {
+			return fmt.Errorf("volume snapshot %s/%s is not bound", volumeSnapshot.Namespace, name)
+		}
+
+		volumeSnapshotContent, err := snapshotClient.VolumeSnapshotContents().Get(context.Background(), *volumeSnapshot.Status.BoundVolumeSnapshotContentName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if volumeSnapshotContent.Status == nil || volumeSnapshotContent.Status.SnapshotHandle == nil {
+			return fmt.Errorf("volume snapshot content %s has no snapshot handle", volumeSnapshotContent.Name)
+		}
+
+		transferredContent := &vsv1.VolumeSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        generateTransferredVolumeSnapshotContentName(vmClone.UID, name),
+				Annotations: getCloneAnnotations(vmClone),
+			},
+			Spec: vsv1.VolumeSnapshotContentSpec{
+				VolumeSnapshotRef: corev1.ObjectReference{
+					Kind:       "VolumeSnapshot",
+					APIVersion: vsv1.SchemeGroupVersion.String(),
+					Namespace:  targetNamespace,
+					Name:       name,
+				},
+				DeletionPolicy:          vsv1.VolumeSnapshotContentRetain,
+				Driver:                  volumeSnapshotContent.Spec.Driver,
+				VolumeSnapshotClassName: volumeSnapshotContent.Spec.VolumeSnapshotClassName,
+				Source: vsv1.VolumeSnapshotContentSource{
+					SnapshotHandle: volumeSnapshotContent.Status.SnapshotHandle,
+				},
+			},
+		}
+		_, err = snapshotClient.VolumeSnapshotContents().Create(context.Background(), transferredContent, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+
+		transferredVolumeSnapshot := &vsv1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       targetNamespace,
+				Annotations:     getCloneAnnotations(vmClone),
+				OwnerReferences: []metav1.OwnerReference{*owner},
+			},
+			Spec: vsv1.VolumeSnapshotSpec{
+				Source: vsv1.VolumeSnapshotSource{
+					VolumeSnapshotContentName: &transferredContent.Name,
+				},
+				VolumeSnapshotClassName: volumeSnapshot.Spec.VolumeSnapshotClassName,
+			},
+		}
+		_, err = snapshotClient.VolumeSnapshots(targetNamespace).Create(context.Background(), transferredVolumeSnapshot, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// transferControllerRevisions copies the instancetype and preference revisions captured by the snapshot,
+// and updates the revision names of the VM in the content of the transferred snapshot accordingly.
+func (ctrl *VMCloneController) transferControllerRevisions(vm *snapshotv1.VirtualMachine, snapshot, transferredSnapshot *snapshotv1.VirtualMachineSnapshot, owner *metav1.OwnerReference) error {
+	transfer := func(revisionName string) (string, error) {
+		revision, err := ctrl.client.AppsV1().ControllerRevisions(snapshot.Namespace).Get(context.Background(), revisionName, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+
+		transferredRevision := &appsv1.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				// the restore expects the name of the snapshot in the name of the revision
+				Name:            strings.Replace(revisionName, snapshot.Name, transferredSnapshot.Name, 1),
+				Namespace:       transferredSnapshot.Namespace,
+				Labels:          revision.Labels,
+				OwnerReferences: []metav1.OwnerReference{*owner},
+			},
+			Data:     revision.Data,
+			Revision: revision.Revision,
+		}
+		_, err = ctrl.client.AppsV1().ControllerRevisions(transferredRevision.Namespace).Create(context.Background(), transferredRevision, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return "", err
+		}
+		return transferredRevision.Name, nil
+	}
+
+	if vm.Spec.Instancetype != nil && vm.Spec.Instancetype.RevisionName != "" {
+		revisionName, err := transfer(vm.Spec.Instancetype.RevisionName)
+		if err != nil {
+			return err
+		}
+		vm.Spec.Instancetype.RevisionName = revisionName
+	}
+
+	if vm.Spec.Preference != nil && vm.Spec.Preference.RevisionName != "" {
+		revisionName, err := transfer(vm.Spec.Preference.RevisionName)
+		if err != nil {
+			return err
+		}
+		vm.Spec.Preference.RevisionName = revisionName
+	}
+
+	return nil
+}
+
+// cleanupTransferredSnapshot deletes the copy of the snapshot in the target namespace. The copied
+// volume snapshots and controller revisions are garbage collected with it, while the pre-provisioned
+// volume snapshot contents are cluster scoped and have to be deleted explicitly.
+func (ctrl *VMCloneController) cleanupTransferredSnapshot(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+	targetNamespace := getTargetNamespace(vmClone)
+	name := generateSnapshotName(vmClone.UID)
+
+	err := ctrl.client.VirtualMachineSnapshot(targetNamespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		syncInfo.setError(fmt.Errorf("cannot clean up snapshot %s/%s for clone %s", targetNamespace, name, vmClone.Name))
+		return syncInfo
+	}
+
+	if vmClone.Status.SnapshotName == nil {
+		return syncInfo
+	}
+	obj, exists, err := ctrl.snapshotStore.GetByKey(getKey(*vmClone.Status.SnapshotName, vmClone.Namespace))
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting snapshot %s from cache for clone %s: %v", *vmClone.Status.SnapshotName, vmClone.Name, err))
+		return syncInfo
+	} else if !exists {
+		return syncInfo
+	}
+
+	content, err := ctrl.getSnapshotContent(obj.(*snapshotv1.VirtualMachineSnapshot))
+	if err != nil {
+		log.Log.Object(vmClone).Reason(err).Warning("cannot clean up transferred volume snapshot contents")
+		return syncInfo
+	}
+
+	for _, volumeBackup := range content.Spec.VolumeBackups {
+		if volumeBackup.VolumeSnapshotName == nil {
+			continue
+		}
+		contentName := generateTransferredVolumeSnapshotContentName(vmClone.UID, *volumeBackup.VolumeSnapshotName)
+		err := ctrl.client.KubernetesSnapshotClient().SnapshotV1().VolumeSnapshotContents().Delete(context.Background(), contentName, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			syncInfo.setError(fmt.Errorf("cannot clean up volume snapshot content %s for clone %s", contentName, vmClone.Name))
+			return syncInfo
+		}
+	}
+
+	return syncInfo
+}
+
+// syncTargetNamespaceCleanup makes sure that the objects created in the target namespace are
+// deleted together with the clone, since they cannot be garbage collected. It returns true
+// if the finalizers of the clone were updated, or if the clone is being deleted, in which
+// case the clone should not be synced any further.
+func (ctrl *VMCloneController) syncTargetNamespaceCleanup(vmClone *clone.VirtualMachineClone) (bool, error) {
+	if vmClone.DeletionTimestamp == nil {
+		if controller.HasFinalizer(vmClone, targetNamespaceCleanupFinalizer) {
+			return false, nil
+		}
+		cpy := vmClone.DeepCopy()
+		controller.AddFinalizer(cpy, targetNamespaceCleanupFinalizer)
+		return true, ctrl.patchFinalizers(vmClone, cpy.Finalizers)
+	}
+
+	if !controller.HasFinalizer(vmClone, targetNamespaceCleanupFinalizer) {
+		return true, nil
+	}
+
+	syncInfo := syncInfoType{}
+	if vmClone.Status.RestoreName != nil {
+		syncInfo = ctrl.cleanupRestore(vmClone, syncInfo)
+	}
+	if syncInfo.err == nil {
+		syncInfo = ctrl.cleanupTransferredSnapshot(vmClone, syncInfo)
+	}
+	if syncInfo.err == nil && needsVirtSysprep(vmClone) {
+		syncInfo = ctrl.cleanupVirtSysprepPod(vmClone, syncInfo)
+	}
+	if syncInfo.err != nil {
+		return true, syncInfo.err
+	}
+
+	cpy := vmClone.DeepCopy()
+	controller.RemoveFinalizer(cpy, targetNamespaceCleanupFinalizer)
+	return true, ctrl.patchFinalizers(vmClone, cpy.Finalizers)
+}
+
+func (ctrl *VMCloneController) patchFinalizers(vmClone *clone.VirtualMachineClone, finalizers []string) error {
+	patchBytes, err := patch.New(
+		patch.WithTest("/metadata/finalizers", vmClone.Finalizers),
+		patch.WithReplace("/metadata/finalizers", finalizers),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.client.VirtualMachineClone(vmClone.Namespace).Patch(context.Background(), vmClone.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
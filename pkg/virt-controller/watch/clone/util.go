@@ -6,6 +6,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -57,7 +58,7 @@ func generateNameWithRandomSuffix(names ...string) string {
 }
 
 func generateSnapshotName(vmCloneUID types.UID) string {
-	return fmt.Sprintf("tmp-snapshot-%s", string(vmCloneUID))
+	return virtsnapshot.GetCloneSnapshotName(vmCloneUID)
 }
 
 func generateRestoreName(vmCloneUID types.UID) string {
@@ -126,8 +127,13 @@ func getCloneOwnerReference(cloneName string, cloneUID types.UID) metav1.OwnerRe
 
 // If the provided object is owned by a clone object, the first return parameter would be true
 // and the second one would be the key of the clone. Otherwise, the first return parameter would
-// be false and the second parameter is to be ignored.
+// be false and the second parameter is to be ignored. Objects in the target namespace of a clone
+// are annotated with the key of the clone instead.
 func isOwnedByClone(obj metav1.Object) (isOwned bool, key string) {
+	if key, exists := obj.GetAnnotations()[clone.VirtualMachineCloneAnnotation]; exists {
+		return true, key
+	}
+
 	cloneKind := clone.VirtualMachineCloneKind.Kind
 	cloneApiVersion := clone.VirtualMachineCloneKind.GroupVersion().String()
 
@@ -25,6 +25,8 @@ import (
 	"regexp"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/types"
+
 	"kubevirt.io/client-go/log"
 
 	clone "kubevirt.io/api/clone/v1beta1"
@@ -33,7 +35,10 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 )
 
-func generatePatches(source *k6tv1.VirtualMachine, cloneSpec *clone.VirtualMachineCloneSpec) ([]string, error) {
+const sysprepVolumeName = "clone-sysprep"
+
+func generatePatches(source *k6tv1.VirtualMachine, vmClone *clone.VirtualMachineClone) ([]string, error) {
+	cloneSpec := &vmClone.Spec
 	patchSet := patch.New()
 	addMacAddressPatches(patchSet, source.Spec.Template.Spec.Domain.Devices.Interfaces, cloneSpec.NewMacAddresses)
 	addSmbiosSerialPatches(patchSet, source.Spec.Template.Spec.Domain.Firmware, cloneSpec.NewSMBiosSerial)
@@ -42,6 +47,7 @@ func generatePatches(source *k6tv1.VirtualMachine, cloneSpec *clone.VirtualMachi
 	addRemovePatchesFromFilter(patchSet, source.Spec.Template.ObjectMeta.Labels, cloneSpec.Template.LabelFilters, "/spec/template/metadata/labels")
 	addRemovePatchesFromFilter(patchSet, source.Spec.Template.ObjectMeta.Annotations, cloneSpec.Template.AnnotationFilters, "/spec/template/metadata/annotations")
 	addFirmwareUUIDPatches(patchSet, source.Spec.Template.Spec.Domain.Firmware)
+	addGuestIdentityPatches(patchSet, source, cloneSpec.GuestIdentity, vmClone.UID)
 
 	patches, err := generateStringPatchOperations(patchSet)
 	if err != nil {
@@ -156,3 +162,66 @@ func addFirmwareUUIDPatches(patchSet *patch.PatchSet, firmware *k6tv1.Firmware)
 
 	patchSet.AddOption(patch.WithReplace("/spec/template/spec/domain/firmware/uuid", ""))
 }
+
+func addGuestIdentityPatches(patchSet *patch.PatchSet, source *k6tv1.VirtualMachine, guestIdentity *clone.VirtualMachineCloneGuestIdentity, cloneUID types.UID) {
+	if guestIdentity == nil {
+		return
+	}
+
+	// A new cloud-init instance ID makes cloud-init in the guest treat the target as a new instance
+	instanceID := string(cloneUID)
+	if guestIdentity.CloudInitInstanceID != nil {
+		instanceID = *guestIdentity.CloudInitInstanceID
+	}
+	if len(source.Spec.Template.ObjectMeta.Annotations) == 0 {
+		patchSet.AddOption(patch.WithAdd("/spec/template/metadata/annotations", map[string]string{
+			k6tv1.CloudInitInstanceIDAnnotation: instanceID,
+		}))
+	} else {
+		patchSet.AddOption(patch.WithAdd(fmt.Sprintf("/spec/template/metadata/annotations/%s", patch.EscapeJSONPointer(k6tv1.CloudInitInstanceIDAnnotation)), instanceID))
+	}
+
+	// The hostname defaults to the name of the target
+	if source.Spec.Template.Spec.Hostname != "" {
+		patchSet.AddOption(patch.WithRemove("/spec/template/spec/hostname"))
+	}
+
+	if guestIdentity.Generalization != nil && guestIdentity.Generalization.Sysprep != nil {
+		addSysprepPatches(patchSet, &source.Spec.Template.Spec, guestIdentity.Generalization.Sysprep)
+	}
+}
+
+func addSysprepPatches(patchSet *patch.PatchSet, vmiSpec *k6tv1.VirtualMachineInstanceSpec, sysprep *k6tv1.SysprepSource) {
+	for idx, volume := range vmiSpec.Volumes {
+		if volume.Sysprep != nil {
+			patchSet.AddOption(patch.WithReplace(fmt.Sprintf("/spec/template/spec/volumes/%d/sysprep", idx), sysprep))
+			return
+		}
+	}
+
+	volume := k6tv1.Volume{
+		Name: sysprepVolumeName,
+		VolumeSource: k6tv1.VolumeSource{
+			Sysprep: sysprep,
+		},
+	}
+	if len(vmiSpec.Volumes) == 0 {
+		patchSet.AddOption(patch.WithAdd("/spec/template/spec/volumes", []k6tv1.Volume{volume}))
+	} else {
+		patchSet.AddOption(patch.WithAdd("/spec/template/spec/volumes/-", volume))
+	}
+
+	disk := k6tv1.Disk{
+		Name: sysprepVolumeName,
+		DiskDevice: k6tv1.DiskDevice{
+			CDRom: &k6tv1.CDRomTarget{
+				Bus: k6tv1.DiskBusSATA,
+			},
+		},
+	}
+	if len(vmiSpec.Domain.Devices.Disks) == 0 {
+		patchSet.AddOption(patch.WithAdd("/spec/template/spec/domain/devices/disks", []k6tv1.Disk{disk}))
+	} else {
+		patchSet.AddOption(patch.WithAdd("/spec/template/spec/domain/devices/disks/-", disk))
+	}
+}
@@ -0,0 +1,445 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// connectionSecretKubeconfigKey is the key of the kubeconfig in the
+	// connection secret of a cross-cluster migration
+	connectionSecretKubeconfigKey = "kubeconfig"
+
+	// crossClusterSyncInterval is the interval the state of the receiving
+	// migration in the target cluster is checked at, there is no informer
+	// watching the target cluster.
+	crossClusterSyncInterval = 2 * time.Second
+)
+
+func newRemoteClient(kubeconfig []byte) (kubecli.KubevirtClient, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return kubecli.GetKubevirtClientFromRESTConfig(restConfig)
+}
+
+// remoteClient returns a client for the target cluster of a send migration. The connection
+// secrets are kept in the KubeVirt namespace, so that the controller does not need access
+// to secrets cluster-wide. The migration create admitter ensures that the creator of the
+// migration is allowed to get the secret.
+func (c *Controller) remoteClient(migration *virtv1.VirtualMachineInstanceMigration) (kubecli.KubevirtClient, error) {
+	secretName := migration.Spec.SendTo.ConnectionSecretRef.Name
+	obj, exists, err := c.secretStore.GetByKey(controller.NamespacedKey(c.kubevirtNamespace, secretName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection secret %s: %v", secretName, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("connection secret %s does not exist", secretName)
+	}
+
+	kubeconfig, ok := obj.(*k8sv1.Secret).Data[connectionSecretKubeconfigKey]
+	if !ok {
+		return nil, fmt.Errorf("connection secret %s has no %s key", secretName, connectionSecretKubeconfigKey)
+	}
+	return c.remoteClientFunc(kubeconfig)
+}
+
+// receivingVMI looks up the migration with the migration ID of the send migration in the
+// target cluster and returns it together with the VMI it receives
+func receivingVMI(remoteClient kubecli.KubevirtClient, migration *virtv1.VirtualMachineInstanceMigration) (*virtv1.VirtualMachineInstanceMigration, *virtv1.VirtualMachineInstance, error) {
+	migrationList, err := remoteClient.VirtualMachineInstanceMigration(migration.Namespace).List(context.Background(), v1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var receiving *virtv1.VirtualMachineInstanceMigration
+	for i := range migrationList.Items {
+		candidate := &migrationList.Items[i]
+		if candidate.Spec.Receive == nil || candidate.Spec.Receive.MigrationID != migration.Spec.SendTo.MigrationID {
+			continue
+		}
+		// prefer a migration which is still in progress over finalized ones
+		if receiving == nil || (receiving.IsFinal() && !candidate.IsFinal()) {
+			receiving = candidate
+		}
+	}
+	if receiving == nil {
+		return nil, nil, nil
+	}
+
+	vmi, err := remoteClient.VirtualMachineInstance(migration.Namespace).Get(context.Background(), receiving.Spec.VMIName, v1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return receiving, vmi, nil
+}
+
+// isVMISent returns true if the VMI is final because it was sent to another cluster
+func isVMISent(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) bool {
+	state := vmi.Status.MigrationState
+	return migration.Spec.SendTo != nil && state != nil && state.MigrationUID == migration.UID &&
+		state.Completed && !state.Failed
+}
+
+func hasVolumesToCopy(vmi *virtv1.VirtualMachineInstance) bool {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil || volume.DataVolume != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// handleSendHandoff waits for the receiving migration in the target cluster to prepare
+// its target and hands the migration off to virt-handler on the source node.
+func (c *Controller) handleSendHandoff(key string, migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID {
+		// already handed off
+		return nil
+	}
+
+	remoteClient, err := c.remoteClient(migration)
+	if err != nil {
+		return err
+	}
+	receiving, remoteVMI, err := receivingVMI(remoteClient, migration)
+	if err != nil {
+		return fmt.Errorf("failed to look up the receiving migration %s: %v", migration.Spec.SendTo.MigrationID, err)
+	}
+	if receiving == nil {
+		log.Log.Object(migration).V(3).Infof("Waiting for the receiving migration %s to be created", migration.Spec.SendTo.MigrationID)
+		c.Queue.AddAfter(key, crossClusterSyncInterval)
+		return nil
+	}
+	if receiving.IsFinal() {
+		return c.markSendFailedOnVMI(migration, vmi, fmt.Sprintf("receiving migration %s/%s is %s", receiving.Namespace, receiving.Name, receiving.Status.Phase))
+	}
+
+	targetState := remoteVMI.Status.MigrationState
+	if targetState == nil || targetState.MigrationUID != receiving.UID ||
+		targetState.TargetNodeAddress == "" || len(targetState.TargetDirectMigrationNodePorts) == 0 {
+		log.Log.Object(migration).V(3).Infof("Waiting for the target of the receiving migration %s to be ready", migration.Spec.SendTo.MigrationID)
+		c.Queue.AddAfter(key, crossClusterSyncInterval)
+		return nil
+	}
+
+	if err := c.handOverVMRunStrategy(vmi); err != nil {
+		return err
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+		MigrationUID:                    migration.UID,
+		SourceNode:                      vmi.Status.NodeName,
+		TargetNode:                      targetState.TargetNode,
+		TargetPod:                       targetState.TargetPod,
+		TargetNodeAddress:               targetState.TargetNodeAddress,
+		TargetDirectMigrationNodePorts:  targetState.TargetDirectMigrationNodePorts,
+		TargetVirtualMachineInstanceUID: remoteVMI.UID,
+	}
+	if migration.Status.MigrationState != nil {
+		vmiCopy.Status.MigrationState.SourcePod = migration.Status.MigrationState.SourcePod
+	}
+	if hasVolumesToCopy(vmi) {
+		vmiCopy.Status.MigrationMethod = virtv1.BlockMigration
+	}
+
+	clusterMigrationConfigs := c.clusterConfig.GetMigrationConfiguration().DeepCopy()
+	if err := c.matchMigrationPolicy(vmiCopy, clusterMigrationConfigs); err != nil {
+		return fmt.Errorf("failed to match migration policy: %v", err)
+	}
+	if !c.isMigrationPolicyMatched(vmiCopy) {
+		vmiCopy.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
+	}
+
+	if err := c.patchVMI(vmi, vmiCopy); err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedHandOverPodReason, fmt.Sprintf("Failed to set MigrationStat in VMI status. :%v", err))
+		return err
+	}
+
+	c.addHandOffKey(controller.MigrationKey(migration))
+	log.Log.Object(vmi).Infof("Handed off migration %s/%s to the source virt-handler.", migration.Namespace, migration.Name)
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, controller.SuccessfulHandOverPodReason, "Migration target in another cluster is ready, sending VMI.")
+	return nil
+}
+
+// syncSentMigration reports the progress of the target in the other cluster to
+// virt-handler on the source node, which waits for the target node to detect
+// the domain before it finalizes the migration.
+func (c *Controller) syncSentMigration(key string, migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	state := vmi.Status.MigrationState
+	if state.Completed || state.Failed {
+		return nil
+	}
+	c.Queue.AddAfter(key, crossClusterSyncInterval)
+
+	remoteClient, err := c.remoteClient(migration)
+	if err != nil {
+		return err
+	}
+	remoteVMI, err := remoteClient.VirtualMachineInstance(migration.Namespace).Get(context.Background(), vmi.Name, v1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get the receiving VMI: %v", err)
+	}
+	if remoteVMI.UID != state.TargetVirtualMachineInstanceUID || remoteVMI.Status.MigrationState == nil {
+		return fmt.Errorf("the receiving VMI %s/%s is gone", migration.Namespace, vmi.Name)
+	}
+
+	targetState := remoteVMI.Status.MigrationState
+	if targetState.Failed && state.StartTimestamp == nil {
+		return c.markSendFailedOnVMI(migration, vmi, "the receiving migration failed")
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.MigrationState.TargetNodeDomainDetected = targetState.TargetNodeDomainDetected
+	vmiCopy.Status.MigrationState.TargetNodeDomainReadyTimestamp = targetState.TargetNodeDomainReadyTimestamp
+	return c.patchVMI(vmi, vmiCopy)
+}
+
+// markSendFailedOnVMI fails a send migration which virt-handler did not start yet
+func (c *Controller) markSendFailedOnVMI(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, reason string) error {
+	vmiCopy := vmi.DeepCopy()
+	if vmiCopy.Status.MigrationState == nil || vmiCopy.Status.MigrationState.MigrationUID != migration.UID {
+		vmiCopy.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+			MigrationUID: migration.UID,
+			SourceNode:   vmi.Status.NodeName,
+		}
+	}
+	now := v1.NewTime(time.Now())
+	vmiCopy.Status.MigrationState.StartTimestamp = &now
+	vmiCopy.Status.MigrationState.EndTimestamp = &now
+	vmiCopy.Status.MigrationState.Failed = true
+	vmiCopy.Status.MigrationState.Completed = true
+	vmiCopy.Status.MigrationState.FailureReason = reason
+
+	if err := c.patchVMI(vmi, vmiCopy); err != nil {
+		return err
+	}
+	c.recorder.Event(vmi, k8sv1.EventTypeWarning, controller.FailedMigrationReason, fmt.Sprintf("VirtualMachineInstance migration uid %s failed. reason: %s", string(migration.UID), reason))
+	return nil
+}
+
+// finalizeSentMigration hands the VM over to the other cluster once the VMI was
+// received, or gives it back to its original run strategy if the migration failed.
+// In the latter case the receiving migration is failed too.
+func (c *Controller) finalizeSentMigration(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if migration.Status.Phase == virtv1.MigrationSucceeded {
+		return c.restoreVMRunStrategy(vmi, virtv1.RunStrategyHalted)
+	}
+
+	if err := c.restoreVMRunStrategy(vmi, ""); err != nil {
+		return err
+	}
+
+	state := vmi.Status.MigrationState
+	if state == nil || state.MigrationUID != migration.UID || state.TargetVirtualMachineInstanceUID == "" {
+		// the receiving VMI was never involved
+		return nil
+	}
+
+	remoteClient, err := c.remoteClient(migration)
+	if err != nil {
+		return err
+	}
+	remoteVMI, err := remoteClient.VirtualMachineInstance(migration.Namespace).Get(context.Background(), vmi.Name, v1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	targetState := remoteVMI.Status.MigrationState
+	if remoteVMI.UID != state.TargetVirtualMachineInstanceUID || targetState == nil || targetState.Failed || targetState.Completed {
+		return nil
+	}
+
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/migrationState/migrationUid", targetState.MigrationUID),
+		patch.WithAdd("/status/migrationState/failed", true),
+		patch.WithAdd("/status/migrationState/failureReason", "the sending migration failed"),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = remoteClient.VirtualMachineInstance(remoteVMI.Namespace).Patch(context.Background(), remoteVMI.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	return err
+}
+
+// handOverVMRunStrategy switches the VM of a VMI which is sent to another cluster to the
+// Manual run strategy, so that the VMI is not restarted once it is gone. The original run
+// strategy is kept in an annotation.
+func (c *Controller) handOverVMRunStrategy(vmi *virtv1.VirtualMachineInstance) error {
+	vm, err := c.vmOfVMI(vmi)
+	if vm == nil || err != nil {
+		return err
+	}
+	if _, exists := vm.Annotations[virtv1.CrossClusterMigrationRunStrategyAnnotation]; exists {
+		return nil
+	}
+
+	runStrategy, err := vm.RunStrategy()
+	if err != nil {
+		return err
+	}
+
+	patchSet := patch.New()
+	if vm.Spec.Running != nil {
+		patchSet.AddOption(patch.WithRemove("/spec/running"))
+	}
+	patchSet.AddOption(patch.WithAdd("/spec/runStrategy", virtv1.RunStrategyManual))
+	if vm.Annotations == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{virtv1.CrossClusterMigrationRunStrategyAnnotation: string(runStrategy)}))
+	} else {
+		patchSet.AddOption(patch.WithAdd(fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(virtv1.CrossClusterMigrationRunStrategyAnnotation)), string(runStrategy)))
+	}
+	return c.patchVM(vm, patchSet)
+}
+
+// restoreVMRunStrategy sets the run strategy of the VM back to the one it had before
+// its VMI was sent to another cluster, or to the given run strategy if not empty.
+func (c *Controller) restoreVMRunStrategy(vmi *virtv1.VirtualMachineInstance, runStrategy virtv1.VirtualMachineRunStrategy) error {
+	vm, err := c.vmOfVMI(vmi)
+	if vm == nil || err != nil {
+		return err
+	}
+	originalRunStrategy, exists := vm.Annotations[virtv1.CrossClusterMigrationRunStrategyAnnotation]
+	if !exists {
+		return nil
+	}
+	if runStrategy == "" {
+		runStrategy = virtv1.VirtualMachineRunStrategy(originalRunStrategy)
+	}
+
+	return c.patchVM(vm, patch.New(
+		patch.WithAdd("/spec/runStrategy", runStrategy),
+		patch.WithRemove(fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(virtv1.CrossClusterMigrationRunStrategyAnnotation))),
+	))
+}
+
+func (c *Controller) vmOfVMI(vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, error) {
+	owner := v1.GetControllerOf(vmi)
+	if owner == nil || owner.Kind != virtv1.VirtualMachineGroupVersionKind.Kind {
+		return nil, nil
+	}
+	vm, err := c.clientset.VirtualMachine(vmi.Namespace).Get(context.Background(), owner.Name, v1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if vm.UID != owner.UID {
+		return nil, nil
+	}
+	return vm, nil
+}
+
+func (c *Controller) patchVM(vm *virtv1.VirtualMachine, patchSet *patch.PatchSet) error {
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	return err
+}
+
+// handleReceiverTargetPodCreation creates the target pod for a VMI received from
+// another cluster. There is no source pod in this cluster.
+func (c *Controller) handleReceiverTargetPodCreation(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if err := c.handleBackendStorage(migration, vmi); err != nil {
+		return err
+	}
+	return c.createTargetPod(migration, vmi, nil)
+}
+
+// finalizeReceivedVMI hands a received VMI over to the target node, once the
+// target node detected the running domain. From then on the VMI is handled
+// like any other running VMI.
+func (c *Controller) finalizeReceivedVMI(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	state := vmi.Status.MigrationState
+	if state.Completed {
+		return nil
+	}
+
+	if err := c.removeReceiverAnnotationFromVM(vmi); err != nil {
+		return err
+	}
+
+	patchSet := patch.New(
+		patch.WithTest("/status/migrationState/migrationUid", migration.UID),
+		patch.WithAdd("/status/migrationState/completed", true),
+		patch.WithAdd("/status/migrationState/endTimestamp", v1.Now()),
+		patch.WithAdd("/status/nodeName", state.TargetNode),
+		patch.WithReplace("/status/phase", virtv1.Running),
+		patch.WithRemove(fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(virtv1.MigrationReceiverAnnotation))),
+	)
+	if vmi.Labels == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/labels", map[string]string{virtv1.NodeNameLabel: state.TargetNode}))
+	} else {
+		patchSet.AddOption(patch.WithAdd(fmt.Sprintf("/metadata/labels/%s", patch.EscapeJSONPointer(virtv1.NodeNameLabel)), state.TargetNode))
+	}
+	if state.StartTimestamp == nil {
+		patchSet.AddOption(patch.WithAdd("/status/migrationState/startTimestamp", state.TargetNodeDomainReadyTimestamp))
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{}); err != nil {
+		return err
+	}
+
+	log.Log.Object(vmi).Infof("Received VMI from another cluster on node %s", state.TargetNode)
+	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, virtv1.Migrated.String(), "The VirtualMachineInstance was received on node %s.", state.TargetNode)
+	return nil
+}
+
+// removeReceiverAnnotationFromVM makes sure that the VM of a received VMI starts
+// future VMIs normally instead of waiting for another migration.
+func (c *Controller) removeReceiverAnnotationFromVM(vmi *virtv1.VirtualMachineInstance) error {
+	vm, err := c.vmOfVMI(vmi)
+	if vm == nil || err != nil {
+		return err
+	}
+	if vm.Spec.Template == nil {
+		return nil
+	}
+	if _, exists := vm.Spec.Template.ObjectMeta.Annotations[virtv1.MigrationReceiverAnnotation]; !exists {
+		return nil
+	}
+	return c.patchVM(vm, patch.New(
+		patch.WithRemove(fmt.Sprintf("/spec/template/metadata/annotations/%s", patch.EscapeJSONPointer(virtv1.MigrationReceiverAnnotation))),
+	))
+}
@@ -106,6 +106,8 @@ type Controller struct {
 	pdbIndexer           cache.Indexer
 	migrationPolicyStore cache.Store
 	resourceQuotaIndexer cache.Indexer
+	secretStore          cache.Store
+	namespaceStore       cache.Store
 	recorder             record.EventRecorder
 	podExpectations      *controller.UIDTrackingControllerExpectations
 	pvcExpectations      *controller.UIDTrackingControllerExpectations
@@ -113,11 +115,21 @@ type Controller struct {
 	clusterConfig        *virtconfig.ClusterConfig
 	hasSynced            func() bool
 
+	// the set of pending migrations waiting for a free migration slot,
+	// protected by the migrationStartLock. the map keys are migration keys
+	queuedMigrations map[string]struct{}
+
 	// the set of cancelled migrations before being handed off to virt-handler.
 	// the map keys are migration keys
 	handOffLock sync.Mutex
 	handOffMap  map[string]struct{}
 
+	// builds the clients for the target clusters of cross-cluster migrations
+	remoteClientFunc func(kubeconfig []byte) (kubecli.KubevirtClient, error)
+	// the namespace KubeVirt is installed in, it holds the connection secrets
+	// of cross-cluster migrations, which are read from the secretStore
+	kubevirtNamespace string
+
 	unschedulablePendingTimeoutSeconds int64
 	catchAllPendingTimeoutSeconds      int64
 }
@@ -133,9 +145,12 @@ func NewController(templateService services.TemplateService,
 	pdbInformer cache.SharedIndexInformer,
 	migrationPolicyInformer cache.SharedIndexInformer,
 	resourceQuotaInformer cache.SharedIndexInformer,
+	secretInformer cache.SharedIndexInformer,
+	namespaceInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
+	kubevirtNamespace string,
 ) (*Controller, error) {
 
 	c := &Controller{
@@ -154,20 +169,25 @@ func NewController(templateService services.TemplateService,
 		pdbIndexer:           pdbInformer.GetIndexer(),
 		resourceQuotaIndexer: resourceQuotaInformer.GetIndexer(),
 		migrationPolicyStore: migrationPolicyInformer.GetStore(),
+		secretStore:          secretInformer.GetStore(),
+		namespaceStore:       namespaceInformer.GetStore(),
 		recorder:             recorder,
 		clientset:            clientset,
 		podExpectations:      controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		pvcExpectations:      controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		migrationStartLock:   &sync.Mutex{},
+		queuedMigrations:     make(map[string]struct{}),
 		clusterConfig:        clusterConfig,
 		handOffMap:           make(map[string]struct{}),
+		remoteClientFunc:     newRemoteClient,
+		kubevirtNamespace:    kubevirtNamespace,
 
 		unschedulablePendingTimeoutSeconds: defaultUnschedulablePendingTimeoutSeconds,
 		catchAllPendingTimeoutSeconds:      defaultCatchAllPendingTimeoutSeconds,
 	}
 
 	c.hasSynced = func() bool {
-		return vmiInformer.HasSynced() && podInformer.HasSynced() && migrationInformer.HasSynced() && pdbInformer.HasSynced() && resourceQuotaInformer.HasSynced()
+		return vmiInformer.HasSynced() && podInformer.HasSynced() && migrationInformer.HasSynced() && pdbInformer.HasSynced() && resourceQuotaInformer.HasSynced() && secretInformer.HasSynced() && namespaceInformer.HasSynced()
 	}
 
 	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -279,6 +299,10 @@ func (c *Controller) patchVMI(origVMI, newVMI *virtv1.VirtualMachineInstance) er
 		}
 	}
 
+	if origVMI.Status.MigrationMethod != newVMI.Status.MigrationMethod {
+		patchSet.AddOption(patch.WithAdd("/status/migrationMethod", newVMI.Status.MigrationMethod))
+	}
+
 	if !equality.Semantic.DeepEqual(origVMI.Labels, newVMI.Labels) {
 		patchSet.AddOption(
 			patch.WithTest("/metadata/labels", origVMI.Labels),
@@ -311,6 +335,7 @@ func (c *Controller) execute(key string) error {
 	if !exists {
 		c.podExpectations.DeleteExpectations(key)
 		c.removeHandOffKey(key)
+		c.removeQueuedMigration(key)
 		return nil
 	}
 	migration := obj.(*virtv1.VirtualMachineInstanceMigration)
@@ -369,6 +394,18 @@ func (c *Controller) execute(key string) error {
 	}
 
 	if migration.IsFinal() {
+		if migration.Spec.SendTo != nil {
+			err = c.finalizeSentMigration(migration, vmi)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = c.handleMigrationRetry(migration, vmi)
+		if err != nil {
+			return err
+		}
+
 		err = c.garbageCollectFinalizedMigrations(vmi)
 		if err != nil {
 			return err
@@ -465,7 +502,7 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		}
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed because vmi does not exist.")
 		log.Log.Object(migration).Error("vmi does not exist")
-	} else if vmi.IsFinal() {
+	} else if vmi.IsFinal() && !isVMISent(migration, vmi) {
 		err := c.failMigration(migrationCopy)
 		if err != nil {
 			return err
@@ -493,7 +530,7 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		}
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed because target pod shutdown during migration")
 		log.Log.Object(migration).Errorf("target pod %s/%s shutdown during migration", pod.Namespace, pod.Name)
-	} else if migration.TargetIsCreated() && !podExists {
+	} else if migration.TargetIsCreated() && !podExists && migration.Spec.SendTo == nil {
 		err := c.failMigration(migrationCopy)
 		if err != nil {
 			return err
@@ -554,6 +591,10 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		}
 	}
 
+	if migrationCopy.Status.Retry == nil {
+		migrationCopy.Status.Retry = newMigrationRetryStatus(migration)
+	}
+
 	controller.SetVMIMigrationPhaseTransitionTimestamp(migration, migrationCopy)
 	controller.SetSourcePod(migrationCopy, vmi, c.podIndexer)
 
@@ -600,7 +641,14 @@ func (c *Controller) processMigrationPhase(
 			log.Log.Object(migration).Error("Migration object ont eligible for migration because another job is in progress")
 		}
 	case virtv1.MigrationPending:
-		if pod != nil {
+		if migration.Spec.SendTo != nil {
+			// the target pod is created by the receiving migration in the target cluster
+			if vmi.Status.MigrationState != nil &&
+				vmi.Status.MigrationState.MigrationUID == migration.UID &&
+				vmi.Status.MigrationState.TargetNode != "" {
+				migrationCopy.Status.Phase = virtv1.MigrationPreparingTarget
+			}
+		} else if pod != nil {
 			if controller.VMIHasHotplugVolumes(vmi) {
 				if attachmentPod != nil {
 					migrationCopy.Status.Phase = virtv1.MigrationScheduling
@@ -641,11 +689,18 @@ func (c *Controller) processMigrationPhase(
 			migrationCopy.Status.Phase = virtv1.MigrationTargetReady
 		}
 	case virtv1.MigrationTargetReady:
-		if vmi.Status.MigrationState.StartTimestamp != nil {
+		// the source of a received VMI is in another cluster, the
+		// target detecting the incoming domain is the first sign of it
+		if vmi.Status.MigrationState.StartTimestamp != nil ||
+			(migration.Spec.Receive != nil && vmi.Status.MigrationState.TargetNodeDomainDetected) {
 			migrationCopy.Status.Phase = virtv1.MigrationRunning
 		}
 	case virtv1.MigrationRunning:
-		_, exists := pod.Annotations[virtv1.MigrationTargetReadyTimestamp]
+		// the target pod of a sent VMI is in another cluster
+		exists := pod == nil
+		if pod != nil {
+			_, exists = pod.Annotations[virtv1.MigrationTargetReadyTimestamp]
+		}
 		if !exists && vmi.Status.MigrationState.TargetNodeDomainReadyTimestamp != nil {
 			if backendstorage.IsBackendStorageNeededForVMI(&vmi.Spec) {
 				err := backendstorage.MigrationHandoff(c.clientset, c.pvcStore, migration)
@@ -713,7 +768,14 @@ func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMig
 		// Give time to the PVC informer to update itself
 		return nil
 	}
-	templatePod, err := c.templateService.RenderMigrationManifest(vmi, migration, sourcePod)
+	var templatePod *k8sv1.Pod
+	var err error
+	if sourcePod == nil {
+		// the source of a received VMI runs in another cluster
+		templatePod, err = c.templateService.RenderLaunchManifest(vmi)
+	} else {
+		templatePod, err = c.templateService.RenderMigrationManifest(vmi, migration, sourcePod)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to render launch manifest: %v", err)
 	}
@@ -740,11 +802,13 @@ func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMig
 		templatePod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(templatePod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, antiAffinityTerm)
 	}
 
+	applyMigrationTargetConstraints(migration, templatePod)
+
 	templatePod.ObjectMeta.Labels[virtv1.MigrationJobLabel] = string(migration.UID)
 	templatePod.ObjectMeta.Annotations[virtv1.MigrationJobNameAnnotation] = migration.Name
 
 	// If cpu model is "host model" allow migration only to nodes that supports this cpu model
-	if cpu := vmi.Spec.Domain.CPU; cpu != nil && cpu.Model == virtv1.CPUModeHostModel {
+	if cpu := vmi.Spec.Domain.CPU; cpu != nil && cpu.Model == virtv1.CPUModeHostModel && sourcePod != nil {
 		node, err := c.getNodeForVMI(vmi)
 
 		if err != nil {
@@ -758,7 +822,7 @@ func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMig
 	}
 
 	matchLevelOnTarget := c.clusterConfig.GetMigrationConfiguration().MatchSELinuxLevelOnMigration
-	if matchLevelOnTarget == nil || *matchLevelOnTarget {
+	if (matchLevelOnTarget == nil || *matchLevelOnTarget) && sourcePod != nil {
 		err = setTargetPodSELinuxLevel(templatePod, vmi.Status.SelinuxContext)
 		if err != nil {
 			return err
@@ -828,6 +892,10 @@ func (c *Controller) handleMigrationBackoff(key string, vmi *virtv1.VirtualMachi
 	if !existsEvacMig && !existsWorkUpdMig {
 		return nil
 	}
+	// Retries of failed migrations already wait for the backoff of their retry policy
+	if migrationAttempt(migration) > 1 {
+		return nil
+	}
 
 	migrations, err := c.listBackoffEligibleMigrations(vmi.Namespace, vmi.Name)
 	if err != nil {
@@ -957,6 +1025,11 @@ func (c *Controller) handleTargetPodHandoff(migration *virtv1.VirtualMachineInst
 	// the vmi and prepare the local environment for the migration
 	vmiCopy.ObjectMeta.Labels[virtv1.MigrationTargetNodeNameLabel] = pod.Spec.NodeName
 
+	// The volumes of a VMI received from another cluster are copied
+	if migration.Spec.Receive != nil {
+		vmiCopy.Status.MigrationMethod = virtv1.BlockMigration
+	}
+
 	if controller.VMIHasHotplugVolumes(vmiCopy) {
 		attachmentPods, err := controller.AttachmentPods(pod, c.podIndexer)
 		if err != nil {
@@ -980,6 +1053,16 @@ func (c *Controller) handleTargetPodHandoff(migration *virtv1.VirtualMachineInst
 		vmiCopy.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
 	}
 
+	if migrationAttempt(migration) > 1 {
+		retryPolicy, err := c.getMigrationPolicyRetryPolicy(vmiCopy)
+		if err != nil {
+			return fmt.Errorf("failed to get the retry policy of the migration policy: %v", err)
+		}
+		if retryPolicy != nil {
+			applyMigrationRetryFallback(retryPolicy, vmiCopy.Status.MigrationState.MigrationConfiguration)
+		}
+	}
+
 	if controller.VMIHasHotplugCPU(vmi) && vmi.IsCPUDedicated() {
 		cpuLimitsCount, err := getTargetPodLimitsCount(pod)
 		if err != nil {
@@ -1078,6 +1161,11 @@ func (c *Controller) handleTargetPodCreation(key string, migration *virtv1.Virtu
 
 	}
 
+	priorityQueueEnabled := c.clusterConfig.MigrationPriorityQueueEnabled()
+	if priorityQueueEnabled {
+		c.queuedMigrations[key] = struct{}{}
+	}
+
 	// Don't start new migrations if we wait for migration object updates because of new target pods
 	runningMigrations, err := c.findRunningMigrations()
 	if err != nil {
@@ -1106,6 +1194,19 @@ func (c *Controller) handleTargetPodCreation(key string, migration *virtv1.Virtu
 		return nil
 	}
 
+	if priorityQueueEnabled {
+		ahead, err := c.queuedMigrationsAhead(key, migration, vmi, runningMigrations)
+		if err != nil {
+			return err
+		}
+		freeSlots := int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster) - len(runningMigrations)
+		if ahead >= freeSlots {
+			log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because [%d] queued migrations take precedence.", vmi.Namespace, vmi.Name, ahead)
+			c.Queue.AddAfter(key, time.Second*5)
+			return nil
+		}
+	}
+
 	// migration was accepted into the system, now see if we
 	// should create the target pod
 	if vmi.IsRunning() {
@@ -1138,7 +1239,10 @@ func (c *Controller) handleTargetPodCreation(key string, migration *virtv1.Virtu
 		if err != nil {
 			return err
 		}
-		return c.createTargetPod(migration, vmi, sourcePod)
+		if err := c.createTargetPod(migration, vmi, sourcePod); err != nil {
+			return err
+		}
+		delete(c.queuedMigrations, key)
 	}
 	return nil
 }
@@ -1362,6 +1466,19 @@ func (c *Controller) sync(key string, migration *virtv1.VirtualMachineInstanceMi
 		return nil
 	}
 
+	// Cross-cluster migrations are finalized by the controllers, the
+	// node on the other end of the migration is in another cluster.
+	if handedOff := vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID; handedOff {
+		if migration.Spec.Receive != nil && vmi.Status.MigrationState.TargetNodeDomainReadyTimestamp != nil {
+			return c.finalizeReceivedVMI(migration, vmi)
+		}
+		if migration.Spec.SendTo != nil {
+			if err := c.syncSentMigration(key, migration, vmi); err != nil {
+				return err
+			}
+		}
+	}
+
 	if migrationFinalizedOnVMI := vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID &&
 		vmi.Status.MigrationState.EndTimestamp != nil; migrationFinalizedOnVMI {
 		return nil
@@ -1381,13 +1498,26 @@ func (c *Controller) sync(key string, migration *virtv1.VirtualMachineInstanceMi
 		if migration.DeletionTimestamp != nil {
 			return c.handlePreHandoffMigrationCancel(migration, vmi, pod)
 		}
+		if backoffUntil := migrationRetryBackoffUntil(migration); backoffUntil != nil {
+			if backoff := time.Until(backoffUntil.Time); backoff > 0 {
+				log.Log.Object(migration).Infof("retry in backoff, re-enqueueing after %v", backoff)
+				c.Queue.AddAfter(key, backoff)
+				return nil
+			}
+		}
 		if err = c.handleMigrationBackoff(key, vmi, migration); errors.Is(err, migrationBackoffError) {
 			warningMsg := fmt.Sprintf("backoff migrating vmi %s/%s", vmi.Namespace, vmi.Name)
 			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, err.Error(), warningMsg)
 			return nil
 		}
 
-		if !targetPodExists {
+		if migration.Spec.SendTo != nil {
+			return c.handleSendHandoff(key, migration, vmi)
+		}
+
+		if !targetPodExists && migration.Spec.Receive != nil {
+			return c.handleReceiverTargetPodCreation(migration, vmi)
+		} else if !targetPodExists {
 			sourcePod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
 			if err != nil {
 				log.Log.Reason(err).Error("Failed to fetch pods for namespace from cache.")
@@ -2056,6 +2186,95 @@ func (c *Controller) alertIfHostModelIsUnschedulable(vmi *virtv1.VirtualMachineI
 	}
 }
 
+// applyMigrationTargetConstraints restricts the nodes the target pod can be scheduled to
+// with the node selector and affinity requested by the migration. Constraints already set
+// on the pod are preserved, the migration can only narrow them down.
+func applyMigrationTargetConstraints(migration *virtv1.VirtualMachineInstanceMigration, pod *k8sv1.Pod) {
+	for key, value := range migration.Spec.NodeSelector {
+		if pod.Spec.NodeSelector == nil {
+			pod.Spec.NodeSelector = map[string]string{}
+		}
+		if _, exists := pod.Spec.NodeSelector[key]; !exists {
+			pod.Spec.NodeSelector[key] = value
+		}
+	}
+
+	affinity := migration.Spec.Affinity
+	if affinity == nil {
+		return
+	}
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &k8sv1.Affinity{}
+	}
+
+	if affinity.NodeAffinity != nil {
+		if pod.Spec.Affinity.NodeAffinity == nil {
+			pod.Spec.Affinity.NodeAffinity = &k8sv1.NodeAffinity{}
+		}
+		nodeAffinity := pod.Spec.Affinity.NodeAffinity
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = mergeNodeSelectors(
+			nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+			affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+		)
+		nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+			nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution...,
+		)
+	}
+
+	if affinity.PodAffinity != nil {
+		if pod.Spec.Affinity.PodAffinity == nil {
+			pod.Spec.Affinity.PodAffinity = &k8sv1.PodAffinity{}
+		}
+		podAffinity := pod.Spec.Affinity.PodAffinity
+		podAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+			podAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+			affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution...,
+		)
+		podAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+			podAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution...,
+		)
+	}
+
+	if affinity.PodAntiAffinity != nil {
+		if pod.Spec.Affinity.PodAntiAffinity == nil {
+			pod.Spec.Affinity.PodAntiAffinity = &k8sv1.PodAntiAffinity{}
+		}
+		podAntiAffinity := pod.Spec.Affinity.PodAntiAffinity
+		podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+			podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+			affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution...,
+		)
+		podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+			podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution...,
+		)
+	}
+}
+
+// mergeNodeSelectors returns a node selector matching only the nodes matched by both selectors.
+// Node selector terms are ORed, so every term of one selector is combined with every term of the other.
+func mergeNodeSelectors(existing, added *k8sv1.NodeSelector) *k8sv1.NodeSelector {
+	if added == nil || len(added.NodeSelectorTerms) == 0 {
+		return existing
+	}
+	if existing == nil || len(existing.NodeSelectorTerms) == 0 {
+		return added.DeepCopy()
+	}
+
+	merged := &k8sv1.NodeSelector{}
+	for _, existingTerm := range existing.NodeSelectorTerms {
+		for _, addedTerm := range added.NodeSelectorTerms {
+			term := existingTerm.DeepCopy()
+			term.MatchExpressions = append(term.MatchExpressions, addedTerm.MatchExpressions...)
+			term.MatchFields = append(term.MatchFields, addedTerm.MatchFields...)
+			merged.NodeSelectorTerms = append(merged.NodeSelectorTerms, *term)
+		}
+	}
+	return merged
+}
+
 func prepareNodeSelectorForHostCpuModel(node *k8sv1.Node, pod *k8sv1.Pod, sourcePod *k8sv1.Pod) error {
 	var hostCpuModel, nodeSelectorKeyForHostModel, hostModelLabelValue string
 	migratedAtLeastOnce := false
@@ -2112,17 +2331,8 @@ func (c *Controller) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, cl
 		return err
 	}
 
-	// Fetch cluster policies
-	var policies []v1alpha1.MigrationPolicy
-	migrationInterfaceList := c.migrationPolicyStore.List()
-	for _, obj := range migrationInterfaceList {
-		policy := obj.(*v1alpha1.MigrationPolicy)
-		policies = append(policies, *policy)
-	}
-	policiesListObj := v1alpha1.MigrationPolicyList{Items: policies}
-
 	// Override cluster-wide migration configuration if migration policy is matched
-	matchedPolicy := matchPolicy(&policiesListObj, vmi, vmiNamespace)
+	matchedPolicy := matchPolicy(c.listMigrationPolicies(), vmi, vmiNamespace)
 
 	if matchedPolicy == nil {
 		log.Log.Object(vmi).Reason(err).Infof("no migration policy matched for VMI %s", vmi.Name)
@@ -2143,6 +2353,15 @@ func (c *Controller) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, cl
 	return nil
 }
 
+func (c *Controller) listMigrationPolicies() *v1alpha1.MigrationPolicyList {
+	var policies []v1alpha1.MigrationPolicy
+	for _, obj := range c.migrationPolicyStore.List() {
+		policy := obj.(*v1alpha1.MigrationPolicy)
+		policies = append(policies, *policy)
+	}
+	return &v1alpha1.MigrationPolicyList{Items: policies}
+}
+
 func (c *Controller) isMigrationPolicyMatched(vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi == nil {
 		return false
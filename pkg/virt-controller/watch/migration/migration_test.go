@@ -59,6 +59,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
 )
@@ -244,6 +245,7 @@ var _ = Describe("Migration watcher", func() {
 		resourceQuotaInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
 		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
 		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		secretInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Secret{})
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
@@ -265,9 +267,12 @@ var _ = Describe("Migration watcher", func() {
 			pdbInformer,
 			migrationPolicyInformer,
 			resourceQuotaInformer,
+			secretInformer,
+			namespaceInformer,
 			recorder,
 			virtClient,
 			config,
+			"kubevirt",
 		)
 		// Wrap our workqueue to have a way to detect when we are done processing updates
 		mockQueue = testutils.NewMockWorkQueue(controller.Queue)
@@ -277,6 +282,7 @@ var _ = Describe("Migration watcher", func() {
 			TypeMeta:   metav1.TypeMeta{Kind: "Namespace"},
 			ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault},
 		}
+		Expect(namespaceInformer.GetStore().Add(&namespace)).To(Succeed())
 
 		// Set up mock client
 		kubeClient = fake.NewSimpleClientset(&namespace)
@@ -884,6 +890,60 @@ var _ = Describe("Migration watcher", func() {
 			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 2, 1, 1)
 		})
 
+		It("should create target pod restricted to the nodes requested by the migration", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			vmi.Spec.NodeSelector = map[string]string{
+				"zone": "east",
+			}
+			vmi.Spec.Affinity = &k8sv1.Affinity{
+				NodeAffinity: &k8sv1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+						NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+							{MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "rack", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"a"}}}},
+							{MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "rack", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"b"}}}},
+						},
+					},
+				},
+			}
+
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.Spec.NodeSelector = map[string]string{
+				"zone":        "west",
+				"maintenance": "target",
+			}
+			migration.Spec.Affinity = &k8sv1.Affinity{
+				NodeAffinity: &k8sv1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+						NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+							{MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "gpu", Operator: k8sv1.NodeSelectorOpExists}}},
+						},
+					},
+				},
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 2)
+
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{
+				LabelSelector: fmt.Sprintf("%s=%s", virtv1.MigrationJobLabel, string(migration.UID)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(HaveLen(1))
+			targetPod := pods.Items[0]
+			Expect(targetPod.Spec.NodeSelector).To(HaveKeyWithValue("zone", "east"))
+			Expect(targetPod.Spec.NodeSelector).To(HaveKeyWithValue("maintenance", "target"))
+			for _, term := range targetPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+				Expect(term.MatchExpressions).To(ContainElement(HaveField("Key", "rack")))
+				Expect(term.MatchExpressions).To(ContainElement(HaveField("Key", "gpu")))
+			}
+		})
+
 		It("should place migration in scheduling state if pod exists", func() {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
 			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
@@ -974,6 +1034,118 @@ var _ = Describe("Migration watcher", func() {
 		)
 	})
 
+	Context("Migration priority queue", func() {
+		BeforeEach(func() {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				DeveloperConfiguration: &virtv1.DeveloperConfiguration{
+					FeatureGates: []string{featuregate.MigrationPriorityQueue},
+				},
+			})
+		})
+
+		addRunningMigrations := func(count int) {
+			for i := 0; i < count; i++ {
+				vmi := newVirtualMachine(fmt.Sprintf("runningvmi%v", i), virtv1.Running)
+				addNodeNameToVMI(vmi, fmt.Sprintf("node%v", i))
+				addMigration(newMigration(fmt.Sprintf("runningmigration%v", i), vmi.Name, virtv1.MigrationScheduling))
+				addVirtualMachineInstance(vmi)
+			}
+		}
+
+		addQueuedMigration := func(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) {
+			addNodeNameToVMI(vmi, "queuednode")
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			controller.queuedMigrations[virtcontroller.NamespacedKey(migration.Namespace, migration.Name)] = struct{}{}
+		}
+
+		It("should let a queued migration with a higher priority take the last free slot", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			addRunningMigrations(4)
+
+			criticalVMI := newVirtualMachine("criticalvmi", virtv1.Running)
+			criticalMigration := newMigration("criticalmigration", criticalVMI.Name, virtv1.MigrationPending)
+			criticalMigration.Annotations[virtv1.EvacuationMigrationAnnotation] = "queuednode"
+			addQueuedMigration(criticalMigration, criticalVMI)
+
+			sanityExecute()
+
+			expectPodDoesNotExist(vmi.Namespace, "testvmi", "testmigration")
+			Expect(controller.queuedMigrations).To(HaveKey(virtcontroller.NamespacedKey(migration.Namespace, migration.Name)))
+		})
+
+		It("should start a migration ahead of queued migrations with a lower priority", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.Spec.Priority = pointer.P(virtv1.MigrationPrioritySystemCritical)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			addRunningMigrations(4)
+
+			updateVMI := newVirtualMachine("updatevmi", virtv1.Running)
+			updateMigration := newMigration("updatemigration", updateVMI.Name, virtv1.MigrationPending)
+			updateMigration.Annotations[virtv1.WorkloadUpdateMigrationAnnotation] = ""
+			addQueuedMigration(updateMigration, updateVMI)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+			Expect(controller.queuedMigrations).ToNot(HaveKey(virtcontroller.NamespacedKey(migration.Namespace, migration.Name)))
+		})
+
+		It("should not be held back by queued migrations on a node at its outbound limit", func() {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				DeveloperConfiguration: &virtv1.DeveloperConfiguration{
+					FeatureGates: []string{featuregate.MigrationPriorityQueue},
+				},
+				MigrationConfiguration: &virtv1.MigrationConfiguration{
+					ParallelMigrationsPerCluster: pointer.P(uint32(3)),
+				},
+			})
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			for i := 0; i < 2; i++ {
+				runningVMI := newVirtualMachine(fmt.Sprintf("runningvmi%v", i), virtv1.Running)
+				addNodeNameToVMI(runningVMI, "queuednode")
+				addMigration(newMigration(fmt.Sprintf("runningmigration%v", i), runningVMI.Name, virtv1.MigrationScheduling))
+				addVirtualMachineInstance(runningVMI)
+			}
+
+			criticalVMI := newVirtualMachine("criticalvmi", virtv1.Running)
+			criticalMigration := newMigration("criticalmigration", criticalVMI.Name, virtv1.MigrationPending)
+			criticalMigration.Spec.Priority = pointer.P(virtv1.MigrationPrioritySystemCritical)
+			addQueuedMigration(criticalMigration, criticalVMI)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+		})
+
+		It("should forget a queued migration once it is deleted", func() {
+			key := virtcontroller.NamespacedKey(k8sv1.NamespaceDefault, "deletedmigration")
+			controller.queuedMigrations[key] = struct{}{}
+
+			controller.Queue.Add(key)
+			controller.Execute()
+
+			Expect(controller.queuedMigrations).ToNot(HaveKey(key))
+		})
+	})
+
 	Context("Migration garbage collection", func() {
 		DescribeTable("should garbage old finalized migration objects", func(phase virtv1.VirtualMachineInstanceMigrationPhase) {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
@@ -2099,6 +2271,116 @@ var _ = Describe("Migration watcher", func() {
 		)
 	})
 
+	Context("Migration retry", func() {
+		var vmi *virtv1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = newVirtualMachine("testvmi", virtv1.Running)
+		})
+
+		addMigrationAndVMI := func(migration *virtv1.VirtualMachineInstanceMigration) {
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+		}
+
+		It("should retry a failed migration", func() {
+			failedMigration := newMigration("testmigration", vmi.Name, virtv1.MigrationFailed)
+			failedMigration.Spec.RetryPolicy = &virtv1.MigrationRetryPolicy{MaxAttempts: pointer.P(uint32(2))}
+			addMigrationAndVMI(failedMigration)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulRetryMigrationReason)
+			retry, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(failedMigration.Namespace).Get(context.Background(), "testmigration-retry-2", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry.Annotations).To(HaveKeyWithValue(virtv1.MigrationAttemptAnnotation, "2"))
+			Expect(retry.Annotations).To(HaveKeyWithValue(virtv1.MigrationRetryOfAnnotation, failedMigration.Name))
+
+			updatedMigration, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(failedMigration.Namespace).Get(context.Background(), failedMigration.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedMigration.Status.Retry).To(Equal(&virtv1.MigrationRetryStatus{Attempt: 1, RetriedBy: retry.Name}))
+		})
+
+		It("should retry a failed migration with the retry policy of the matching migration policy", func() {
+			policy := *preparePolicyAndVMIWithNSAndVMILabels(vmi, nil, 1, 0)
+			policy.Spec.RetryPolicy = &virtv1.MigrationRetryPolicy{MaxAttempts: pointer.P(uint32(2)), AllowPostCopyOnRetry: pointer.P(true)}
+			addMigrationPolicies(policy)
+			failedMigration := newMigration("testmigration", vmi.Name, virtv1.MigrationFailed)
+			addMigrationAndVMI(failedMigration)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulRetryMigrationReason)
+			retry, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(failedMigration.Namespace).Get(context.Background(), "testmigration-retry-2", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			By("not copying the fallbacks of the migration policy into the retry")
+			Expect(retry.Spec.RetryPolicy).To(Equal(&virtv1.MigrationRetryPolicy{MaxAttempts: pointer.P(uint32(2))}))
+		})
+
+		It("should not retry a failed migration without retry policy", func() {
+			failedMigration := newMigration("testmigration", vmi.Name, virtv1.MigrationFailed)
+			addMigrationAndVMI(failedMigration)
+
+			sanityExecute()
+
+			_, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(failedMigration.Namespace).Get(context.Background(), "testmigration-retry-2", metav1.GetOptions{})
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		})
+
+		It("should not retry a failed migration once all attempts are used up", func() {
+			failedMigration := newMigration("testmigration-retry-2", vmi.Name, virtv1.MigrationFailed)
+			failedMigration.Annotations[virtv1.MigrationAttemptAnnotation] = "2"
+			failedMigration.Spec.RetryPolicy = &virtv1.MigrationRetryPolicy{MaxAttempts: pointer.P(uint32(2))}
+			addMigrationAndVMI(failedMigration)
+
+			sanityExecute()
+
+			_, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(failedMigration.Namespace).Get(context.Background(), "testmigration-retry-3", metav1.GetOptions{})
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		})
+
+		It("should not retry a failed migration when another migration took over", func() {
+			failedMigration := newMigration("testmigration", vmi.Name, virtv1.MigrationFailed)
+			failedMigration.Spec.RetryPolicy = &virtv1.MigrationRetryPolicy{}
+			addMigrationAndVMI(failedMigration)
+			controller.migrationIndexer.Add(newMigration("othermigration", vmi.Name, virtv1.MigrationScheduling))
+
+			sanityExecute()
+
+			_, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(failedMigration.Namespace).Get(context.Background(), "testmigration-retry-2", metav1.GetOptions{})
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		})
+
+		It("should not start a retry during its backoff", func() {
+			retry := newMigration("testmigration-retry-2", vmi.Name, virtv1.MigrationPending)
+			retry.Annotations[virtv1.MigrationAttemptAnnotation] = "2"
+			retry.Annotations[virtv1.MigrationRetryOfAnnotation] = "testmigration"
+			addMigrationAndVMI(retry)
+
+			sanityExecute()
+
+			expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(retry.UID))
+			updatedRetry, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(retry.Namespace).Get(context.Background(), retry.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedRetry.Status.Retry).ToNot(BeNil())
+			Expect(updatedRetry.Status.Retry.Attempt).To(Equal(uint32(2)))
+			Expect(updatedRetry.Status.Retry.RetryOf).To(Equal("testmigration"))
+		})
+
+		It("should start a retry after its backoff", func() {
+			retry := newMigration("testmigration-retry-2", vmi.Name, virtv1.MigrationPending)
+			retry.Annotations[virtv1.MigrationAttemptAnnotation] = "2"
+			retry.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+			addMigrationAndVMI(retry)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, retry.UID, 1, 0, 0)
+		})
+	})
+
 	Context("Descheduler annotations", func() {
 		var vmi *virtv1.VirtualMachineInstance
 
@@ -2227,6 +2509,169 @@ var _ = Describe("Migration watcher", func() {
 			expectTargetPodWithSELinuxLevel(vmi.Namespace, vmi.UID, migration.UID, "")
 		})
 	})
+
+	Context("Migration across clusters", func() {
+		const migrationID = "migration-id"
+
+		var remoteClientset *kubevirtfake.Clientset
+
+		BeforeEach(func() {
+			remoteClientset = kubevirtfake.NewSimpleClientset()
+			remoteClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+			remoteClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Return(remoteClientset.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
+			remoteClient.EXPECT().VirtualMachineInstance(k8sv1.NamespaceDefault).Return(remoteClientset.KubevirtV1().VirtualMachineInstances(k8sv1.NamespaceDefault)).AnyTimes()
+			controller.remoteClientFunc = func(kubeconfig []byte) (kubecli.KubevirtClient, error) {
+				Expect(kubeconfig).To(Equal([]byte("remote")))
+				return remoteClient, nil
+			}
+
+			Expect(controller.secretStore.Add(&k8sv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "remote-cluster", Namespace: "kubevirt"},
+				Data:       map[string][]byte{connectionSecretKubeconfigKey: []byte("remote")},
+			})).To(Succeed())
+		})
+
+		newSendMigration := func(vmiName string) *virtv1.VirtualMachineInstanceMigration {
+			migration := newMigration("sendmigration", vmiName, virtv1.MigrationPending)
+			migration.Spec.SendTo = &virtv1.VirtualMachineInstanceMigrationSendTo{
+				MigrationID:         migrationID,
+				ConnectionSecretRef: k8sv1.LocalObjectReference{Name: "remote-cluster"},
+			}
+			return migration
+		}
+
+		newReceiveMigration := func(vmiName string, phase virtv1.VirtualMachineInstanceMigrationPhase) *virtv1.VirtualMachineInstanceMigration {
+			migration := newMigration("receivemigration", vmiName, phase)
+			migration.Spec.Receive = &virtv1.VirtualMachineInstanceMigrationReceive{MigrationID: migrationID}
+			return migration
+		}
+
+		newReceiverVirtualMachine := func(name string) *virtv1.VirtualMachineInstance {
+			vmi := newVirtualMachine(name, virtv1.Pending)
+			vmi.Status.NodeName = ""
+			vmi.Annotations[virtv1.MigrationReceiverAnnotation] = ""
+			return vmi
+		}
+
+		addRemoteReceiver := func(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) {
+			_, err := remoteClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Create(context.Background(), migration, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = remoteClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("should create the target pod of a receive migration without a source pod", func() {
+			vmi := newReceiverVirtualMachine("testvmi")
+			migration := newReceiveMigration(vmi.Name, virtv1.MigrationPending)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{
+				LabelSelector: fmt.Sprintf("%s=%s,%s=%s", virtv1.MigrationJobLabel, string(migration.UID), virtv1.CreatedByLabel, string(vmi.UID)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(HaveLen(1))
+		})
+
+		It("should finalize the received VMI once the domain is ready on the target", func() {
+			now := metav1.Now()
+			vmi := newReceiverVirtualMachine("testvmi")
+			migration := newReceiveMigration(vmi.Name, virtv1.MigrationRunning)
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:                   migration.UID,
+				TargetNode:                     "node01",
+				TargetNodeDomainDetected:       true,
+				TargetNodeDomainReadyTimestamp: &now,
+			}
+			targetPod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(targetPod)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtv1.Migrated.String())
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Status.Phase).To(Equal(virtv1.Running))
+			Expect(updatedVMI.Status.NodeName).To(Equal("node01"))
+			Expect(updatedVMI.Status.MigrationState.Completed).To(BeTrue())
+			Expect(updatedVMI.Annotations).ToNot(HaveKey(virtv1.MigrationReceiverAnnotation))
+		})
+
+		It("should hand the target of the receiving migration over to the source virt-handler", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newSendMigration(vmi.Name)
+			receiving := newReceiveMigration(vmi.Name, virtv1.MigrationScheduled)
+			remoteVMI := newReceiverVirtualMachine(vmi.Name)
+			remoteVMI.UID = "remotevmi"
+			remoteVMI.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:                   receiving.UID,
+				TargetNode:                     "remotenode",
+				TargetPod:                      "remotepod",
+				TargetNodeAddress:              "10.10.10.10",
+				TargetDirectMigrationNodePorts: map[string]int{"49152": 0},
+			}
+			addRemoteReceiver(receiving, remoteVMI)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name, PointTo(MatchFields(IgnoreExtras, Fields{
+				"MigrationUID":                    Equal(migration.UID),
+				"SourceNode":                      Equal(vmi.Status.NodeName),
+				"TargetNode":                      Equal("remotenode"),
+				"TargetPod":                       Equal("remotepod"),
+				"TargetNodeAddress":               Equal("10.10.10.10"),
+				"TargetDirectMigrationNodePorts":  HaveKeyWithValue("49152", 0),
+				"TargetVirtualMachineInstanceUID": Equal(types.UID("remotevmi")),
+			})))
+		})
+
+		It("should wait for the target of the receiving migration to be ready", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newSendMigration(vmi.Name)
+			addRemoteReceiver(newReceiveMigration(vmi.Name, virtv1.MigrationPending), newReceiverVirtualMachine(vmi.Name))
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name, BeNil())
+			expectMigrationPendingState(migration.Namespace, migration.Name)
+		})
+
+		It("should fail the send migration if the receiving migration failed", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newSendMigration(vmi.Name)
+			addRemoteReceiver(newReceiveMigration(vmi.Name, virtv1.MigrationFailed), newReceiverVirtualMachine(vmi.Name))
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.FailedMigrationReason)
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name, PointTo(MatchFields(IgnoreExtras, Fields{
+				"MigrationUID": Equal(migration.UID),
+				"Failed":       BeTrue(),
+				"Completed":    BeTrue(),
+			})))
+		})
+	})
 })
 
 func newPDB(name string, vmi *virtv1.VirtualMachineInstance, pods int) *policyv1.PodDisruptionBudget {
@@ -0,0 +1,335 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// maxMatchedVMIs caps the VMIs listed in the policy status, to keep the policy object small
+const maxMatchedVMIs = 50
+
+// PolicyStatusController reports the VMIs a migration policy currently applies to,
+// and how the recent migrations which used the policy ended, in the policy status.
+// Recent migrations are the ones which were not garbage collected yet.
+type PolicyStatusController struct {
+	clientset            kubecli.KubevirtClient
+	Queue                workqueue.TypedRateLimitingInterface[string]
+	migrationPolicyStore cache.Store
+	vmiStore             cache.Indexer
+	namespaceStore       cache.Store
+	migrationStore       cache.Store
+	hasSynced            func() bool
+}
+
+func NewPolicyStatusController(clientset kubecli.KubevirtClient,
+	migrationPolicyInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	migrationInformer cache.SharedIndexInformer,
+	namespaceInformer cache.SharedIndexInformer,
+) (*PolicyStatusController, error) {
+	c := &PolicyStatusController{
+		clientset: clientset,
+		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-migration-policy-status"},
+		),
+		migrationPolicyStore: migrationPolicyInformer.GetStore(),
+		vmiStore:             vmiInformer.GetIndexer(),
+		namespaceStore:       namespaceInformer.GetStore(),
+		migrationStore:       migrationInformer.GetStore(),
+	}
+
+	c.hasSynced = func() bool {
+		return migrationPolicyInformer.HasSynced() && vmiInformer.HasSynced() &&
+			migrationInformer.HasSynced() && namespaceInformer.HasSynced()
+	}
+
+	_, err := migrationPolicyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueueAllPolicies() },
+		UpdateFunc: c.updatePolicy,
+		DeleteFunc: func(_ interface{}) { c.enqueueAllPolicies() },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVMIPolicy,
+		DeleteFunc: c.enqueueVMIPolicy,
+		UpdateFunc: c.updateVMI,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = namespaceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.updateNamespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = migrationInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, curr interface{}) { c.enqueueMigrationPolicy(curr) },
+		DeleteFunc: c.enqueueMigrationPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *PolicyStatusController) enqueueAllPolicies() {
+	for _, key := range c.migrationPolicyStore.ListKeys() {
+		c.Queue.Add(key)
+	}
+}
+
+// updatePolicy enqueues all policies when the selectors or the priority of a policy change,
+// since a policy can take VMIs over from the others
+func (c *PolicyStatusController) updatePolicy(old, curr interface{}) {
+	oldPolicy := old.(*v1alpha1.MigrationPolicy)
+	currPolicy := curr.(*v1alpha1.MigrationPolicy)
+	if !equality.Semantic.DeepEqual(oldPolicy.Spec, currPolicy.Spec) {
+		c.enqueueAllPolicies()
+	}
+}
+
+func (c *PolicyStatusController) enqueueVMIPolicy(obj interface{}) {
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if vmi, ok = tombstone.Obj.(*virtv1.VirtualMachineInstance); !ok {
+			return
+		}
+	}
+	obj, exists, err := c.namespaceStore.GetByKey(vmi.Namespace)
+	if err != nil || !exists {
+		return
+	}
+	c.enqueueMatchedPolicy(c.policyList(), vmi, obj.(*k8sv1.Namespace))
+}
+
+// enqueueMatchedPolicy enqueues the policy the VMI would be migrated with, if any
+func (c *PolicyStatusController) enqueueMatchedPolicy(policyList *v1alpha1.MigrationPolicyList, vmi *virtv1.VirtualMachineInstance, namespace *k8sv1.Namespace) {
+	if matchedPolicy := matchPolicy(policyList, vmi, namespace); matchedPolicy != nil {
+		c.Queue.Add(matchedPolicy.Name)
+	}
+}
+
+func (c *PolicyStatusController) updateVMI(old, curr interface{}) {
+	oldVMI := old.(*virtv1.VirtualMachineInstance)
+	currVMI := curr.(*virtv1.VirtualMachineInstance)
+	if !equality.Semantic.DeepEqual(oldVMI.Labels, currVMI.Labels) || oldVMI.IsFinal() != currVMI.IsFinal() {
+		c.enqueueVMIPolicy(oldVMI)
+		c.enqueueVMIPolicy(currVMI)
+	}
+}
+
+func (c *PolicyStatusController) updateNamespace(old, curr interface{}) {
+	oldNamespace := old.(*k8sv1.Namespace)
+	currNamespace := curr.(*k8sv1.Namespace)
+	if equality.Semantic.DeepEqual(oldNamespace.Labels, currNamespace.Labels) {
+		return
+	}
+	objs, err := c.vmiStore.ByIndex(cache.NamespaceIndex, currNamespace.Name)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to list the VMIs of namespace %s.", currNamespace.Name)
+		return
+	}
+	policyList := c.policyList()
+	for _, obj := range objs {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		c.enqueueMatchedPolicy(policyList, vmi, oldNamespace)
+		c.enqueueMatchedPolicy(policyList, vmi, currNamespace)
+	}
+}
+
+func (c *PolicyStatusController) policyList() *v1alpha1.MigrationPolicyList {
+	policyList := &v1alpha1.MigrationPolicyList{}
+	for _, obj := range c.migrationPolicyStore.List() {
+		policyList.Items = append(policyList.Items, *obj.(*v1alpha1.MigrationPolicy))
+	}
+	return policyList
+}
+
+func (c *PolicyStatusController) enqueueMigrationPolicy(obj interface{}) {
+	migration, ok := obj.(*virtv1.VirtualMachineInstanceMigration)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if migration, ok = tombstone.Obj.(*virtv1.VirtualMachineInstanceMigration); !ok {
+			return
+		}
+	}
+	if policyName := migrationPolicyName(migration); policyName != "" && migration.IsFinal() {
+		c.Queue.Add(policyName)
+	}
+}
+
+func migrationPolicyName(migration *virtv1.VirtualMachineInstanceMigration) string {
+	state := migration.Status.MigrationState
+	if state == nil || state.MigrationPolicyName == nil {
+		return ""
+	}
+	return *state.MigrationPolicyName
+}
+
+func (c *PolicyStatusController) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting migration policy status controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping migration policy status controller.")
+}
+
+func (c *PolicyStatusController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *PolicyStatusController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing migration policy %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed migration policy %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *PolicyStatusController) execute(key string) error {
+	obj, exists, err := c.migrationPolicyStore.GetByKey(key)
+	if err != nil {
+		return err
+	} else if !exists {
+		return nil
+	}
+	policy := obj.(*v1alpha1.MigrationPolicy)
+
+	matched, err := c.matchedVMIs(policy)
+	if err != nil {
+		return err
+	}
+	status := v1alpha1.MigrationPolicyStatus{
+		MatchedVirtualMachineInstanceCount: int64(len(matched)),
+	}
+	if len(matched) > maxMatchedVMIs {
+		matched = matched[:maxMatchedVMIs]
+	}
+	status.MatchedVirtualMachineInstances = matched
+	for _, obj := range c.migrationStore.List() {
+		migration := obj.(*virtv1.VirtualMachineInstanceMigration)
+		if migrationPolicyName(migration) != policy.Name {
+			continue
+		}
+		switch migration.Status.Phase {
+		case virtv1.MigrationSucceeded:
+			status.SucceededMigrations++
+		case virtv1.MigrationFailed:
+			status.FailedMigrations++
+		}
+	}
+
+	if equality.Semantic.DeepEqual(policy.Status, status) {
+		return nil
+	}
+	policyCopy := policy.DeepCopy()
+	policyCopy.Status = status
+	_, err = c.clientset.MigrationPolicy().UpdateStatus(context.Background(), policyCopy, v1.UpdateOptions{})
+	return err
+}
+
+// matchedVMIs returns the VMIs which would be migrated with the policy, sorted by namespace and name.
+// The VMIs are walked namespace by namespace and only the ones the selectors of the policy match
+// are compared against the other policies, to avoid matching every VMI against every policy.
+func (c *PolicyStatusController) matchedVMIs(policy *v1alpha1.MigrationPolicy) ([]v1alpha1.MatchedVirtualMachineInstance, error) {
+	var policyList *v1alpha1.MigrationPolicyList
+
+	var matched []v1alpha1.MatchedVirtualMachineInstance
+	for _, obj := range c.namespaceStore.List() {
+		namespace := obj.(*k8sv1.Namespace)
+		objs, err := c.vmiStore.ByIndex(cache.NamespaceIndex, namespace.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			vmi := obj.(*virtv1.VirtualMachineInstance)
+			if vmi.IsFinal() {
+				continue
+			}
+			if doesMatch, _ := countMatchingLabels(policy, vmi.Labels, namespace.Labels); !doesMatch {
+				continue
+			}
+			if policyList == nil {
+				policyList = c.policyList()
+			}
+			matchedPolicy := matchPolicy(policyList, vmi, namespace)
+			if matchedPolicy != nil && matchedPolicy.Name == policy.Name {
+				matched = append(matched, v1alpha1.MatchedVirtualMachineInstance{Namespace: vmi.Namespace, Name: vmi.Name})
+			}
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].Namespace != matched[j].Namespace {
+			return matched[i].Namespace < matched[j].Namespace
+		}
+		return matched[i].Name < matched[j].Name
+	})
+	return matched, nil
+}
@@ -0,0 +1,203 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Migration policy status", func() {
+	var (
+		controller    *PolicyStatusController
+		virtClientset *kubevirtfake.Clientset
+	)
+
+	BeforeEach(func() {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClientset = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().MigrationPolicy().Return(virtClientset.MigrationsV1alpha1().MigrationPolicies()).AnyTimes()
+
+		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		migrationInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstanceMigration{})
+		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+
+		var err error
+		controller, err = NewPolicyStatusController(virtClient, migrationPolicyInformer, vmiInformer, migrationInformer, namespaceInformer)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(controller.namespaceStore.Add(&k8sv1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: k8sv1.NamespaceDefault, Labels: map[string]string{"tier": "gold"}},
+		})).To(Succeed())
+	})
+
+	addPolicy := func(name string, vmiSelector migrationsv1.LabelSelector) *migrationsv1.MigrationPolicy {
+		policy := kubecli.NewMinimalMigrationPolicy(name)
+		policy.Spec.Selectors = &migrationsv1.Selectors{VirtualMachineInstanceSelector: vmiSelector}
+		Expect(controller.migrationPolicyStore.Add(policy)).To(Succeed())
+		_, err := virtClientset.MigrationsV1alpha1().MigrationPolicies().Create(context.Background(), policy, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return policy
+	}
+
+	addVMI := func(name string, labels map[string]string, phase virtv1.VirtualMachineInstancePhase) {
+		vmi := newVirtualMachine(name, phase)
+		vmi.Labels = labels
+		Expect(controller.vmiStore.Add(vmi)).To(Succeed())
+	}
+
+	addMigration := func(name, policyName string, phase virtv1.VirtualMachineInstanceMigrationPhase) {
+		migration := newMigration(name, "testvmi", phase)
+		migration.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+			MigrationPolicyName: pointer.P(policyName),
+		}
+		Expect(controller.migrationStore.Add(migration)).To(Succeed())
+	}
+
+	sync := func(policy *migrationsv1.MigrationPolicy) *migrationsv1.MigrationPolicy {
+		controller.Queue.Add(policy.Name)
+		Expect(controller.Execute()).To(BeTrue())
+		Expect(controller.Queue.NumRequeues(policy.Name)).To(BeZero())
+		updatedPolicy, err := virtClientset.MigrationsV1alpha1().MigrationPolicies().Get(context.Background(), policy.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return updatedPolicy
+	}
+
+	It("should report the VMIs the policy applies to", func() {
+		policy := addPolicy("gold", migrationsv1.LabelSelector{"app": "db"})
+		addPolicy("more-specific", migrationsv1.LabelSelector{"app": "db", "size": "large"})
+
+		addVMI("db-small", map[string]string{"app": "db"}, virtv1.Running)
+		addVMI("db-other", map[string]string{"app": "db"}, virtv1.Scheduled)
+		addVMI("db-large", map[string]string{"app": "db", "size": "large"}, virtv1.Running)
+		addVMI("db-done", map[string]string{"app": "db"}, virtv1.Succeeded)
+		addVMI("web", map[string]string{"app": "web"}, virtv1.Running)
+
+		updatedPolicy := sync(policy)
+		Expect(updatedPolicy.Status.MatchedVirtualMachineInstances).To(Equal([]migrationsv1.MatchedVirtualMachineInstance{
+			{Namespace: k8sv1.NamespaceDefault, Name: "db-other"},
+			{Namespace: k8sv1.NamespaceDefault, Name: "db-small"},
+		}))
+		Expect(updatedPolicy.Status.MatchedVirtualMachineInstanceCount).To(BeEquivalentTo(2))
+	})
+
+	It("should only report the VMIs of the namespaces the policy selects", func() {
+		Expect(controller.namespaceStore.Add(&k8sv1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Labels: map[string]string{"tier": "silver"}},
+		})).To(Succeed())
+		policy := addPolicy("gold", migrationsv1.LabelSelector{"app": "db"})
+		policy.Spec.Selectors.NamespaceSelector = migrationsv1.LabelSelector{"tier": "gold"}
+		Expect(controller.migrationPolicyStore.Update(policy)).To(Succeed())
+
+		addVMI("db", map[string]string{"app": "db"}, virtv1.Running)
+		otherVMI := newVirtualMachine("db-other", virtv1.Running)
+		otherVMI.Namespace = "other"
+		otherVMI.Labels = map[string]string{"app": "db"}
+		Expect(controller.vmiStore.Add(otherVMI)).To(Succeed())
+
+		updatedPolicy := sync(policy)
+		Expect(updatedPolicy.Status.MatchedVirtualMachineInstances).To(Equal([]migrationsv1.MatchedVirtualMachineInstance{
+			{Namespace: k8sv1.NamespaceDefault, Name: "db"},
+		}))
+	})
+
+	It("should cap the listed VMIs but count all of them", func() {
+		policy := addPolicy("gold", migrationsv1.LabelSelector{"app": "db"})
+		for i := 0; i < maxMatchedVMIs+10; i++ {
+			addVMI(fmt.Sprintf("db-%03d", i), map[string]string{"app": "db"}, virtv1.Running)
+		}
+
+		updatedPolicy := sync(policy)
+		Expect(updatedPolicy.Status.MatchedVirtualMachineInstances).To(HaveLen(maxMatchedVMIs))
+		Expect(updatedPolicy.Status.MatchedVirtualMachineInstances[0].Name).To(Equal("db-000"))
+		Expect(updatedPolicy.Status.MatchedVirtualMachineInstanceCount).To(BeEquivalentTo(maxMatchedVMIs + 10))
+	})
+
+	It("should only enqueue the policies matched by an updated VMI", func() {
+		addPolicy("db", migrationsv1.LabelSelector{"app": "db"})
+		addPolicy("web", migrationsv1.LabelSelector{"app": "web"})
+		addPolicy("cache", migrationsv1.LabelSelector{"app": "cache"})
+
+		oldVMI := newVirtualMachine("testvmi", virtv1.Running)
+		oldVMI.Labels = map[string]string{"app": "db"}
+		currVMI := oldVMI.DeepCopy()
+		currVMI.Labels = map[string]string{"app": "web"}
+		controller.updateVMI(oldVMI, currVMI)
+
+		Expect(controller.Queue.Len()).To(Equal(2))
+		first, _ := controller.Queue.Get()
+		second, _ := controller.Queue.Get()
+		Expect([]string{first, second}).To(ConsistOf("db", "web"))
+	})
+
+	It("should only enqueue the policies matched by the VMIs of a relabeled namespace", func() {
+		addPolicy("db", migrationsv1.LabelSelector{"app": "db"})
+		addPolicy("web", migrationsv1.LabelSelector{"app": "web"})
+		addVMI("testvmi", map[string]string{"app": "db"}, virtv1.Running)
+
+		oldNamespace := &k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: k8sv1.NamespaceDefault}}
+		currNamespace := oldNamespace.DeepCopy()
+		currNamespace.Labels = map[string]string{"tier": "gold"}
+		controller.updateNamespace(oldNamespace, currNamespace)
+
+		Expect(controller.Queue.Len()).To(Equal(1))
+		key, _ := controller.Queue.Get()
+		Expect(key).To(Equal("db"))
+	})
+
+	It("should count the recent migrations which used the policy", func() {
+		policy := addPolicy("gold", migrationsv1.LabelSelector{"app": "db"})
+
+		addMigration("succeeded1", policy.Name, virtv1.MigrationSucceeded)
+		addMigration("succeeded2", policy.Name, virtv1.MigrationSucceeded)
+		addMigration("failed", policy.Name, virtv1.MigrationFailed)
+		addMigration("running", policy.Name, virtv1.MigrationRunning)
+		addMigration("other", "other-policy", virtv1.MigrationFailed)
+
+		updatedPolicy := sync(policy)
+		Expect(updatedPolicy.Status.SucceededMigrations).To(BeEquivalentTo(2))
+		Expect(updatedPolicy.Status.FailedMigrations).To(BeEquivalentTo(1))
+	})
+
+	It("should enqueue the policy of a finished migration", func() {
+		migration := newMigration("testmigration", "testvmi", virtv1.MigrationSucceeded)
+		migration.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+			MigrationPolicyName: pointer.P("gold"),
+		}
+		controller.enqueueMigrationPolicy(migration)
+		Expect(controller.Queue.Len()).To(Equal(1))
+	})
+})
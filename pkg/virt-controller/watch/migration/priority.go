@@ -0,0 +1,166 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// effectiveMigrationPriority returns the priority set on the migration, or the
+// default priority of the component which created it.
+func effectiveMigrationPriority(migration *virtv1.VirtualMachineInstanceMigration) virtv1.MigrationPriority {
+	if migration.Spec.Priority != nil {
+		return *migration.Spec.Priority
+	}
+	if _, exists := migration.Annotations[virtv1.EvacuationMigrationAnnotation]; exists {
+		return virtv1.MigrationPrioritySystemCritical
+	}
+	if _, exists := migration.Annotations[virtv1.WorkloadUpdateMigrationAnnotation]; exists {
+		return virtv1.MigrationPrioritySystemMaintenance
+	}
+	return virtv1.MigrationPriorityUserTriggered
+}
+
+func migrationPriorityRank(priority virtv1.MigrationPriority) int {
+	switch priority {
+	case virtv1.MigrationPrioritySystemCritical:
+		return 2
+	case virtv1.MigrationPriorityUserTriggered:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// queuedMigration is a pending migration waiting for a free migration slot
+type queuedMigration struct {
+	migration *virtv1.VirtualMachineInstanceMigration
+	// the priority of the virt-launcher pod, derived from the priority class of the VMI
+	podPriority int32
+	// the number of running migrations in the namespace of the migration
+	runningInNamespace int
+}
+
+// startsBefore orders queued migrations by their priority first and by the
+// priority of the VMI second. Migrations with the same priorities are shared
+// fairly between namespaces, the namespace with the least running migrations
+// goes first. The oldest migration wins all remaining ties.
+func (q queuedMigration) startsBefore(other queuedMigration) bool {
+	if rank, otherRank := migrationPriorityRank(effectiveMigrationPriority(q.migration)), migrationPriorityRank(effectiveMigrationPriority(other.migration)); rank != otherRank {
+		return rank > otherRank
+	}
+	if q.podPriority != other.podPriority {
+		return q.podPriority > other.podPriority
+	}
+	if q.runningInNamespace != other.runningInNamespace {
+		return q.runningInNamespace < other.runningInNamespace
+	}
+	if !q.migration.CreationTimestamp.Equal(&other.migration.CreationTimestamp) {
+		return q.migration.CreationTimestamp.Before(&other.migration.CreationTimestamp)
+	}
+	return q.migration.UID < other.migration.UID
+}
+
+// queuedMigrationsAhead counts the migrations which wait for a free migration slot
+// and have to be started before the given migration. Only migrations which could
+// be started right now, if there was a free slot in the cluster, are taken into
+// account. The caller has to hold the migrationStartLock.
+func (c *Controller) queuedMigrationsAhead(key string, migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, runningMigrations []*virtv1.VirtualMachineInstanceMigration) (int, error) {
+	runningPerNamespace := map[string]int{}
+	running := map[string]struct{}{}
+	for _, m := range runningMigrations {
+		runningPerNamespace[m.Namespace]++
+		running[string(m.UID)] = struct{}{}
+	}
+
+	newQueuedMigration := func(m *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) (queuedMigration, error) {
+		q := queuedMigration{migration: m, runningInNamespace: runningPerNamespace[m.Namespace]}
+		pod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
+		if err != nil {
+			return q, err
+		}
+		if pod != nil && pod.Spec.Priority != nil {
+			q.podPriority = *pod.Spec.Priority
+		}
+		return q, nil
+	}
+
+	current, err := newQueuedMigration(migration, vmi)
+	if err != nil {
+		return 0, err
+	}
+
+	ahead := 0
+	for queuedKey := range c.queuedMigrations {
+		if queuedKey == key {
+			continue
+		}
+		obj, exists, err := c.migrationIndexer.GetByKey(queuedKey)
+		if err != nil {
+			return 0, err
+		}
+		if !exists {
+			delete(c.queuedMigrations, queuedKey)
+			continue
+		}
+		queued := obj.(*virtv1.VirtualMachineInstanceMigration)
+		if _, isRunning := running[string(queued.UID)]; isRunning || queued.Status.Phase != virtv1.MigrationPending || queued.DeletionTimestamp != nil {
+			delete(c.queuedMigrations, queuedKey)
+			continue
+		}
+
+		obj, exists, err = c.vmiStore.GetByKey(controller.NamespacedKey(queued.Namespace, queued.Spec.VMIName))
+		if err != nil {
+			return 0, err
+		}
+		if !exists || !obj.(*virtv1.VirtualMachineInstance).IsRunning() {
+			continue
+		}
+		queuedVMI := obj.(*virtv1.VirtualMachineInstance)
+
+		// Migrations blocked by the outbound limit of their node
+		// must not hold back migrations from other nodes
+		outboundMigrations, err := c.outboundMigrationsOnNode(queuedVMI.Status.NodeName, runningMigrations)
+		if err != nil {
+			return 0, err
+		}
+		if outboundMigrations >= int(*c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode) {
+			continue
+		}
+
+		q, err := newQueuedMigration(queued, queuedVMI)
+		if err != nil {
+			return 0, err
+		}
+		if q.startsBefore(current) {
+			ahead++
+		}
+	}
+	return ahead, nil
+}
+
+func (c *Controller) removeQueuedMigration(migrationKey string) {
+	c.migrationStartLock.Lock()
+	defer c.migrationStartLock.Unlock()
+
+	delete(c.queuedMigrations, migrationKey)
+}
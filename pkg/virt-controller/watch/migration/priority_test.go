@@ -0,0 +1,96 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Migration priority", func() {
+	newQueuedMigration := func(name string, annotations map[string]string, priority *virtv1.MigrationPriority) queuedMigration {
+		migration := newMigration(name, "testvmi", virtv1.MigrationPending)
+		for key, value := range annotations {
+			migration.Annotations[key] = value
+		}
+		migration.Spec.Priority = priority
+		return queuedMigration{migration: migration}
+	}
+
+	DescribeTable("should default the priority", func(annotations map[string]string, priority *virtv1.MigrationPriority, expected virtv1.MigrationPriority) {
+		Expect(effectiveMigrationPriority(newQueuedMigration("migration", annotations, priority).migration)).To(Equal(expected))
+	},
+		Entry("of evacuations to system-critical",
+			map[string]string{virtv1.EvacuationMigrationAnnotation: "node01"}, nil, virtv1.MigrationPrioritySystemCritical),
+		Entry("of workload updates to system-maintenance",
+			map[string]string{virtv1.WorkloadUpdateMigrationAnnotation: ""}, nil, virtv1.MigrationPrioritySystemMaintenance),
+		Entry("of all other migrations to user-triggered",
+			nil, nil, virtv1.MigrationPriorityUserTriggered),
+		Entry("unless a priority is set",
+			map[string]string{virtv1.EvacuationMigrationAnnotation: "node01"}, pointer.P(virtv1.MigrationPrioritySystemMaintenance), virtv1.MigrationPrioritySystemMaintenance),
+	)
+
+	It("should order by migration priority first", func() {
+		update := newQueuedMigration("update", map[string]string{virtv1.WorkloadUpdateMigrationAnnotation: ""}, nil)
+		update.podPriority = 1000
+		user := newQueuedMigration("user", nil, nil)
+		evacuation := newQueuedMigration("evacuation", map[string]string{virtv1.EvacuationMigrationAnnotation: "node01"}, nil)
+
+		Expect(evacuation.startsBefore(user)).To(BeTrue())
+		Expect(user.startsBefore(update)).To(BeTrue())
+		Expect(update.startsBefore(evacuation)).To(BeFalse())
+	})
+
+	It("should prefer VMIs with a higher pod priority", func() {
+		critical := newQueuedMigration("critical", nil, nil)
+		critical.podPriority = 1000
+		regular := newQueuedMigration("regular", nil, nil)
+
+		Expect(critical.startsBefore(regular)).To(BeTrue())
+		Expect(regular.startsBefore(critical)).To(BeFalse())
+	})
+
+	It("should prefer namespaces with less running migrations", func() {
+		busy := newQueuedMigration("busy", nil, nil)
+		busy.runningInNamespace = 2
+		busy.migration.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+		idle := newQueuedMigration("idle", nil, nil)
+
+		Expect(idle.startsBefore(busy)).To(BeTrue())
+		Expect(busy.startsBefore(idle)).To(BeFalse())
+	})
+
+	It("should prefer the oldest migration", func() {
+		older := newQueuedMigration("older", nil, nil)
+		older.migration.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+		newer := newQueuedMigration("newer", nil, nil)
+
+		Expect(older.startsBefore(newer)).To(BeTrue())
+		Expect(newer.startsBefore(older)).To(BeFalse())
+	})
+})
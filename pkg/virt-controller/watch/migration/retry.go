@@ -0,0 +1,224 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	defaultMigrationRetryMaxAttempts           = uint32(3)
+	defaultMigrationRetryInitialBackoffSeconds = int64(20)
+	maxMigrationRetryBackoff                   = time.Hour
+)
+
+// migrationAttempt returns the number of the attempt a migration is, starting at 1
+func migrationAttempt(migration *virtv1.VirtualMachineInstanceMigration) uint32 {
+	attempt, err := strconv.ParseUint(migration.Annotations[virtv1.MigrationAttemptAnnotation], 10, 32)
+	if err != nil || attempt < 1 {
+		return 1
+	}
+	return uint32(attempt)
+}
+
+// migrationRetryBackoffUntil returns the time a retry has to wait for before it starts.
+// The first retry waits for the initial backoff, every further retry doubles it
+// up to an hour.
+func migrationRetryBackoffUntil(migration *virtv1.VirtualMachineInstanceMigration) *metav1.Time {
+	attempt := migrationAttempt(migration)
+	if attempt < 2 {
+		return nil
+	}
+
+	backoffSeconds := defaultMigrationRetryInitialBackoffSeconds
+	if retryPolicy := migration.Spec.RetryPolicy; retryPolicy != nil && retryPolicy.InitialBackoffSeconds != nil {
+		backoffSeconds = *retryPolicy.InitialBackoffSeconds
+	}
+	backoff := time.Duration(backoffSeconds) * time.Second
+	for i := uint32(2); i < attempt && backoff < maxMigrationRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxMigrationRetryBackoff {
+		backoff = maxMigrationRetryBackoff
+	}
+
+	backoffUntil := metav1.NewTime(migration.CreationTimestamp.Add(backoff))
+	return &backoffUntil
+}
+
+func newMigrationRetryStatus(migration *virtv1.VirtualMachineInstanceMigration) *virtv1.MigrationRetryStatus {
+	attempt := migrationAttempt(migration)
+	if attempt < 2 {
+		return nil
+	}
+	return &virtv1.MigrationRetryStatus{
+		Attempt:      attempt,
+		RetryOf:      migration.Annotations[virtv1.MigrationRetryOfAnnotation],
+		BackoffUntil: migrationRetryBackoffUntil(migration),
+	}
+}
+
+// getMigrationRetryPolicy returns the retry policy of the migration or,
+// if the migration has none, the one of the migration policy matching the VMI.
+func (c *Controller) getMigrationRetryPolicy(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) (*virtv1.MigrationRetryPolicy, error) {
+	if migration.Spec.RetryPolicy != nil {
+		return migration.Spec.RetryPolicy, nil
+	}
+	return c.getMigrationPolicyRetryPolicy(vmi)
+}
+
+// getMigrationPolicyRetryPolicy returns the retry policy of the migration policy
+// matching the VMI. Only the cluster-scoped migration policies may relax the
+// migration configuration of a retry.
+func (c *Controller) getMigrationPolicyRetryPolicy(vmi *virtv1.VirtualMachineInstance) (*virtv1.MigrationRetryPolicy, error) {
+	obj, exists, err := c.namespaceStore.GetByKey(vmi.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("namespace %s does not exist", vmi.Namespace)
+	}
+	matchedPolicy := matchPolicy(c.listMigrationPolicies(), vmi, obj.(*k8sv1.Namespace))
+	if matchedPolicy == nil {
+		return nil, nil
+	}
+	return matchedPolicy.Spec.RetryPolicy, nil
+}
+
+// applyMigrationRetryFallback relaxes the migration configuration of a retry
+// according to the retry policy.
+func applyMigrationRetryFallback(retryPolicy *virtv1.MigrationRetryPolicy, migrationConfiguration *virtv1.MigrationConfiguration) {
+	if retryPolicy.AllowPostCopyOnRetry != nil && *retryPolicy.AllowPostCopyOnRetry {
+		migrationConfiguration.AllowPostCopy = pointer.P(true)
+		migrationConfiguration.AllowWorkloadDisruption = pointer.P(true)
+	}
+	if retryPolicy.BandwidthPerMigrationOnRetry != nil {
+		bandwidth := retryPolicy.BandwidthPerMigrationOnRetry.DeepCopy()
+		migrationConfiguration.BandwidthPerMigration = &bandwidth
+	}
+}
+
+func newRetryMigration(migration *virtv1.VirtualMachineInstanceMigration, retryPolicy *virtv1.MigrationRetryPolicy, attempt uint32) *virtv1.VirtualMachineInstanceMigration {
+	baseName := strings.TrimSuffix(migration.Name, fmt.Sprintf("-retry-%d", attempt-1))
+
+	retry := &virtv1.VirtualMachineInstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-retry-%d", baseName, attempt),
+			Namespace:   migration.Namespace,
+			Labels:      map[string]string{},
+			Annotations: map[string]string{},
+		},
+		Spec: *migration.Spec.DeepCopy(),
+	}
+	// Keep the labels and annotations identifying the origin of the migration,
+	// so that the evacuation and workload update controllers track the retries
+	for key, value := range migration.Labels {
+		retry.Labels[key] = value
+	}
+	for key, value := range migration.Annotations {
+		retry.Annotations[key] = value
+	}
+	delete(retry.Annotations, virtv1.ControllerAPILatestVersionObservedAnnotation)
+	delete(retry.Annotations, virtv1.ControllerAPIStorageVersionObservedAnnotation)
+	retry.Annotations[virtv1.MigrationRetryOfAnnotation] = migration.Name
+	retry.Annotations[virtv1.MigrationAttemptAnnotation] = strconv.FormatUint(uint64(attempt), 10)
+	retry.Spec.RetryPolicy = retryPolicy.DeepCopy()
+	// The fallbacks are only honored from the migration policies, which are
+	// read again when the retry starts
+	retry.Spec.RetryPolicy.AllowPostCopyOnRetry = nil
+	retry.Spec.RetryPolicy.BandwidthPerMigrationOnRetry = nil
+
+	return retry
+}
+
+// handleMigrationRetry creates a new migration retrying the failed migration,
+// unless the retry policy is exhausted or another migration took over.
+func (c *Controller) handleMigrationRetry(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if migration.Status.Phase != virtv1.MigrationFailed || migration.DeletionTimestamp != nil || migration.IsCrossCluster() {
+		return nil
+	}
+	if migration.Status.Retry != nil && migration.Status.Retry.RetriedBy != "" {
+		return nil
+	}
+	if !vmi.IsRunning() || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	retryPolicy, err := c.getMigrationRetryPolicy(migration, vmi)
+	if err != nil {
+		return err
+	}
+	if retryPolicy == nil {
+		return nil
+	}
+
+	maxAttempts := defaultMigrationRetryMaxAttempts
+	if retryPolicy.MaxAttempts != nil {
+		maxAttempts = *retryPolicy.MaxAttempts
+	}
+	attempt := migrationAttempt(migration)
+	if attempt >= maxAttempts {
+		return nil
+	}
+
+	retry := newRetryMigration(migration, retryPolicy, attempt+1)
+
+	migrations, err := c.listMigrationsMatchingVMI(vmi.Namespace, vmi.Name)
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if m.UID != migration.UID && m.Name != retry.Name && !m.IsFinal() {
+			log.Log.Object(migration).Infof("Not retrying the failed migration, migration %s took over", m.Name)
+			return nil
+		}
+	}
+
+	_, err = c.clientset.VirtualMachineInstanceMigration(migration.Namespace).Create(context.Background(), retry, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedRetryMigrationReason, "Error creating migration %s to retry the failed migration: %v", retry.Name, err)
+		return err
+	}
+	if err == nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeNormal, controller.SuccessfulRetryMigrationReason, "Created migration %s, attempt %d of %d", retry.Name, attempt+1, maxAttempts)
+	}
+
+	migrationCopy := migration.DeepCopy()
+	if migrationCopy.Status.Retry == nil {
+		migrationCopy.Status.Retry = &virtv1.MigrationRetryStatus{Attempt: attempt}
+	}
+	migrationCopy.Status.Retry.RetriedBy = retry.Name
+	_, err = c.clientset.VirtualMachineInstanceMigration(migration.Namespace).UpdateStatus(context.Background(), migrationCopy, metav1.UpdateOptions{})
+	return err
+}
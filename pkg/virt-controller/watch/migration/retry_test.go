@@ -0,0 +1,145 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package migration
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Migration retry", func() {
+	newRetry := func(attempt string, retryPolicy *virtv1.MigrationRetryPolicy) *virtv1.VirtualMachineInstanceMigration {
+		migration := newMigration("testmigration-retry-"+attempt, "testvmi", virtv1.MigrationPending)
+		migration.Annotations[virtv1.MigrationAttemptAnnotation] = attempt
+		migration.Annotations[virtv1.MigrationRetryOfAnnotation] = "testmigration"
+		migration.Spec.RetryPolicy = retryPolicy
+		return migration
+	}
+
+	DescribeTable("should parse the attempt", func(annotations map[string]string, expected uint32) {
+		migration := newMigration("testmigration", "testvmi", virtv1.MigrationPending)
+		for key, value := range annotations {
+			migration.Annotations[key] = value
+		}
+		Expect(migrationAttempt(migration)).To(Equal(expected))
+	},
+		Entry("of a migration without annotation", nil, uint32(1)),
+		Entry("of a retry", map[string]string{virtv1.MigrationAttemptAnnotation: "3"}, uint32(3)),
+		Entry("of an invalid annotation", map[string]string{virtv1.MigrationAttemptAnnotation: "third"}, uint32(1)),
+		Entry("of a zero annotation", map[string]string{virtv1.MigrationAttemptAnnotation: "0"}, uint32(1)),
+	)
+
+	DescribeTable("should back off", func(attempt string, retryPolicy *virtv1.MigrationRetryPolicy, expected time.Duration) {
+		migration := newRetry(attempt, retryPolicy)
+		backoffUntil := migrationRetryBackoffUntil(migration)
+		Expect(backoffUntil).ToNot(BeNil())
+		Expect(backoffUntil.Sub(migration.CreationTimestamp.Time)).To(Equal(expected))
+	},
+		Entry("the default initial backoff on the first retry", "2", nil, 20*time.Second),
+		Entry("twice as long on the second retry", "3", nil, 40*time.Second),
+		Entry("the configured initial backoff", "3", &virtv1.MigrationRetryPolicy{InitialBackoffSeconds: pointer.P(int64(5))}, 10*time.Second),
+		Entry("at most an hour", "30", nil, time.Hour),
+	)
+
+	It("should not back off the first attempt", func() {
+		migration := newMigration("testmigration", "testvmi", virtv1.MigrationPending)
+		Expect(migrationRetryBackoffUntil(migration)).To(BeNil())
+		Expect(newMigrationRetryStatus(migration)).To(BeNil())
+	})
+
+	It("should report the retry status", func() {
+		migration := newRetry("2", nil)
+		Expect(newMigrationRetryStatus(migration)).To(Equal(&virtv1.MigrationRetryStatus{
+			Attempt:      2,
+			RetryOf:      "testmigration",
+			BackoffUntil: migrationRetryBackoffUntil(migration),
+		}))
+	})
+
+	It("should create a retry keeping the origin of the migration", func() {
+		migration := newMigration("testmigration", "testvmi", virtv1.MigrationFailed)
+		migration.Labels = map[string]string{"app": "test"}
+		migration.Annotations[virtv1.EvacuationMigrationAnnotation] = "node01"
+		migration.Spec.NodeSelector = map[string]string{"zone": "a"}
+		retryPolicy := &virtv1.MigrationRetryPolicy{MaxAttempts: pointer.P(uint32(5))}
+
+		retry := newRetryMigration(migration, retryPolicy, 2)
+		Expect(retry.Name).To(Equal("testmigration-retry-2"))
+		Expect(retry.Labels).To(Equal(migration.Labels))
+		Expect(retry.Annotations).To(Equal(map[string]string{
+			virtv1.EvacuationMigrationAnnotation: "node01",
+			virtv1.MigrationRetryOfAnnotation:    "testmigration",
+			virtv1.MigrationAttemptAnnotation:    "2",
+		}))
+		Expect(retry.Spec.NodeSelector).To(Equal(migration.Spec.NodeSelector))
+		Expect(retry.Spec.RetryPolicy).To(Equal(retryPolicy))
+
+		Expect(newRetryMigration(retry, retryPolicy, 3).Name).To(Equal("testmigration-retry-3"))
+	})
+
+	It("should not copy the fallbacks of the retry policy into the retry", func() {
+		migration := newMigration("testmigration", "testvmi", virtv1.MigrationFailed)
+		retryPolicy := &virtv1.MigrationRetryPolicy{
+			MaxAttempts:                  pointer.P(uint32(5)),
+			AllowPostCopyOnRetry:         pointer.P(true),
+			BandwidthPerMigrationOnRetry: resource.NewScaledQuantity(64, resource.Mega),
+		}
+
+		retry := newRetryMigration(migration, retryPolicy, 2)
+		Expect(retry.Spec.RetryPolicy).To(Equal(&virtv1.MigrationRetryPolicy{MaxAttempts: pointer.P(uint32(5))}))
+		Expect(retryPolicy.AllowPostCopyOnRetry).ToNot(BeNil())
+	})
+
+	DescribeTable("should relax the migration configuration", func(retryPolicy *virtv1.MigrationRetryPolicy, expected *virtv1.MigrationConfiguration) {
+		migrationConfiguration := &virtv1.MigrationConfiguration{
+			AllowPostCopy:         pointer.P(false),
+			BandwidthPerMigration: resource.NewScaledQuantity(0, resource.Mega),
+		}
+		applyMigrationRetryFallback(retryPolicy, migrationConfiguration)
+		Expect(migrationConfiguration).To(Equal(expected))
+	},
+		Entry("by allowing post-copy",
+			&virtv1.MigrationRetryPolicy{AllowPostCopyOnRetry: pointer.P(true)},
+			&virtv1.MigrationConfiguration{
+				AllowPostCopy:           pointer.P(true),
+				AllowWorkloadDisruption: pointer.P(true),
+				BandwidthPerMigration:   resource.NewScaledQuantity(0, resource.Mega),
+			}),
+		Entry("by changing the bandwidth",
+			&virtv1.MigrationRetryPolicy{BandwidthPerMigrationOnRetry: resource.NewScaledQuantity(64, resource.Mega)},
+			&virtv1.MigrationConfiguration{
+				AllowPostCopy:         pointer.P(false),
+				BandwidthPerMigration: resource.NewScaledQuantity(64, resource.Mega),
+			}),
+		Entry("not without fallback",
+			&virtv1.MigrationRetryPolicy{},
+			&virtv1.MigrationConfiguration{
+				AllowPostCopy:         pointer.P(false),
+				BandwidthPerMigration: resource.NewScaledQuantity(0, resource.Mega),
+			}),
+	)
+})
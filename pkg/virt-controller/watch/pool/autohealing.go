@@ -0,0 +1,317 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
+)
+
+const (
+	defaultUnhealthyTimeout = 5 * time.Minute
+
+	UnhealthyReplicasReason          = "UnhealthyReplicas"
+	ReplacementRateLimitedReason     = "ReplacementRateLimited"
+	FailedReplaceUnhealthyReason     = "FailedReplaceUnhealthy"
+	SuccessfulReplaceUnhealthyReason = "SuccessfulReplaceUnhealthy"
+)
+
+// unhealthyVM is a VM of the pool whose running VMI fails some of the health checks.
+type unhealthyVM struct {
+	vm             *virtv1.VirtualMachine
+	failedChecks   []poolv1.VirtualMachinePoolHealthCheck
+	unhealthySince time.Time
+}
+
+// unhealthyTracker remembers since when the VMIs of a pool fail health checks whose
+// conditions do not carry a transition time, like the AgentConnected condition which
+// is removed when the guest agent disconnects. The times are lost when virt-controller
+// restarts, which only delays the replacement.
+type unhealthyTracker struct {
+	lock  sync.Mutex
+	since map[string]map[types.UID]time.Time
+}
+
+func newUnhealthyTracker() *unhealthyTracker {
+	return &unhealthyTracker{since: map[string]map[types.UID]time.Time{}}
+}
+
+// observe returns since when the VMI is known to fail the check, starting
+// to track it now if it was not observed in the previous sync of the pool.
+func (t *unhealthyTracker) observe(poolKey string, observed map[types.UID]time.Time, uid types.UID, now time.Time) time.Time {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	since, exists := t.since[poolKey][uid]
+	if !exists {
+		since = now
+	}
+	observed[uid] = since
+	return since
+}
+
+// set replaces the tracked VMIs of the pool with the ones observed in the current sync.
+func (t *unhealthyTracker) set(poolKey string, observed map[types.UID]time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if len(observed) == 0 {
+		delete(t.since, poolKey)
+		return
+	}
+	t.since[poolKey] = observed
+}
+
+func (t *unhealthyTracker) forget(poolKey string) {
+	t.set(poolKey, nil)
+}
+
+func getHealthChecks(autohealing *poolv1.VirtualMachinePoolAutohealing) []poolv1.VirtualMachinePoolHealthCheck {
+	if len(autohealing.HealthChecks) == 0 {
+		return []poolv1.VirtualMachinePoolHealthCheck{poolv1.VirtualMachinePoolReadyHealthCheck}
+	}
+	return autohealing.HealthChecks
+}
+
+func getUnhealthyTimeout(autohealing *poolv1.VirtualMachinePoolAutohealing) time.Duration {
+	if autohealing.UnhealthyTimeout == nil {
+		return defaultUnhealthyTimeout
+	}
+	return autohealing.UnhealthyTimeout.Duration
+}
+
+func calcMaxConcurrentReplacements(pool *poolv1.VirtualMachinePool) int {
+	maxConcurrent := intstr.FromInt32(1)
+	if pool.Spec.Autohealing.MaxConcurrentReplacements != nil {
+		maxConcurrent = *pool.Spec.Autohealing.MaxConcurrentReplacements
+	}
+	replacements, err := intstr.GetScaledValueFromIntOrPercent(&maxConcurrent, getWantedReplicas(pool), true)
+	if err != nil || replacements < 1 {
+		return 1
+	}
+	return replacements
+}
+
+func getRunningSince(vmi *virtv1.VirtualMachineInstance) time.Time {
+	for _, ts := range vmi.Status.PhaseTransitionTimestamps {
+		if ts.Phase == virtv1.Running {
+			return ts.PhaseTransitionTimestamp.Time
+		}
+	}
+	return vmi.CreationTimestamp.Time
+}
+
+// findUnhealthyVMs returns the VMs of the pool whose running VMIs fail the health
+// checks of the autohealing configuration. VMs without a running VMI are not
+// considered, they are taken care of by their run strategy.
+func (c *Controller) findUnhealthyVMs(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) ([]unhealthyVM, error) {
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return nil, err
+	}
+
+	if pool.Spec.Autohealing == nil {
+		c.unhealthyVMIs.forget(poolKey)
+		return nil, nil
+	}
+
+	now := time.Now()
+	observed := map[types.UID]time.Time{}
+	checks := getHealthChecks(pool.Spec.Autohealing)
+	cm := controller.NewVirtualMachineInstanceConditionManager()
+
+	var unhealthy []unhealthyVM
+	for _, vm := range filterDeletingVMs(vms) {
+		obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.DeletionTimestamp != nil || vmi.Status.Phase != virtv1.Running {
+			continue
+		}
+
+		// a VMI can not be unhealthy for longer than it is running
+		current := unhealthyVM{vm: vm, unhealthySince: getRunningSince(vmi)}
+		for _, check := range checks {
+			var since time.Time
+			switch check {
+			case poolv1.VirtualMachinePoolReadyHealthCheck:
+				cond := cm.GetCondition(vmi, virtv1.VirtualMachineInstanceReady)
+				if cond != nil && cond.Status == k8score.ConditionTrue {
+					continue
+				}
+				if cond != nil && !cond.LastTransitionTime.IsZero() {
+					since = cond.LastTransitionTime.Time
+				}
+			case poolv1.VirtualMachinePoolAgentConnectedHealthCheck:
+				if cm.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceAgentConnected, k8score.ConditionTrue) {
+					continue
+				}
+				since = c.unhealthyVMIs.observe(poolKey, observed, vmi.UID, now)
+			default:
+				continue
+			}
+			current.failedChecks = append(current.failedChecks, check)
+			if since.After(current.unhealthySince) {
+				current.unhealthySince = since
+			}
+		}
+
+		if len(current.failedChecks) > 0 {
+			unhealthy = append(unhealthy, current)
+		}
+	}
+	c.unhealthyVMIs.set(poolKey, observed)
+
+	sort.SliceStable(unhealthy, func(i, j int) bool {
+		return unhealthy[i].unhealthySince.Before(unhealthy[j].unhealthySince)
+	})
+	return unhealthy, nil
+}
+
+// autoheal replaces the VMs which have been unhealthy for longer than the unhealthy
+// timeout, the longest unhealthy first. The replacements are limited by the maximum
+// number of concurrent replacements. The VMs get created again with the same name by
+// the next scale out.
+func (c *Controller) autoheal(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, unhealthy []unhealthyVM) common.SyncError {
+	if pool.Spec.Autohealing == nil || len(unhealthy) == 0 {
+		return nil
+	}
+
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return common.NewSyncError(err, FailedReplaceUnhealthyReason)
+	}
+
+	timeout := getUnhealthyTimeout(pool.Spec.Autohealing)
+	allowed := calcMaxConcurrentReplacements(pool) - (len(vms) - len(filterDeletingVMs(vms)))
+
+	for _, u := range unhealthy {
+		remaining := time.Until(u.unhealthySince.Add(timeout))
+		if remaining > 0 {
+			// check again once the VM exceeded the timeout
+			c.queue.AddAfter(poolKey, remaining)
+			break
+		}
+		if allowed <= 0 {
+			log.Log.Object(pool).V(4).Infof("Replacement of unhealthy vm %s/%s is rate limited", u.vm.Namespace, u.vm.Name)
+			break
+		}
+		allowed--
+
+		if err := c.replaceVM(pool, poolKey, u); err != nil {
+			return common.NewSyncError(fmt.Errorf("Error while replacing unhealthy vm %s/%s: %v", u.vm.Namespace, u.vm.Name, err), FailedReplaceUnhealthyReason)
+		}
+	}
+	return nil
+}
+
+func (c *Controller) replaceVM(pool *poolv1.VirtualMachinePool, poolKey string, u unhealthyVM) error {
+	vm := u.vm
+
+	// make sure volumes which have to be retained are not garbage collected with the VM
+	if err := c.syncVMVolumeOwnership(pool, vm); err != nil {
+		return err
+	}
+
+	c.expectations.ExpectDeletions(poolKey, []string{controller.VirtualMachineKey(vm)})
+	foreGround := metav1.DeletePropagationForeground
+	err := c.clientset.VirtualMachine(vm.Namespace).Delete(context.Background(), vm.Name, metav1.DeleteOptions{PropagationPolicy: &foreGround})
+	if err != nil {
+		c.expectations.DeletionObserved(poolKey, controller.VirtualMachineKey(vm))
+		c.recorder.Eventf(pool, k8score.EventTypeWarning, common.FailedDeleteVirtualMachineReason, "Error deleting unhealthy virtual machine %s: %v", vm.Name, err)
+		return err
+	}
+
+	c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulReplaceUnhealthyReason, "Replacing VM %s/%s which failed the health checks %s", vm.Namespace, vm.Name, formatHealthChecks(u.failedChecks))
+	log.Log.Object(pool).Infof("Deleted unhealthy vm %s/%s from pool", vm.Namespace, vm.Name)
+	return nil
+}
+
+// updateUnhealthyCondition records the unhealthy VMs of the pool in the ReplicaUnhealthy condition.
+func (c *Controller) updateUnhealthyCondition(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, unhealthy []unhealthyVM) {
+	cm := controller.NewVirtualMachinePoolConditionManager()
+
+	if len(unhealthy) == 0 {
+		if cm.HasCondition(pool, poolv1.VirtualMachinePoolReplicaUnhealthy) {
+			cm.RemoveCondition(pool, poolv1.VirtualMachinePoolReplicaUnhealthy)
+		}
+		return
+	}
+
+	reason := UnhealthyReplicasReason
+	timeout := getUnhealthyTimeout(pool.Spec.Autohealing)
+	if time.Since(unhealthy[0].unhealthySince) >= timeout &&
+		len(vms)-len(filterDeletingVMs(vms)) >= calcMaxConcurrentReplacements(pool) {
+		reason = ReplacementRateLimitedReason
+	}
+
+	var msgs []string
+	for _, u := range unhealthy {
+		msgs = append(msgs, fmt.Sprintf("%s failed %s", u.vm.Name, formatHealthChecks(u.failedChecks)))
+	}
+	message := strings.Join(msgs, ", ")
+
+	cond := cm.GetCondition(pool, poolv1.VirtualMachinePoolReplicaUnhealthy)
+	if cond != nil && cond.Reason == reason && cond.Message == message {
+		return
+	}
+	transitionTime := metav1.Now()
+	if cond != nil {
+		// the condition manager only replaces conditions whose reason changed
+		transitionTime = cond.LastTransitionTime
+		cm.RemoveCondition(pool, poolv1.VirtualMachinePoolReplicaUnhealthy)
+	}
+	cm.UpdateCondition(pool, &poolv1.VirtualMachinePoolCondition{
+		Type:               poolv1.VirtualMachinePoolReplicaUnhealthy,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: transitionTime,
+		Status:             k8score.ConditionTrue,
+	})
+}
+
+func formatHealthChecks(checks []poolv1.VirtualMachinePoolHealthCheck) string {
+	names := make([]string, 0, len(checks))
+	for _, check := range checks {
+		names = append(names, string(check))
+	}
+	return strings.Join(names, ",")
+}
@@ -7,6 +7,7 @@ import (
 	"maps"
 	"math"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -25,6 +26,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -49,6 +51,9 @@ type Controller struct {
 	vmiStore        cache.Store
 	poolIndexer     cache.Indexer
 	revisionIndexer cache.Indexer
+	dataVolumeStore cache.Store
+	pvcStore        cache.Store
+	unhealthyVMIs   *unhealthyTracker
 	recorder        record.EventRecorder
 	expectations    *controller.UIDTrackingControllerExpectations
 	burstReplicas   uint
@@ -67,6 +72,7 @@ const (
 	FailedScaleInReason         = "FailedScaleIn"
 	FailedUpdateReason          = "FailedUpdate"
 	FailedRevisionPruningReason = "FailedRevisionPruning"
+	FailedVolumeOwnershipReason = "FailedVolumeOwnership"
 
 	SuccessfulPausedPoolReason = "SuccessfulPaused"
 	SuccessfulResumePoolReason = "SuccessfulResume"
@@ -80,6 +86,8 @@ func NewController(clientset kubecli.KubevirtClient,
 	vmInformer cache.SharedIndexInformer,
 	poolInformer cache.SharedIndexInformer,
 	revisionInformer cache.SharedIndexInformer,
+	dataVolumeInformer cache.SharedIndexInformer,
+	pvcInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	burstReplicas uint) (*Controller, error) {
 	c := &Controller{
@@ -92,13 +100,17 @@ func NewController(clientset kubecli.KubevirtClient,
 		vmiStore:        vmiInformer.GetStore(),
 		vmIndexer:       vmInformer.GetIndexer(),
 		revisionIndexer: revisionInformer.GetIndexer(),
+		dataVolumeStore: dataVolumeInformer.GetStore(),
+		pvcStore:        pvcInformer.GetStore(),
+		unhealthyVMIs:   newUnhealthyTracker(),
 		recorder:        recorder,
 		expectations:    controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		burstReplicas:   burstReplicas,
 	}
 
 	c.hasSynced = func() bool {
-		return poolInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && revisionInformer.HasSynced()
+		return poolInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && revisionInformer.HasSynced() &&
+			dataVolumeInformer.HasSynced() && pvcInformer.HasSynced()
 	}
 
 	_, err := poolInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -472,12 +484,104 @@ func (c *Controller) listVMsFromNamespace(namespace string) ([]*virtv1.VirtualMa
 }
 
 func (c *Controller) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
-	wantedReplicas := int32(1)
+	wantedReplicas := getWantedReplicas(pool) + c.calcSurge(pool, vms)
+
+	return len(vms) - wantedReplicas
+}
+
+func getWantedReplicas(pool *poolv1.VirtualMachinePool) int {
 	if pool.Spec.Replicas != nil {
-		wantedReplicas = *pool.Spec.Replicas
+		return int(*pool.Spec.Replicas)
+	}
+	return 1
+}
+
+// getRollingUpdate returns the rolling update configuration of the pool,
+// or nil if the pool does not use the RollingUpdate strategy.
+func getRollingUpdate(pool *poolv1.VirtualMachinePool) *poolv1.VirtualMachinePoolRollingUpdate {
+	strategy := pool.Spec.UpdateStrategy
+	if strategy == nil {
+		return nil
+	}
+	if strategy.Type != "" && strategy.Type != poolv1.VirtualMachinePoolRollingUpdateStrategyType {
+		return nil
 	}
+	if strategy.RollingUpdate == nil {
+		return &poolv1.VirtualMachinePoolRollingUpdate{}
+	}
+	return strategy.RollingUpdate
+}
 
-	return len(vms) - int(wantedReplicas)
+// calcSurge returns the number of VMs which are created above the desired
+// replicas while a rolling update is in progress.
+func (c *Controller) calcSurge(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
+	rollingUpdate := getRollingUpdate(pool)
+	if rollingUpdate == nil {
+		return 0
+	}
+
+	maxSurge := calcMaxSurge(pool, rollingUpdate)
+	if maxSurge == 0 {
+		return 0
+	}
+
+	if int(c.countUpdatedVMs(pool, vms)) == len(vms) {
+		// no update in progress
+		return 0
+	}
+	return maxSurge
+}
+
+func calcMaxSurge(pool *poolv1.VirtualMachinePool, rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate) int {
+	if rollingUpdate.MaxSurge == nil {
+		return 0
+	}
+	maxSurge, err := intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxSurge, getWantedReplicas(pool), true)
+	if err != nil || maxSurge < 0 {
+		return 0
+	}
+	return maxSurge
+}
+
+// countUpdatedVMs returns the number of VMs which, including their running VMIs,
+// match the current virtual machine template of the pool.
+func (c *Controller) countUpdatedVMs(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int32 {
+	count := int32(0)
+	for _, vm := range vms {
+		if c.isUpdatedVM(pool, vm) {
+			count++
+		}
+	}
+	return count
+}
+
+func (c *Controller) isUpdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) bool {
+	revisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
+	if !exists {
+		return false
+	}
+
+	poolSpec, exists, err := c.getControllerRevision(pool.Namespace, revisionName)
+	if err != nil || !exists {
+		return false
+	}
+	if !equality.Semantic.DeepEqual(poolSpec.VirtualMachineTemplate, pool.Spec.VirtualMachineTemplate) {
+		return false
+	}
+
+	obj, exists, _ := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if !exists {
+		// no VMI which could be outdated
+		return true
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	return vmi.Labels[virtv1.VirtualMachinePoolRevisionName] == revisionName
+}
+
+// isAvailableVMI returns true if the VMI is ready and not being deleted.
+func isAvailableVMI(vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi.DeletionTimestamp == nil &&
+		controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceReady, k8score.ConditionTrue)
 }
 
 func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
@@ -526,10 +630,17 @@ func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virt
 		count = len(elgibleVMs)
 	}
 
-	// random delete strategy
-	rand.Shuffle(len(elgibleVMs), func(i, j int) {
-		elgibleVMs[i], elgibleVMs[j] = elgibleVMs[j], elgibleVMs[i]
-	})
+	if pool.Spec.PersistentVolumeClaimRetentionPolicy != nil {
+		// stateful delete strategy, scaling out again reuses the ordinals in the same order
+		sort.SliceStable(elgibleVMs, func(i, j int) bool {
+			return ordinalFromName(elgibleVMs[i].Name) > ordinalFromName(elgibleVMs[j].Name)
+		})
+	} else {
+		// random delete strategy
+		rand.Shuffle(len(elgibleVMs), func(i, j int) {
+			elgibleVMs[i], elgibleVMs[j] = elgibleVMs[j], elgibleVMs[i]
+		})
+	}
 
 	log.Log.Object(pool).Infof("Removing %d VMs from pool", count)
 
@@ -544,6 +655,14 @@ func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virt
 			defer wg.Done()
 			vm := deleteList[idx]
 
+			// make sure volumes which have to be retained are not garbage collected with the VM
+			if err := c.syncVMVolumeOwnership(pool, vm); err != nil {
+				c.expectations.DeletionObserved(poolKey, controller.VirtualMachineKey(vm))
+				c.recorder.Eventf(pool, k8score.EventTypeWarning, common.FailedDeleteVirtualMachineReason, "Error updating the volume ownership of virtual machine %s: %v", vm.ObjectMeta.Name, err)
+				errChan <- err
+				return
+			}
+
 			foreGround := metav1.DeletePropagationForeground
 			err := c.clientset.VirtualMachine(vm.Namespace).Delete(context.Background(), vm.Name, metav1.DeleteOptions{PropagationPolicy: &foreGround})
 			if err != nil {
@@ -552,6 +671,14 @@ func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virt
 				errChan <- err
 				return
 			}
+
+			if shouldDeleteVolumesOnScaleIn(pool) {
+				if err := c.deleteVMVolumes(vm); err != nil {
+					c.recorder.Eventf(pool, k8score.EventTypeWarning, common.FailedDeleteVirtualMachineReason, "Error deleting the volumes of virtual machine %s: %v", vm.ObjectMeta.Name, err)
+					errChan <- err
+					return
+				}
+			}
 			c.recorder.Eventf(pool, k8score.EventTypeNormal, common.SuccessfulDeleteVirtualMachineReason, "Deleted VM %s/%s with uid %v from pool", vm.Namespace, vm.Name, vm.ObjectMeta.UID)
 			log.Log.Object(pool).Infof("Deleted vm %s/%s from pool", vm.Namespace, vm.Name)
 		}(i)
@@ -616,6 +743,15 @@ func indexFromName(name string) (int, error) {
 	return strconv.Atoi(slice[len(slice)-1])
 }
 
+// ordinalFromName returns the index of the VM, or -1 if the name has no index.
+func ordinalFromName(name string) int {
+	index, err := indexFromName(name)
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
 func indexVMSpec(spec *virtv1.VirtualMachineSpec, idx int) *virtv1.VirtualMachineSpec {
 
 	if len(spec.DataVolumeTemplates) == 0 {
@@ -875,34 +1011,26 @@ func (c *Controller) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vmOutd
 	return nil
 }
 
-func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedList []*virtv1.VirtualMachine) error {
+type vmiUpdate struct {
+	vm         *virtv1.VirtualMachine
+	vmi        *virtv1.VirtualMachineInstance
+	updateType proactiveUpdateType
+}
+
+func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, vmUpdatedList []*virtv1.VirtualMachine) error {
+	updates, outdatedErr := c.getVMIUpdates(pool, vmUpdatedList)
+	updates = c.limitVMIRestarts(pool, vms, updates)
+
 	var wg sync.WaitGroup
-	wg.Add(len(vmUpdatedList))
-	errChan := make(chan error, len(vmUpdatedList))
-	for i := 0; i < len(vmUpdatedList); i++ {
+	wg.Add(len(updates))
+	errChan := make(chan error, len(updates))
+	for i := 0; i < len(updates); i++ {
 		go func(idx int) {
 			defer wg.Done()
-			vm := vmUpdatedList[idx]
-
-			vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
-			obj, exists, _ := c.vmiStore.GetByKey(vmiKey)
-			if !exists {
-				// no VMI to update
-				return
-			}
-			vmi := obj.(*virtv1.VirtualMachineInstance)
-			if vmi.DeletionTimestamp != nil {
-				// ignore VMIs which are already deleting
-				return
-			}
+			vm := updates[idx].vm
+			vmi := updates[idx].vmi
 
-			updateType, err := c.isOutdatedVMI(vm, vmi)
-			if err != nil {
-				errChan <- err
-				return
-
-			}
-			switch updateType {
+			switch updates[idx].updateType {
 			case proactiveUpdateTypeRestart:
 				err := c.clientset.VirtualMachineInstance(vm.ObjectMeta.Namespace).Delete(context.Background(), vmi.ObjectMeta.Name, v1.DeleteOptions{})
 				if err != nil {
@@ -951,6 +1079,10 @@ func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedL
 	}
 	wg.Wait()
 
+	if outdatedErr != nil {
+		return outdatedErr
+	}
+
 	select {
 	case err := <-errChan:
 		// Only return the first error which occurred. We log the rest
@@ -961,6 +1093,119 @@ func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedL
 	return nil
 }
 
+// getVMIUpdates returns the updates required by the running VMIs of the VMs.
+// VMIs for which the update could not be determined are skipped, the first
+// error encountered is returned together with the updates of the other VMIs.
+func (c *Controller) getVMIUpdates(pool *poolv1.VirtualMachinePool, vmUpdatedList []*virtv1.VirtualMachine) ([]vmiUpdate, error) {
+	var updates []vmiUpdate
+	var firstErr error
+
+	for _, vm := range vmUpdatedList {
+		vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
+		obj, exists, _ := c.vmiStore.GetByKey(vmiKey)
+		if !exists {
+			// no VMI to update
+			continue
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.DeletionTimestamp != nil {
+			// ignore VMIs which are already deleting
+			continue
+		}
+
+		updateType, err := c.isOutdatedVMI(pool, vm, vmi)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if updateType == proactiveUpdateTypeLiveUpdate {
+			updateType = getLiveUpdateType(vm)
+		}
+		if updateType != proactiveUpdateTypeNone {
+			updates = append(updates, vmiUpdate{vm: vm, vmi: vmi, updateType: updateType})
+		}
+	}
+
+	return updates, firstErr
+}
+
+// getLiveUpdateType decides how a VMI with live-updatable changes is updated.
+// The VM controller applies these changes to the VMI itself, or marks the VM
+// with the RestartRequired condition if it can't.
+func getLiveUpdateType(vm *virtv1.VirtualMachine) proactiveUpdateType {
+	if vm.Status.DesiredGeneration != vm.Generation {
+		// wait until the VM controller processed the updated VM
+		return proactiveUpdateTypeNone
+	}
+	if controller.NewVirtualMachineConditionManager().HasCondition(vm, virtv1.VirtualMachineRestartRequired) {
+		return proactiveUpdateTypeRestart
+	}
+	return proactiveUpdateTypePatchRevisionLabel
+}
+
+// limitVMIRestarts removes the VMI restarts which are not allowed by the update strategy of the pool.
+// Without an update strategy, all outdated VMIs are restarted at once.
+func (c *Controller) limitVMIRestarts(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, updates []vmiUpdate) []vmiUpdate {
+	if pool.Spec.UpdateStrategy == nil {
+		return updates
+	}
+
+	// With the OnDelete strategy, no restarts are allowed
+	allowedRestarts := 0
+	if rollingUpdate := getRollingUpdate(pool); rollingUpdate != nil {
+		allowedRestarts = c.calcAllowedRestarts(pool, vms, rollingUpdate)
+	}
+
+	var allowed []vmiUpdate
+	for _, update := range updates {
+		if update.updateType != proactiveUpdateTypeRestart {
+			allowed = append(allowed, update)
+			continue
+		}
+		// Restarting an unavailable VMI does not reduce the availability of the pool
+		if !isAvailableVMI(update.vmi) {
+			allowed = append(allowed, update)
+		} else if allowedRestarts > 0 {
+			allowed = append(allowed, update)
+			allowedRestarts--
+		} else {
+			log.Log.Object(pool).V(4).Infof("Postponing restart of outdated vmi %s/%s", update.vmi.Namespace, update.vmi.Name)
+		}
+	}
+	return allowed
+}
+
+// calcAllowedRestarts returns how many available VMIs can be restarted
+// without exceeding maxUnavailable.
+func (c *Controller) calcAllowedRestarts(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate) int {
+	maxUnavailable := intstr.FromInt32(1)
+	if rollingUpdate.MaxUnavailable != nil {
+		maxUnavailable = *rollingUpdate.MaxUnavailable
+	}
+	wantedReplicas := getWantedReplicas(pool)
+	unavailable, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, wantedReplicas, false)
+	if err != nil {
+		log.Log.Object(pool).Reason(err).Error("Invalid maxUnavailable, not restarting available vmis")
+		return 0
+	}
+	if unavailable == 0 && calcMaxSurge(pool, rollingUpdate) == 0 {
+		// percentages can round down to 0, make sure the update can progress
+		unavailable = 1
+	}
+
+	available := 0
+	for _, vm := range vms {
+		obj, exists, _ := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+		if exists && isAvailableVMI(obj.(*virtv1.VirtualMachineInstance)) {
+			available++
+		}
+	}
+
+	return available - (wantedReplicas - unavailable)
+}
+
 type proactiveUpdateType string
 
 const (
@@ -968,11 +1213,13 @@ const (
 	proactiveUpdateTypeRestart proactiveUpdateType = "restart"
 	// VMI spec is identify in current vmi pool, just needs revision label updated
 	proactiveUpdateTypePatchRevisionLabel proactiveUpdateType = "label-patch"
+	// VMI spec has only changed in fields which can be updated on the running VMI
+	proactiveUpdateTypeLiveUpdate proactiveUpdateType = "live-update"
 	// VMI does not need an update
 	proactiveUpdateTypeNone proactiveUpdateType = "no-update"
 )
 
-func (c *Controller) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (proactiveUpdateType, error) {
+func (c *Controller) isOutdatedVMI(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (proactiveUpdateType, error) {
 	// This function compares the pool revision (pool spec at a specific point in time) synced
 	// to the VM vs the one used to create the VMI. By comparing the pool spec revisions between
 	// the VM and VMI we can determine if the VM has mutated in a way that should result
@@ -992,6 +1239,8 @@ func (c *Controller) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Virtua
 	//    proactive restart is required.
 	// 4. If the expected VMI template specs from the revisions are not identical in name, but
 	//    are identical in DeepEquals, patch the VMI with the new revision name used on the vm.
+	// 5. If live updates are enabled in the rolling update of the pool and the VMI template specs
+	//    only differ in live-updatable fields, let the VM controller update the running VMI.
 
 	vmRevisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
 	if !exists {
@@ -1041,6 +1290,11 @@ func (c *Controller) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Virtua
 	// the VM and the revision used to create the VMI, then the VMI
 	// must be updated.
 	if !equality.Semantic.DeepEqual(currentVMITemplate, expectedVMITemplate) {
+		if rollingUpdate := getRollingUpdate(pool); rollingUpdate != nil && rollingUpdate.LiveUpdate &&
+			isLiveUpdatable(currentVMITemplate, expectedVMITemplate) {
+			log.Log.Infof("Marking vmi %s/%s for live update due out of sync spec", vm.Namespace, vm.Name)
+			return proactiveUpdateTypeLiveUpdate, nil
+		}
 		log.Log.Infof("Marking vmi %s/%s for update due out of sync spec", vm.Namespace, vm.Name)
 		return proactiveUpdateTypeRestart, nil
 	}
@@ -1050,6 +1304,27 @@ func (c *Controller) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Virtua
 	return proactiveUpdateTypePatchRevisionLabel, nil
 }
 
+// isLiveUpdatable returns true if the VMI templates only differ in fields
+// which the VM controller can apply to a running VMI.
+func isLiveUpdatable(current, expected *virtv1.VirtualMachineInstanceTemplateSpec) bool {
+	if current == nil || expected == nil {
+		return false
+	}
+
+	updated := current.DeepCopy()
+	if updated.Spec.Domain.CPU != nil && expected.Spec.Domain.CPU != nil {
+		updated.Spec.Domain.CPU.Sockets = expected.Spec.Domain.CPU.Sockets
+	}
+	if updated.Spec.Domain.Memory != nil && expected.Spec.Domain.Memory != nil {
+		updated.Spec.Domain.Memory.Guest = expected.Spec.Domain.Memory.Guest
+	}
+	updated.Spec.NodeSelector = expected.Spec.NodeSelector
+	updated.Spec.Affinity = expected.Spec.Affinity
+	updated.Spec.Tolerations = expected.Spec.Tolerations
+
+	return equality.Semantic.DeepEqual(updated, expected)
+}
+
 func (c *Controller) isOutdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) (bool, error) {
 
 	if vm.Labels == nil {
@@ -1154,7 +1429,7 @@ func (c *Controller) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtu
 		return common.NewSyncError(fmt.Errorf("Error during VM update: %v", err), FailedUpdateReason), false
 	}
 
-	err = c.proactiveUpdate(pool, vmUpdatedList)
+	err = c.proactiveUpdate(pool, vms, vmUpdatedList)
 	if err != nil {
 		return common.NewSyncError(fmt.Errorf("Error during VMI update: %v", err), FailedUpdateReason), false
 	}
@@ -1192,7 +1467,7 @@ func (c *Controller) Execute() bool {
 	return true
 }
 
-func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, syncErr common.SyncError) error {
+func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, unhealthy []unhealthyVM, syncErr common.SyncError) error {
 
 	key, err := controller.KeyFunc(origPool)
 	if err != nil {
@@ -1241,8 +1516,13 @@ func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*vi
 		c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulResumePoolReason, "Pool is unpaused")
 	}
 
+	c.updateUnhealthyCondition(pool, vms, unhealthy)
+
 	pool.Status.Replicas = int32(len(vms))
 	pool.Status.ReadyReplicas = int32(len(c.filterReadyVMs(vms)))
+	pool.Status.UpdatedReplicas = c.countUpdatedVMs(pool, vms)
+	pool.Status.OutdatedReplicas = pool.Status.Replicas - pool.Status.UpdatedReplicas
+	pool.Status.UnhealthyReplicas = int32(len(unhealthy))
 
 	if !equality.Semantic.DeepEqual(pool.Status, origPool.Status) || pool.Status.Replicas != pool.Status.ReadyReplicas {
 		_, err := c.clientset.VirtualMachinePool(pool.Namespace).UpdateStatus(context.Background(), pool, metav1.UpdateOptions{})
@@ -1271,6 +1551,7 @@ func (c *Controller) execute(key string) error {
 		logger = logger.Object(pool)
 	} else {
 		c.expectations.DeleteExpectations(key)
+		c.unhealthyVMIs.forget(key)
 		return nil
 	}
 
@@ -1308,6 +1589,11 @@ func (c *Controller) execute(key string) error {
 		return err
 	}
 
+	unhealthy, err := c.findUnhealthyVMs(pool, vms)
+	if err != nil {
+		return err
+	}
+
 	needsSync := c.expectations.SatisfiedExpectations(key)
 	if needsSync && !pool.Spec.Paused && pool.DeletionTimestamp == nil {
 		scaleIsStable := false
@@ -1318,6 +1604,15 @@ func (c *Controller) execute(key string) error {
 			logger.Reason(err).Error("Scaling the pool failed.")
 		}
 
+		if syncErr == nil {
+			syncErr = c.syncVolumeOwnership(pool, vms)
+		}
+
+		if scaleIsStable && syncErr == nil {
+			// replace unhealthy VMs, they get created again by the next scale out
+			syncErr = c.autoheal(pool, vms, unhealthy)
+		}
+
 		needsSync = c.expectations.SatisfiedExpectations(key)
 		if needsSync && scaleIsStable && syncErr == nil {
 			// Handle updates after scale operations are satisfied.
@@ -1334,7 +1629,7 @@ func (c *Controller) execute(key string) error {
 		syncErr = c.pruneUnusedRevisions(pool, vms)
 	}
 
-	err = c.updateStatus(pool, vms, syncErr)
+	err = c.updateStatus(pool, vms, unhealthy, syncErr)
 	if err != nil {
 		return err
 	}
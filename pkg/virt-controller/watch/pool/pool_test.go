@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -31,6 +32,8 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -39,9 +42,11 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	"kubevirt.io/client-go/api"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 	"kubevirt.io/client-go/testing"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
@@ -94,6 +99,7 @@ var _ = Describe("Pool", func() {
 		var mockQueue *testutils.MockWorkQueue[string]
 		var fakeVirtClient *kubevirtfake.Clientset
 		var k8sClient *k8sfake.Clientset
+		var cdiClient *cdifake.Clientset
 
 		addCR := func(cr *appsv1.ControllerRevision) {
 			controller.revisionIndexer.Add(cr)
@@ -137,11 +143,16 @@ var _ = Describe("Pool", func() {
 				},
 			})
 
+			dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+			pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+
 			controller, _ = NewController(virtClient,
 				vmiInformer,
 				vmInformer,
 				poolInformer,
 				crInformer,
+				dataVolumeInformer,
+				pvcInformer,
 				recorder,
 				uint(10))
 			// Wrap our workqueue to have a way to detect when we are done processing updates
@@ -167,6 +178,14 @@ var _ = Describe("Pool", func() {
 				return true, nil, nil
 			})
 			virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+			virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+			cdiClient = cdifake.NewSimpleClientset()
+			cdiClient.Fake.PrependReactor("*", "*", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				Expect(action).To(BeNil())
+				return true, nil, nil
+			})
+			virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 		})
 
 		addPool := func(pool *poolv1.VirtualMachinePool) {
@@ -262,6 +281,7 @@ var _ = Describe("Pool", func() {
 			pool, vm := DefaultPool(1)
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			poolRevision := createPoolRevision(pool)
 
 			pool.Generation = 123
@@ -319,6 +339,7 @@ var _ = Describe("Pool", func() {
 			pool, vm := DefaultPool(1)
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.OutdatedReplicas = 1
 
 			oldPoolRevision := createPoolRevision(pool)
 
@@ -365,6 +386,612 @@ var _ = Describe("Pool", func() {
 			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(1))
 		})
 
+		Context("with an update strategy", func() {
+			var (
+				pool            *poolv1.VirtualMachinePool
+				vm              *v1.VirtualMachine
+				oldPoolRevision *appsv1.ControllerRevision
+				newPoolRevision *appsv1.ControllerRevision
+			)
+
+			BeforeEach(func() {
+				pool, vm = DefaultPool(3)
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 1}
+				oldPoolRevision = createPoolRevision(pool)
+
+				pool.Generation = 123
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 2}
+				newPoolRevision = createPoolRevision(pool)
+
+				vm.Spec = *pool.Spec.VirtualMachineTemplate.Spec.DeepCopy()
+				vm = injectPoolRevisionLabelsIntoVM(vm, newPoolRevision.Name)
+				markVmAsReady(vm)
+
+				addPool(pool)
+				addCR(oldPoolRevision)
+				addCR(newPoolRevision)
+
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+			})
+
+			// addOutdatedVMIs adds VMs with the current pool revision, running VMIs from the old pool revision
+			addOutdatedVMIs := func(ready ...bool) {
+				for i, isReady := range ready {
+					vmCopy := vm.DeepCopy()
+					vmCopy.Name = fmt.Sprintf("%s-%d", pool.Name, i)
+					addVM(vmCopy)
+
+					vmi := api.NewMinimalVMI(vmCopy.Name)
+					vmi.Namespace = vmCopy.Namespace
+					vmi.Spec = oldPoolRevisionVMISpec(oldPoolRevision)
+					vmi.Labels = maps.Clone(vmCopy.Spec.Template.ObjectMeta.Labels)
+					vmi.Labels[v1.VirtualMachinePoolRevisionName] = oldPoolRevision.Name
+					if isReady {
+						watchtesting.MarkAsReady(vmi)
+					}
+					addVMI(vmi)
+				}
+			}
+
+			expectPoolStatus := func(updated, outdated int32) {
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updatedPool := update.GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updatedPool.Status.UpdatedReplicas).To(Equal(updated))
+					Expect(updatedPool.Status.OutdatedReplicas).To(Equal(outdated))
+					return true, update.GetObject(), nil
+				})
+			}
+
+			It("should restart all outdated VMIs at once without an update strategy", func() {
+				addOutdatedVMIs(true, true, true)
+				expectPoolStatus(0, 3)
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(3))
+			})
+
+			It("should not restart outdated VMIs with the OnDelete strategy", func() {
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{Type: poolv1.VirtualMachinePoolOnDeleteStrategyType}
+				addOutdatedVMIs(true, true, true)
+				expectPoolStatus(0, 3)
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+			})
+
+			DescribeTable("should restart outdated VMIs within maxUnavailable with the RollingUpdate strategy", func(maxUnavailable *intstr.IntOrString, ready []bool, expectedRestarts int) {
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					Type:          poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+					RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{MaxUnavailable: maxUnavailable},
+				}
+				addOutdatedVMIs(ready...)
+				expectPoolStatus(0, 3)
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(expectedRestarts))
+			},
+				Entry("with the default of one VMI", nil, []bool{true, true, true}, 1),
+				Entry("with an absolute number", pointer.P(intstr.FromInt32(2)), []bool{true, true, true}, 2),
+				Entry("with a percentage rounded down", pointer.P(intstr.FromString("50%")), []bool{true, true, true}, 1),
+				Entry("when VMIs are already unavailable", pointer.P(intstr.FromInt32(1)), []bool{true, false, true}, 1),
+				Entry("and always restart unavailable VMIs", pointer.P(intstr.FromInt32(1)), []bool{true, false, false}, 2),
+				Entry("with at least one VMI if the percentage rounds down to 0", pointer.P(intstr.FromString("10%")), []bool{true, true, true}, 1),
+			)
+
+			It("should create maxSurge additional VMs while the rolling update is in progress", func() {
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+						MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+						MaxSurge:       pointer.P(intstr.FromInt32(1)),
+					},
+				}
+				addOutdatedVMIs(true, true, true)
+				expectVMCreation(Equal(fmt.Sprintf("%s-3", pool.Name)))
+				expectPoolStatus(0, 3)
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(1))
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+			})
+
+			It("should restart outdated VMIs once surge VMs are available", func() {
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+						MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+						MaxSurge:       pointer.P(intstr.FromInt32(1)),
+					},
+				}
+				addOutdatedVMIs(true, true, true)
+
+				surgeVM := vm.DeepCopy()
+				surgeVM.Name = fmt.Sprintf("%s-3", pool.Name)
+				addVM(surgeVM)
+				surgeVMI := api.NewMinimalVMI(surgeVM.Name)
+				surgeVMI.Namespace = surgeVM.Namespace
+				surgeVMI.Labels = maps.Clone(surgeVM.Spec.Template.ObjectMeta.Labels)
+				watchtesting.MarkAsReady(surgeVMI)
+				addVMI(surgeVMI)
+
+				expectPoolStatus(1, 3)
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(1))
+			})
+
+			Context("and live updates", func() {
+				BeforeEach(func() {
+					pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+						RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{LiveUpdate: true},
+					}
+					vm.Generation = 2
+					vm.Status.DesiredGeneration = 2
+				})
+
+				It("should let the VM controller update the VMIs", func() {
+					addOutdatedVMIs(true, true, true)
+					fakeVirtClient.Fake.PrependReactor("patch", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+						patchAction, ok := action.(k8stesting.PatchAction)
+						Expect(ok).To(BeTrue())
+						Expect(string(patchAction.GetPatch())).To(ContainSubstring(newPoolRevision.Name))
+						return true, nil, nil
+					})
+					expectPoolStatus(0, 3)
+
+					sanityExecute()
+
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(3))
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				It("should wait for the VM controller to process the updated VMs", func() {
+					vm.Status.DesiredGeneration = 1
+					addOutdatedVMIs(true, true, true)
+					expectPoolStatus(0, 3)
+
+					sanityExecute()
+
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				It("should restart the VMIs within maxUnavailable if the VM requires a restart", func() {
+					virtcontroller.NewVirtualMachineConditionManager().UpdateCondition(vm, &v1.VirtualMachineCondition{
+						Type:   v1.VirtualMachineRestartRequired,
+						Status: k8sv1.ConditionTrue,
+					})
+					addOutdatedVMIs(true, true, true)
+					expectPoolStatus(0, 3)
+
+					sanityExecute()
+
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(1))
+				})
+
+				It("should restart the VMIs within maxUnavailable if fields which are not live-updatable changed", func() {
+					pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+					newPoolRevision = createPoolRevision(pool)
+					Expect(controller.revisionIndexer.Update(newPoolRevision)).To(Succeed())
+					addOutdatedVMIs(true, true, true)
+					expectPoolStatus(0, 3)
+
+					sanityExecute()
+
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(1))
+				})
+			})
+		})
+
+		Context("with a volume retention policy", func() {
+			var (
+				pool         *poolv1.VirtualMachinePool
+				vm           *v1.VirtualMachine
+				poolRevision *appsv1.ControllerRevision
+			)
+
+			BeforeEach(func() {
+				pool, vm = DefaultPool(1)
+				pool.UID = "pool-uid"
+				pool.Spec.VirtualMachineTemplate.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{
+					ObjectMeta: metav1.ObjectMeta{Name: "disk"},
+				}}
+				poolRevision = createPoolRevision(pool)
+
+				vm.Spec = *pool.Spec.VirtualMachineTemplate.Spec.DeepCopy()
+				vm = injectPoolRevisionLabelsIntoVM(vm, poolRevision.Name)
+				vm.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
+
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					return true, update.GetObject(), nil
+				})
+			})
+
+			newPoolVM := func(index int) *v1.VirtualMachine {
+				vmCopy := vm.DeepCopy()
+				vmCopy.Name = fmt.Sprintf("%s-%d", pool.Name, index)
+				vmCopy.UID = k8stypes.UID(fmt.Sprintf("vm-uid-%d", index))
+				vmCopy.Spec = *indexVMSpec(&vmCopy.Spec, index)
+				return vmCopy
+			}
+
+			patchedOwnerUIDs := func(action k8stesting.Action) []k8stypes.UID {
+				patchAction, ok := action.(k8stesting.PatchAction)
+				Expect(ok).To(BeTrue())
+				var ops []struct {
+					Op    string          `json:"op"`
+					Value json.RawMessage `json:"value"`
+				}
+				Expect(json.Unmarshal(patchAction.GetPatch(), &ops)).To(Succeed())
+				var ownerReferences []metav1.OwnerReference
+				Expect(json.Unmarshal(ops[len(ops)-1].Value, &ownerReferences)).To(Succeed())
+
+				uids := []k8stypes.UID{}
+				for _, ref := range ownerReferences {
+					uids = append(uids, ref.UID)
+				}
+				return uids
+			}
+
+			DescribeTable("should hand the ownership of the DataVolumes to", func(policy *poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy, expectedOwnerUIDs []k8stypes.UID) {
+				pool.Spec.PersistentVolumeClaimRetentionPolicy = policy
+				poolVM := newPoolVM(0)
+				addPool(pool)
+				addVM(poolVM)
+				addCR(poolRevision)
+
+				Expect(controller.dataVolumeStore.Add(&cdiv1.DataVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "disk-0",
+						Namespace:       poolVM.Namespace,
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(poolVM, v1.VirtualMachineGroupVersionKind)},
+					},
+				})).To(Succeed())
+				cdiClient.Fake.PrependReactor("patch", "datavolumes", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					Expect(patchedOwnerUIDs(action)).To(Equal(expectedOwnerUIDs))
+					return true, nil, nil
+				})
+
+				sanityExecute()
+
+				expectedPatches := 1
+				if policy == nil {
+					expectedPatches = 0
+				}
+				Expect(testing.FilterActions(&cdiClient.Fake, "patch", "datavolumes")).To(HaveLen(expectedPatches))
+			},
+				Entry("nothing else without a policy", nil, nil),
+				Entry("the pool if they are retained on scale in",
+					&poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy{WhenScaled: poolv1.RetainPersistentVolumeClaimRetentionPolicyType},
+					[]k8stypes.UID{"pool-uid"}),
+				Entry("nobody if they are retained on pool deletion",
+					&poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy{WhenDeleted: poolv1.RetainPersistentVolumeClaimRetentionPolicyType},
+					[]k8stypes.UID{}),
+			)
+
+			It("should hand the ownership of a PVC to the pool if its DataVolume was garbage collected", func() {
+				pool.Spec.PersistentVolumeClaimRetentionPolicy = &poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy{
+					WhenScaled: poolv1.RetainPersistentVolumeClaimRetentionPolicyType,
+				}
+				poolVM := newPoolVM(0)
+				addPool(pool)
+				addVM(poolVM)
+				addCR(poolRevision)
+
+				Expect(controller.pvcStore.Add(&k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "disk-0",
+						Namespace:       poolVM.Namespace,
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(poolVM, v1.VirtualMachineGroupVersionKind)},
+					},
+				})).To(Succeed())
+				k8sClient.Fake.PrependReactor("patch", "persistentvolumeclaims", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					Expect(patchedOwnerUIDs(action)).To(Equal([]k8stypes.UID{"pool-uid"}))
+					return true, nil, nil
+				})
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&k8sClient.Fake, "patch", "persistentvolumeclaims")).To(HaveLen(1))
+			})
+
+			It("should not touch DataVolumes controlled by something else", func() {
+				pool.Spec.PersistentVolumeClaimRetentionPolicy = &poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy{
+					WhenDeleted: poolv1.RetainPersistentVolumeClaimRetentionPolicyType,
+				}
+				poolVM := newPoolVM(0)
+				addPool(pool)
+				addVM(poolVM)
+				addCR(poolRevision)
+
+				otherVM := newPoolVM(1)
+				Expect(controller.dataVolumeStore.Add(&cdiv1.DataVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "disk-0",
+						Namespace:       poolVM.Namespace,
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(otherVM, v1.VirtualMachineGroupVersionKind)},
+					},
+				})).To(Succeed())
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&cdiClient.Fake, "patch", "datavolumes")).To(BeEmpty())
+			})
+
+			It("should scale in starting with the highest ordinal and delete volumes which are not retained", func() {
+				pool.Spec.PersistentVolumeClaimRetentionPolicy = &poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy{
+					WhenDeleted: poolv1.RetainPersistentVolumeClaimRetentionPolicyType,
+				}
+				addPool(pool)
+				addCR(poolRevision)
+				for i := 0; i < 3; i++ {
+					addVM(newPoolVM(i))
+				}
+
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+				cdiClient.Fake.PrependReactor("delete", "datavolumes", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+
+				var deletedVMs []string
+				for _, action := range testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines") {
+					deletedVMs = append(deletedVMs, action.(k8stesting.DeleteAction).GetName())
+				}
+				Expect(deletedVMs).To(ConsistOf(fmt.Sprintf("%s-1", pool.Name), fmt.Sprintf("%s-2", pool.Name)))
+
+				var deletedDataVolumes []string
+				for _, action := range testing.FilterActions(&cdiClient.Fake, "delete", "datavolumes") {
+					deletedDataVolumes = append(deletedDataVolumes, action.(k8stesting.DeleteAction).GetName())
+				}
+				Expect(deletedDataVolumes).To(ConsistOf("disk-1", "disk-2"))
+			})
+
+			It("should keep the volumes of removed VMs if they are retained on scale in", func() {
+				pool.Spec.PersistentVolumeClaimRetentionPolicy = &poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy{
+					WhenScaled: poolv1.RetainPersistentVolumeClaimRetentionPolicyType,
+				}
+				addPool(pool)
+				addCR(poolRevision)
+				addVM(newPoolVM(0))
+				addVM(newPoolVM(1))
+
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					deleteAction, ok := action.(k8stesting.DeleteAction)
+					Expect(ok).To(BeTrue())
+					Expect(deleteAction.GetName()).To(Equal(fmt.Sprintf("%s-1", pool.Name)))
+					return true, nil, nil
+				})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(HaveLen(1))
+				Expect(testing.FilterActions(&cdiClient.Fake, "delete", "datavolumes")).To(BeEmpty())
+			})
+		})
+
+		Context("with autohealing", func() {
+			var (
+				pool         *poolv1.VirtualMachinePool
+				vm           *v1.VirtualMachine
+				poolRevision *appsv1.ControllerRevision
+				updatedPool  *poolv1.VirtualMachinePool
+			)
+
+			BeforeEach(func() {
+				pool, vm = DefaultPool(2)
+				pool.Spec.Autohealing = &poolv1.VirtualMachinePoolAutohealing{
+					UnhealthyTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+				}
+				poolRevision = createPoolRevision(pool)
+
+				vm.Spec = *pool.Spec.VirtualMachineTemplate.Spec.DeepCopy()
+				vm = injectPoolRevisionLabelsIntoVM(vm, poolRevision.Name)
+				markVmAsReady(vm)
+
+				updatedPool = nil
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updatedPool = update.GetObject().(*poolv1.VirtualMachinePool)
+					return true, update.GetObject(), nil
+				})
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+			})
+
+			// addPoolVM adds a VM with a VMI which is running for an hour and is
+			// not ready since the given time, or ready if the time is zero.
+			addPoolVM := func(index int, unreadySince time.Time) *v1.VirtualMachineInstance {
+				vmCopy := vm.DeepCopy()
+				vmCopy.Name = fmt.Sprintf("%s-%d", pool.Name, index)
+				addVM(vmCopy)
+
+				vmi := api.NewMinimalVMI(vmCopy.Name)
+				vmi.Namespace = vmCopy.Namespace
+				vmi.UID = k8stypes.UID(vmCopy.Name)
+				vmi.Labels = maps.Clone(vmCopy.Spec.Template.ObjectMeta.Labels)
+				vmi.Status.Phase = v1.Running
+				vmi.Status.PhaseTransitionTimestamps = []v1.VirtualMachineInstancePhaseTransitionTimestamp{{
+					Phase:                    v1.Running,
+					PhaseTransitionTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+				}}
+				readyCondition := v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstanceReady,
+					Status: k8sv1.ConditionTrue,
+				}
+				if !unreadySince.IsZero() {
+					readyCondition.Status = k8sv1.ConditionFalse
+					readyCondition.LastTransitionTime = metav1.NewTime(unreadySince)
+				}
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{readyCondition}
+				addVMI(vmi)
+				return vmi
+			}
+
+			deletedVMs := func() []string {
+				names := []string{}
+				for _, action := range testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines") {
+					names = append(names, action.(k8stesting.DeleteAction).GetName())
+				}
+				return names
+			}
+
+			expectUnhealthyCondition := func(reason string) {
+				Expect(updatedPool).ToNot(BeNil())
+				cond := virtcontroller.NewVirtualMachinePoolConditionManager().GetCondition(updatedPool, poolv1.VirtualMachinePoolReplicaUnhealthy)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+				Expect(cond.Reason).To(Equal(reason))
+			}
+
+			It("should replace a VM which is not ready for longer than the unhealthy timeout", func() {
+				addPool(pool)
+				addCR(poolRevision)
+				addPoolVM(0, time.Now().Add(-10*time.Minute))
+				addPoolVM(1, time.Time{})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, SuccessfulReplaceUnhealthyReason)
+				Expect(deletedVMs()).To(ConsistOf(fmt.Sprintf("%s-0", pool.Name)))
+				Expect(updatedPool.Status.UnhealthyReplicas).To(Equal(int32(1)))
+				expectUnhealthyCondition(UnhealthyReplicasReason)
+			})
+
+			It("should check a VM which is not ready again once the unhealthy timeout expires", func() {
+				addPool(pool)
+				addCR(poolRevision)
+				addPoolVM(0, time.Now().Add(-time.Minute))
+				addPoolVM(1, time.Time{})
+
+				sanityExecute()
+
+				Expect(deletedVMs()).To(BeEmpty())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+				Expect(updatedPool.Status.UnhealthyReplicas).To(Equal(int32(1)))
+				expectUnhealthyCondition(UnhealthyReplicasReason)
+			})
+
+			It("should not consider a VM unhealthy for longer than its VMI is running", func() {
+				addPool(pool)
+				addCR(poolRevision)
+				vmi := addPoolVM(0, time.Now().Add(-10*time.Minute))
+				vmi.Status.PhaseTransitionTimestamps[0].PhaseTransitionTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+				addPoolVM(1, time.Time{})
+
+				sanityExecute()
+
+				Expect(deletedVMs()).To(BeEmpty())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+			})
+
+			It("should replace at most maxConcurrentReplacements VMs at once", func() {
+				addPool(pool)
+				addCR(poolRevision)
+				addPoolVM(0, time.Now().Add(-10*time.Minute))
+				addPoolVM(1, time.Now().Add(-20*time.Minute))
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, SuccessfulReplaceUnhealthyReason)
+				Expect(deletedVMs()).To(ConsistOf(fmt.Sprintf("%s-1", pool.Name)))
+			})
+
+			It("should not replace VMs while others are still being replaced", func() {
+				addPool(pool)
+				addCR(poolRevision)
+				addPoolVM(0, time.Now().Add(-10*time.Minute))
+				addPoolVM(1, time.Time{})
+
+				deletingVM := vm.DeepCopy()
+				deletingVM.Name = fmt.Sprintf("%s-2", pool.Name)
+				deletingVM.DeletionTimestamp = pointer.P(metav1.Now())
+				addVM(deletingVM)
+
+				sanityExecute()
+
+				Expect(deletedVMs()).To(BeEmpty())
+				expectUnhealthyCondition(ReplacementRateLimitedReason)
+			})
+
+			It("should replace a VM whose guest agent is not connected for longer than the unhealthy timeout", func() {
+				pool.Spec.Autohealing.HealthChecks = []poolv1.VirtualMachinePoolHealthCheck{poolv1.VirtualMachinePoolAgentConnectedHealthCheck}
+				addPool(pool)
+				addCR(poolRevision)
+				vmi := addPoolVM(0, time.Time{})
+				connectedVMI := addPoolVM(1, time.Time{})
+				connectedVMI.Status.Conditions = append(connectedVMI.Status.Conditions, v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstanceAgentConnected,
+					Status: k8sv1.ConditionTrue,
+				})
+
+				poolKey, err := virtcontroller.KeyFunc(pool)
+				Expect(err).ToNot(HaveOccurred())
+				controller.unhealthyVMIs.set(poolKey, map[k8stypes.UID]time.Time{vmi.UID: time.Now().Add(-10 * time.Minute)})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, SuccessfulReplaceUnhealthyReason)
+				Expect(deletedVMs()).To(ConsistOf(vmi.Name))
+			})
+
+			It("should start tracking a VM whose guest agent is not connected", func() {
+				pool.Spec.Autohealing.HealthChecks = []poolv1.VirtualMachinePoolHealthCheck{poolv1.VirtualMachinePoolAgentConnectedHealthCheck}
+				addPool(pool)
+				addCR(poolRevision)
+				vmi := addPoolVM(0, time.Time{})
+				connectedVMI := addPoolVM(1, time.Time{})
+				connectedVMI.Status.Conditions = append(connectedVMI.Status.Conditions, v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstanceAgentConnected,
+					Status: k8sv1.ConditionTrue,
+				})
+
+				sanityExecute()
+
+				Expect(deletedVMs()).To(BeEmpty())
+				poolKey, err := virtcontroller.KeyFunc(pool)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(controller.unhealthyVMIs.since[poolKey]).To(HaveKey(vmi.UID))
+			})
+
+			It("should remove the unhealthy condition once all VMs are healthy", func() {
+				virtcontroller.NewVirtualMachinePoolConditionManager().UpdateCondition(pool, &poolv1.VirtualMachinePoolCondition{
+					Type:   poolv1.VirtualMachinePoolReplicaUnhealthy,
+					Status: k8sv1.ConditionTrue,
+					Reason: UnhealthyReplicasReason,
+				})
+				addPool(pool)
+				addCR(poolRevision)
+				addPoolVM(0, time.Time{})
+				addPoolVM(1, time.Time{})
+
+				sanityExecute()
+
+				Expect(deletedVMs()).To(BeEmpty())
+				Expect(updatedPool).ToNot(BeNil())
+				Expect(virtcontroller.NewVirtualMachinePoolConditionManager().HasCondition(updatedPool, poolv1.VirtualMachinePoolReplicaUnhealthy)).To(BeFalse())
+			})
+		})
+
 		It("should do nothing", func() {
 			pool, vm := DefaultPool(1)
 			vm.Name = fmt.Sprintf("%s-0", pool.Name)
@@ -375,6 +1002,7 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -395,6 +1023,7 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -750,6 +1379,12 @@ func DefaultPool(replicas int32) (*poolv1.VirtualMachinePool, *v1.VirtualMachine
 	return pool, vm.DeepCopy()
 }
 
+func oldPoolRevisionVMISpec(poolRevision *appsv1.ControllerRevision) v1.VirtualMachineInstanceSpec {
+	poolSpec := &poolv1.VirtualMachinePoolSpec{}
+	Expect(json.Unmarshal(poolRevision.Data.Raw, poolSpec)).To(Succeed())
+	return poolSpec.VirtualMachineTemplate.Spec.Template.Spec
+}
+
 func markVmAsReady(vm *v1.VirtualMachine) {
 	virtcontroller.NewVirtualMachineConditionManager().UpdateCondition(vm, &v1.VirtualMachineCondition{Type: v1.VirtualMachineReady, Status: k8sv1.ConditionTrue})
 }
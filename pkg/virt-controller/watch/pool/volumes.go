@@ -0,0 +1,199 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pool
+
+import (
+	"context"
+	"fmt"
+
+	k8score "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
+)
+
+// The volumes created from the data volume templates of a VM are owned by the VM,
+// so they are garbage collected together with it. To retain them, the pool controller
+// hands their ownership over to the pool, or removes it, depending on the retention policy.
+// A VM which is created again with the same ordinal reuses the retained volumes, since
+// the names of the volumes are derived from the ordinal.
+
+func getRetentionPolicy(pool *poolv1.VirtualMachinePool) (whenDeleted, whenScaled poolv1.PersistentVolumeClaimRetentionPolicyType) {
+	whenDeleted = poolv1.DeletePersistentVolumeClaimRetentionPolicyType
+	whenScaled = poolv1.DeletePersistentVolumeClaimRetentionPolicyType
+
+	if policy := pool.Spec.PersistentVolumeClaimRetentionPolicy; policy != nil {
+		if policy.WhenDeleted != "" {
+			whenDeleted = policy.WhenDeleted
+		}
+		if policy.WhenScaled != "" {
+			whenScaled = policy.WhenScaled
+		}
+	}
+	return whenDeleted, whenScaled
+}
+
+// getVolumeOwner returns the owner the volumes of the VM should have, or nil
+// if the volumes should not be owned by anything.
+func getVolumeOwner(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) *metav1.OwnerReference {
+	whenDeleted, whenScaled := getRetentionPolicy(pool)
+	switch {
+	case whenDeleted == poolv1.RetainPersistentVolumeClaimRetentionPolicyType:
+		return nil
+	case whenScaled == poolv1.RetainPersistentVolumeClaimRetentionPolicyType:
+		owner := poolOwnerRef(pool)
+		return &owner
+	default:
+		return metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)
+	}
+}
+
+// shouldDeleteVolumesOnScaleIn returns true if the volumes of VMs removed on
+// scale in have to be deleted, because they are not owned by the VMs.
+func shouldDeleteVolumesOnScaleIn(pool *poolv1.VirtualMachinePool) bool {
+	whenDeleted, whenScaled := getRetentionPolicy(pool)
+	return whenDeleted == poolv1.RetainPersistentVolumeClaimRetentionPolicyType &&
+		whenScaled == poolv1.DeletePersistentVolumeClaimRetentionPolicyType
+}
+
+func (c *Controller) syncVolumeOwnership(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) common.SyncError {
+	for _, vm := range vms {
+		if vm.DeletionTimestamp != nil {
+			continue
+		}
+		if err := c.syncVMVolumeOwnership(pool, vm); err != nil {
+			return common.NewSyncError(fmt.Errorf("Error while updating the volume ownership of vm %s/%s: %v", vm.Namespace, vm.Name, err), FailedVolumeOwnershipReason)
+		}
+	}
+	return nil
+}
+
+// syncVMVolumeOwnership updates the owner references of the DataVolumes created for the VM
+// according to the retention policy of the pool. If a DataVolume was garbage collected
+// by CDI, the owner references of its PVC are updated instead.
+func (c *Controller) syncVMVolumeOwnership(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) error {
+	owner := getVolumeOwner(pool, vm)
+
+	for _, template := range vm.Spec.DataVolumeTemplates {
+		key := controller.NamespacedKey(vm.Namespace, template.Name)
+
+		obj, exists, err := c.dataVolumeStore.GetByKey(key)
+		if err != nil {
+			return err
+		}
+		if exists {
+			dataVolume := obj.(*cdiv1.DataVolume)
+			if err := c.patchVolumeOwnerReferences(pool, vm, dataVolume, owner); err != nil {
+				return err
+			}
+			continue
+		}
+
+		obj, exists, err = c.pvcStore.GetByKey(key)
+		if err != nil {
+			return err
+		}
+		if exists {
+			pvc := obj.(*k8score.PersistentVolumeClaim)
+			if err := c.patchVolumeOwnerReferences(pool, vm, pvc, owner); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Controller) patchVolumeOwnerReferences(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine, volume metav1.Object, owner *metav1.OwnerReference) error {
+	if controllerRef := metav1.GetControllerOf(volume); controllerRef != nil &&
+		controllerRef.UID != vm.UID && controllerRef.UID != pool.UID {
+		// controlled by something else, like a DataVolume or a VM outside of the pool
+		return nil
+	}
+
+	ownerReferences := volume.GetOwnerReferences()
+	newOwnerReferences := []metav1.OwnerReference{}
+	hasOwner := false
+	for _, ref := range ownerReferences {
+		if owner != nil && equality.Semantic.DeepEqual(ref, *owner) {
+			hasOwner = true
+		} else if ref.UID == vm.UID || ref.UID == pool.UID {
+			continue
+		}
+		newOwnerReferences = append(newOwnerReferences, ref)
+	}
+	if owner != nil && !hasOwner {
+		newOwnerReferences = append(newOwnerReferences, *owner)
+	}
+
+	if equality.Semantic.DeepEqual(ownerReferences, newOwnerReferences) {
+		return nil
+	}
+
+	patchSet := patch.New()
+	if len(ownerReferences) == 0 {
+		patchSet.AddOption(patch.WithAdd("/metadata/ownerReferences", newOwnerReferences))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/metadata/ownerReferences", ownerReferences),
+			patch.WithReplace("/metadata/ownerReferences", newOwnerReferences),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	switch volume.(type) {
+	case *cdiv1.DataVolume:
+		_, err = c.clientset.CdiClient().CdiV1beta1().DataVolumes(volume.GetNamespace()).Patch(context.Background(), volume.GetName(), types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	default:
+		_, err = c.clientset.CoreV1().PersistentVolumeClaims(volume.GetNamespace()).Patch(context.Background(), volume.GetName(), types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	}
+	if err != nil {
+		return err
+	}
+	log.Log.Object(pool).Infof("Updated the owner references of volume %s/%s of vm %s", volume.GetNamespace(), volume.GetName(), vm.Name)
+	return nil
+}
+
+// deleteVMVolumes deletes the DataVolumes created for the VM, or their PVCs
+// if the DataVolumes were garbage collected by CDI.
+func (c *Controller) deleteVMVolumes(vm *virtv1.VirtualMachine) error {
+	for _, template := range vm.Spec.DataVolumeTemplates {
+		err := c.clientset.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Delete(context.Background(), template.Name, metav1.DeleteOptions{})
+		if errors.IsNotFound(err) {
+			err = c.clientset.CoreV1().PersistentVolumeClaims(vm.Namespace).Delete(context.Background(), template.Name, metav1.DeleteOptions{})
+		}
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
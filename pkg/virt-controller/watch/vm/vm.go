@@ -116,6 +116,7 @@ const (
 	hotplugMemoryErrorReason     = "HotPlugMemoryError"
 	volumesUpdateErrorReason     = "VolumesUpdateError"
 	tolerationsChangeErrorReason = "TolerationsChangeError"
+	bandwidthChangeErrorReason   = "InterfaceBandwidthChangeError"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -731,6 +732,44 @@ func (c *Controller) handleTolerationsChangeRequest(vm *virtv1.VirtualMachine, v
 	return nil
 }
 
+func (c *Controller) handleInterfaceBandwidthChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmIfacesByName := vmispec.IndexInterfaceSpecByName(vm.Spec.Template.Spec.Domain.Devices.Interfaces)
+	updatedIfaces := make([]virtv1.Interface, 0, len(vmi.Spec.Domain.Devices.Interfaces))
+	for _, vmiIface := range vmi.Spec.Domain.Devices.Interfaces {
+		if vmIface, exists := vmIfacesByName[vmiIface.Name]; exists {
+			vmiIface.Bandwidth = vmIface.Bandwidth
+		}
+		updatedIfaces = append(updatedIfaces, vmiIface)
+	}
+
+	if equality.Semantic.DeepEqual(updatedIfaces, vmi.Spec.Domain.Devices.Interfaces) {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("interface bandwidth should not be changed during VMI migration")
+	}
+
+	patchBytes, err := patch.New(
+		patch.WithTest("/spec/domain/devices/interfaces", vmi.Spec.Domain.Devices.Interfaces),
+		patch.WithReplace("/spec/domain/devices/interfaces", updatedIfaces),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update the interface bandwidth: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (c *Controller) handleAffinityChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		return nil
@@ -2860,6 +2899,30 @@ func validLiveUpdateDisks(oldVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.Virtu
 	return true
 }
 
+// syncInterfaceLinkStates copies the requested link state (up or down) of the
+// current interfaces to the last seen interfaces they are present in.
+func syncInterfaceLinkStates(lastSeenIfaces, currentIfaces []virtv1.Interface) {
+	currentIfacesByName := vmispec.IndexInterfaceSpecByName(currentIfaces)
+	for i, lastSeenIface := range lastSeenIfaces {
+		currentIface, exists := currentIfacesByName[lastSeenIface.Name]
+		if !exists || lastSeenIface.State == virtv1.InterfaceStateAbsent || currentIface.State == virtv1.InterfaceStateAbsent {
+			continue
+		}
+		lastSeenIfaces[i].State = currentIface.State
+	}
+}
+
+// syncInterfaceBandwidths copies the bandwidth limits of the current interfaces
+// to the last seen interfaces they are present in.
+func syncInterfaceBandwidths(lastSeenIfaces, currentIfaces []virtv1.Interface) {
+	currentIfacesByName := vmispec.IndexInterfaceSpecByName(currentIfaces)
+	for i, lastSeenIface := range lastSeenIfaces {
+		if currentIface, exists := currentIfacesByName[lastSeenIface.Name]; exists {
+			lastSeenIfaces[i].Bandwidth = currentIface.Bandwidth
+		}
+	}
+}
+
 func setRestartRequired(vm *virtv1.VirtualMachine, message string) {
 	vmConditions := controller.NewVirtualMachineConditionManager()
 	vmConditions.UpdateCondition(vm, &virtv1.VirtualMachineCondition{
@@ -2914,6 +2977,7 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 		lastSeenVM.Spec.Template.Spec.NodeSelector = currentVM.Spec.Template.Spec.NodeSelector
 		lastSeenVM.Spec.Template.Spec.Affinity = currentVM.Spec.Template.Spec.Affinity
 		lastSeenVM.Spec.Template.Spec.Tolerations = currentVM.Spec.Template.Spec.Tolerations
+		syncInterfaceBandwidths(lastSeenVM.Spec.Template.Spec.Domain.Devices.Interfaces, currentVM.Spec.Template.Spec.Domain.Devices.Interfaces)
 	} else {
 		// In the case live-updates aren't enable the volume set of the VM can be still changed by volume hotplugging.
 		// For imperative volume hotplug, first the VM status with the request AND the VMI spec are updated, then in the
@@ -2929,6 +2993,9 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 		}
 	}
 
+	// The link state of the interfaces is always live-updatable
+	syncInterfaceLinkStates(lastSeenVM.Spec.Template.Spec.Domain.Devices.Interfaces, currentVM.Spec.Template.Spec.Domain.Devices.Interfaces)
+
 	if !equality.Semantic.DeepEqual(lastSeenVM.Spec.Template.Spec, currentVM.Spec.Template.Spec) {
 		setRestartRequired(vm, "a non-live-updatable field was changed in the template spec")
 		return true
@@ -3063,6 +3130,10 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 			return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling tolerations change request: %v", err), tolerationsChangeErrorReason), nil
 		}
 
+		if err := c.handleInterfaceBandwidthChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling interface bandwidth change request: %v", err), bandwidthChangeErrorReason), nil
+		}
+
 		if err := c.handleMemoryHotplugRequest(vmCopy, vmi); err != nil {
 			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling memory hotplug requests: %v", err), hotplugMemoryErrorReason), nil
 		}
@@ -6450,6 +6450,88 @@ var _ = Describe("VirtualMachine", func() {
 				})
 			})
 
+			Context("Interfaces", func() {
+				DescribeTable("should not set the restart condition when the link state changes", func(state v1.InterfaceState) {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+					updatedVM := vm.DeepCopy()
+					updatedVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].State = state
+
+					Expect(controller.addRestartRequiredIfNeeded(&vm.Spec, updatedVM, vmi)).To(BeFalse())
+					Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(updatedVM, v1.VirtualMachineRestartRequired)).To(BeFalse())
+				},
+					Entry("to down", v1.InterfaceStateLinkDown),
+					Entry("to up", v1.InterfaceStateLinkUp),
+				)
+
+				It("should set the restart condition when another interface field changes", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+					updatedVM := vm.DeepCopy()
+					updatedVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].State = v1.InterfaceStateLinkDown
+					updatedVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+
+					Expect(controller.addRestartRequiredIfNeeded(&vm.Spec, updatedVM, vmi)).To(BeTrue())
+				})
+
+				It("should not set the restart condition when the bandwidth changes", func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+					updatedVM := vm.DeepCopy()
+					updatedVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+						Inbound: &v1.BandwidthLimits{Average: resource.MustParse("10M")},
+					}
+
+					Expect(controller.addRestartRequiredIfNeeded(&vm.Spec, updatedVM, vmi)).To(BeFalse())
+				})
+
+				It("should live-update the bandwidth of the VMI interfaces", func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					bandwidth := &v1.InterfaceBandwidth{
+						Outbound: &v1.BandwidthLimits{Average: resource.MustParse("10M")},
+					}
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = bandwidth
+					vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleInterfaceBandwidthChangeRequest(vm, vmi)).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth).To(Equal(bandwidth))
+				})
+
+				It("should not live-update the bandwidth during migration", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+						Outbound: &v1.BandwidthLimits{Average: resource.MustParse("10M")},
+					}
+					vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+					vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{StartTimestamp: pointer.P(metav1.Now())}
+
+					Expect(controller.handleInterfaceBandwidthChangeRequest(vm, vmi)).To(MatchError(ContainSubstring("during VMI migration")))
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+				})
+			})
+
 			Context("Instance Types and Preferences", func() {
 				const resourceUID types.UID = "9160e5de-2540-476a-86d9-af0081aee68a"
 				const resourceGeneration int64 = 1
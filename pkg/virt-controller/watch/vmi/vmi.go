@@ -55,6 +55,7 @@ import (
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
@@ -302,6 +303,12 @@ func (c *Controller) execute(key string) error {
 		return nil
 	}
 
+	// A VMI waiting for a cross-cluster migration is prepared by the
+	// migration controller, it must not be started here.
+	if migrations.IsMigrationReceiver(vmi) && vmi.DeletionTimestamp == nil {
+		return c.updateMigrationReceiverStatus(vmi)
+	}
+
 	// Only consider pods which belong to this vmi
 	// excluding unfinalized migration targets from this list.
 	pod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
@@ -727,6 +734,27 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 	return nil
 }
 
+// updateMigrationReceiverStatus moves a VMI waiting for a cross-cluster migration to Pending.
+// The migration controller hands the VMI back once it has been received.
+func (c *Controller) updateMigrationReceiverStatus(vmi *virtv1.VirtualMachineInstance) error {
+	if !vmi.IsUnprocessed() {
+		return nil
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.Phase = virtv1.Pending
+	controller.SetVMIPhaseTransitionTimestamp(vmi, vmiCopy)
+
+	key := controller.VirtualMachineInstanceKey(vmi)
+	c.vmiExpectations.SetExpectations(key, 1, 0)
+	_, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Update(context.Background(), vmiCopy, v1.UpdateOptions{})
+	if err != nil {
+		c.vmiExpectations.LowerExpectations(key, 1, 0)
+		return err
+	}
+	return nil
+}
+
 func preparePodPatch(oldPod, newPod *k8sv1.Pod) *patch.PatchSet {
 	podConditions := controller.NewPodConditionManager()
 	if podConditions.ConditionsEqual(oldPod, newPod) {
@@ -77,6 +77,8 @@ type MigrationOptions struct {
 	AllowPostCopy            bool
 	ParallelMigrationThreads *uint
 	AllowWorkloadDisruption  bool
+	Compression              v1.MigrationCompression
+	MaxDowntimeMilliseconds  int64
 }
 
 type LauncherClient interface {
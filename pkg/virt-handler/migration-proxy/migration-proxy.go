@@ -50,6 +50,11 @@ type ProxyManager interface {
 	GetTargetListenerPorts(key string) map[string]int
 	StopTargetListener(key string)
 
+	// StartCrossClusterTargetListener starts a target listener which also trusts the remote clusters
+	StartCrossClusterTargetListener(key string, targetUnixFiles []string) error
+	// StartCrossClusterSourceListener starts a source listener which also trusts the remote clusters
+	StartCrossClusterSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error
+
 	StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error
 	GetSourceListenerFiles(key string) []string
 	StopSourceListener(key string)
@@ -65,6 +70,9 @@ type migrationProxyManager struct {
 	managerLock     sync.Mutex
 	serverTLSConfig *tls.Config
 	clientTLSConfig *tls.Config
+	// the TLS configs of cross-cluster migrations trust the CAs of the remote clusters as well
+	crossClusterServerTLSConfig *tls.Config
+	crossClusterClientTLSConfig *tls.Config
 
 	isShuttingDown bool
 	config         *virtconfig.ClusterConfig
@@ -114,14 +122,26 @@ func GetMigrationPortsList(isBlockMigration bool) (ports []int) {
 	return
 }
 
-func NewMigrationProxyManager(serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, config *virtconfig.ClusterConfig) ProxyManager {
+func NewMigrationProxyManager(serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, crossClusterServerTLSConfig *tls.Config, crossClusterClientTLSConfig *tls.Config, config *virtconfig.ClusterConfig) ProxyManager {
 	return &migrationProxyManager{
-		sourceProxies:   make(map[string][]*migrationProxy),
-		targetProxies:   make(map[string][]*migrationProxy),
-		serverTLSConfig: serverTLSConfig,
-		clientTLSConfig: clientTLSConfig,
-		config:          config,
+		sourceProxies:               make(map[string][]*migrationProxy),
+		targetProxies:               make(map[string][]*migrationProxy),
+		serverTLSConfig:             serverTLSConfig,
+		clientTLSConfig:             clientTLSConfig,
+		crossClusterServerTLSConfig: crossClusterServerTLSConfig,
+		crossClusterClientTLSConfig: crossClusterClientTLSConfig,
+		config:                      config,
+	}
+}
+
+func (m *migrationProxyManager) tlsConfigs(crossCluster bool) (serverTLSConfig *tls.Config, clientTLSConfig *tls.Config) {
+	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
+		return nil, nil
+	}
+	if crossCluster {
+		return m.crossClusterServerTLSConfig, m.crossClusterClientTLSConfig
 	}
+	return m.serverTLSConfig, m.clientTLSConfig
 }
 
 func SourceUnixFile(baseDir string, key string) string {
@@ -129,6 +149,14 @@ func SourceUnixFile(baseDir string, key string) string {
 }
 
 func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles []string) error {
+	return m.startTargetListener(key, targetUnixFiles, false)
+}
+
+func (m *migrationProxyManager) StartCrossClusterTargetListener(key string, targetUnixFiles []string) error {
+	return m.startTargetListener(key, targetUnixFiles, true)
+}
+
+func (m *migrationProxyManager) startTargetListener(key string, targetUnixFiles []string, crossCluster bool) error {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
@@ -169,12 +197,7 @@ func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles
 
 	zeroAddress := ip.GetIPZeroAddress()
 	proxiesList := []*migrationProxy{}
-	serverTLSConfig := m.serverTLSConfig
-	clientTLSConfig := m.clientTLSConfig
-	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
-		serverTLSConfig = nil
-		clientTLSConfig = nil
-	}
+	serverTLSConfig, clientTLSConfig := m.tlsConfigs(crossCluster)
 	for _, targetUnixFile := range targetUnixFiles {
 		// 0 means random port is used
 		proxy := NewTargetProxy(zeroAddress, 0, serverTLSConfig, clientTLSConfig, targetUnixFile, key)
@@ -258,6 +281,14 @@ func (m *migrationProxyManager) StopTargetListener(key string) {
 }
 
 func (m *migrationProxyManager) StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error {
+	return m.startSourceListener(key, targetAddress, destSrcPortMap, baseDir, false)
+}
+
+func (m *migrationProxyManager) StartCrossClusterSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error {
+	return m.startSourceListener(key, targetAddress, destSrcPortMap, baseDir, true)
+}
+
+func (m *migrationProxyManager) startSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string, crossCluster bool) error {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
@@ -296,12 +327,7 @@ func (m *migrationProxyManager) StartSourceListener(key string, targetAddress st
 			}
 		}
 	}
-	serverTLSConfig := m.serverTLSConfig
-	clientTLSConfig := m.clientTLSConfig
-	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
-		serverTLSConfig = nil
-		clientTLSConfig = nil
-	}
+	serverTLSConfig, clientTLSConfig := m.tlsConfigs(crossCluster)
 	proxiesList := []*migrationProxy{}
 	for destPort, srcPort := range destSrcPortMap {
 		proxyKey := ConstructProxyKey(key, srcPort)
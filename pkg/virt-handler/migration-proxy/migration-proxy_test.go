@@ -21,6 +21,7 @@ package migrationproxy
 
 import (
 	"crypto/tls"
+	"fmt"
 	"net"
 	"os"
 	"path/filepath"
@@ -162,7 +163,7 @@ var _ = Describe("MigrationProxy", func() {
 				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
 					MigrationConfiguration: migrationConfig,
 				})
-				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, tlsConfig, config)
 				manager.StartTargetListener("mykey", []string{virtqemudSock, directSock})
 				destSrcPortMap := manager.GetTargetListenerPorts("mykey")
 				manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir)
@@ -212,6 +213,50 @@ var _ = Describe("MigrationProxy", func() {
 				Entry("with TLS disabled", &v1.MigrationConfiguration{DisableTLS: pointer.P(true)}),
 			)
 
+			It("by creating both ends of a cross-cluster migration with the cross-cluster TLS configs", func() {
+				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
+				virtqemudListener, err := net.Listen("unix", virtqemudSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer virtqemudListener.Close()
+
+				// connections of the local migrations fail the handshake
+				localTLSConfig := &tls.Config{
+					MinVersion: tls.VersionTLS12,
+					GetCertificate: func(info *tls.ClientHelloInfo) (*tls.Certificate, error) {
+						return nil, fmt.Errorf("not trusted")
+					},
+				}
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+				manager := NewMigrationProxyManager(localTLSConfig, localTLSConfig, tlsConfig, tlsConfig, config)
+				Expect(manager.StartCrossClusterTargetListener("mykey", []string{virtqemudSock})).To(Succeed())
+				defer manager.StopTargetListener("mykey")
+				destSrcPortMap := manager.GetTargetListenerPorts("mykey")
+				Expect(manager.StartCrossClusterSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir)).To(Succeed())
+				defer manager.StopSourceListener("mykey")
+
+				numBytes := make(chan int)
+				go func() {
+					fd, err := virtqemudListener.Accept()
+					Expect(err).ShouldNot(HaveOccurred())
+
+					var bytes [1024]byte
+					n, err := fd.Read(bytes[0:])
+					Expect(err).ShouldNot(HaveOccurred())
+					numBytes <- n
+				}()
+
+				sourceFiles := manager.GetSourceListenerFiles("mykey")
+				Expect(sourceFiles).To(HaveLen(1))
+				conn, err := net.Dial("unix", sourceFiles[0])
+				Expect(err).ShouldNot(HaveOccurred())
+				defer conn.Close()
+
+				messageBytes := []byte("some message")
+				sentLen, err := conn.Write(messageBytes)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(<-numBytes).To(Equal(sentLen))
+			})
+
 			DescribeTable("by ensuring no new listeners can be created after shutdown", func(migrationConfig *v1.MigrationConfiguration) {
 
 				key1 := "key1"
@@ -230,7 +275,7 @@ var _ = Describe("MigrationProxy", func() {
 				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
 					MigrationConfiguration: migrationConfig,
 				})
-				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, tlsConfig, config)
 				err = manager.StartTargetListener(key1, []string{virtqemudSock, directSock})
 				Expect(err).ShouldNot(HaveOccurred())
 				destSrcPortMap := manager.GetTargetListenerPorts(key1)
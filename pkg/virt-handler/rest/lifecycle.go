@@ -33,7 +33,9 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 const (
@@ -237,6 +239,75 @@ func (lh *LifecycleHandler) GetFilesystems(request *restful.Request, response *r
 	response.WriteEntity(fsList)
 }
 
+func (lh *LifecycleHandler) GetMigrationProgress(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	if vmi.Status.MigrationState == nil || vmi.Status.MigrationState.Completed {
+		log.Log.Object(vmi).Error("VMI is not migrating")
+		response.WriteError(http.StatusConflict, fmt.Errorf("VMI is not migrating"))
+		return
+	}
+
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain stats")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	progress := v1.VirtualMachineInstanceMigrationProgress{
+		MigrationUID: vmi.Status.MigrationState.MigrationUID,
+		Mode:         vmi.Status.MigrationState.Mode,
+	}
+	if exists && domainStats.MigrateDomainJobInfo != nil {
+		setMigrationProgressFromJobInfo(&progress, domainStats.MigrateDomainJobInfo)
+	}
+
+	response.WriteEntity(progress)
+}
+
+func setMigrationProgressFromJobInfo(progress *v1.VirtualMachineInstanceMigrationProgress, jobInfo *stats.DomainJobInfo) {
+	if jobInfo.DataTotalSet {
+		progress.DataTotalBytes = pointer.P(jobInfo.DataTotal)
+	}
+	if jobInfo.DataProcessedSet {
+		progress.DataProcessedBytes = pointer.P(jobInfo.DataProcessed)
+	}
+	if jobInfo.DataRemainingSet {
+		progress.DataRemainingBytes = pointer.P(jobInfo.DataRemaining)
+	}
+	if jobInfo.MemDirtyRateSet {
+		progress.MemoryDirtyRateBytes = pointer.P(jobInfo.MemDirtyRate)
+	}
+	if jobInfo.MemoryBpsSet {
+		progress.MemoryTransferRateBytes = pointer.P(jobInfo.MemoryBps)
+	}
+	if jobInfo.MemIterationSet {
+		progress.MemoryIterations = pointer.P(jobInfo.MemIteration)
+	}
+	if jobInfo.AutoConvergeThrottleSet {
+		progress.AutoConvergeThrottle = pointer.P(jobInfo.AutoConvergeThrottle)
+	}
+	if jobInfo.MemPostcopyReqsSet {
+		progress.PostCopyRequests = pointer.P(jobInfo.MemPostcopyReqs)
+	}
+	if jobInfo.TimeElapsedSet {
+		progress.ElapsedMilliseconds = pointer.P(jobInfo.TimeElapsed)
+	}
+
+	// libvirt only reports the remaining time for some jobs, fall back to
+	// estimating it from the remaining data and the current transfer rate
+	switch {
+	case jobInfo.TimeRemainingSet:
+		progress.EstimatedRemainingMilliseconds = pointer.P(jobInfo.TimeRemaining)
+	case jobInfo.DataRemainingSet && jobInfo.MemoryBpsSet && jobInfo.MemoryBps > 0:
+		progress.EstimatedRemainingMilliseconds = pointer.P(jobInfo.DataRemaining * 1000 / jobInfo.MemoryBps)
+	}
+}
+
 func (lh *LifecycleHandler) getVMILauncherClient(request *restful.Request, response *restful.Response) (*v1.VirtualMachineInstance, cmdclient.LauncherClient, error) {
 	vmi, code, err := getVMI(request, lh.vmiStore)
 	if err != nil {
@@ -97,6 +97,8 @@ import (
 
 type netconf interface {
 	Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, launcherPid int, preSetup func() error) error
+	SetupBandwidth(vmi *v1.VirtualMachineInstance, launcherPid int) error
+	UpdateBandwidthStatus(vmi *v1.VirtualMachineInstance)
 	Teardown(vmi *v1.VirtualMachineInstance) error
 }
 
@@ -113,6 +115,12 @@ type downwardMetricsManager interface {
 	StopServer(vmi *v1.VirtualMachineInstance)
 }
 
+type routerAdvertisementManager interface {
+	Run(stopCh chan struct{})
+	StartServer(vmi *v1.VirtualMachineInstance, pid int) error
+	StopServer(vmi *v1.VirtualMachineInstance)
+}
+
 const (
 	failedDetectIsolationFmt              = "failed to detect isolation for launcher pod: %v"
 	unableCreateVirtLauncherConnectionFmt = "unable to create virt-launcher client connection: %v"
@@ -163,6 +171,7 @@ func NewController(
 	clientset kubecli.KubevirtClient,
 	host string,
 	migrationIpAddress string,
+	podIpAddress string,
 	virtShareDir string,
 	virtPrivateDir string,
 	kubeletPodsDir string,
@@ -174,6 +183,7 @@ func NewController(
 	podIsolationDetector isolation.PodIsolationDetector,
 	migrationProxy migrationproxy.ProxyManager,
 	downwardMetricsManager downwardMetricsManager,
+	routerAdvertisementManager routerAdvertisementManager,
 	capabilities *libvirtxml.Caps,
 	hostCpuModel string,
 	netConf netconf,
@@ -202,6 +212,7 @@ func NewController(
 		clientset:                        clientset,
 		host:                             host,
 		migrationIpAddress:               migrationIpAddress,
+		podIpAddress:                     podIpAddress,
 		virtShareDir:                     virtShareDir,
 		vmiSourceStore:                   vmiSourceInformer.GetStore(),
 		vmiTargetStore:                   vmiTargetInformer.GetStore(),
@@ -257,6 +268,7 @@ func NewController(
 	c.launcherClients = virtcache.LauncherClientInfoByVMI{}
 
 	c.downwardMetricsManager = downwardMetricsManager
+	c.routerAdvertisementManager = routerAdvertisementManager
 
 	c.domainNotifyPipes = make(map[string]string)
 
@@ -289,26 +301,28 @@ type netBindingPluginMemoryCalculator interface {
 }
 
 type VirtualMachineController struct {
-	recorder                 record.EventRecorder
-	clientset                kubecli.KubevirtClient
-	host                     string
-	migrationIpAddress       string
-	virtShareDir             string
-	virtPrivateDir           string
-	queue                    workqueue.TypedRateLimitingInterface[string]
-	vmiSourceStore           cache.Store
-	vmiTargetStore           cache.Store
-	domainStore              cache.Store
-	launcherClients          virtcache.LauncherClientInfoByVMI
-	heartBeatInterval        time.Duration
-	deviceManagerController  *device_manager.DeviceController
-	migrationProxy           migrationproxy.ProxyManager
-	podIsolationDetector     isolation.PodIsolationDetector
-	containerDiskMounter     container_disk.Mounter
-	hotplugVolumeMounter     hotplug_volume.VolumeMounter
-	clusterConfig            *virtconfig.ClusterConfig
-	sriovHotplugExecutorPool *executor.RateLimitedExecutorPool
-	downwardMetricsManager   downwardMetricsManager
+	recorder                   record.EventRecorder
+	clientset                  kubecli.KubevirtClient
+	host                       string
+	migrationIpAddress         string
+	podIpAddress               string
+	virtShareDir               string
+	virtPrivateDir             string
+	queue                      workqueue.TypedRateLimitingInterface[string]
+	vmiSourceStore             cache.Store
+	vmiTargetStore             cache.Store
+	domainStore                cache.Store
+	launcherClients            virtcache.LauncherClientInfoByVMI
+	heartBeatInterval          time.Duration
+	deviceManagerController    *device_manager.DeviceController
+	migrationProxy             migrationproxy.ProxyManager
+	podIsolationDetector       isolation.PodIsolationDetector
+	containerDiskMounter       container_disk.Mounter
+	hotplugVolumeMounter       hotplug_volume.VolumeMounter
+	clusterConfig              *virtconfig.ClusterConfig
+	sriovHotplugExecutorPool   *executor.RateLimitedExecutorPool
+	downwardMetricsManager     downwardMetricsManager
+	routerAdvertisementManager routerAdvertisementManager
 
 	netConf                          netconf
 	netStat                          netstat
@@ -688,6 +702,13 @@ func (c *VirtualMachineController) migrationSourceUpdateVMIStatus(origVMI *v1.Vi
 		} else {
 			log.Log.Object(vmi).Info("Waiting on the target node to observe the migrated domain before performing the handoff")
 		}
+	} else if vmi.Status.MigrationState != nil && migrations.IsCrossClusterMigrationSource(vmi) {
+		// The domain now runs in another cluster, which owns it from now on.
+		// There is no node to transfer the VMI to in this cluster.
+		vmi.Status.Phase = v1.Succeeded
+		vmi.Status.MigrationState.Completed = true
+		c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Migrated.String(), fmt.Sprintf("The VirtualMachineInstance migrated to node %s in another cluster.", migrationHost))
+		log.Log.Object(vmi).Infof("migration completed to node %s in another cluster", migrationHost)
 	} else if vmi.Status.MigrationState != nil {
 		// this is the migration ACK.
 		// At this point we know that the migration has completed and that
@@ -789,15 +810,19 @@ func (c *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 		if vmi.Status.MigrationState != nil {
 			hostAddress = vmi.Status.MigrationState.TargetNodeAddress
 		}
-		if hostAddress != c.migrationIpAddress {
+		targetAddress := c.migrationTargetAddress(vmi)
+		if hostAddress != targetAddress {
 			portsList := make([]string, 0, len(destSrcPortsMap))
 
 			for k := range destSrcPortsMap {
 				portsList = append(portsList, k)
 			}
 			portsStrList := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(portsList)), ","), "[]")
-			c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.PreparingTarget.String(), fmt.Sprintf("Migration Target is listening at %s, on ports: %s", c.migrationIpAddress, portsStrList))
-			vmiCopy.Status.MigrationState.TargetNodeAddress = c.migrationIpAddress
+			if network := c.unavailableMigrationNetwork(vmi); network != "" {
+				c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, v1.PreparingTarget.String(), "Migration network %s is not the migration network of the cluster, migrating through %s", network, targetAddress)
+			}
+			c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.PreparingTarget.String(), fmt.Sprintf("Migration Target is listening at %s, on ports: %s", targetAddress, portsStrList))
+			vmiCopy.Status.MigrationState.TargetNodeAddress = targetAddress
 			vmiCopy.Status.MigrationState.TargetDirectMigrationNodePorts = destSrcPortsMap
 		}
 
@@ -1381,8 +1406,11 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 	if err = c.updateMemoryInfo(vmi, domain); err != nil {
 		return err
 	}
-	err = c.netStat.UpdateStatus(vmi, domain)
-	return err
+	if err = c.netStat.UpdateStatus(vmi, domain); err != nil {
+		return err
+	}
+	c.netConf.UpdateBandwidthStatus(vmi)
+	return nil
 }
 
 func (c *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) error {
@@ -1665,6 +1693,7 @@ func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 	go c.deviceManagerController.Run(stopCh)
 
 	go c.downwardMetricsManager.Run(stopCh)
+	go c.routerAdvertisementManager.Run(stopCh)
 
 	cache.WaitForCacheSync(stopCh, c.hasSynced)
 
@@ -1804,7 +1833,8 @@ func (c *VirtualMachineController) migrationTargetExecute(vmi *v1.VirtualMachine
 	// set true when the current migration target has exitted and needs to be cleaned up.
 	shouldCleanUp := false
 
-	if vmiExists && vmi.IsRunning() {
+	// a VMI received from another cluster only starts running once the migration completed
+	if vmiExists && (vmi.IsRunning() || migrations.IsMigrationReceiver(vmi)) {
 		shouldUpdate = true
 	}
 
@@ -2166,6 +2196,7 @@ func (c *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 	c.migrationProxy.StopSourceListener(vmiId)
 
 	c.downwardMetricsManager.StopServer(vmi)
+	c.routerAdvertisementManager.StopServer(vmi)
 
 	// Unmount container disks and clean up remaining files
 	if err := c.containerDiskMounter.Unmount(vmi); err != nil {
@@ -2501,13 +2532,21 @@ func (c *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 	// A relevant error will be returned in this case.
 	for _, volume := range vmi.Spec.Volumes {
 		volSrc := volume.VolumeSource
-		if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil {
+		if (volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil) && migrations.IsCrossClusterMigrationSource(vmi) {
+			// volumes are never shared between clusters, their content is
+			// copied to the volumes of the receiving VMI
+			blockMigrate = true
+			continue
+		}
+		if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil || storagetypes.IsSavedStateVolume(&volume) {
 
 			var claimName string
 			if volSrc.PersistentVolumeClaim != nil {
 				claimName = volSrc.PersistentVolumeClaim.ClaimName
-			} else {
+			} else if volSrc.DataVolume != nil {
 				claimName = volSrc.DataVolume.Name
+			} else {
+				claimName = volSrc.MemoryDump.ClaimName
 			}
 
 			volumeStatus, ok := volumeStatusMap[volume.Name]
@@ -2591,7 +2630,11 @@ func (c *VirtualMachineController) handleTargetMigrationProxy(vmi *v1.VirtualMac
 		destSocketFile := migrationproxy.SourceUnixFile(baseDir, key)
 		migrationTargetSockets = append(migrationTargetSockets, destSocketFile)
 	}
-	err = c.migrationProxy.StartTargetListener(string(vmi.UID), migrationTargetSockets)
+	startTargetListener := c.migrationProxy.StartTargetListener
+	if migrations.IsMigrationReceiver(vmi) {
+		startTargetListener = c.migrationProxy.StartCrossClusterTargetListener
+	}
+	err = startTargetListener(string(vmi.UID), migrationTargetSockets)
 	if err != nil {
 		return err
 	}
@@ -2619,7 +2662,11 @@ func (c *VirtualMachineController) handleSourceMigrationProxy(vmi *v1.VirtualMac
 		msg := "No migration proxy has been created for this vmi"
 		return fmt.Errorf("%s", msg)
 	}
-	err = c.migrationProxy.StartSourceListener(
+	startSourceListener := c.migrationProxy.StartSourceListener
+	if migrations.IsCrossClusterMigrationSource(vmi) {
+		startSourceListener = c.migrationProxy.StartCrossClusterSourceListener
+	}
+	err = startSourceListener(
 		string(vmi.UID),
 		vmi.Status.MigrationState.TargetNodeAddress,
 		vmi.Status.MigrationState.TargetDirectMigrationNodePorts,
@@ -2695,8 +2742,14 @@ func (c *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			AllowPostCopy:           *migrationConfiguration.AllowPostCopy,
 			AllowWorkloadDisruption: *migrationConfiguration.AllowWorkloadDisruption,
 		}
+		if migrationConfiguration.Compression != nil {
+			options.Compression = *migrationConfiguration.Compression
+		}
+		if migrationConfiguration.MaxDowntimeMilliseconds != nil {
+			options.MaxDowntimeMilliseconds = *migrationConfiguration.MaxDowntimeMilliseconds
+		}
 
-		configureParallelMigrationThreads(options, origVMI)
+		configureParallelMigrationThreads(options, origVMI, migrationConfiguration)
 
 		marshalledOptions, err := json.Marshal(options)
 		if err != nil {
@@ -2820,6 +2873,10 @@ func (c *VirtualMachineController) vmUpdateHelperMigrationTarget(origVMI *v1.Vir
 	if err != nil {
 		return fmt.Errorf(failedDetectIsolationFmt, err)
 	}
+
+	if err := c.netConf.SetupBandwidth(vmi, isolationRes.Pid()); err != nil {
+		return fmt.Errorf("failed to configure vmi interfaces bandwidth for migration target: %w", err)
+	}
 	virtLauncherRootMount, err := isolationRes.MountRoot()
 	if err != nil {
 		return err
@@ -3036,6 +3093,10 @@ func (c *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 		if err != nil {
 			return fmt.Errorf(failedDetectIsolationFmt, err)
 		}
+
+		if err := c.netConf.SetupBandwidth(vmi, isolationRes.Pid()); err != nil {
+			return fmt.Errorf("failed to configure vmi interfaces bandwidth: %w", err)
+		}
 		virtLauncherRootMount, err := isolationRes.MountRoot()
 		if err != nil {
 			return err
@@ -3121,11 +3182,22 @@ func (c *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 			return err
 		}
 
+		if err := c.routerAdvertisementManager.StartServer(vmi, isolationRes.Pid()); err != nil {
+			log.Log.Object(vmi).Error(err.Error())
+			errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
+		}
+
 		if err := c.setupNetwork(vmi, netsetup.FilterNetsForLiveUpdate(vmi)); err != nil {
 			log.Log.Object(vmi).Error(err.Error())
 			c.recorder.Event(vmi, k8sv1.EventTypeWarning, "NicHotplug", err.Error())
 			errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
 		}
+
+		if err := c.netConf.SetupBandwidth(vmi, isolationRes.Pid()); err != nil {
+			log.Log.Object(vmi).Error(err.Error())
+			c.recorder.Event(vmi, k8sv1.EventTypeWarning, "InterfaceBandwidth", err.Error())
+			errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
+		}
 	}
 
 	smbios := c.clusterConfig.GetSMBIOS()
@@ -3680,7 +3752,44 @@ func (c *VirtualMachineController) updateMemoryInfo(vmi *v1.VirtualMachineInstan
 	return nil
 }
 
-func configureParallelMigrationThreads(options *cmdclient.MigrationOptions, vm *v1.VirtualMachineInstance) {
+// migrationTargetAddress returns the address the migration target of the VMI is advertised at.
+// A migration configuration with an empty network migrates through the pod network, even if
+// the cluster has a dedicated migration network.
+func (c *VirtualMachineController) migrationTargetAddress(vmi *v1.VirtualMachineInstance) string {
+	if vmi.Status.MigrationState == nil || vmi.Status.MigrationState.MigrationConfiguration == nil {
+		return c.migrationIpAddress
+	}
+	if network := vmi.Status.MigrationState.MigrationConfiguration.Network; network != nil && *network == "" {
+		return c.podIpAddress
+	}
+	return c.migrationIpAddress
+}
+
+// unavailableMigrationNetwork returns the migration network requested by the migration configuration
+// of the VMI if virt-handler is not attached to it. The migration network of the cluster is used instead.
+func (c *VirtualMachineController) unavailableMigrationNetwork(vmi *v1.VirtualMachineInstance) string {
+	if vmi.Status.MigrationState == nil || vmi.Status.MigrationState.MigrationConfiguration == nil {
+		return ""
+	}
+	network := vmi.Status.MigrationState.MigrationConfiguration.Network
+	if network == nil || *network == "" {
+		return ""
+	}
+	if clusterNetwork := c.clusterConfig.GetMigrationConfiguration().Network; clusterNetwork != nil && *clusterNetwork == *network {
+		return ""
+	}
+	return *network
+}
+
+func configureParallelMigrationThreads(options *cmdclient.MigrationOptions, vm *v1.VirtualMachineInstance, migrationConfiguration *v1.MigrationConfiguration) {
+	// An explicitly configured number of threads is honored as is, 0 disables parallel migration
+	if threads := migrationConfiguration.ParallelMigrationThreads; threads != nil {
+		if *threads > 0 {
+			options.ParallelMigrationThreads = pointer.P(uint(*threads))
+		}
+		return
+	}
+
 	// When the CPU is limited, there's a risk of the migration threads choking the CPU resources on the compute container.
 	// For this reason, we will avoid configuring migration threads in such scenarios.
 	if cpuLimit, cpuLimitExists := vm.Spec.Domain.Resources.Limits[k8sv1.ResourceCPU]; cpuLimitExists && !cpuLimit.IsZero() {
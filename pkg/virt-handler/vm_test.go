@@ -196,7 +196,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 		mockHotplugVolumeMounter = hotplugvolume.NewMockVolumeMounter(ctrl)
 		mockCgroupManager = cgroup.NewMockManager(ctrl)
 
-		migrationProxy := migrationproxy.NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+		migrationProxy := migrationproxy.NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, tlsConfig, config)
 		fakeDownwardMetricsManager := newFakeManager()
 
 		networkBindingPluginMemoryCalculator = &stubNetBindingPluginMemoryCalculator{}
@@ -205,6 +205,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 			virtClient,
 			host,
 			podIpAddress,
+			podIpAddress,
 			shareDir,
 			privateDir,
 			podsDir,
@@ -216,6 +217,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 			mockIsolationDetector,
 			migrationProxy,
 			fakeDownwardMetricsManager,
+			newFakeManager(),
 			nil,
 			"",
 			&netConfStub{},
@@ -1809,6 +1811,80 @@ var _ = Describe("VirtualMachineInstance", func() {
 			testutils.ExpectEvent(recorder, VMIMigrating)
 		})
 
+		It("should migrate vmi with the tunables of its migration configuration", func() {
+			migrationConfiguration := controller.clusterConfig.GetMigrationConfiguration().DeepCopy()
+			migrationConfiguration.ParallelMigrationThreads = pointer.P(uint32(2))
+			migrationConfiguration.Compression = pointer.P(v1.MigrationCompressionZstd)
+			migrationConfiguration.MaxDowntimeMilliseconds = pointer.P(int64(500))
+
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Labels = make(map[string]string)
+			vmi.Status.NodeName = host
+			vmi.Labels[v1.MigrationTargetNodeNameLabel] = "othernode"
+			vmi.Status.Interfaces = make([]v1.VirtualMachineInstanceNetworkInterface, 0)
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNode:                     "othernode",
+				TargetNodeAddress:              "127.0.0.1:12345",
+				SourceNode:                     host,
+				MigrationUID:                   "123",
+				TargetDirectMigrationNodePorts: map[string]int{"49152": 12132},
+				MigrationConfiguration:         migrationConfiguration,
+			}
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domainFeeder.Add(domain)
+			vmiFeeder.Add(vmi)
+			options := &cmdclient.MigrationOptions{
+				Bandwidth:                resource.MustParse("0Mi"),
+				ProgressTimeout:          virtconfig.MigrationProgressTimeout,
+				CompletionTimeoutPerGiB:  virtconfig.MigrationCompletionTimeoutPerGiB,
+				UnsafeMigration:          virtconfig.DefaultUnsafeMigrationOverride,
+				AllowPostCopy:            virtconfig.MigrationAllowPostCopy,
+				ParallelMigrationThreads: pointer.P(uint(2)),
+				Compression:              v1.MigrationCompressionZstd,
+				MaxDowntimeMilliseconds:  500,
+			}
+			client.EXPECT().MigrateVirtualMachine(vmi, options)
+			sanityExecute()
+			testutils.ExpectEvent(recorder, VMIMigrating)
+		})
+
+		DescribeTable("should advertise the migration target at", func(network *string, expectedAddress string) {
+			controller.migrationIpAddress = "10.10.20.10"
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationConfiguration: &v1.MigrationConfiguration{Network: network},
+			}
+			Expect(controller.migrationTargetAddress(vmi)).To(Equal(expectedAddress))
+		},
+			Entry("the migration network address by default", nil, "10.10.20.10"),
+			Entry("the migration network address if the migration network is configured", pointer.P("migration-net"), "10.10.20.10"),
+			Entry("the pod address if the network is overridden with the pod network", pointer.P(""), "10.10.10.10"),
+		)
+
+		DescribeTable("should report the migration network as unavailable", func(network *string, expectedNetwork string) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationConfiguration: &v1.MigrationConfiguration{Network: network},
+			}
+			Expect(controller.unavailableMigrationNetwork(vmi)).To(Equal(expectedNetwork))
+		},
+			Entry("never by default", nil, ""),
+			Entry("never for the pod network", pointer.P(""), ""),
+			Entry("if it is not the migration network of the cluster", pointer.P("other-net"), "other-net"),
+		)
+
 		It("should not try to migrate a vmi twice", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -2374,6 +2450,37 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(blockMigrate).To(BeTrue())
 			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI: PVC testblock is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)")))
 		})
+		It("should fail migration for a non-shared saved memory state PVC", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "memory-state",
+					VolumeSource: v1.VolumeSource{
+						MemoryDump: &v1.MemoryDumpVolumeSource{
+							PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+									ClaimName: "testblock",
+								},
+							},
+							Format: v1.MemoryDumpFormatSaveState,
+						},
+					},
+				},
+			}
+
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name: "memory-state",
+					PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+					},
+				},
+			}
+
+			blockMigrate, err := controller.checkVolumesForMigration(vmi)
+			Expect(blockMigrate).To(BeTrue())
+			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI: PVC testblock is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)")))
+		})
 		It("should be allowed to migrate a mix of shared and non-shared disks", func() {
 
 			vmi := api2.NewMinimalVMI("testvmi")
@@ -3630,6 +3737,12 @@ func (nc *netConfStub) Setup(vmi *v1.VirtualMachineInstance, _ []v1.Network, lau
 	return nil
 }
 
+func (nc *netConfStub) SetupBandwidth(_ *v1.VirtualMachineInstance, _ int) error {
+	return nil
+}
+
+func (nc *netConfStub) UpdateBandwidthStatus(_ *v1.VirtualMachineInstance) {}
+
 func (nc *netConfStub) Teardown(vmi *v1.VirtualMachineInstance) error {
 	nc.vmiUID = ""
 	return nil
@@ -1146,6 +1146,86 @@ func (in *DomainList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshot) DeepCopyInto(out *DomainSnapshot) {
+	*out = *in
+	out.XMLName = in.XMLName
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(DomainSnapshotMemory)
+		**out = **in
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = new(DomainSnapshotDisks)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshot.
+func (in *DomainSnapshot) DeepCopy() *DomainSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshotDisk) DeepCopyInto(out *DomainSnapshotDisk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshotDisk.
+func (in *DomainSnapshotDisk) DeepCopy() *DomainSnapshotDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshotDisks) DeepCopyInto(out *DomainSnapshotDisks) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]DomainSnapshotDisk, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshotDisks.
+func (in *DomainSnapshotDisks) DeepCopy() *DomainSnapshotDisks {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotDisks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshotMemory) DeepCopyInto(out *DomainSnapshotMemory) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshotMemory.
+func (in *DomainSnapshotMemory) DeepCopy() *DomainSnapshotMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	*out = *in
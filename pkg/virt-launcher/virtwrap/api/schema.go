@@ -406,6 +406,28 @@ type MemoryDumpMetadata struct {
 	FailureReason  string       `xml:"failureReason,omitempty"`
 }
 
+// DomainSnapshot represents a libvirt domain snapshot as described in
+// https://libvirt.org/formatsnapshot.html
+type DomainSnapshot struct {
+	XMLName xml.Name              `xml:"domainsnapshot"`
+	Memory  *DomainSnapshotMemory `xml:"memory,omitempty"`
+	Disks   *DomainSnapshotDisks  `xml:"disks,omitempty"`
+}
+
+type DomainSnapshotMemory struct {
+	Snapshot string `xml:"snapshot,attr"`
+	File     string `xml:"file,attr,omitempty"`
+}
+
+type DomainSnapshotDisks struct {
+	Disks []DomainSnapshotDisk `xml:"disk"`
+}
+
+type DomainSnapshotDisk struct {
+	Name     string `xml:"name,attr"`
+	Snapshot string `xml:"snapshot,attr"`
+}
+
 type MigrationMetadata struct {
 	UID            types.UID        `xml:"uid,omitempty"`
 	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
@@ -1319,6 +1319,17 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces[0].Rom.Enabled).To(Equal("no"))
 		})
 
+		DescribeTable("should set the link state of the interface", func(state v1.InterfaceState, expectedLinkState *api.LinkState) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Interfaces[0].State = state
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Interfaces[0].LinkState).To(Equal(expectedLinkState))
+		},
+			Entry("to down when the state is down", v1.InterfaceStateLinkDown, &api.LinkState{State: "down"}),
+			Entry("to the default when the state is up", v1.InterfaceStateLinkUp, nil),
+			Entry("to the default when the state is not set", v1.InterfaceState(""), nil),
+		)
+
 		When("NIC PCI address is specified on VMI", func() {
 			const pciAddress = "0000:81:01.0"
 			expectedPCIAddress := api.Address{
@@ -117,6 +117,9 @@ func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdcl
 	if shouldConfigureParallel, _ := shouldConfigureParallelMigration(options); shouldConfigureParallel {
 		migrateFlags |= libvirt.MIGRATE_PARALLEL
 	}
+	if migrationCompression(options) != "" {
+		migrateFlags |= libvirt.MIGRATE_COMPRESSED
+	}
 
 	return migrateFlags
 
@@ -229,6 +232,10 @@ func migratableDomXML(dom cli.VirDomain, vmi *v1.VirtualMachineInstance, domSpec
 		log.Log.Object(vmi).Reason(err).Error("Failed to set size for local disk.")
 		return "", err
 	}
+	// the receiving VMI in another cluster has its own UID
+	if migrations.IsCrossClusterMigrationSource(vmi) && domcfg.Metadata != nil {
+		domcfg.Metadata.XML = strings.ReplaceAll(domcfg.Metadata.XML, string(vmi.UID), string(vmi.Status.MigrationState.TargetVirtualMachineInstanceUID))
+	}
 
 	return domcfg.Marshal()
 }
@@ -266,7 +273,8 @@ func classifyVolumesForMigration(vmi *v1.VirtualMachineInstance) *migrationDisks
 		volSrc := volume.VolumeSource
 		switch {
 		case volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil:
-			if _, ok := migrateDisks[volume.Name]; ok {
+			// volumes are never shared with another cluster
+			if _, ok := migrateDisks[volume.Name]; ok || migrations.IsCrossClusterMigrationSource(vmi) {
 				disks.localToMigrate[volume.Name] = true
 			} else {
 				disks.shared[volume.Name] = true
@@ -765,6 +773,10 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		ParallelConnectionsSet: parallelMigrationSet,
 		ParallelConnections:    parallelMigrationThreads,
 	}
+	if compression := migrationCompression(options); compression != "" {
+		params.Compression = compression
+		params.CompressionSet = true
+	}
 
 	copyDisks := getDiskTargetsForMigration(dom, vmi)
 	if len(copyDisks) != 0 {
@@ -974,6 +986,12 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 		return err
 	}
 
+	if options.MaxDowntimeMilliseconds > 0 {
+		if err := dom.MigrateSetMaxDowntime(uint64(options.MaxDowntimeMilliseconds), 0); err != nil {
+			return fmt.Errorf("failed to set the maximum downtime of the migration: %v", err)
+		}
+	}
+
 	// initiate the live migration
 	var dstURI string
 	if virtutil.IsNonRootVMI(vmi) {
@@ -1053,8 +1071,29 @@ func shouldConfigureParallelMigration(options *cmdclient.MigrationOptions) (shou
 	if options.ParallelMigrationThreads == nil {
 		return
 	}
+	// XBZRLE compression is not supported on multifd streams
+	if options.Compression == v1.MigrationCompressionXBZRLE {
+		return
+	}
 
 	shouldConfigure = true
 	threadsCount = int(*options.ParallelMigrationThreads)
 	return
 }
+
+// migrationCompression returns the compression method applied to a migration, if any.
+// zstd compresses the multifd streams and is only applied to parallel migrations.
+func migrationCompression(options *cmdclient.MigrationOptions) string {
+	if options == nil {
+		return ""
+	}
+	switch options.Compression {
+	case v1.MigrationCompressionXBZRLE:
+		return string(options.Compression)
+	case v1.MigrationCompressionZstd:
+		if shouldConfigureParallel, _ := shouldConfigureParallelMigration(options); shouldConfigureParallel {
+			return string(options.Compression)
+		}
+	}
+	return ""
+}
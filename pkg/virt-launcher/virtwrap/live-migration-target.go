@@ -94,6 +94,10 @@ func (l *LibvirtDomainManager) reconnectGuestNics(vmi *v1.VirtualMachineInstance
 		if _, exist := ifacesToRefresh[iface.Alias.GetName()]; !exist {
 			continue
 		}
+		// Interfaces with an administratively down link are kept down
+		if iface.LinkState != nil && iface.LinkState.State == string(v1.InterfaceStateLinkDown) {
+			continue
+		}
 
 		if err = reconnectIface(dom, iface); err != nil {
 			return fmt.Errorf("failed to update network %s, err: %v", iface.Alias.GetName(), err)
@@ -61,10 +61,12 @@ import (
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/ignition"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netsriov "kubevirt.io/kubevirt/pkg/network/deviceinfo"
+	"kubevirt.io/kubevirt/pkg/network/metadataservice"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -108,6 +110,9 @@ const (
 const maxConcurrentHotplugHostDevices = 1
 const maxConcurrentMemoryDumps = 1
 
+// savedStateDir is the directory the saved state volume is mounted at, can be overridden by tests
+var savedStateDir = hostdisk.GetMountedHostDiskDir
+
 type contextStore struct {
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -177,6 +182,9 @@ type LibvirtDomainManager struct {
 
 	metadataCache    *metadata.Cache
 	domainStatsCache *virtcache.TimeDefinedCache[*stats.DomainStats]
+
+	// metadataServiceStarted is set once the metadata service serves the guest, for the lifetime of the pod
+	metadataServiceStarted bool
 }
 
 type pausedVMIs struct {
@@ -783,6 +791,13 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return domain, fmt.Errorf("preparing the pod network failed: %v", err)
 	}
 
+	if vmi.Spec.MetadataService != nil && !l.metadataServiceStarted {
+		if err := metadataservice.Start(vmi, config.SecretSourceDir); err != nil {
+			return domain, err
+		}
+		l.metadataServiceStarted = true
+	}
+
 	// Create ephemeral disk for container disks
 	err = containerdisk.CreateEphemeralImages(vmi, l.ephemeralDiskCreator, disksInfo)
 	if err != nil {
@@ -1262,6 +1277,9 @@ func (l *LibvirtDomainManager) syncNetworkHotplug(
 	if err := networkInterfaceManager.hotUnplugVirtioInterface(vmi, &api.Domain{Spec: *oldSpec}); err != nil {
 		return err
 	}
+	if err := networkInterfaceManager.updateInterfacesLinkState(vmi, &api.Domain{Spec: *oldSpec}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1275,6 +1293,16 @@ func (l *LibvirtDomainManager) startDomain(
 	}
 
 	createFlags := getDomainCreateFlags(vmi)
+	if resumed, err := l.resumeFromSavedState(vmi, dom, createFlags); err != nil {
+		return err
+	} else if resumed {
+		logger.Info("Domain resumed from saved memory state.")
+		if vmi.ShouldStartPaused() {
+			l.paused.add(vmi.UID)
+		}
+		return nil
+	}
+
 	if err := dom.CreateWithFlags(createFlags); err != nil {
 		logger.Reason(err).
 			Errorf("Failed to start VirtualMachineInstance with flags %v.", createFlags)
@@ -1288,6 +1316,62 @@ func (l *LibvirtDomainManager) startDomain(
 	return nil
 }
 
+// savedStateFile returns the path of the save image on the saved state volume
+// of the VMI, or an empty string if there is none to resume from
+func savedStateFile(vmi *v1.VirtualMachineInstance) (string, error) {
+	for _, volume := range vmi.Spec.Volumes {
+		if !storagetypes.IsSavedStateVolume(&volume) {
+			continue
+		}
+		dir := savedStateDir(volume.Name)
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return "", nil
+			}
+			return "", err
+		}
+		for _, file := range files {
+			if !file.IsDir() && strings.HasSuffix(file.Name(), "memory.dump") {
+				return filepath.Join(dir, file.Name()), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// resumeFromSavedState restores the domain from the save image on the saved state
+// volume of the VMI. The image is consumed by a successful restore so that later
+// starts of the VMI boot the guest again. If the domain can't be restored from it,
+// the guest is booted instead.
+func (l *LibvirtDomainManager) resumeFromSavedState(vmi *v1.VirtualMachineInstance, dom cli.VirDomain, createFlags libvirt.DomainCreateFlags) (bool, error) {
+	logger := log.Log.Object(vmi)
+
+	stateFile, err := savedStateFile(vmi)
+	if err != nil || stateFile == "" {
+		return false, err
+	}
+
+	domXML, err := dom.GetXMLDesc(libvirt.DOMAIN_XML_SECURE)
+	if err != nil {
+		return false, err
+	}
+
+	restoreFlags := libvirt.DOMAIN_SAVE_RUNNING
+	if createFlags&libvirt.DOMAIN_START_PAUSED != 0 {
+		restoreFlags = libvirt.DOMAIN_SAVE_PAUSED
+	}
+	if err := l.virConn.DomainRestoreFlags(stateFile, domXML, restoreFlags); err != nil {
+		logger.Reason(err).Warningf("Failed to resume from saved memory state %s, booting instead", stateFile)
+		return false, nil
+	}
+
+	if err := os.Remove(stateFile); err != nil {
+		logger.Reason(err).Warningf("Failed to remove consumed saved memory state %s", stateFile)
+	}
+	return true, nil
+}
+
 func (l *LibvirtDomainManager) lookupOrCreateVirDomain(
 	domain *api.Domain,
 	vmi *v1.VirtualMachineInstance,
@@ -1543,7 +1627,11 @@ func (l *LibvirtDomainManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPa
 	logger.Infof("Starting memory dump")
 	failed := false
 	reason := ""
-	err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	if memoryDumpFormat(vmi, dumpPath) == v1.MemoryDumpFormatSaveState {
+		err = saveMemoryState(dom, dumpPath)
+	} else {
+		err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	}
 	if err != nil {
 		failed = true
 		reason = fmt.Sprintf("%s: %s", failedDomainMemoryDump, err)
@@ -1555,6 +1643,56 @@ func (l *LibvirtDomainManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPa
 	return err
 }
 
+// memoryDumpFormat returns the format requested for the memory dump volume
+// which is mounted at the directory of the dump path
+func memoryDumpFormat(vmi *v1.VirtualMachineInstance, dumpPath string) v1.MemoryDumpFormat {
+	volumeName := filepath.Base(filepath.Dir(dumpPath))
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == volumeName && volume.MemoryDump != nil && volume.MemoryDump.Format != "" {
+			return volume.MemoryDump.Format
+		}
+	}
+	return v1.MemoryDumpFormatRaw
+}
+
+// saveMemoryState writes the memory and device state of the domain to an
+// external memory snapshot without touching the disks. The file is a libvirt
+// save image the domain can be restored from.
+func saveMemoryState(dom cli.VirDomain, dumpPath string) error {
+	domSpec, err := getDomainSpec(dom)
+	if err != nil {
+		return err
+	}
+
+	snapshot := api.DomainSnapshot{
+		Memory: &api.DomainSnapshotMemory{
+			Snapshot: "external",
+			File:     dumpPath,
+		},
+		Disks: &api.DomainSnapshotDisks{},
+	}
+	for _, disk := range domSpec.Devices.Disks {
+		snapshot.Disks.Disks = append(snapshot.Disks.Disks, api.DomainSnapshotDisk{
+			Name:     disk.Target.Device,
+			Snapshot: "no",
+		})
+	}
+
+	snapshotXML, err := xml.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	domSnapshot, err := dom.CreateSnapshotXML(string(snapshotXML), libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA)
+	if err != nil {
+		return err
+	}
+	if domSnapshot != nil {
+		return domSnapshot.Free()
+	}
+	return nil
+}
+
 func (l *LibvirtDomainManager) shouldSkipMemoryDump(dumpPath string) bool {
 	memoryDumpMetadata, _ := l.metadataCache.MemoryDump.Load()
 	if memoryDumpMetadata.FileName == filepath.Base(dumpPath) {
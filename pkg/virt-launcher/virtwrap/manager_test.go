@@ -470,6 +470,80 @@ var _ = Describe("Manager", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(newspec).ToNot(BeNil())
 		})
+		Context("with a saved memory state volume", func() {
+			var stateFile string
+
+			BeforeEach(func() {
+				stateDir := GinkgoT().TempDir()
+				origSavedStateDir := savedStateDir
+				savedStateDir = func(volumeName string) string {
+					return filepath.Join(stateDir, volumeName)
+				}
+				DeferCleanup(func() { savedStateDir = origSavedStateDir })
+
+				Expect(os.MkdirAll(filepath.Join(stateDir, "memory-state"), 0750)).To(Succeed())
+				stateFile = filepath.Join(stateDir, "memory-state", "testvmi-memory-state-20260101-000000.memory.dump")
+				Expect(os.WriteFile(stateFile, []byte("state"), 0640)).To(Succeed())
+			})
+
+			newVMIWithSavedState := func() *v1.VirtualMachineInstance {
+				vmi := newVMI(testNamespace, testVmName)
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: "memory-state",
+					VolumeSource: v1.VolumeSource{
+						MemoryDump: &v1.MemoryDumpVolumeSource{
+							PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+									ClaimName: "memory-state",
+								},
+							},
+							Format: v1.MemoryDumpFormatSaveState,
+						},
+					},
+				})
+				return vmi
+			}
+
+			It("should resume a new VirtualMachineInstance from the saved state and consume it", func() {
+				vmi := newVMIWithSavedState()
+				mockConn.EXPECT().LookupDomainByName(testDomainName).Return(nil, libvirt.Error{Code: libvirt.ERR_NO_DOMAIN})
+
+				domainSpec := expectedDomainFor(vmi)
+				xml, err := xml.MarshalIndent(domainSpec, "", "\t")
+				Expect(err).ToNot(HaveOccurred())
+				mockConn.EXPECT().DomainDefineXML(string(xml)).DoAndReturn(mockDomainWithFreeExpectation)
+				mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_SHUTDOWN, 1, nil)
+				mockDomain.EXPECT().GetXMLDesc(libvirt.DOMAIN_XML_SECURE).Return(string(xml), nil)
+				mockConn.EXPECT().DomainRestoreFlags(stateFile, string(xml), libvirt.DOMAIN_SAVE_RUNNING).Return(nil)
+				mockDomain.EXPECT().CreateWithFlags(gomock.Any()).Times(0)
+				mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).MaxTimes(2).Return(string(xml), nil)
+				manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+				newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newspec).ToNot(BeNil())
+				Expect(stateFile).ToNot(BeAnExistingFile())
+			})
+
+			It("should boot a new VirtualMachineInstance when the saved state can't be restored", func() {
+				vmi := newVMIWithSavedState()
+				mockConn.EXPECT().LookupDomainByName(testDomainName).Return(nil, libvirt.Error{Code: libvirt.ERR_NO_DOMAIN})
+
+				domainSpec := expectedDomainFor(vmi)
+				xml, err := xml.MarshalIndent(domainSpec, "", "\t")
+				Expect(err).ToNot(HaveOccurred())
+				mockConn.EXPECT().DomainDefineXML(string(xml)).DoAndReturn(mockDomainWithFreeExpectation)
+				mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_SHUTDOWN, 1, nil)
+				mockDomain.EXPECT().GetXMLDesc(libvirt.DOMAIN_XML_SECURE).Return(string(xml), nil)
+				mockConn.EXPECT().DomainRestoreFlags(stateFile, string(xml), libvirt.DOMAIN_SAVE_RUNNING).Return(fmt.Errorf("incompatible save image"))
+				mockDomain.EXPECT().CreateWithFlags(libvirt.DOMAIN_NONE).Return(nil)
+				mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).MaxTimes(2).Return(string(xml), nil)
+				manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+				newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newspec).ToNot(BeNil())
+				Expect(stateFile).To(BeAnExistingFile())
+			})
+		})
 		It("should define and start a new VirtualMachineInstance with userData", func() {
 			vmi := newVMI(testNamespace, testVmName)
 			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(nil, libvirt.Error{Code: libvirt.ERR_NO_DOMAIN})
@@ -689,6 +763,45 @@ var _ = Describe("Manager", func() {
 				return memoryDump.Failed
 			}, 5*time.Second).Should(BeTrue(), "failed memory dump result wasn't set")
 		})
+		It("should save the memory state when the memory dump volume requests it", func() {
+			const stateDumpPath = "/test/dump/memory-state/vol1.memory.dump"
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.Disks = []api.Disk{{Target: api.DiskTarget{Device: "vda"}}}
+			domainXML, err := xml.Marshal(domainSpec)
+			Expect(err).ToNot(HaveOccurred())
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainXML), nil)
+			mockDomain.EXPECT().CreateSnapshotXML(
+				`<domainsnapshot><memory snapshot="external" file="`+stateDumpPath+`"></memory><disks><disk name="vda" snapshot="no"></disk></disks></domainsnapshot>`,
+				libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA,
+			).Return(nil, nil)
+			mockDomain.EXPECT().CoreDumpWithFormat(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "memory-state",
+				VolumeSource: v1.VolumeSource{
+					MemoryDump: &v1.MemoryDumpVolumeSource{
+						PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "memory-state",
+							},
+							Hotpluggable: true,
+						},
+						Format: v1.MemoryDumpFormatSaveState,
+					},
+				},
+			})
+			Expect(manager.MemoryDump(vmi, stateDumpPath)).To(Succeed())
+
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Completed && !memoryDump.Failed
+			}, 5*time.Second).Should(BeTrue())
+		})
 		It("should pause a VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
 
@@ -1996,6 +2109,42 @@ var _ = Describe("Manager", func() {
 			}, 5*time.Second, 2).Should(BeTrue(), fmt.Sprintf("failed migration result wasn't set [%+v]", migration))
 		})
 
+		It("should set the maximum downtime before the migration is started", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: "111222333",
+			}
+			domainSpec := expectedDomainFor(vmi)
+			domainSpec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{}
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).AnyTimes().DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetState().AnyTimes().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+
+			domainXml, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).ToNot(HaveOccurred())
+			mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().Return(&libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_NONE}, nil)
+			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).AnyTimes().Return(string(domainXml), nil)
+
+			gomock.InOrder(
+				mockDomain.EXPECT().MigrateSetMaxDowntime(uint64(500), uint32(0)).Return(nil),
+				mockDomain.EXPECT().MigrateToURI3(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("MigrationFailed")),
+			)
+			options := &cmdclient.MigrationOptions{
+				Bandwidth:               resource.MustParse("64Mi"),
+				ProgressTimeout:         150,
+				CompletionTimeoutPerGiB: 300,
+				MaxDowntimeMilliseconds: 500,
+			}
+			Expect(manager.MigrateVMI(vmi, options)).To(Succeed())
+
+			Eventually(func() bool {
+				migration, _ := metadataCache.Migration.Load()
+				return migration.Failed
+			}, 5*time.Second, 2).Should(BeTrue())
+		})
+
 		It("should detect inprogress migration job", func() {
 			vmi := newVMI(testNamespace, testVmName)
 			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
@@ -3167,6 +3316,7 @@ var _ = Describe("Manager helper functions", func() {
 			Entry("with nil migration threads", &cmdclient.MigrationOptions{ParallelMigrationThreads: nil}),
 			Entry("with nil migration threads and post-copy allowed", &cmdclient.MigrationOptions{ParallelMigrationThreads: nil, AllowPostCopy: true}),
 			Entry("with non-nil migration threads and post-copy allowed", &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(3)), AllowPostCopy: true}),
+			Entry("with non-nil migration threads and xbzrle compression", &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(3)), Compression: v1.MigrationCompressionXBZRLE}),
 		)
 
 		It("should configure parallel migration with non-nil migration threads and post-copy not allowed", func() {
@@ -3179,6 +3329,25 @@ var _ = Describe("Manager helper functions", func() {
 		})
 	})
 
+	Context("migrationCompression", func() {
+		DescribeTable("should apply", func(options *cmdclient.MigrationOptions, expectedCompression string) {
+			Expect(migrationCompression(options)).To(Equal(expectedCompression))
+			flags := generateMigrationFlags(false, false, options)
+			if expectedCompression == "" {
+				Expect(flags & libvirt.MIGRATE_COMPRESSED).To(BeZero())
+			} else {
+				Expect(flags & libvirt.MIGRATE_COMPRESSED).To(Equal(libvirt.MIGRATE_COMPRESSED))
+			}
+		},
+			Entry("no compression by default", &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(3))}, ""),
+			Entry("xbzrle compression", &cmdclient.MigrationOptions{Compression: v1.MigrationCompressionXBZRLE}, "xbzrle"),
+			Entry("xbzrle compression instead of parallel migration", &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(3)), Compression: v1.MigrationCompressionXBZRLE}, "xbzrle"),
+			Entry("zstd compression to parallel migrations", &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(3)), Compression: v1.MigrationCompressionZstd}, "zstd"),
+			Entry("no zstd compression without parallel migration", &cmdclient.MigrationOptions{Compression: v1.MigrationCompressionZstd}, ""),
+			Entry("no zstd compression to post-copy migrations", &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(3)), AllowPostCopy: true, Compression: v1.MigrationCompressionZstd}, ""),
+		)
+	})
+
 })
 
 func newVMI(namespace, name string) *v1.VirtualMachineInstance {
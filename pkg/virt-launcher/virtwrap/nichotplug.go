@@ -112,6 +112,53 @@ func (vim *virtIOInterfaceManager) hotUnplugVirtioInterface(vmi *v1.VirtualMachi
 	return nil
 }
 
+func (vim *virtIOInterfaceManager) updateInterfacesLinkState(vmi *v1.VirtualMachineInstance, currentDomain *api.Domain) error {
+	for _, domainIface := range interfacesWithLinkStateToUpdate(vmi.Spec.Domain.Devices.Interfaces, currentDomain.Spec.Devices.Interfaces) {
+		log.Log.Infof("setting the link of %s %s", domainIface.Alias.GetName(), domainIface.LinkState.State)
+
+		ifaceXML, err := xml.Marshal(domainIface)
+		if err != nil {
+			return err
+		}
+
+		if err := vim.dom.UpdateDeviceFlags(string(ifaceXML), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			log.Log.Reason(err).Errorf("libvirt failed to set the link of interface %s %s: %v", domainIface.Alias.GetName(), domainIface.LinkState.State, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// interfacesWithLinkStateToUpdate returns the domain interfaces whose link state
+// differs from the one requested by the VMI spec, with the requested link state set.
+func interfacesWithLinkStateToUpdate(vmiSpecInterfaces []v1.Interface, domainSpecInterfaces []api.Interface) []api.Interface {
+	var domainIfacesToUpdate []api.Interface
+	for _, vmiIface := range vmiSpecInterfaces {
+		if vmiIface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		domainIface := lookupDomainInterfaceByName(domainSpecInterfaces, vmiIface.Name)
+		if domainIface == nil {
+			continue
+		}
+
+		requestedLinkState := v1.InterfaceStateLinkUp
+		if vmiIface.State == v1.InterfaceStateLinkDown {
+			requestedLinkState = v1.InterfaceStateLinkDown
+		}
+		currentLinkState := v1.InterfaceStateLinkUp
+		if domainIface.LinkState != nil && domainIface.LinkState.State != "" {
+			currentLinkState = v1.InterfaceState(domainIface.LinkState.State)
+		}
+
+		if requestedLinkState != currentLinkState {
+			domainIface.LinkState = &api.LinkState{State: string(requestedLinkState)}
+			domainIfacesToUpdate = append(domainIfacesToUpdate, *domainIface)
+		}
+	}
+	return domainIfacesToUpdate
+}
+
 func interfacesToHotUnplug(vmiSpecInterfaces []v1.Interface, vmiSpecNets []v1.Network, domainSpecInterfaces []api.Interface) []api.Interface {
 	ifaces2remove := netvmispec.FilterInterfacesSpec(vmiSpecInterfaces, func(iface v1.Interface) bool {
 		return iface.State == v1.InterfaceStateAbsent
@@ -239,6 +239,85 @@ var _ = Describe("nic hot-unplug on virt-launcher", func() {
 	)
 })
 
+var _ = Describe("nic link state update on virt-launcher", func() {
+	const networkName = "n1"
+
+	linkDown := &api.LinkState{State: "down"}
+	linkUp := &api.LinkState{State: "up"}
+
+	DescribeTable("domain interfaces with link state to update",
+		func(vmiSpecIfaces []v1.Interface, domainSpecIfaces []api.Interface, expectedDomainSpecIfaces []api.Interface) {
+			Expect(interfacesWithLinkStateToUpdate(vmiSpecIfaces, domainSpecIfaces)).To(ConsistOf(expectedDomainSpecIfaces))
+		},
+		Entry("given no VMI interfaces and no domain interfaces", nil, nil, nil),
+		Entry("given 1 VMI interface without state and an associated interface in the domain with link up",
+			[]v1.Interface{{Name: networkName}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName)}},
+			nil,
+		),
+		Entry("given 1 VMI down interface and an associated interface in the domain with link down",
+			[]v1.Interface{{Name: networkName, State: v1.InterfaceStateLinkDown}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkDown}},
+			nil,
+		),
+		Entry("given 1 VMI down interface and an associated interface in the domain with link up",
+			[]v1.Interface{{Name: networkName, State: v1.InterfaceStateLinkDown}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName)}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkDown}},
+		),
+		Entry("given 1 VMI up interface and an associated interface in the domain with link down",
+			[]v1.Interface{{Name: networkName, State: v1.InterfaceStateLinkUp}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkDown}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkUp}},
+		),
+		Entry("given 1 VMI interface without state and an associated interface in the domain with link down",
+			[]v1.Interface{{Name: networkName}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkDown}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkUp}},
+		),
+		Entry("given 1 VMI absent interface and an associated interface in the domain with link down",
+			[]v1.Interface{{Name: networkName, State: v1.InterfaceStateAbsent}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkDown}},
+			nil,
+		),
+		Entry("given 1 VMI down interface and no associated interface in the domain",
+			[]v1.Interface{{Name: networkName, State: v1.InterfaceStateLinkDown}},
+			nil,
+			nil,
+		),
+	)
+
+	It("updateInterfacesLinkState updates the domain interface through libvirt", func() {
+		vmi := &v1.VirtualMachineInstance{Spec: v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{Devices: v1.Devices{
+			Interfaces: []v1.Interface{{Name: networkName, State: v1.InterfaceStateLinkDown}},
+		}}}}
+		domain := &api.Domain{Spec: api.DomainSpec{Devices: api.Devices{
+			Interfaces: []api.Interface{{Type: "ethernet", Alias: api.NewUserDefinedAlias(networkName)}},
+		}}}
+		expectedIfaceXML, err := xml.Marshal(api.Interface{Type: "ethernet", Alias: api.NewUserDefinedAlias(networkName), LinkState: linkDown})
+		Expect(err).NotTo(HaveOccurred())
+
+		mockClient := cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		mockClient.EXPECT().UpdateDeviceFlags(string(expectedIfaceXML), affectDeviceLiveAndConfigLibvirtFlags).Return(nil)
+
+		Expect(newVirtIOInterfaceManager(mockClient, &fakeVMConfigurator{}).updateInterfacesLinkState(vmi, domain)).To(Succeed())
+	})
+
+	It("updateInterfacesLinkState fails when libvirt fails to update the domain interface", func() {
+		vmi := &v1.VirtualMachineInstance{Spec: v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{Devices: v1.Devices{
+			Interfaces: []v1.Interface{{Name: networkName, State: v1.InterfaceStateLinkDown}},
+		}}}}
+		domain := &api.Domain{Spec: api.DomainSpec{Devices: api.Devices{
+			Interfaces: []api.Interface{{Type: "ethernet", Alias: api.NewUserDefinedAlias(networkName)}},
+		}}}
+
+		mockClient := cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		mockClient.EXPECT().UpdateDeviceFlags(gomock.Any(), affectDeviceLiveAndConfigLibvirtFlags).Return(fmt.Errorf("boom"))
+
+		Expect(newVirtIOInterfaceManager(mockClient, &fakeVMConfigurator{}).updateInterfacesLinkState(vmi, domain)).To(MatchError("boom"))
+	})
+})
+
 var _ = Describe("domain network interfaces resources", func() {
 
 	DescribeTable("are ignored when",
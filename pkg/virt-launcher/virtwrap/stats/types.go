@@ -180,14 +180,24 @@ type DomainStatsMemory struct {
 // mimic existing structs, but data is taken from
 // DomainJobInfo
 type DomainJobInfo struct {
-	DataTotalSet     bool
-	DataTotal        uint64
-	DataProcessedSet bool
-	DataProcessed    uint64
-	MemoryBpsSet     bool
-	MemoryBps        uint64
-	DataRemainingSet bool
-	DataRemaining    uint64
-	MemDirtyRateSet  bool
-	MemDirtyRate     uint64
+	DataTotalSet            bool
+	DataTotal               uint64
+	DataProcessedSet        bool
+	DataProcessed           uint64
+	MemoryBpsSet            bool
+	MemoryBps               uint64
+	DataRemainingSet        bool
+	DataRemaining           uint64
+	MemDirtyRateSet         bool
+	MemDirtyRate            uint64
+	MemIterationSet         bool
+	MemIteration            uint64
+	AutoConvergeThrottleSet bool
+	AutoConvergeThrottle    int
+	MemPostcopyReqsSet      bool
+	MemPostcopyReqs         uint64
+	TimeElapsedSet          bool
+	TimeElapsed             uint64
+	TimeRemainingSet        bool
+	TimeRemaining           uint64
 }
@@ -231,5 +231,16 @@ func Convert_libvirt_DomainJobInfo_To_stats_DomainJobInfo(info *libvirt.DomainJo
 		DataRemaining:    info.DataRemaining,
 		MemDirtyRateSet:  info.MemDirtyRateSet && info.MemPageSizeSet,
 		MemDirtyRate:     info.MemDirtyRate * info.MemPageSize,
+
+		MemIterationSet:         info.MemIterationSet,
+		MemIteration:            info.MemIteration,
+		AutoConvergeThrottleSet: info.AutoConvergeThrottleSet,
+		AutoConvergeThrottle:    info.AutoConvergeThrottle,
+		MemPostcopyReqsSet:      info.MemPostcopyReqsSet,
+		MemPostcopyReqs:         info.MemPostcopyReqs,
+		TimeElapsedSet:          info.TimeElapsedSet,
+		TimeElapsed:             info.TimeElapsed,
+		TimeRemainingSet:        info.TimeRemainingSet,
+		TimeRemaining:           info.TimeRemaining,
 	}
 }
@@ -112,6 +112,40 @@ var _ = Describe("StatsConverter", func() {
 			}
 			Expect(equal).To(BeTrue())
 		})
+
+		It("should convert migration job info", func() {
+			in := &libvirt.DomainJobInfo{
+				DataRemainingSet:        true,
+				DataRemaining:           1024,
+				MemDirtyRateSet:         true,
+				MemDirtyRate:            10,
+				MemPageSizeSet:          true,
+				MemPageSize:             4096,
+				MemIterationSet:         true,
+				MemIteration:            3,
+				AutoConvergeThrottleSet: true,
+				AutoConvergeThrottle:    20,
+				MemPostcopyReqsSet:      true,
+				MemPostcopyReqs:         5,
+				TimeElapsedSet:          true,
+				TimeElapsed:             1500,
+			}
+
+			Expect(Convert_libvirt_DomainJobInfo_To_stats_DomainJobInfo(in)).To(Equal(&stats.DomainJobInfo{
+				DataRemainingSet:        true,
+				DataRemaining:           1024,
+				MemDirtyRateSet:         true,
+				MemDirtyRate:            40960,
+				MemIterationSet:         true,
+				MemIteration:            3,
+				AutoConvergeThrottleSet: true,
+				AutoConvergeThrottle:    20,
+				MemPostcopyReqsSet:      true,
+				MemPostcopyReqs:         5,
+				TimeElapsedSet:          true,
+				TimeElapsed:             1500,
+			}))
+		})
 	})
 })
 
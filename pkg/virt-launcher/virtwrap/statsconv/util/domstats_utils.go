@@ -201,7 +201,17 @@ var Testdataexpected = `{
      "MemDirtyRate": 0,
      "MemDirtyRateSet": false,
      "MemoryBpsSet": false,
-     "MemoryBps": 0
+     "MemoryBps": 0,
+     "MemIteration": 0,
+     "MemIterationSet": false,
+     "AutoConvergeThrottle": 0,
+     "AutoConvergeThrottleSet": false,
+     "MemPostcopyReqs": 0,
+     "MemPostcopyReqsSet": false,
+     "TimeElapsed": 0,
+     "TimeElapsedSet": false,
+     "TimeRemaining": 0,
+     "TimeRemainingSet": false
    },
    "Name": "testName", 
    "Net": [
@@ -66,8 +66,11 @@ var (
 	VIRTUALMACHINEPOOL               = "virtualmachinepools." + poolv1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOT           = "virtualmachinesnapshots." + snapshotv1beta1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOTCONTENT    = "virtualmachinesnapshotcontents." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTSCHEDULE   = "virtualmachinesnapshotschedules." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTGROUP      = "virtualmachinesnapshotgroups." + snapshotv1beta1.SchemeGroupVersion.Group
 	VIRTUALMACHINEEXPORT             = "virtualmachineexports." + exportv1beta1.SchemeGroupVersion.Group
 	MIGRATIONPOLICY                  = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
+	VIRTUALMACHINESTORAGEMIGRATION   = "virtualmachinestoragemigrations." + migrationsv1.VirtualMachineStorageMigrationKind.Group
 	VIRTUALMACHINECLONE              = "virtualmachineclones." + clone.GroupName
 )
 
@@ -553,6 +556,99 @@ func NewVirtualMachineSnapshotContentCrd() (*extv1.CustomResourceDefinition, err
 	return crd, nil
 }
 
+func NewVirtualMachineSnapshotScheduleCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTSCHEDULE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotschedules",
+			Singular:   "virtualmachinesnapshotschedule",
+			Kind:       "VirtualMachineSnapshotSchedule",
+			ShortNames: []string{"vmsnapshotschedule", "vmsnapshotschedules"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Schedule", Type: "string", JSONPath: ".spec.schedule"},
+		{Name: "Disabled", Type: "boolean", JSONPath: ".spec.disabled"},
+		{Name: "LastScheduleTime", Type: "date", JSONPath: ".status.lastScheduleTime"},
+		{Name: "NextScheduleTime", Type: "date", JSONPath: ".status.nextScheduleTime"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineSnapshotGroupCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTGROUP
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotgroups",
+			Singular:   "virtualmachinesnapshotgroup",
+			Kind:       "VirtualMachineSnapshotGroup",
+			ShortNames: []string{"vmsnapshotgroup", "vmsnapshotgroups"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+		{Name: "FreezeTime", Type: "date", JSONPath: ".status.freezeTime"},
+		{Name: "ReadyToUse", Type: "boolean", JSONPath: ".status.readyToUse"},
+		{Name: "CreationTime", Type: "date", JSONPath: ".status.creationTime"},
+		{Name: "Error", Type: "string", JSONPath: ".status.error.message"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineRestoreCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -864,6 +960,49 @@ func NewMigrationPolicyCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewVirtualMachineStorageMigrationCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESTORAGEMIGRATION
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: migrationsv1.VirtualMachineStorageMigrationKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    migrationsv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     migrations.ResourceVirtualMachineStorageMigrations,
+			Singular:   "virtualmachinestoragemigration",
+			Kind:       migrationsv1.VirtualMachineStorageMigrationKind.Kind,
+			ShortNames: []string{"vmstoragemigration", "vmstoragemigrations"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "VM", Type: "string", JSONPath: ".spec.vmName"},
+		{Name: "StorageClass", Type: "string", JSONPath: ".spec.storageClassName"},
+		{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+		{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+	}, &extv1.CustomResourceSubresources{
+		Status: &extv1.CustomResourceSubresourceStatus{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineCloneCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
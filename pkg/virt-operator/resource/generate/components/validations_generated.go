@@ -1211,6 +1211,12 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy or cancelled depending on other settings. Defaults to 150
                   format: int64
                   type: integer
+                compression:
+                  description: |-
+                    Compression is the compression method used for the memory of a VMI.
+                    zstd requires multifd and is not applied when multifd is not in use. xbzrle disables multifd.
+                    By default, the memory is not compressed.
+                  type: string
                 disableTLS:
                   description: |-
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
@@ -1223,6 +1229,12 @@ var CRDsValidation map[string]string = map[string]string{
                     That will ensure the target virt-launcher doesn't share categories with another pod on the node.
                     However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
                   type: boolean
+                maxDowntimeMilliseconds:
+                  description: |-
+                    MaxDowntimeMilliseconds is the maximum time in milliseconds a VMI may be paused at the end of a
+                    live migration. By default, the hypervisor default of 300 milliseconds is used.
+                  format: int64
+                  type: integer
                 network:
                   description: |-
                     Network is the name of the CNI network to use for live migrations. By default, migrations go
@@ -1233,6 +1245,13 @@ var CRDsValidation map[string]string = map[string]string{
                     NodeDrainTaintKey defines the taint key that indicates a node should be drained.
                     Note: this option relies on the deprecated node taint feature. Default: kubevirt.io/drain
                   type: string
+                parallelMigrationThreads:
+                  description: |-
+                    ParallelMigrationThreads is the number of multifd streams used to transfer the memory of a VMI.
+                    Setting it to 0 disables multifd. By default, 8 streams are used unless the VMI has a CPU limit
+                    or post-copy is allowed.
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: |-
                     ParallelMigrationsPerCluster is the total number of concurrent live migrations
@@ -1344,6 +1363,103 @@ var CRDsValidation map[string]string = map[string]string{
                     DeprecatedPermitSlirpInterface is an alias for the deprecated PermitSlirpInterface.
                     Deprecated: Removed in v1.3.
                   type: boolean
+                trafficFilter:
+                  description: |-
+                    TrafficFilter is enforced on all interfaces with bridge binding.
+                    The protections it enables cannot be disabled by the traffic filter of an interface,
+                    its allowed addresses and ports apply to the interfaces which do not specify their own.
+                  properties:
+                    allowedIPAddresses:
+                      description: |-
+                        AllowedIPAddresses are the source IP addresses or CIDRs the guest is allowed to send packets with.
+                        When specified, packets with any other source IP address are dropped. IPv6 link-local addresses
+                        and DHCP requests of the guest are always allowed.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    allowedMACAddresses:
+                      description: |-
+                        AllowedMACAddresses are the source MAC addresses the guest is allowed to send frames with.
+                        Defaults to the MAC address of the interface, if specified.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    dhcpServerGuard:
+                      description: DHCPServerGuard drops DHCP server messages and
+                        IPv6 router advertisements sent by the guest.
+                      type: boolean
+                    egressPorts:
+                      description: |-
+                        EgressPorts are the ports the guest is allowed to open new connections to.
+                        When specified, any other TCP and UDP traffic from the guest is dropped, except for DHCP requests.
+                      items:
+                        description: |-
+                          Port represents a port to expose from the virtual machine.
+                          Default protocol TCP.
+                          The port field is mandatory
+                        properties:
+                          name:
+                            description: |-
+                              If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                              named port in a pod must have a unique name. Name for the port that can be
+                              referred to by services.
+                            type: string
+                          port:
+                            description: |-
+                              Number of port to expose for the virtual machine.
+                              This must be a valid port number, 0 < x < 65536.
+                            format: int32
+                            type: integer
+                          protocol:
+                            description: |-
+                              Protocol for port. Must be UDP or TCP.
+                              Defaults to "TCP".
+                            type: string
+                        required:
+                        - port
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    ingressPorts:
+                      description: |-
+                        IngressPorts are the ports the guest is allowed to receive new connections on.
+                        When specified, any other TCP and UDP traffic towards the guest is dropped.
+                      items:
+                        description: |-
+                          Port represents a port to expose from the virtual machine.
+                          Default protocol TCP.
+                          The port field is mandatory
+                        properties:
+                          name:
+                            description: |-
+                              If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                              named port in a pod must have a unique name. Name for the port that can be
+                              referred to by services.
+                            type: string
+                          port:
+                            description: |-
+                              Number of port to expose for the virtual machine.
+                              This must be a valid port number, 0 < x < 65536.
+                            format: int32
+                            type: integer
+                          protocol:
+                            description: |-
+                              Protocol for port. Must be UDP or TCP.
+                              Defaults to "TCP".
+                            type: string
+                        required:
+                        - port
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    neighborDiscoveryProtection:
+                      description: |-
+                        NeighborDiscoveryProtection drops ARP messages and IPv6 neighbor advertisements sent by the guest
+                        on behalf of addresses which are not allowed.
+                      type: boolean
+                  type: object
               type: object
             obsoleteCPUModels:
               additionalProperties:
@@ -3891,6 +4007,53 @@ var CRDsValidation map[string]string = map[string]string{
         completionTimeoutPerGiB:
           format: int64
           type: integer
+        compression:
+          description: MigrationCompression is the compression method for the memory
+            of a migrating VMI
+          type: string
+        maxDowntimeMilliseconds:
+          format: int64
+          type: integer
+        network:
+          description: |-
+            Network is the name of the CNI network to use for live migrations. It has to be the
+            migration network of the cluster, or empty to migrate through the pod network.
+          type: string
+        parallelMigrationThreads:
+          format: int32
+          type: integer
+        retryPolicy:
+          description: RetryPolicy defines how failed migrations of the matched VMIs
+            are retried
+          properties:
+            allowPostCopyOnRetry:
+              description: |-
+                AllowPostCopyOnRetry allows retries to switch to post-copy when
+                the migration does not converge.
+                Only honored in the retry policy of a MigrationPolicy.
+              type: boolean
+            bandwidthPerMigrationOnRetry:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                BandwidthPerMigrationOnRetry is the bandwidth limit of retries.
+                Only honored in the retry policy of a MigrationPolicy.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            initialBackoffSeconds:
+              description: |-
+                InitialBackoffSeconds is the time to wait before the first retry,
+                it doubles with every further retry. Defaults to 20.
+              format: int64
+              type: integer
+            maxAttempts:
+              description: |-
+                MaxAttempts is the maximum number of attempts to migrate the VMI,
+                including the first one. Defaults to 3.
+              format: int32
+              type: integer
+          type: object
         selectors:
           properties:
             namespaceSelector:
@@ -3907,6 +4070,40 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     status:
       nullable: true
+      properties:
+        failedMigrations:
+          description: FailedMigrations is the number of recent migrations which failed
+            with the policy
+          format: int64
+          type: integer
+        matchedVirtualMachineInstanceCount:
+          description: MatchedVirtualMachineInstanceCount is the number of VMIs the
+            policy currently applies to
+          format: int64
+          type: integer
+        matchedVirtualMachineInstances:
+          description: |-
+            MatchedVirtualMachineInstances lists up to 50 of the VMIs the policy currently applies to,
+            sorted by namespace and name
+          items:
+            description: MatchedVirtualMachineInstance references a VMI matched by
+              a migration policy
+            properties:
+              name:
+                type: string
+              namespace:
+                type: string
+            required:
+            - name
+            - namespace
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        succeededMigrations:
+          description: SucceededMigrations is the number of recent migrations which
+            succeeded with the policy
+          format: int64
+          type: integer
       type: object
   required:
   - spec
@@ -6256,6 +6453,80 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: |-
+                                  If specified, the traffic of the interface is rate limited accordingly.
+                                  Only supported with bridge and masquerade bindings.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average bit rate
+                                          of the shaped traffic, in bits per second
+                                          (e.g. 10M).
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of data that
+                                          can be sent at the peak rate, in bytes (e.g.
+                                          1Mi).
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the maximum bit rate
+                                          the traffic can be sent at, in bits per
+                                          second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average bit rate
+                                          of the shaped traffic, in bits per second
+                                          (e.g. 10M).
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of data that
+                                          can be sent at the peak rate, in bytes (e.g.
+                                          1Mi).
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the maximum bit rate
+                                          the traffic can be sent at, in bits per
+                                          second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -6286,12 +6557,43 @@ var CRDsValidation map[string]string = map[string]string{
                                   pass additional DHCP options to the VMI
                                 properties:
                                   bootFileName:
-                                    description: If specified will pass option 67
-                                      to interface's DHCP server
+                                    description: |-
+                                      If specified will pass option 67 to interface's DHCP server.
+                                      The DHCPv6 server passes it as the boot file URL option 59 (RFC 5970), served from
+                                      the TFTP server unless it is a URL.
                                     type: string
+                                  dhcpv6Options:
+                                    description: |-
+                                      If specified will pass extra options to interface's DHCPv6 server.
+                                      DHCPv6 has no range of options for private use, see DHCPv6Option for the allowed option codes.
+                                      Prefix delegation (IA_PD) is not offered by the DHCPv6 server.
+                                    items:
+                                      description: DHCPv6Option defines an extra DHCPv6
+                                        option for a VM.
+                                      properties:
+                                        option:
+                                          description: |-
+                                            Option is the DHCPv6 option code, an Integer value from 1-65535.
+                                            The options set by the DHCPv6 server itself cannot be specified: client and server identifiers (1, 2),
+                                            address assignment (3, 4, 5, 13, 25, 26), DNS servers (23), domain search list (24),
+                                            NTP servers (56) and boot file URL (59).
+                                            Required.
+                                          type: integer
+                                        value:
+                                          description: |-
+                                            Value is a String value for the Option provided
+                                            Required.
+                                          type: string
+                                      required:
+                                      - option
+                                      - value
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                   ntpServers:
-                                    description: If specified will pass the configured
-                                      NTP server to the VM via DHCP option 042.
+                                    description: |-
+                                      If specified will pass the configured NTP server to the VM via DHCP option 042.
+                                      IPv6 NTP servers are passed via DHCPv6 option 56.
                                     items:
                                       type: string
                                     type: array
@@ -6400,13 +6702,113 @@ var CRDsValidation map[string]string = map[string]string{
                               state:
                                 description: |-
                                   State represents the requested operational state of the interface.
-                                  The (only) value supported is 'absent', expressing a request to remove the interface.
+                                  The values supported are:
+                                  'absent', expressing a request to remove the interface.
+                                  'down', expressing a request to set the link of the interface down.
+                                  'up', expressing a request to set the link of the interface up (the default).
                                 type: string
                               tag:
                                 description: If specified, the virtual network interface
                                   address and its tag will be provided to the guest
                                   via config drive
                                 type: string
+                              trafficFilter:
+                                description: |-
+                                  If specified, the traffic of the interface is filtered accordingly.
+                                  Only supported with bridge binding.
+                                properties:
+                                  allowedIPAddresses:
+                                    description: |-
+                                      AllowedIPAddresses are the source IP addresses or CIDRs the guest is allowed to send packets with.
+                                      When specified, packets with any other source IP address are dropped. IPv6 link-local addresses
+                                      and DHCP requests of the guest are always allowed.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  allowedMACAddresses:
+                                    description: |-
+                                      AllowedMACAddresses are the source MAC addresses the guest is allowed to send frames with.
+                                      Defaults to the MAC address of the interface, if specified.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  dhcpServerGuard:
+                                    description: DHCPServerGuard drops DHCP server
+                                      messages and IPv6 router advertisements sent
+                                      by the guest.
+                                    type: boolean
+                                  egressPorts:
+                                    description: |-
+                                      EgressPorts are the ports the guest is allowed to open new connections to.
+                                      When specified, any other TCP and UDP traffic from the guest is dropped, except for DHCP requests.
+                                    items:
+                                      description: |-
+                                        Port represents a port to expose from the virtual machine.
+                                        Default protocol TCP.
+                                        The port field is mandatory
+                                      properties:
+                                        name:
+                                          description: |-
+                                            If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                            named port in a pod must have a unique name. Name for the port that can be
+                                            referred to by services.
+                                          type: string
+                                        port:
+                                          description: |-
+                                            Number of port to expose for the virtual machine.
+                                            This must be a valid port number, 0 < x < 65536.
+                                          format: int32
+                                          type: integer
+                                        protocol:
+                                          description: |-
+                                            Protocol for port. Must be UDP or TCP.
+                                            Defaults to "TCP".
+                                          type: string
+                                      required:
+                                      - port
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  ingressPorts:
+                                    description: |-
+                                      IngressPorts are the ports the guest is allowed to receive new connections on.
+                                      When specified, any other TCP and UDP traffic towards the guest is dropped.
+                                    items:
+                                      description: |-
+                                        Port represents a port to expose from the virtual machine.
+                                        Default protocol TCP.
+                                        The port field is mandatory
+                                      properties:
+                                        name:
+                                          description: |-
+                                            If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                            named port in a pod must have a unique name. Name for the port that can be
+                                            referred to by services.
+                                          type: string
+                                        port:
+                                          description: |-
+                                            Number of port to expose for the virtual machine.
+                                            This must be a valid port number, 0 < x < 65536.
+                                          format: int32
+                                          type: integer
+                                        protocol:
+                                          description: |-
+                                            Protocol for port. Must be UDP or TCP.
+                                            Defaults to "TCP".
+                                          type: string
+                                      required:
+                                      - port
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  neighborDiscoveryProtection:
+                                    description: |-
+                                      NeighborDiscoveryProtection drops ARP messages and IPv6 neighbor advertisements sent by the guest
+                                      on behalf of addresses which are not allowed.
+                                    type: boolean
+                                type: object
                             required:
                             - name
                             type: object
@@ -7088,6 +7490,21 @@ var CRDsValidation map[string]string = map[string]string{
                       format: int32
                       type: integer
                   type: object
+                metadataService:
+                  description: |-
+                    MetadataService exposes an EC2 and, optionally, an OpenStack compatible metadata service
+                    to the guest, on the link-local address 169.254.169.254.
+                    The EC2 metadata is served to IMDSv2 clients only, presenting a session token.
+                    The OpenStack metadata, when enabled, is served without a session token.
+                    Requires the pod network to use the masquerade binding.
+                  properties:
+                    openStack:
+                      description: |-
+                        OpenStack also serves the OpenStack metadata tree, under /openstack.
+                        The OpenStack data source of cloud-init does not use session tokens, so this tree,
+                        including the SSH public keys and the user data, is served to any client of the guest.
+                      type: object
+                  type: object
                 networks:
                   description: List of networks that can be attached to a vm's virtual
                     interface.
@@ -7872,6 +8289,12 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          format:
+                            description: |-
+                              Format is the format of the memory dump, defaults to Raw.
+                              A volume which is not hotpluggable and holds a SaveState dump is used
+                              to resume the VMI on start.
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -8058,6 +8481,10 @@ var CRDsValidation map[string]string = map[string]string{
             fileName:
               description: FileName represents the name of the output file
               type: string
+            format:
+              description: Format is the format the memory is dumped in, defaults
+                to Raw
+              type: string
             message:
               description: Message is a detailed message about failure of the memory
                 dump
@@ -8562,6 +8989,78 @@ var CRDsValidation map[string]string = map[string]string{
             type: string
           type: array
           x-kubernetes-list-type: atomic
+        guestIdentity:
+          description: |-
+            GuestIdentity configures the regeneration of the identity of the target's guest,
+            so the target comes up as a machine distinct from the source.
+            If this field is not specified, the guest of the target keeps the identity of the source.
+          properties:
+            cloudInitInstanceID:
+              description: |-
+                CloudInitInstanceID sets the cloud-init instance ID of the target.
+                A new instance ID makes cloud-init run its per-instance modules again on the first boot
+                of the target, which regenerates the SSH host keys and sets the hostname.
+                If this field is not specified, a new instance ID will be generated automatically.
+                The hostname of the target is reset to its name.
+              type: string
+            generalization:
+              description: Generalization attaches a generalization step to the clone.
+              properties:
+                sysprep:
+                  description: |-
+                    Sysprep attaches a Windows sysprep answer file to the target, which specializes
+                    a generalized Windows guest on the first boot of the target.
+                  properties:
+                    configMap:
+                      description: ConfigMap references a ConfigMap that contains
+                        Sysprep answer file named autounattend.xml that should be
+                        attached as disk of CDROM type.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                    secret:
+                      description: Secret references a k8s Secret that contains Sysprep
+                        answer file named autounattend.xml that should be attached
+                        as disk of CDROM type.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                  type: object
+                virtSysprep:
+                  description: |-
+                    VirtSysprep runs virt-sysprep from the libguestfs-tools image on the volumes of the target
+                    before the clone succeeds. It resets the machine-id, the SSH host keys and the other
+                    machine specific state of a Linux guest.
+                  properties:
+                    operations:
+                      description: |-
+                        Operations is the list of virt-sysprep operations to run.
+                        If this field is not specified, the default operations of virt-sysprep are run.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  type: object
+              type: object
+          type: object
         labelFilters:
           description: |-
             Example use: "!some/key*".
@@ -8633,6 +9132,13 @@ var CRDsValidation map[string]string = map[string]string{
           - name
           type: object
           x-kubernetes-map-type: atomic
+        targetNamespace:
+          description: |-
+            TargetNamespace is the namespace the target is created in.
+            If not provided, the target is created in the namespace of the clone.
+            Cloning into another namespace requires permissions to read the source
+            and to create virtual machines in the target namespace.
+          type: string
         template:
           description: For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
           properties:
@@ -9640,6 +10146,16 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineExportSpec is the spec for a VirtualMachineExport
         resource
       properties:
+        baseSnapshotName:
+          description: |-
+            BaseSnapshotName is the name of a VirtualMachineSnapshot of the same VirtualMachine as the source.
+            When set, every exported disk image additionally provides the extents that changed between the
+            base snapshot and the source snapshot, and a stream containing only the data of those extents.
+            The extents are computed by comparing the disk images: the volumes of the base snapshot are
+            restored into additional PersistentVolumeClaims for the lifetime of the export, and both images
+            are read once, skipping the ranges unallocated in both.
+            Only valid when the source is a VirtualMachineSnapshot.
+          type: string
         source:
           description: |-
             TypedLocalObjectReference contains enough information to let you locate the
@@ -11476,6 +11992,73 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          If specified, the traffic of the interface is rate limited accordingly.
+                          Only supported with bridge and masquerade bindings.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average bit rate of the
+                                  shaped traffic, in bits per second (e.g. 10M).
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of data that can
+                                  be sent at the peak rate, in bytes (e.g. 1Mi).
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the maximum bit rate the traffic
+                                  can be sent at, in bits per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average bit rate of the
+                                  shaped traffic, in bits per second (e.g. 10M).
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of data that can
+                                  be sent at the peak rate, in bytes (e.g. 1Mi).
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the maximum bit rate the traffic
+                                  can be sent at, in bits per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -11506,14 +12089,45 @@ var CRDsValidation map[string]string = map[string]string{
                           additional DHCP options to the VMI
                         properties:
                           bootFileName:
-                            description: If specified will pass option 67 to interface's
-                              DHCP server
+                            description: |-
+                              If specified will pass option 67 to interface's DHCP server.
+                              The DHCPv6 server passes it as the boot file URL option 59 (RFC 5970), served from
+                              the TFTP server unless it is a URL.
                             type: string
-                          ntpServers:
-                            description: If specified will pass the configured NTP
-                              server to the VM via DHCP option 042.
+                          dhcpv6Options:
+                            description: |-
+                              If specified will pass extra options to interface's DHCPv6 server.
+                              DHCPv6 has no range of options for private use, see DHCPv6Option for the allowed option codes.
+                              Prefix delegation (IA_PD) is not offered by the DHCPv6 server.
                             items:
-                              type: string
+                              description: DHCPv6Option defines an extra DHCPv6 option
+                                for a VM.
+                              properties:
+                                option:
+                                  description: |-
+                                    Option is the DHCPv6 option code, an Integer value from 1-65535.
+                                    The options set by the DHCPv6 server itself cannot be specified: client and server identifiers (1, 2),
+                                    address assignment (3, 4, 5, 13, 25, 26), DNS servers (23), domain search list (24),
+                                    NTP servers (56) and boot file URL (59).
+                                    Required.
+                                  type: integer
+                                value:
+                                  description: |-
+                                    Value is a String value for the Option provided
+                                    Required.
+                                  type: string
+                              required:
+                              - option
+                              - value
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          ntpServers:
+                            description: |-
+                              If specified will pass the configured NTP server to the VM via DHCP option 042.
+                              IPv6 NTP servers are passed via DHCPv6 option 56.
+                            items:
+                              type: string
                             type: array
                           privateOptions:
                             description: 'If specified will pass extra DHCP options
@@ -11620,12 +12234,111 @@ var CRDsValidation map[string]string = map[string]string{
                       state:
                         description: |-
                           State represents the requested operational state of the interface.
-                          The (only) value supported is 'absent', expressing a request to remove the interface.
+                          The values supported are:
+                          'absent', expressing a request to remove the interface.
+                          'down', expressing a request to set the link of the interface down.
+                          'up', expressing a request to set the link of the interface up (the default).
                         type: string
                       tag:
                         description: If specified, the virtual network interface address
                           and its tag will be provided to the guest via config drive
                         type: string
+                      trafficFilter:
+                        description: |-
+                          If specified, the traffic of the interface is filtered accordingly.
+                          Only supported with bridge binding.
+                        properties:
+                          allowedIPAddresses:
+                            description: |-
+                              AllowedIPAddresses are the source IP addresses or CIDRs the guest is allowed to send packets with.
+                              When specified, packets with any other source IP address are dropped. IPv6 link-local addresses
+                              and DHCP requests of the guest are always allowed.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          allowedMACAddresses:
+                            description: |-
+                              AllowedMACAddresses are the source MAC addresses the guest is allowed to send frames with.
+                              Defaults to the MAC address of the interface, if specified.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          dhcpServerGuard:
+                            description: DHCPServerGuard drops DHCP server messages
+                              and IPv6 router advertisements sent by the guest.
+                            type: boolean
+                          egressPorts:
+                            description: |-
+                              EgressPorts are the ports the guest is allowed to open new connections to.
+                              When specified, any other TCP and UDP traffic from the guest is dropped, except for DHCP requests.
+                            items:
+                              description: |-
+                                Port represents a port to expose from the virtual machine.
+                                Default protocol TCP.
+                                The port field is mandatory
+                              properties:
+                                name:
+                                  description: |-
+                                    If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                    named port in a pod must have a unique name. Name for the port that can be
+                                    referred to by services.
+                                  type: string
+                                port:
+                                  description: |-
+                                    Number of port to expose for the virtual machine.
+                                    This must be a valid port number, 0 < x < 65536.
+                                  format: int32
+                                  type: integer
+                                protocol:
+                                  description: |-
+                                    Protocol for port. Must be UDP or TCP.
+                                    Defaults to "TCP".
+                                  type: string
+                              required:
+                              - port
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          ingressPorts:
+                            description: |-
+                              IngressPorts are the ports the guest is allowed to receive new connections on.
+                              When specified, any other TCP and UDP traffic towards the guest is dropped.
+                            items:
+                              description: |-
+                                Port represents a port to expose from the virtual machine.
+                                Default protocol TCP.
+                                The port field is mandatory
+                              properties:
+                                name:
+                                  description: |-
+                                    If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                    named port in a pod must have a unique name. Name for the port that can be
+                                    referred to by services.
+                                  type: string
+                                port:
+                                  description: |-
+                                    Number of port to expose for the virtual machine.
+                                    This must be a valid port number, 0 < x < 65536.
+                                  format: int32
+                                  type: integer
+                                protocol:
+                                  description: |-
+                                    Protocol for port. Must be UDP or TCP.
+                                    Defaults to "TCP".
+                                  type: string
+                              required:
+                              - port
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          neighborDiscoveryProtection:
+                            description: |-
+                              NeighborDiscoveryProtection drops ARP messages and IPv6 neighbor advertisements sent by the guest
+                              on behalf of addresses which are not allowed.
+                            type: boolean
+                        type: object
                     required:
                     - name
                     type: object
@@ -12299,6 +13012,21 @@ var CRDsValidation map[string]string = map[string]string{
               format: int32
               type: integer
           type: object
+        metadataService:
+          description: |-
+            MetadataService exposes an EC2 and, optionally, an OpenStack compatible metadata service
+            to the guest, on the link-local address 169.254.169.254.
+            The EC2 metadata is served to IMDSv2 clients only, presenting a session token.
+            The OpenStack metadata, when enabled, is served without a session token.
+            Requires the pod network to use the masquerade binding.
+          properties:
+            openStack:
+              description: |-
+                OpenStack also serves the OpenStack metadata tree, under /openstack.
+                The OpenStack data source of cloud-init does not use session tokens, so this tree,
+                including the SSH public keys and the user data, is served to any client of the guest.
+              type: object
+          type: object
         networks:
           description: List of networks that can be attached to a vm's virtual interface.
           items:
@@ -13072,6 +13800,12 @@ var CRDsValidation map[string]string = map[string]string{
                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                     type: string
+                  format:
+                    description: |-
+                      Format is the format of the memory dump, defaults to Raw.
+                      A volume which is not hotpluggable and holds a SaveState dump is used
+                      to resume the VMI on start.
+                    type: string
                   hotpluggable:
                     description: Hotpluggable indicates whether the volume can be
                       hotplugged and hotunplugged.
@@ -13297,6 +14031,71 @@ var CRDsValidation map[string]string = map[string]string{
           description: Interfaces represent the details of available network interfaces.
           items:
             properties:
+              bandwidth:
+                description: Bandwidth reports the bandwidth limits applied on the
+                  interface
+                properties:
+                  inbound:
+                    description: Inbound limits the traffic received by the guest.
+                    properties:
+                      average:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Average is the average bit rate of the shaped
+                          traffic, in bits per second (e.g. 10M).
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      burst:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Burst is the amount of data that can be sent
+                          at the peak rate, in bytes (e.g. 1Mi).
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      peak:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Peak is the maximum bit rate the traffic can
+                          be sent at, in bits per second.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - average
+                    type: object
+                  outbound:
+                    description: Outbound limits the traffic sent by the guest.
+                    properties:
+                      average:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Average is the average bit rate of the shaped
+                          traffic, in bits per second (e.g. 10M).
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      burst:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Burst is the amount of data that can be sent
+                          at the peak rate, in bytes (e.g. 1Mi).
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      peak:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Peak is the maximum bit rate the traffic can
+                          be sent at, in bits per second.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - average
+                    type: object
+                type: object
               infoSource:
                 description: 'Specifies the origin of the interface data collected.
                   values: domain, guest-agent, multus-status.'
@@ -13577,6 +14376,12 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy or cancelled depending on other settings. Defaults to 150
                   format: int64
                   type: integer
+                compression:
+                  description: |-
+                    Compression is the compression method used for the memory of a VMI.
+                    zstd requires multifd and is not applied when multifd is not in use. xbzrle disables multifd.
+                    By default, the memory is not compressed.
+                  type: string
                 disableTLS:
                   description: |-
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
@@ -13589,6 +14394,12 @@ var CRDsValidation map[string]string = map[string]string{
                     That will ensure the target virt-launcher doesn't share categories with another pod on the node.
                     However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
                   type: boolean
+                maxDowntimeMilliseconds:
+                  description: |-
+                    MaxDowntimeMilliseconds is the maximum time in milliseconds a VMI may be paused at the end of a
+                    live migration. By default, the hypervisor default of 300 milliseconds is used.
+                  format: int64
+                  type: integer
                 network:
                   description: |-
                     Network is the name of the CNI network to use for live migrations. By default, migrations go
@@ -13599,6 +14410,13 @@ var CRDsValidation map[string]string = map[string]string{
                     NodeDrainTaintKey defines the taint key that indicates a node should be drained.
                     Note: this option relies on the deprecated node taint feature. Default: kubevirt.io/drain
                   type: string
+                parallelMigrationThreads:
+                  description: |-
+                    ParallelMigrationThreads is the number of multifd streams used to transfer the memory of a VMI.
+                    Setting it to 0 disables multifd. By default, 8 streams are used unless the VMI has a CPU limit
+                    or post-copy is allowed.
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: |-
                     ParallelMigrationsPerCluster is the total number of concurrent live migrations
@@ -13693,6 +14511,11 @@ var CRDsValidation map[string]string = map[string]string{
             targetPod:
               description: The target pod that the VMI is moving to
               type: string
+            targetVirtualMachineInstanceUID:
+              description: |-
+                The UID of the VMI receiving the migration in another cluster.
+                Only set on the source of a cross-cluster migration.
+              type: string
           type: object
         migrationTransport:
           description: This represents the migration transport
@@ -13912,163 +14735,1204 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
-        vmiName:
-          description: The name of the VMI to perform the migration on. VMI must exist
-            in the migration objects namespace
-          type: string
-      type: object
-    status:
-      description: VirtualMachineInstanceMigration reprents information pertaining
-        to a VMI's migration.
-      properties:
-        conditions:
-          items:
-            properties:
-              lastProbeTime:
-                format: date-time
-                nullable: true
-                type: string
-              lastTransitionTime:
-                format: date-time
-                nullable: true
-                type: string
-              message:
-                type: string
-              reason:
-                type: string
-              status:
-                type: string
-              type:
-                type: string
-            required:
-            - status
-            - type
-            type: object
-          type: array
-        migrationState:
-          description: Represents the status of a live migration
+        affinity:
+          description: |-
+            Affinity adds scheduling constraints for the migration target.
+            Required node affinity terms are combined with the terms set on the VMI,
+            all other terms are added to the ones set on the VMI.
           properties:
-            abortRequested:
-              description: Indicates that the migration has been requested to abort
-              type: boolean
-            abortStatus:
-              description: Indicates the final status of the live migration abortion
-              type: string
-            completed:
-              description: Indicates the migration completed
-              type: boolean
-            endTimestamp:
-              description: The time the migration action ended
-              format: date-time
-              nullable: true
-              type: string
-            failed:
-              description: Indicates that the migration failed
-              type: boolean
-            failureReason:
-              description: Contains the reason why the migration failed
-              type: string
-            migrationConfiguration:
-              description: Migration configurations to apply
+            nodeAffinity:
+              description: Describes node affinity scheduling rules for the pod.
               properties:
-                allowAutoConverge:
-                  description: |-
-                    AllowAutoConverge allows the platform to compromise performance/availability of VMIs to
-                    guarantee successful VMI live migrations. Defaults to false
-                  type: boolean
-                allowPostCopy:
-                  description: |-
-                    AllowPostCopy enables post-copy live migrations. Such migrations allow even the busiest VMIs
-                    to successfully live-migrate. However, events like a network failure can cause a VMI crash.
-                    If set to true, migrations will still start in pre-copy, but switch to post-copy when
-                    CompletionTimeoutPerGiB triggers. Defaults to false
-                  type: boolean
-                allowWorkloadDisruption:
-                  description: |-
-                    AllowWorkloadDisruption indicates that the migration shouldn't be
-                    canceled after acceptableCompletionTime is exceeded. Instead, if
-                    permitted, migration will be switched to post-copy or the VMI will be
-                    paused to allow the migration to complete
-                  type: boolean
-                bandwidthPerMigration:
-                  anyOf:
-                  - type: integer
-                  - type: string
-                  description: |-
-                    BandwidthPerMigration limits the amount of network bandwidth live migrations are allowed to use.
-                    The value is in quantity per second. Defaults to 0 (no limit)
-                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                  x-kubernetes-int-or-string: true
-                completionTimeoutPerGiB:
-                  description: |-
-                    CompletionTimeoutPerGiB is the maximum number of seconds per GiB a migration is allowed to take.
-                    If the timeout is reached, the migration will be either paused, switched
-                    to post-copy or cancelled depending on other settings. Defaults to 150
-                  format: int64
-                  type: integer
-                disableTLS:
-                  description: |-
-                    When set to true, DisableTLS will disable the additional layer of live migration encryption
-                    provided by KubeVirt. This is usually a bad idea. Defaults to false
-                  type: boolean
-                matchSELinuxLevelOnMigration:
-                  description: |-
-                    By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
-                    When set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.
-                    That will ensure the target virt-launcher doesn't share categories with another pod on the node.
-                    However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
-                  type: boolean
-                network:
-                  description: |-
-                    Network is the name of the CNI network to use for live migrations. By default, migrations go
-                    through the pod network.
-                  type: string
-                nodeDrainTaintKey:
-                  description: |-
-                    NodeDrainTaintKey defines the taint key that indicates a node should be drained.
-                    Note: this option relies on the deprecated node taint feature. Default: kubevirt.io/drain
-                  type: string
-                parallelMigrationsPerCluster:
-                  description: |-
-                    ParallelMigrationsPerCluster is the total number of concurrent live migrations
-                    allowed cluster-wide. Defaults to 5
-                  format: int32
-                  type: integer
-                parallelOutboundMigrationsPerNode:
-                  description: |-
-                    ParallelOutboundMigrationsPerNode is the maximum number of concurrent outgoing live migrations
-                    allowed per node. Defaults to 2
-                  format: int32
-                  type: integer
-                progressTimeout:
-                  description: |-
-                    ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.
-                    Hitting this timeout means a migration transferred 0 data for that many seconds. The migration is
-                    then considered stuck and therefore cancelled. Defaults to 150
-                  format: int64
-                  type: integer
-                unsafeMigrationOverride:
+                preferredDuringSchedulingIgnoredDuringExecution:
                   description: |-
-                    UnsafeMigrationOverride allows live migrations to occur even if the compatibility check
-                    indicates the migration will be unsafe to the guest. Defaults to false
-                  type: boolean
-              type: object
-            migrationPolicyName:
-              description: Name of the migration policy. If string is empty, no policy
-                is matched
-              type: string
-            migrationUid:
-              description: The VirtualMachineInstanceMigration object associated with
-                this migration
-              type: string
-            mode:
-              description: Lets us know if the vmi is currently running pre or post
-                copy migration
-              type: string
-            sourceNode:
-              description: The source node that the VMI originated on
-              type: string
-            sourcePersistentStatePVCName:
+                    The scheduler will prefer to schedule pods to nodes that satisfy
+                    the affinity expressions specified by this field, but it may choose
+                    a node that violates one or more of the expressions. The node that is
+                    most preferred is the one with the greatest sum of weights, i.e.
+                    for each node that meets all of the scheduling requirements (resource
+                    request, requiredDuringScheduling affinity expressions, etc.),
+                    compute a sum by iterating through the elements of this field and adding
+                    "weight" to the sum if the node matches the corresponding matchExpressions; the
+                    node(s) with the highest sum are the most preferred.
+                  items:
+                    description: |-
+                      An empty preferred scheduling term matches all objects with implicit weight 0
+                      (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
+                    properties:
+                      preference:
+                        description: A node selector term, associated with the corresponding
+                          weight.
+                        properties:
+                          matchExpressions:
+                            description: A list of node selector requirements by node's
+                              labels.
+                            items:
+                              description: |-
+                                A node selector requirement is a selector that contains values, a key, and an operator
+                                that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies
+                                    to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    Represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: |-
+                                    An array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. If the operator is Gt or Lt, the values
+                                    array must have a single element, which will be interpreted as an integer.
+                                    This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchFields:
+                            description: A list of node selector requirements by node's
+                              fields.
+                            items:
+                              description: |-
+                                A node selector requirement is a selector that contains values, a key, and an operator
+                                that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies
+                                    to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    Represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: |-
+                                    An array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. If the operator is Gt or Lt, the values
+                                    array must have a single element, which will be interpreted as an integer.
+                                    This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      weight:
+                        description: Weight associated with matching the corresponding
+                          nodeSelectorTerm, in the range 1-100.
+                        format: int32
+                        type: integer
+                    required:
+                    - preference
+                    - weight
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                requiredDuringSchedulingIgnoredDuringExecution:
+                  description: |-
+                    If the affinity requirements specified by this field are not met at
+                    scheduling time, the pod will not be scheduled onto the node.
+                    If the affinity requirements specified by this field cease to be met
+                    at some point during pod execution (e.g. due to an update), the system
+                    may or may not try to eventually evict the pod from its node.
+                  properties:
+                    nodeSelectorTerms:
+                      description: Required. A list of node selector terms. The terms
+                        are ORed.
+                      items:
+                        description: |-
+                          A null or empty node selector term matches no objects. The requirements of
+                          them are ANDed.
+                          The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.
+                        properties:
+                          matchExpressions:
+                            description: A list of node selector requirements by node's
+                              labels.
+                            items:
+                              description: |-
+                                A node selector requirement is a selector that contains values, a key, and an operator
+                                that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies
+                                    to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    Represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: |-
+                                    An array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. If the operator is Gt or Lt, the values
+                                    array must have a single element, which will be interpreted as an integer.
+                                    This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchFields:
+                            description: A list of node selector requirements by node's
+                              fields.
+                            items:
+                              description: |-
+                                A node selector requirement is a selector that contains values, a key, and an operator
+                                that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies
+                                    to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    Represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: |-
+                                    An array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. If the operator is Gt or Lt, the values
+                                    array must have a single element, which will be interpreted as an integer.
+                                    This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - nodeSelectorTerms
+                  type: object
+                  x-kubernetes-map-type: atomic
+              type: object
+            podAffinity:
+              description: Describes pod affinity scheduling rules (e.g. co-locate
+                this pod in the same node, zone, etc. as some other pod(s)).
+              properties:
+                preferredDuringSchedulingIgnoredDuringExecution:
+                  description: |-
+                    The scheduler will prefer to schedule pods to nodes that satisfy
+                    the affinity expressions specified by this field, but it may choose
+                    a node that violates one or more of the expressions. The node that is
+                    most preferred is the one with the greatest sum of weights, i.e.
+                    for each node that meets all of the scheduling requirements (resource
+                    request, requiredDuringScheduling affinity expressions, etc.),
+                    compute a sum by iterating through the elements of this field and adding
+                    "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the
+                    node(s) with the highest sum are the most preferred.
+                  items:
+                    description: The weights of all of the matched WeightedPodAffinityTerm
+                      fields are added per-node to find the most preferred node(s)
+                    properties:
+                      podAffinityTerm:
+                        description: Required. A pod affinity term, associated with
+                          the corresponding weight.
+                        properties:
+                          labelSelector:
+                            description: |-
+                              A label query over a set of resources, in this case pods.
+                              If it's null, this PodAffinityTerm matches with no Pods.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          matchLabelKeys:
+                            description: |-
+                              MatchLabelKeys is a set of pod label keys to select which pods will
+                              be taken into consideration. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                              to select the group of existing pods which pods will be taken into consideration
+                              for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                              pod labels will be ignored. The default value is empty.
+                              The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                              Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                              This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          mismatchLabelKeys:
+                            description: |-
+                              MismatchLabelKeys is a set of pod label keys to select which pods will
+                              be taken into consideration. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                              to select the group of existing pods which pods will be taken into consideration
+                              for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                              pod labels will be ignored. The default value is empty.
+                              The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                              Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                              This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          namespaceSelector:
+                            description: |-
+                              A label query over the set of namespaces that the term applies to.
+                              The term is applied to the union of the namespaces selected by this field
+                              and the ones listed in the namespaces field.
+                              null selector and null or empty namespaces list means "this pod's namespace".
+                              An empty selector ({}) matches all namespaces.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          namespaces:
+                            description: |-
+                              namespaces specifies a static list of namespace names that the term applies to.
+                              The term is applied to the union of the namespaces listed in this field
+                              and the ones selected by namespaceSelector.
+                              null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          topologyKey:
+                            description: |-
+                              This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                              the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                              whose value of the label with key topologyKey matches that of any node on which any of the
+                              selected pods is running.
+                              Empty topologyKey is not allowed.
+                            type: string
+                        required:
+                        - topologyKey
+                        type: object
+                      weight:
+                        description: |-
+                          weight associated with matching the corresponding podAffinityTerm,
+                          in the range 1-100.
+                        format: int32
+                        type: integer
+                    required:
+                    - podAffinityTerm
+                    - weight
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                requiredDuringSchedulingIgnoredDuringExecution:
+                  description: |-
+                    If the affinity requirements specified by this field are not met at
+                    scheduling time, the pod will not be scheduled onto the node.
+                    If the affinity requirements specified by this field cease to be met
+                    at some point during pod execution (e.g. due to a pod label update), the
+                    system may or may not try to eventually evict the pod from its node.
+                    When there are multiple elements, the lists of nodes corresponding to each
+                    podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                  items:
+                    description: |-
+                      Defines a set of pods (namely those matching the labelSelector
+                      relative to the given namespace(s)) that this pod should be
+                      co-located (affinity) or not co-located (anti-affinity) with,
+                      where co-located is defined as running on a node whose value of
+                      the label with key <topologyKey> matches that of any node on which
+                      a pod of the set of pods is running
+                    properties:
+                      labelSelector:
+                        description: |-
+                          A label query over a set of resources, in this case pods.
+                          If it's null, this PodAffinityTerm matches with no Pods.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      matchLabelKeys:
+                        description: |-
+                          MatchLabelKeys is a set of pod label keys to select which pods will
+                          be taken into consideration. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                          to select the group of existing pods which pods will be taken into consideration
+                          for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                          pod labels will be ignored. The default value is empty.
+                          The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                          Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                          This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      mismatchLabelKeys:
+                        description: |-
+                          MismatchLabelKeys is a set of pod label keys to select which pods will
+                          be taken into consideration. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                          to select the group of existing pods which pods will be taken into consideration
+                          for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                          pod labels will be ignored. The default value is empty.
+                          The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                          Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                          This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      namespaceSelector:
+                        description: |-
+                          A label query over the set of namespaces that the term applies to.
+                          The term is applied to the union of the namespaces selected by this field
+                          and the ones listed in the namespaces field.
+                          null selector and null or empty namespaces list means "this pod's namespace".
+                          An empty selector ({}) matches all namespaces.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      namespaces:
+                        description: |-
+                          namespaces specifies a static list of namespace names that the term applies to.
+                          The term is applied to the union of the namespaces listed in this field
+                          and the ones selected by namespaceSelector.
+                          null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      topologyKey:
+                        description: |-
+                          This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                          the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                          whose value of the label with key topologyKey matches that of any node on which any of the
+                          selected pods is running.
+                          Empty topologyKey is not allowed.
+                        type: string
+                    required:
+                    - topologyKey
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+            podAntiAffinity:
+              description: Describes pod anti-affinity scheduling rules (e.g. avoid
+                putting this pod in the same node, zone, etc. as some other pod(s)).
+              properties:
+                preferredDuringSchedulingIgnoredDuringExecution:
+                  description: |-
+                    The scheduler will prefer to schedule pods to nodes that satisfy
+                    the anti-affinity expressions specified by this field, but it may choose
+                    a node that violates one or more of the expressions. The node that is
+                    most preferred is the one with the greatest sum of weights, i.e.
+                    for each node that meets all of the scheduling requirements (resource
+                    request, requiredDuringScheduling anti-affinity expressions, etc.),
+                    compute a sum by iterating through the elements of this field and adding
+                    "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the
+                    node(s) with the highest sum are the most preferred.
+                  items:
+                    description: The weights of all of the matched WeightedPodAffinityTerm
+                      fields are added per-node to find the most preferred node(s)
+                    properties:
+                      podAffinityTerm:
+                        description: Required. A pod affinity term, associated with
+                          the corresponding weight.
+                        properties:
+                          labelSelector:
+                            description: |-
+                              A label query over a set of resources, in this case pods.
+                              If it's null, this PodAffinityTerm matches with no Pods.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          matchLabelKeys:
+                            description: |-
+                              MatchLabelKeys is a set of pod label keys to select which pods will
+                              be taken into consideration. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                              to select the group of existing pods which pods will be taken into consideration
+                              for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                              pod labels will be ignored. The default value is empty.
+                              The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                              Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                              This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          mismatchLabelKeys:
+                            description: |-
+                              MismatchLabelKeys is a set of pod label keys to select which pods will
+                              be taken into consideration. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                              to select the group of existing pods which pods will be taken into consideration
+                              for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                              pod labels will be ignored. The default value is empty.
+                              The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                              Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                              This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          namespaceSelector:
+                            description: |-
+                              A label query over the set of namespaces that the term applies to.
+                              The term is applied to the union of the namespaces selected by this field
+                              and the ones listed in the namespaces field.
+                              null selector and null or empty namespaces list means "this pod's namespace".
+                              An empty selector ({}) matches all namespaces.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          namespaces:
+                            description: |-
+                              namespaces specifies a static list of namespace names that the term applies to.
+                              The term is applied to the union of the namespaces listed in this field
+                              and the ones selected by namespaceSelector.
+                              null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          topologyKey:
+                            description: |-
+                              This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                              the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                              whose value of the label with key topologyKey matches that of any node on which any of the
+                              selected pods is running.
+                              Empty topologyKey is not allowed.
+                            type: string
+                        required:
+                        - topologyKey
+                        type: object
+                      weight:
+                        description: |-
+                          weight associated with matching the corresponding podAffinityTerm,
+                          in the range 1-100.
+                        format: int32
+                        type: integer
+                    required:
+                    - podAffinityTerm
+                    - weight
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                requiredDuringSchedulingIgnoredDuringExecution:
+                  description: |-
+                    If the anti-affinity requirements specified by this field are not met at
+                    scheduling time, the pod will not be scheduled onto the node.
+                    If the anti-affinity requirements specified by this field cease to be met
+                    at some point during pod execution (e.g. due to a pod label update), the
+                    system may or may not try to eventually evict the pod from its node.
+                    When there are multiple elements, the lists of nodes corresponding to each
+                    podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                  items:
+                    description: |-
+                      Defines a set of pods (namely those matching the labelSelector
+                      relative to the given namespace(s)) that this pod should be
+                      co-located (affinity) or not co-located (anti-affinity) with,
+                      where co-located is defined as running on a node whose value of
+                      the label with key <topologyKey> matches that of any node on which
+                      a pod of the set of pods is running
+                    properties:
+                      labelSelector:
+                        description: |-
+                          A label query over a set of resources, in this case pods.
+                          If it's null, this PodAffinityTerm matches with no Pods.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      matchLabelKeys:
+                        description: |-
+                          MatchLabelKeys is a set of pod label keys to select which pods will
+                          be taken into consideration. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                          to select the group of existing pods which pods will be taken into consideration
+                          for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                          pod labels will be ignored. The default value is empty.
+                          The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                          Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                          This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      mismatchLabelKeys:
+                        description: |-
+                          MismatchLabelKeys is a set of pod label keys to select which pods will
+                          be taken into consideration. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                          to select the group of existing pods which pods will be taken into consideration
+                          for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                          pod labels will be ignored. The default value is empty.
+                          The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                          Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                          This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      namespaceSelector:
+                        description: |-
+                          A label query over the set of namespaces that the term applies to.
+                          The term is applied to the union of the namespaces selected by this field
+                          and the ones listed in the namespaces field.
+                          null selector and null or empty namespaces list means "this pod's namespace".
+                          An empty selector ({}) matches all namespaces.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      namespaces:
+                        description: |-
+                          namespaces specifies a static list of namespace names that the term applies to.
+                          The term is applied to the union of the namespaces listed in this field
+                          and the ones selected by namespaceSelector.
+                          null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      topologyKey:
+                        description: |-
+                          This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                          the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                          whose value of the label with key topologyKey matches that of any node on which any of the
+                          selected pods is running.
+                          Empty topologyKey is not allowed.
+                        type: string
+                    required:
+                    - topologyKey
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+          type: object
+        nodeSelector:
+          additionalProperties:
+            type: string
+          description: |-
+            NodeSelector restricts the set of nodes the VMI can be migrated to.
+            It is merged into the node selector of the VMI, in case of key collisions
+            the values set on the VMI are preserved, so the migration can only
+            restrict but not bypass the constraints already set on the VMI.
+            More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+          type: object
+        priority:
+          description: |-
+            Priority defines the order in which pending migrations are started when
+            the parallel migration limits are reached, supported values are:
+            system-critical - Migrations required to evacuate nodes.
+            user-triggered - Migrations requested by users.
+            system-maintenance - Migrations rolling out workload updates.
+            Defaults to system-critical for evacuations, system-maintenance for
+            workload updates and user-triggered for all other migrations.
+            Only users allowed to update the KubeVirt resource can request another
+            priority than user-triggered.
+            Requires the MigrationPriorityQueue feature gate.
+          enum:
+          - system-critical
+          - user-triggered
+          - system-maintenance
+          type: string
+        receive:
+          description: |-
+            Receive prepares the VMI as the target of a migration sent
+            from another cluster. The VMI has to be created with the
+            kubevirt.io/migration-receiver annotation.
+            Mutually exclusive with SendTo.
+          properties:
+            migrationID:
+              description: MigrationID identifies this migration for the sending cluster
+              type: string
+          required:
+          - migrationID
+          type: object
+        retryPolicy:
+          description: |-
+            RetryPolicy defines how the migration is retried when it fails.
+            Takes precedence over the retry policy of a matching MigrationPolicy.
+          properties:
+            allowPostCopyOnRetry:
+              description: |-
+                AllowPostCopyOnRetry allows retries to switch to post-copy when
+                the migration does not converge.
+                Only honored in the retry policy of a MigrationPolicy.
+              type: boolean
+            bandwidthPerMigrationOnRetry:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                BandwidthPerMigrationOnRetry is the bandwidth limit of retries.
+                Only honored in the retry policy of a MigrationPolicy.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            initialBackoffSeconds:
+              description: |-
+                InitialBackoffSeconds is the time to wait before the first retry,
+                it doubles with every further retry. Defaults to 20.
+              format: int64
+              type: integer
+            maxAttempts:
+              description: |-
+                MaxAttempts is the maximum number of attempts to migrate the VMI,
+                including the first one. Defaults to 3.
+              format: int32
+              type: integer
+          type: object
+        sendTo:
+          description: |-
+            SendTo migrates the VMI to another cluster, where a migration with
+            a matching Receive section prepares the target.
+            Mutually exclusive with Receive.
+          properties:
+            connectionSecretRef:
+              description: |-
+                ConnectionSecretRef references a secret in the namespace KubeVirt is installed in,
+                holding a kubeconfig for the target cluster under the "kubeconfig" key.
+                The creator of the migration has to be allowed to get the secret.
+                The receiving migration is looked up in the namespace of the migration.
+              properties:
+                name:
+                  default: ""
+                  description: |-
+                    Name of the referent.
+                    This field is effectively required, but due to backwards compatibility is
+                    allowed to be empty. Instances of this type with an empty value here are
+                    almost certainly wrong.
+                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                  type: string
+              type: object
+              x-kubernetes-map-type: atomic
+            migrationID:
+              description: MigrationID identifies the receiving migration in the target
+                cluster
+              type: string
+          required:
+          - connectionSecretRef
+          - migrationID
+          type: object
+        vmiName:
+          description: The name of the VMI to perform the migration on. VMI must exist
+            in the migration objects namespace
+          type: string
+      type: object
+    status:
+      description: VirtualMachineInstanceMigration reprents information pertaining
+        to a VMI's migration.
+      properties:
+        conditions:
+          items:
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        migrationState:
+          description: Represents the status of a live migration
+          properties:
+            abortRequested:
+              description: Indicates that the migration has been requested to abort
+              type: boolean
+            abortStatus:
+              description: Indicates the final status of the live migration abortion
+              type: string
+            completed:
+              description: Indicates the migration completed
+              type: boolean
+            endTimestamp:
+              description: The time the migration action ended
+              format: date-time
+              nullable: true
+              type: string
+            failed:
+              description: Indicates that the migration failed
+              type: boolean
+            failureReason:
+              description: Contains the reason why the migration failed
+              type: string
+            migrationConfiguration:
+              description: Migration configurations to apply
+              properties:
+                allowAutoConverge:
+                  description: |-
+                    AllowAutoConverge allows the platform to compromise performance/availability of VMIs to
+                    guarantee successful VMI live migrations. Defaults to false
+                  type: boolean
+                allowPostCopy:
+                  description: |-
+                    AllowPostCopy enables post-copy live migrations. Such migrations allow even the busiest VMIs
+                    to successfully live-migrate. However, events like a network failure can cause a VMI crash.
+                    If set to true, migrations will still start in pre-copy, but switch to post-copy when
+                    CompletionTimeoutPerGiB triggers. Defaults to false
+                  type: boolean
+                allowWorkloadDisruption:
+                  description: |-
+                    AllowWorkloadDisruption indicates that the migration shouldn't be
+                    canceled after acceptableCompletionTime is exceeded. Instead, if
+                    permitted, migration will be switched to post-copy or the VMI will be
+                    paused to allow the migration to complete
+                  type: boolean
+                bandwidthPerMigration:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    BandwidthPerMigration limits the amount of network bandwidth live migrations are allowed to use.
+                    The value is in quantity per second. Defaults to 0 (no limit)
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                completionTimeoutPerGiB:
+                  description: |-
+                    CompletionTimeoutPerGiB is the maximum number of seconds per GiB a migration is allowed to take.
+                    If the timeout is reached, the migration will be either paused, switched
+                    to post-copy or cancelled depending on other settings. Defaults to 150
+                  format: int64
+                  type: integer
+                compression:
+                  description: |-
+                    Compression is the compression method used for the memory of a VMI.
+                    zstd requires multifd and is not applied when multifd is not in use. xbzrle disables multifd.
+                    By default, the memory is not compressed.
+                  type: string
+                disableTLS:
+                  description: |-
+                    When set to true, DisableTLS will disable the additional layer of live migration encryption
+                    provided by KubeVirt. This is usually a bad idea. Defaults to false
+                  type: boolean
+                matchSELinuxLevelOnMigration:
+                  description: |-
+                    By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
+                    When set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.
+                    That will ensure the target virt-launcher doesn't share categories with another pod on the node.
+                    However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
+                  type: boolean
+                maxDowntimeMilliseconds:
+                  description: |-
+                    MaxDowntimeMilliseconds is the maximum time in milliseconds a VMI may be paused at the end of a
+                    live migration. By default, the hypervisor default of 300 milliseconds is used.
+                  format: int64
+                  type: integer
+                network:
+                  description: |-
+                    Network is the name of the CNI network to use for live migrations. By default, migrations go
+                    through the pod network.
+                  type: string
+                nodeDrainTaintKey:
+                  description: |-
+                    NodeDrainTaintKey defines the taint key that indicates a node should be drained.
+                    Note: this option relies on the deprecated node taint feature. Default: kubevirt.io/drain
+                  type: string
+                parallelMigrationThreads:
+                  description: |-
+                    ParallelMigrationThreads is the number of multifd streams used to transfer the memory of a VMI.
+                    Setting it to 0 disables multifd. By default, 8 streams are used unless the VMI has a CPU limit
+                    or post-copy is allowed.
+                  format: int32
+                  type: integer
+                parallelMigrationsPerCluster:
+                  description: |-
+                    ParallelMigrationsPerCluster is the total number of concurrent live migrations
+                    allowed cluster-wide. Defaults to 5
+                  format: int32
+                  type: integer
+                parallelOutboundMigrationsPerNode:
+                  description: |-
+                    ParallelOutboundMigrationsPerNode is the maximum number of concurrent outgoing live migrations
+                    allowed per node. Defaults to 2
+                  format: int32
+                  type: integer
+                progressTimeout:
+                  description: |-
+                    ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.
+                    Hitting this timeout means a migration transferred 0 data for that many seconds. The migration is
+                    then considered stuck and therefore cancelled. Defaults to 150
+                  format: int64
+                  type: integer
+                unsafeMigrationOverride:
+                  description: |-
+                    UnsafeMigrationOverride allows live migrations to occur even if the compatibility check
+                    indicates the migration will be unsafe to the guest. Defaults to false
+                  type: boolean
+              type: object
+            migrationPolicyName:
+              description: Name of the migration policy. If string is empty, no policy
+                is matched
+              type: string
+            migrationUid:
+              description: The VirtualMachineInstanceMigration object associated with
+                this migration
+              type: string
+            mode:
+              description: Lets us know if the vmi is currently running pre or post
+                copy migration
+              type: string
+            sourceNode:
+              description: The source node that the VMI originated on
+              type: string
+            sourcePersistentStatePVCName:
               description: If the VMI being migrated uses persistent features (backend-storage),
                 its source PVC name is saved here
               type: string
@@ -14122,6 +15986,11 @@ var CRDsValidation map[string]string = map[string]string{
             targetPod:
               description: The target pod that the VMI is moving to
               type: string
+            targetVirtualMachineInstanceUID:
+              description: |-
+                The UID of the VMI receiving the migration in another cluster.
+                Only set on the source of a cross-cluster migration.
+              type: string
           type: object
         phase:
           description: VirtualMachineInstanceMigrationPhase is a label for the condition
@@ -14147,6 +16016,30 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        retry:
+          description: Retry records the attempts of a migration with a retry policy
+          properties:
+            attempt:
+              description: Attempt is the number of this attempt to migrate the VMI,
+                starting at 1
+              format: int32
+              type: integer
+            backoffUntil:
+              description: BackoffUntil is the time this migration waits for before
+                it starts
+              format: date-time
+              type: string
+            retriedBy:
+              description: RetriedBy is the name of the migration retrying this migration
+                after it failed
+              type: string
+            retryOf:
+              description: RetryOf is the name of the failed migration retried by
+                this migration
+              type: string
+          required:
+          - attempt
+          type: object
       type: object
   required:
   - spec
@@ -14676,6 +16569,73 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          If specified, the traffic of the interface is rate limited accordingly.
+                          Only supported with bridge and masquerade bindings.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average bit rate of the
+                                  shaped traffic, in bits per second (e.g. 10M).
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of data that can
+                                  be sent at the peak rate, in bytes (e.g. 1Mi).
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the maximum bit rate the traffic
+                                  can be sent at, in bits per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average bit rate of the
+                                  shaped traffic, in bits per second (e.g. 10M).
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of data that can
+                                  be sent at the peak rate, in bytes (e.g. 1Mi).
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the maximum bit rate the traffic
+                                  can be sent at, in bits per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -14706,12 +16666,43 @@ var CRDsValidation map[string]string = map[string]string{
                           additional DHCP options to the VMI
                         properties:
                           bootFileName:
-                            description: If specified will pass option 67 to interface's
-                              DHCP server
+                            description: |-
+                              If specified will pass option 67 to interface's DHCP server.
+                              The DHCPv6 server passes it as the boot file URL option 59 (RFC 5970), served from
+                              the TFTP server unless it is a URL.
                             type: string
+                          dhcpv6Options:
+                            description: |-
+                              If specified will pass extra options to interface's DHCPv6 server.
+                              DHCPv6 has no range of options for private use, see DHCPv6Option for the allowed option codes.
+                              Prefix delegation (IA_PD) is not offered by the DHCPv6 server.
+                            items:
+                              description: DHCPv6Option defines an extra DHCPv6 option
+                                for a VM.
+                              properties:
+                                option:
+                                  description: |-
+                                    Option is the DHCPv6 option code, an Integer value from 1-65535.
+                                    The options set by the DHCPv6 server itself cannot be specified: client and server identifiers (1, 2),
+                                    address assignment (3, 4, 5, 13, 25, 26), DNS servers (23), domain search list (24),
+                                    NTP servers (56) and boot file URL (59).
+                                    Required.
+                                  type: integer
+                                value:
+                                  description: |-
+                                    Value is a String value for the Option provided
+                                    Required.
+                                  type: string
+                              required:
+                              - option
+                              - value
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                           ntpServers:
-                            description: If specified will pass the configured NTP
-                              server to the VM via DHCP option 042.
+                            description: |-
+                              If specified will pass the configured NTP server to the VM via DHCP option 042.
+                              IPv6 NTP servers are passed via DHCPv6 option 56.
                             items:
                               type: string
                             type: array
@@ -14820,12 +16811,111 @@ var CRDsValidation map[string]string = map[string]string{
                       state:
                         description: |-
                           State represents the requested operational state of the interface.
-                          The (only) value supported is 'absent', expressing a request to remove the interface.
+                          The values supported are:
+                          'absent', expressing a request to remove the interface.
+                          'down', expressing a request to set the link of the interface down.
+                          'up', expressing a request to set the link of the interface up (the default).
                         type: string
                       tag:
                         description: If specified, the virtual network interface address
                           and its tag will be provided to the guest via config drive
                         type: string
+                      trafficFilter:
+                        description: |-
+                          If specified, the traffic of the interface is filtered accordingly.
+                          Only supported with bridge binding.
+                        properties:
+                          allowedIPAddresses:
+                            description: |-
+                              AllowedIPAddresses are the source IP addresses or CIDRs the guest is allowed to send packets with.
+                              When specified, packets with any other source IP address are dropped. IPv6 link-local addresses
+                              and DHCP requests of the guest are always allowed.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          allowedMACAddresses:
+                            description: |-
+                              AllowedMACAddresses are the source MAC addresses the guest is allowed to send frames with.
+                              Defaults to the MAC address of the interface, if specified.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          dhcpServerGuard:
+                            description: DHCPServerGuard drops DHCP server messages
+                              and IPv6 router advertisements sent by the guest.
+                            type: boolean
+                          egressPorts:
+                            description: |-
+                              EgressPorts are the ports the guest is allowed to open new connections to.
+                              When specified, any other TCP and UDP traffic from the guest is dropped, except for DHCP requests.
+                            items:
+                              description: |-
+                                Port represents a port to expose from the virtual machine.
+                                Default protocol TCP.
+                                The port field is mandatory
+                              properties:
+                                name:
+                                  description: |-
+                                    If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                    named port in a pod must have a unique name. Name for the port that can be
+                                    referred to by services.
+                                  type: string
+                                port:
+                                  description: |-
+                                    Number of port to expose for the virtual machine.
+                                    This must be a valid port number, 0 < x < 65536.
+                                  format: int32
+                                  type: integer
+                                protocol:
+                                  description: |-
+                                    Protocol for port. Must be UDP or TCP.
+                                    Defaults to "TCP".
+                                  type: string
+                              required:
+                              - port
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          ingressPorts:
+                            description: |-
+                              IngressPorts are the ports the guest is allowed to receive new connections on.
+                              When specified, any other TCP and UDP traffic towards the guest is dropped.
+                            items:
+                              description: |-
+                                Port represents a port to expose from the virtual machine.
+                                Default protocol TCP.
+                                The port field is mandatory
+                              properties:
+                                name:
+                                  description: |-
+                                    If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                    named port in a pod must have a unique name. Name for the port that can be
+                                    referred to by services.
+                                  type: string
+                                port:
+                                  description: |-
+                                    Number of port to expose for the virtual machine.
+                                    This must be a valid port number, 0 < x < 65536.
+                                  format: int32
+                                  type: integer
+                                protocol:
+                                  description: |-
+                                    Protocol for port. Must be UDP or TCP.
+                                    Defaults to "TCP".
+                                  type: string
+                              required:
+                              - port
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          neighborDiscoveryProtection:
+                            description: |-
+                              NeighborDiscoveryProtection drops ARP messages and IPv6 neighbor advertisements sent by the guest
+                              on behalf of addresses which are not allowed.
+                            type: boolean
+                        type: object
                     required:
                     - name
                     type: object
@@ -17090,6 +19180,80 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: |-
+                                  If specified, the traffic of the interface is rate limited accordingly.
+                                  Only supported with bridge and masquerade bindings.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average bit rate
+                                          of the shaped traffic, in bits per second
+                                          (e.g. 10M).
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of data that
+                                          can be sent at the peak rate, in bytes (e.g.
+                                          1Mi).
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the maximum bit rate
+                                          the traffic can be sent at, in bits per
+                                          second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average bit rate
+                                          of the shaped traffic, in bits per second
+                                          (e.g. 10M).
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of data that
+                                          can be sent at the peak rate, in bytes (e.g.
+                                          1Mi).
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the maximum bit rate
+                                          the traffic can be sent at, in bits per
+                                          second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -17120,12 +19284,43 @@ var CRDsValidation map[string]string = map[string]string{
                                   pass additional DHCP options to the VMI
                                 properties:
                                   bootFileName:
-                                    description: If specified will pass option 67
-                                      to interface's DHCP server
+                                    description: |-
+                                      If specified will pass option 67 to interface's DHCP server.
+                                      The DHCPv6 server passes it as the boot file URL option 59 (RFC 5970), served from
+                                      the TFTP server unless it is a URL.
                                     type: string
+                                  dhcpv6Options:
+                                    description: |-
+                                      If specified will pass extra options to interface's DHCPv6 server.
+                                      DHCPv6 has no range of options for private use, see DHCPv6Option for the allowed option codes.
+                                      Prefix delegation (IA_PD) is not offered by the DHCPv6 server.
+                                    items:
+                                      description: DHCPv6Option defines an extra DHCPv6
+                                        option for a VM.
+                                      properties:
+                                        option:
+                                          description: |-
+                                            Option is the DHCPv6 option code, an Integer value from 1-65535.
+                                            The options set by the DHCPv6 server itself cannot be specified: client and server identifiers (1, 2),
+                                            address assignment (3, 4, 5, 13, 25, 26), DNS servers (23), domain search list (24),
+                                            NTP servers (56) and boot file URL (59).
+                                            Required.
+                                          type: integer
+                                        value:
+                                          description: |-
+                                            Value is a String value for the Option provided
+                                            Required.
+                                          type: string
+                                      required:
+                                      - option
+                                      - value
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                   ntpServers:
-                                    description: If specified will pass the configured
-                                      NTP server to the VM via DHCP option 042.
+                                    description: |-
+                                      If specified will pass the configured NTP server to the VM via DHCP option 042.
+                                      IPv6 NTP servers are passed via DHCPv6 option 56.
                                     items:
                                       type: string
                                     type: array
@@ -17234,13 +19429,113 @@ var CRDsValidation map[string]string = map[string]string{
                               state:
                                 description: |-
                                   State represents the requested operational state of the interface.
-                                  The (only) value supported is 'absent', expressing a request to remove the interface.
+                                  The values supported are:
+                                  'absent', expressing a request to remove the interface.
+                                  'down', expressing a request to set the link of the interface down.
+                                  'up', expressing a request to set the link of the interface up (the default).
                                 type: string
                               tag:
                                 description: If specified, the virtual network interface
                                   address and its tag will be provided to the guest
                                   via config drive
                                 type: string
+                              trafficFilter:
+                                description: |-
+                                  If specified, the traffic of the interface is filtered accordingly.
+                                  Only supported with bridge binding.
+                                properties:
+                                  allowedIPAddresses:
+                                    description: |-
+                                      AllowedIPAddresses are the source IP addresses or CIDRs the guest is allowed to send packets with.
+                                      When specified, packets with any other source IP address are dropped. IPv6 link-local addresses
+                                      and DHCP requests of the guest are always allowed.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  allowedMACAddresses:
+                                    description: |-
+                                      AllowedMACAddresses are the source MAC addresses the guest is allowed to send frames with.
+                                      Defaults to the MAC address of the interface, if specified.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  dhcpServerGuard:
+                                    description: DHCPServerGuard drops DHCP server
+                                      messages and IPv6 router advertisements sent
+                                      by the guest.
+                                    type: boolean
+                                  egressPorts:
+                                    description: |-
+                                      EgressPorts are the ports the guest is allowed to open new connections to.
+                                      When specified, any other TCP and UDP traffic from the guest is dropped, except for DHCP requests.
+                                    items:
+                                      description: |-
+                                        Port represents a port to expose from the virtual machine.
+                                        Default protocol TCP.
+                                        The port field is mandatory
+                                      properties:
+                                        name:
+                                          description: |-
+                                            If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                            named port in a pod must have a unique name. Name for the port that can be
+                                            referred to by services.
+                                          type: string
+                                        port:
+                                          description: |-
+                                            Number of port to expose for the virtual machine.
+                                            This must be a valid port number, 0 < x < 65536.
+                                          format: int32
+                                          type: integer
+                                        protocol:
+                                          description: |-
+                                            Protocol for port. Must be UDP or TCP.
+                                            Defaults to "TCP".
+                                          type: string
+                                      required:
+                                      - port
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  ingressPorts:
+                                    description: |-
+                                      IngressPorts are the ports the guest is allowed to receive new connections on.
+                                      When specified, any other TCP and UDP traffic towards the guest is dropped.
+                                    items:
+                                      description: |-
+                                        Port represents a port to expose from the virtual machine.
+                                        Default protocol TCP.
+                                        The port field is mandatory
+                                      properties:
+                                        name:
+                                          description: |-
+                                            If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                            named port in a pod must have a unique name. Name for the port that can be
+                                            referred to by services.
+                                          type: string
+                                        port:
+                                          description: |-
+                                            Number of port to expose for the virtual machine.
+                                            This must be a valid port number, 0 < x < 65536.
+                                          format: int32
+                                          type: integer
+                                        protocol:
+                                          description: |-
+                                            Protocol for port. Must be UDP or TCP.
+                                            Defaults to "TCP".
+                                          type: string
+                                      required:
+                                      - port
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  neighborDiscoveryProtection:
+                                    description: |-
+                                      NeighborDiscoveryProtection drops ARP messages and IPv6 neighbor advertisements sent by the guest
+                                      on behalf of addresses which are not allowed.
+                                    type: boolean
+                                type: object
                             required:
                             - name
                             type: object
@@ -17922,6 +20217,21 @@ var CRDsValidation map[string]string = map[string]string{
                       format: int32
                       type: integer
                   type: object
+                metadataService:
+                  description: |-
+                    MetadataService exposes an EC2 and, optionally, an OpenStack compatible metadata service
+                    to the guest, on the link-local address 169.254.169.254.
+                    The EC2 metadata is served to IMDSv2 clients only, presenting a session token.
+                    The OpenStack metadata, when enabled, is served without a session token.
+                    Requires the pod network to use the masquerade binding.
+                  properties:
+                    openStack:
+                      description: |-
+                        OpenStack also serves the OpenStack metadata tree, under /openstack.
+                        The OpenStack data source of cloud-init does not use session tokens, so this tree,
+                        including the SSH public keys and the user data, is served to any client of the guest.
+                      type: object
+                  type: object
                 networks:
                   description: List of networks that can be attached to a vm's virtual
                     interface.
@@ -18706,6 +21016,12 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          format:
+                            description: |-
+                              Format is the format of the memory dump, defaults to Raw.
+                              A volume which is not hotpluggable and holds a SaveState dump is used
+                              to resume the VMI on start.
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -19145,9 +21461,58 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
+        autohealing:
+          description: |-
+            Autohealing replaces the VMs whose running VMIs stay unhealthy for too long.
+            By default, unhealthy VMs are not replaced.
+          properties:
+            healthChecks:
+              description: |-
+                HealthChecks the running VMIs of the pool have to pass to be healthy.
+                Can contain "Ready" and "AgentConnected". Defaults to ["Ready"].
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
+            maxConcurrentReplacements:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                MaxConcurrentReplacements is the maximum number of VMs which are replaced
+                at the same time. A VM counts as being replaced until it is deleted.
+                Value can be an absolute number (ex: 5) or a percentage of the desired
+                replicas (ex: 10%). The absolute number is calculated from the percentage
+                by rounding up. Defaults to 1.
+              x-kubernetes-int-or-string: true
+            unhealthyTimeout:
+              description: |-
+                UnhealthyTimeout is the duration a running VMI has to fail its health checks
+                before its VM is replaced. Defaults to 5m.
+              type: string
+          type: object
         paused:
           description: Indicates that the pool is paused.
           type: boolean
+        persistentVolumeClaimRetentionPolicy:
+          description: |-
+            PersistentVolumeClaimRetentionPolicy describes the lifecycle of the volumes
+            created from the data volume templates of the VMs. When set, the pool scales
+            in starting with the VM with the highest ordinal, like a StatefulSet does.
+            By default, the volumes are deleted together with their VM.
+          properties:
+            whenDeleted:
+              description: |-
+                WhenDeleted specifies what happens to the volumes when the pool is deleted.
+                Can be "Retain" or "Delete". Defaults to "Delete".
+              type: string
+            whenScaled:
+              description: |-
+                WhenScaled specifies what happens to the volumes of the VMs which are
+                removed when the pool is scaled in. Can be "Retain" or "Delete".
+                Defaults to "Delete".
+              type: string
+          type: object
         replicas:
           description: |-
             Number of desired pods. This is a pointer to distinguish between explicit
@@ -19201,6 +21566,52 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
           type: object
           x-kubernetes-map-type: atomic
+        updateStrategy:
+          description: |-
+            UpdateStrategy describes how changes to the virtual machine template are
+            rolled out to running VMIs. When unset, all outdated VMIs are restarted at once.
+          properties:
+            rollingUpdate:
+              description: RollingUpdate configures the rolling update. Only allowed
+                with the "RollingUpdate" type.
+              properties:
+                liveUpdate:
+                  description: |-
+                    LiveUpdate applies template changes which only touch live-updatable fields,
+                    like CPU sockets, guest memory, node selector, affinity and tolerations,
+                    to the running VMIs without restarting them. The VMIs get migrated when
+                    this is required to apply the changes. Requires the LiveUpdate VM rollout
+                    strategy, otherwise the VMIs are restarted.
+                  type: boolean
+                maxSurge:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    The maximum number of VMs which can be created above the desired replicas
+                    during the update. Value can be an absolute number (ex: 5) or a percentage
+                    of the desired replicas (ex: 10%). The absolute number is calculated from
+                    the percentage by rounding up.
+                    Defaults to 0.
+                  x-kubernetes-int-or-string: true
+                maxUnavailable:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    The maximum number of VMs which can be unavailable during the update.
+                    Value can be an absolute number (ex: 5) or a percentage of the desired
+                    replicas (ex: 10%). The absolute number is calculated from the percentage
+                    by rounding down. Can not be 0 if maxSurge is 0.
+                    Defaults to 1.
+                  x-kubernetes-int-or-string: true
+              type: object
+            type:
+              description: |-
+                Type of the update strategy. Can be "RollingUpdate" or "OnDelete".
+                Defaults to "RollingUpdate".
+              type: string
+          type: object
         virtualMachineTemplate:
           description: Template describes the VM that will be created.
           properties:
@@ -21570,6 +23981,80 @@ var CRDsValidation map[string]string = map[string]string{
                                           in PCI addresses assigned to the device.
                                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                         type: integer
+                                      bandwidth:
+                                        description: |-
+                                          If specified, the traffic of the interface is rate limited accordingly.
+                                          Only supported with bridge and masquerade bindings.
+                                        properties:
+                                          inbound:
+                                            description: Inbound limits the traffic
+                                              received by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Average is the average
+                                                  bit rate of the shaped traffic,
+                                                  in bits per second (e.g. 10M).
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Burst is the amount of
+                                                  data that can be sent at the peak
+                                                  rate, in bytes (e.g. 1Mi).
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Peak is the maximum bit
+                                                  rate the traffic can be sent at,
+                                                  in bits per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                          outbound:
+                                            description: Outbound limits the traffic
+                                              sent by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Average is the average
+                                                  bit rate of the shaped traffic,
+                                                  in bits per second (e.g. 10M).
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Burst is the amount of
+                                                  data that can be sent at the peak
+                                                  rate, in bytes (e.g. 1Mi).
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Peak is the maximum bit
+                                                  rate the traffic can be sent at,
+                                                  in bits per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                        type: object
                                       binding:
                                         description: |-
                                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -21601,13 +24086,43 @@ var CRDsValidation map[string]string = map[string]string{
                                           VMI
                                         properties:
                                           bootFileName:
-                                            description: If specified will pass option
-                                              67 to interface's DHCP server
+                                            description: |-
+                                              If specified will pass option 67 to interface's DHCP server.
+                                              The DHCPv6 server passes it as the boot file URL option 59 (RFC 5970), served from
+                                              the TFTP server unless it is a URL.
                                             type: string
+                                          dhcpv6Options:
+                                            description: |-
+                                              If specified will pass extra options to interface's DHCPv6 server.
+                                              DHCPv6 has no range of options for private use, see DHCPv6Option for the allowed option codes.
+                                              Prefix delegation (IA_PD) is not offered by the DHCPv6 server.
+                                            items:
+                                              description: DHCPv6Option defines an
+                                                extra DHCPv6 option for a VM.
+                                              properties:
+                                                option:
+                                                  description: |-
+                                                    Option is the DHCPv6 option code, an Integer value from 1-65535.
+                                                    The options set by the DHCPv6 server itself cannot be specified: client and server identifiers (1, 2),
+                                                    address assignment (3, 4, 5, 13, 25, 26), DNS servers (23), domain search list (24),
+                                                    NTP servers (56) and boot file URL (59).
+                                                    Required.
+                                                  type: integer
+                                                value:
+                                                  description: |-
+                                                    Value is a String value for the Option provided
+                                                    Required.
+                                                  type: string
+                                              required:
+                                              - option
+                                              - value
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
                                           ntpServers:
-                                            description: If specified will pass the
-                                              configured NTP server to the VM via
-                                              DHCP option 042.
+                                            description: |-
+                                              If specified will pass the configured NTP server to the VM via DHCP option 042.
+                                              IPv6 NTP servers are passed via DHCPv6 option 56.
                                             items:
                                               type: string
                                             type: array
@@ -21720,13 +24235,113 @@ var CRDsValidation map[string]string = map[string]string{
                                       state:
                                         description: |-
                                           State represents the requested operational state of the interface.
-                                          The (only) value supported is 'absent', expressing a request to remove the interface.
+                                          The values supported are:
+                                          'absent', expressing a request to remove the interface.
+                                          'down', expressing a request to set the link of the interface down.
+                                          'up', expressing a request to set the link of the interface up (the default).
                                         type: string
                                       tag:
                                         description: If specified, the virtual network
                                           interface address and its tag will be provided
                                           to the guest via config drive
                                         type: string
+                                      trafficFilter:
+                                        description: |-
+                                          If specified, the traffic of the interface is filtered accordingly.
+                                          Only supported with bridge binding.
+                                        properties:
+                                          allowedIPAddresses:
+                                            description: |-
+                                              AllowedIPAddresses are the source IP addresses or CIDRs the guest is allowed to send packets with.
+                                              When specified, packets with any other source IP address are dropped. IPv6 link-local addresses
+                                              and DHCP requests of the guest are always allowed.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          allowedMACAddresses:
+                                            description: |-
+                                              AllowedMACAddresses are the source MAC addresses the guest is allowed to send frames with.
+                                              Defaults to the MAC address of the interface, if specified.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          dhcpServerGuard:
+                                            description: DHCPServerGuard drops DHCP
+                                              server messages and IPv6 router advertisements
+                                              sent by the guest.
+                                            type: boolean
+                                          egressPorts:
+                                            description: |-
+                                              EgressPorts are the ports the guest is allowed to open new connections to.
+                                              When specified, any other TCP and UDP traffic from the guest is dropped, except for DHCP requests.
+                                            items:
+                                              description: |-
+                                                Port represents a port to expose from the virtual machine.
+                                                Default protocol TCP.
+                                                The port field is mandatory
+                                              properties:
+                                                name:
+                                                  description: |-
+                                                    If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                                    named port in a pod must have a unique name. Name for the port that can be
+                                                    referred to by services.
+                                                  type: string
+                                                port:
+                                                  description: |-
+                                                    Number of port to expose for the virtual machine.
+                                                    This must be a valid port number, 0 < x < 65536.
+                                                  format: int32
+                                                  type: integer
+                                                protocol:
+                                                  description: |-
+                                                    Protocol for port. Must be UDP or TCP.
+                                                    Defaults to "TCP".
+                                                  type: string
+                                              required:
+                                              - port
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          ingressPorts:
+                                            description: |-
+                                              IngressPorts are the ports the guest is allowed to receive new connections on.
+                                              When specified, any other TCP and UDP traffic towards the guest is dropped.
+                                            items:
+                                              description: |-
+                                                Port represents a port to expose from the virtual machine.
+                                                Default protocol TCP.
+                                                The port field is mandatory
+                                              properties:
+                                                name:
+                                                  description: |-
+                                                    If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                                    named port in a pod must have a unique name. Name for the port that can be
+                                                    referred to by services.
+                                                  type: string
+                                                port:
+                                                  description: |-
+                                                    Number of port to expose for the virtual machine.
+                                                    This must be a valid port number, 0 < x < 65536.
+                                                  format: int32
+                                                  type: integer
+                                                protocol:
+                                                  description: |-
+                                                    Protocol for port. Must be UDP or TCP.
+                                                    Defaults to "TCP".
+                                                  type: string
+                                              required:
+                                              - port
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          neighborDiscoveryProtection:
+                                            description: |-
+                                              NeighborDiscoveryProtection drops ARP messages and IPv6 neighbor advertisements sent by the guest
+                                              on behalf of addresses which are not allowed.
+                                            type: boolean
+                                        type: object
                                     required:
                                     - name
                                     type: object
@@ -22419,6 +25034,21 @@ var CRDsValidation map[string]string = map[string]string{
                               format: int32
                               type: integer
                           type: object
+                        metadataService:
+                          description: |-
+                            MetadataService exposes an EC2 and, optionally, an OpenStack compatible metadata service
+                            to the guest, on the link-local address 169.254.169.254.
+                            The EC2 metadata is served to IMDSv2 clients only, presenting a session token.
+                            The OpenStack metadata, when enabled, is served without a session token.
+                            Requires the pod network to use the masquerade binding.
+                          properties:
+                            openStack:
+                              description: |-
+                                OpenStack also serves the OpenStack metadata tree, under /openstack.
+                                The OpenStack data source of cloud-init does not use session tokens, so this tree,
+                                including the SSH public keys and the user data, is served to any client of the guest.
+                              type: object
+                          type: object
                         networks:
                           description: List of networks that can be attached to a
                             vm's virtual interface.
@@ -23212,6 +25842,12 @@ var CRDsValidation map[string]string = map[string]string{
                                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                     type: string
+                                  format:
+                                    description: |-
+                                      Format is the format of the memory dump, defaults to Raw.
+                                      A volume which is not hotpluggable and holds a SaveState dump is used
+                                      to resume the VMI on start.
+                                    type: string
                                   hotpluggable:
                                     description: Hotpluggable indicates whether the
                                       volume can be hotplugged and hotunplugged.
@@ -23374,10 +26010,28 @@ var CRDsValidation map[string]string = map[string]string{
           description: Canonical form of the label selector for HPA which consumes
             it through the scale subresource.
           type: string
+        outdatedReplicas:
+          description: |-
+            OutdatedReplicas is the number of VMs which, or whose running VMIs,
+            still have to be updated to the current virtual machine template of the pool.
+          format: int32
+          type: integer
         readyReplicas:
           format: int32
           type: integer
-        replicas:
+        replicas:
+          format: int32
+          type: integer
+        unhealthyReplicas:
+          description: |-
+            UnhealthyReplicas is the number of VMs whose running VMIs fail the health
+            checks of the autohealing configuration.
+          format: int32
+          type: integer
+        updatedReplicas:
+          description: |-
+            UpdatedReplicas is the number of VMs which, including their running VMIs,
+            match the current virtual machine template of the pool.
           format: int32
           type: integer
       type: object
@@ -24112,6 +26766,61 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         virtualMachineSnapshotName:
           type: string
+        volumeRestoreMode:
+          description: |-
+            VolumeRestoreMode specifies how the volumes listed in Volumes are restored.
+            Defaults to InPlace.
+          type: string
+        volumeRestoreOverrides:
+          description: |-
+            VolumeRestoreOverrides gives the option to change properties of each restored volume,
+            for example the name of the restored PVC or its storage class.
+            If a volume has no override, the restored PVC gets a generated name and the
+            properties of the PVC in the snapshot.
+          items:
+            description: VolumeRestoreOverride specifies how a volume of the snapshot
+              should be restored
+            properties:
+              annotations:
+                additionalProperties:
+                  type: string
+                description: Annotations are added to the annotations of the restored
+                  PVC
+                type: object
+              labels:
+                additionalProperties:
+                  type: string
+                description: Labels are added to the labels of the restored PVC
+                type: object
+              restoreName:
+                description: |-
+                  RestoreName is the name of the restored PVC.
+                  If it is not specified, a name is generated.
+                type: string
+              storageClassName:
+                description: |-
+                  StorageClassName is the storage class of the restored PVC.
+                  If it is not specified, the storage class of the PVC in the snapshot is used.
+                  The storage class has to be able to provision volumes from the volume snapshot.
+                type: string
+              volumeName:
+                description: VolumeName is the name of the volume in the VM spec of
+                  the snapshot
+                type: string
+            required:
+            - volumeName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        volumes:
+          description: |-
+            Volumes limits the restore to the listed volumes of the snapshot.
+            If it is set, only the listed volumes are restored and the spec of the target
+            is kept, apart from the restored volumes when they are restored in place.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
       required:
       - target
       - virtualMachineSnapshotName
@@ -24216,6 +26925,13 @@ var CRDsValidation map[string]string = map[string]string{
             as failed.
             Defaults to DefaultFailureDeadline - 5min
           type: string
+        includeMemory:
+          description: |-
+            IncludeMemory saves the guest memory and device state of a running vm
+            to a PVC which is snapshotted along with the disks. The vm is paused
+            while the snapshot is taken instead of being frozen.
+            A vm restored from such a snapshot resumes where it was on its next start.
+          type: boolean
         source:
           description: |-
             TypedLocalObjectReference contains enough information to let you locate the
@@ -26756,6 +29472,82 @@ var CRDsValidation map[string]string = map[string]string{
                                               in PCI addresses assigned to the device.
                                               This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                             type: integer
+                                          bandwidth:
+                                            description: |-
+                                              If specified, the traffic of the interface is rate limited accordingly.
+                                              Only supported with bridge and masquerade bindings.
+                                            properties:
+                                              inbound:
+                                                description: Inbound limits the traffic
+                                                  received by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Average is the average
+                                                      bit rate of the shaped traffic,
+                                                      in bits per second (e.g. 10M).
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Burst is the amount
+                                                      of data that can be sent at
+                                                      the peak rate, in bytes (e.g.
+                                                      1Mi).
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Peak is the maximum
+                                                      bit rate the traffic can be
+                                                      sent at, in bits per second.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                              outbound:
+                                                description: Outbound limits the traffic
+                                                  sent by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Average is the average
+                                                      bit rate of the shaped traffic,
+                                                      in bits per second (e.g. 10M).
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Burst is the amount
+                                                      of data that can be sent at
+                                                      the peak rate, in bytes (e.g.
+                                                      1Mi).
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Peak is the maximum
+                                                      bit rate the traffic can be
+                                                      sent at, in bits per second.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                            type: object
                                           binding:
                                             description: |-
                                               Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -26787,13 +29579,43 @@ var CRDsValidation map[string]string = map[string]string{
                                               options to the VMI
                                             properties:
                                               bootFileName:
-                                                description: If specified will pass
-                                                  option 67 to interface's DHCP server
+                                                description: |-
+                                                  If specified will pass option 67 to interface's DHCP server.
+                                                  The DHCPv6 server passes it as the boot file URL option 59 (RFC 5970), served from
+                                                  the TFTP server unless it is a URL.
                                                 type: string
+                                              dhcpv6Options:
+                                                description: |-
+                                                  If specified will pass extra options to interface's DHCPv6 server.
+                                                  DHCPv6 has no range of options for private use, see DHCPv6Option for the allowed option codes.
+                                                  Prefix delegation (IA_PD) is not offered by the DHCPv6 server.
+                                                items:
+                                                  description: DHCPv6Option defines
+                                                    an extra DHCPv6 option for a VM.
+                                                  properties:
+                                                    option:
+                                                      description: |-
+                                                        Option is the DHCPv6 option code, an Integer value from 1-65535.
+                                                        The options set by the DHCPv6 server itself cannot be specified: client and server identifiers (1, 2),
+                                                        address assignment (3, 4, 5, 13, 25, 26), DNS servers (23), domain search list (24),
+                                                        NTP servers (56) and boot file URL (59).
+                                                        Required.
+                                                      type: integer
+                                                    value:
+                                                      description: |-
+                                                        Value is a String value for the Option provided
+                                                        Required.
+                                                      type: string
+                                                  required:
+                                                  - option
+                                                  - value
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
                                               ntpServers:
-                                                description: If specified will pass
-                                                  the configured NTP server to the
-                                                  VM via DHCP option 042.
+                                                description: |-
+                                                  If specified will pass the configured NTP server to the VM via DHCP option 042.
+                                                  IPv6 NTP servers are passed via DHCPv6 option 56.
                                                 items:
                                                   type: string
                                                 type: array
@@ -26906,7 +29728,10 @@ var CRDsValidation map[string]string = map[string]string{
                                           state:
                                             description: |-
                                               State represents the requested operational state of the interface.
-                                              The (only) value supported is 'absent', expressing a request to remove the interface.
+                                              The values supported are:
+                                              'absent', expressing a request to remove the interface.
+                                              'down', expressing a request to set the link of the interface down.
+                                              'up', expressing a request to set the link of the interface up (the default).
                                             type: string
                                           tag:
                                             description: If specified, the virtual
@@ -26914,6 +29739,103 @@ var CRDsValidation map[string]string = map[string]string{
                                               will be provided to the guest via config
                                               drive
                                             type: string
+                                          trafficFilter:
+                                            description: |-
+                                              If specified, the traffic of the interface is filtered accordingly.
+                                              Only supported with bridge binding.
+                                            properties:
+                                              allowedIPAddresses:
+                                                description: |-
+                                                  AllowedIPAddresses are the source IP addresses or CIDRs the guest is allowed to send packets with.
+                                                  When specified, packets with any other source IP address are dropped. IPv6 link-local addresses
+                                                  and DHCP requests of the guest are always allowed.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              allowedMACAddresses:
+                                                description: |-
+                                                  AllowedMACAddresses are the source MAC addresses the guest is allowed to send frames with.
+                                                  Defaults to the MAC address of the interface, if specified.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              dhcpServerGuard:
+                                                description: DHCPServerGuard drops
+                                                  DHCP server messages and IPv6 router
+                                                  advertisements sent by the guest.
+                                                type: boolean
+                                              egressPorts:
+                                                description: |-
+                                                  EgressPorts are the ports the guest is allowed to open new connections to.
+                                                  When specified, any other TCP and UDP traffic from the guest is dropped, except for DHCP requests.
+                                                items:
+                                                  description: |-
+                                                    Port represents a port to expose from the virtual machine.
+                                                    Default protocol TCP.
+                                                    The port field is mandatory
+                                                  properties:
+                                                    name:
+                                                      description: |-
+                                                        If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                                        named port in a pod must have a unique name. Name for the port that can be
+                                                        referred to by services.
+                                                      type: string
+                                                    port:
+                                                      description: |-
+                                                        Number of port to expose for the virtual machine.
+                                                        This must be a valid port number, 0 < x < 65536.
+                                                      format: int32
+                                                      type: integer
+                                                    protocol:
+                                                      description: |-
+                                                        Protocol for port. Must be UDP or TCP.
+                                                        Defaults to "TCP".
+                                                      type: string
+                                                  required:
+                                                  - port
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              ingressPorts:
+                                                description: |-
+                                                  IngressPorts are the ports the guest is allowed to receive new connections on.
+                                                  When specified, any other TCP and UDP traffic towards the guest is dropped.
+                                                items:
+                                                  description: |-
+                                                    Port represents a port to expose from the virtual machine.
+                                                    Default protocol TCP.
+                                                    The port field is mandatory
+                                                  properties:
+                                                    name:
+                                                      description: |-
+                                                        If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                                        named port in a pod must have a unique name. Name for the port that can be
+                                                        referred to by services.
+                                                      type: string
+                                                    port:
+                                                      description: |-
+                                                        Number of port to expose for the virtual machine.
+                                                        This must be a valid port number, 0 < x < 65536.
+                                                      format: int32
+                                                      type: integer
+                                                    protocol:
+                                                      description: |-
+                                                        Protocol for port. Must be UDP or TCP.
+                                                        Defaults to "TCP".
+                                                      type: string
+                                                  required:
+                                                  - port
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              neighborDiscoveryProtection:
+                                                description: |-
+                                                  NeighborDiscoveryProtection drops ARP messages and IPv6 neighbor advertisements sent by the guest
+                                                  on behalf of addresses which are not allowed.
+                                                type: boolean
+                                            type: object
                                         required:
                                         - name
                                         type: object
@@ -27608,6 +30530,21 @@ var CRDsValidation map[string]string = map[string]string{
                                   format: int32
                                   type: integer
                               type: object
+                            metadataService:
+                              description: |-
+                                MetadataService exposes an EC2 and, optionally, an OpenStack compatible metadata service
+                                to the guest, on the link-local address 169.254.169.254.
+                                The EC2 metadata is served to IMDSv2 clients only, presenting a session token.
+                                The OpenStack metadata, when enabled, is served without a session token.
+                                Requires the pod network to use the masquerade binding.
+                              properties:
+                                openStack:
+                                  description: |-
+                                    OpenStack also serves the OpenStack metadata tree, under /openstack.
+                                    The OpenStack data source of cloud-init does not use session tokens, so this tree,
+                                    including the SSH public keys and the user data, is served to any client of the guest.
+                                  type: object
+                              type: object
                             networks:
                               description: List of networks that can be attached to
                                 a vm's virtual interface.
@@ -28411,6 +31348,12 @@ var CRDsValidation map[string]string = map[string]string{
                                           claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                           More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                         type: string
+                                      format:
+                                        description: |-
+                                          Format is the format of the memory dump, defaults to Raw.
+                                          A volume which is not hotpluggable and holds a SaveState dump is used
+                                          to resume the VMI on start.
+                                        type: string
                                       hotpluggable:
                                         description: Hotpluggable indicates whether
                                           the volume can be hotplugged and hotunplugged.
@@ -28604,6 +31547,10 @@ var CRDsValidation map[string]string = map[string]string{
                           description: FileName represents the name of the output
                             file
                           type: string
+                        format:
+                          description: Format is the format the memory is dumped in,
+                            defaults to Raw
+                          type: string
                         message:
                           description: Message is a detailed message about failure
                             of the memory dump
@@ -29307,22 +32254,188 @@ var CRDsValidation map[string]string = map[string]string{
                 type: object
               volumeName:
                 type: string
-              volumeSnapshotName:
+              volumeSnapshotName:
+                type: string
+            required:
+            - persistentVolumeClaim
+            - volumeName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      required:
+      - source
+      type: object
+    status:
+      description: VirtualMachineSnapshotContentStatus is the status for a VirtualMachineSnapshotStatus
+        resource
+      properties:
+        creationTime:
+          format: date-time
+          nullable: true
+          type: string
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        readyToUse:
+          type: boolean
+        volumeSnapshotStatus:
+          items:
+            description: VolumeSnapshotStatus is the status of a VolumeSnapshot
+            properties:
+              creationTime:
+                format: date-time
+                nullable: true
+                type: string
+              error:
+                description: Error is the last error encountered during the snapshot/restore
+                properties:
+                  message:
+                    type: string
+                  time:
+                    format: date-time
+                    type: string
+                type: object
+              readyToUse:
+                type: boolean
+              volumeSnapshotName:
+                type: string
+            required:
+            - volumeSnapshotName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinesnapshotgroup": `openAPIV3Schema:
+  description: |-
+    VirtualMachineSnapshotGroup defines the operation of snapshotting several VMs at the same instant.
+    A VirtualMachineSnapshot is created for every selected VM, all of their file systems are frozen
+    before the first volume is snapshotted and thawed once every volume snapshot was taken.
+    Every member can be restored with a VirtualMachineRestore
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup
+        resource
+      properties:
+        deletionPolicy:
+          description: DeletionPolicy is passed to the created VirtualMachineSnapshots
+          type: string
+        failureDeadline:
+          description: |-
+            This time represents the number of seconds we permit the group snapshot
+            to take. In case we pass this deadline we mark the group and its
+            VirtualMachineSnapshots as failed.
+            Defaults to DefaultFailureDeadline - 5min
+          type: string
+        selector:
+          description: |-
+            Selector selects the VirtualMachines, in the namespace of the group, to snapshot.
+            The members are determined once, when the group is created
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+      required:
+      - selector
+      type: object
+    status:
+      description: VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup
+        resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
                 type: string
             required:
-            - persistentVolumeClaim
-            - volumeName
+            - status
+            - type
             type: object
           type: array
           x-kubernetes-list-type: atomic
-      required:
-      - source
-      type: object
-    status:
-      description: VirtualMachineSnapshotContentStatus is the status for a VirtualMachineSnapshotStatus
-        resource
-      properties:
         creationTime:
+          description: CreationTime is the time the volumes of all members were snapshotted
           format: date-time
           nullable: true
           type: string
@@ -29335,16 +32448,211 @@ var CRDsValidation map[string]string = map[string]string{
               format: date-time
               type: string
           type: object
+        freezeTime:
+          description: |-
+            FreezeTime is the time the file systems of all members were frozen.
+            The volumes of the members are only snapshotted once it is set
+          format: date-time
+          nullable: true
+          type: string
+        members:
+          items:
+            description: VirtualMachineSnapshotGroupMember is a VM snapshotted by
+              a VirtualMachineSnapshotGroup
+            properties:
+              readyToUse:
+                type: boolean
+              virtualMachineName:
+                description: VirtualMachineName is the name of the snapshotted VirtualMachine
+                type: string
+              virtualMachineSnapshotName:
+                description: VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot
+                  of the VM
+                type: string
+            required:
+            - virtualMachineName
+            - virtualMachineSnapshotName
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - virtualMachineName
+          x-kubernetes-list-type: map
+        phase:
+          description: VirtualMachineSnapshotPhase is the current phase of the VirtualMachineSnapshot
+          type: string
         readyToUse:
           type: boolean
-        volumeSnapshotStatus:
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinesnapshotschedule": `openAPIV3Schema:
+  description: VirtualMachineSnapshotSchedule defines a policy to periodically snapshot
+    the selected VMs
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        disabled:
+          description: Disabled pauses the creation and the pruning of snapshots
+          type: boolean
+        failureDeadline:
+          description: FailureDeadline is passed to the created VirtualMachineSnapshots
+          type: string
+        retention:
+          description: |-
+            Retention defines which of the snapshots created by the schedule are kept.
+            All snapshots are kept when not set
+          properties:
+            keepDaily:
+              description: KeepDaily is the number of days for which the most recent
+                snapshot is kept
+              format: int32
+              type: integer
+            keepHourly:
+              description: KeepHourly is the number of hours for which the most recent
+                snapshot is kept
+              format: int32
+              type: integer
+            keepLast:
+              description: KeepLast is the number of most recent snapshots to keep
+              format: int32
+              type: integer
+            keepMonthly:
+              description: KeepMonthly is the number of months for which the most
+                recent snapshot is kept
+              format: int32
+              type: integer
+            keepWeekly:
+              description: KeepWeekly is the number of weeks for which the most recent
+                snapshot is kept
+              format: int32
+              type: integer
+            maxAge:
+              description: |-
+                MaxAge is the maximum age of a snapshot, older snapshots are deleted
+                even when selected by one of the Keep rules
+              type: string
+          type: object
+        schedule:
+          description: |-
+            Schedule in Cron format, either the five standard fields or a
+            descriptor like @hourly, @daily, @weekly or @monthly.
+            Times are interpreted in UTC
+          type: string
+        selector:
+          description: Selector selects the VirtualMachines, in the namespace of the
+            schedule, to snapshot
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+      required:
+      - schedule
+      - selector
+      type: object
+    status:
+      description: VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        conditions:
           items:
-            description: VolumeSnapshotStatus is the status of a VolumeSnapshot
+            description: Condition defines conditions
             properties:
-              creationTime:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
                 format: date-time
                 nullable: true
                 type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        lastScheduleTime:
+          format: date-time
+          nullable: true
+          type: string
+        nextScheduleTime:
+          format: date-time
+          nullable: true
+          type: string
+        virtualMachines:
+          items:
+            description: VirtualMachineSnapshotScheduleVMStatus is the status of a
+              VM selected by a VirtualMachineSnapshotSchedule
+            properties:
               error:
                 description: Error is the last error encountered during the snapshot/restore
                 properties:
@@ -29354,12 +32662,125 @@ var CRDsValidation map[string]string = map[string]string{
                     format: date-time
                     type: string
                 type: object
-              readyToUse:
+              lastSnapshotName:
+                description: LastSnapshotName is the name of the most recently created
+                  VirtualMachineSnapshot
+                type: string
+              lastSuccessTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastSuccessfulSnapshotName:
+                description: LastSuccessfulSnapshotName is the name of the most recent
+                  VirtualMachineSnapshot that succeeded
+                type: string
+              name:
+                description: Name of the VirtualMachine
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinestoragemigration": `openAPIV3Schema:
+  description: |-
+    VirtualMachineStorageMigration moves the volumes of a running VirtualMachine to
+    another storage class. The destination volumes are created as blank DataVolumes
+    and populated by a live migration of the VM.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        sourceVolumePolicy:
+          description: |-
+            SourceVolumePolicy defines what happens to the source volumes once the
+            migration succeeded. Can be "Retain" or "Delete". Defaults to "Retain".
+          enum:
+          - Retain
+          - Delete
+          type: string
+        storageClassName:
+          description: StorageClassName is the storage class of the destination volumes
+          type: string
+        vmName:
+          description: VMName is the name of the VirtualMachine whose volumes are
+            migrated
+          type: string
+        volumes:
+          description: |-
+            Volumes lists the names of the VM volumes to migrate. When empty, all
+            volumes backed by a DataVolume or a PersistentVolumeClaim are migrated,
+            except for those already using the storage class. The migration fails
+            if a name is not a volume of the VM.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+      required:
+      - storageClassName
+      - vmName
+      type: object
+    status:
+      properties:
+        endTimestamp:
+          format: date-time
+          nullable: true
+          type: string
+        message:
+          description: Message describes why the migration failed
+          type: string
+        phase:
+          type: string
+        startTimestamp:
+          format: date-time
+          nullable: true
+          type: string
+        volumes:
+          description: Volumes lists the source and destination of the migrated volumes
+          items:
+            description: StorageMigrationVolume tracks a volume migrated by a VirtualMachineStorageMigration
+            properties:
+              destinationClaimName:
+                description: DestinationClaimName is the name of the DataVolume the
+                  volume is migrated to
+                type: string
+              sourceClaimName:
+                description: SourceClaimName is the name of the DataVolume or PersistentVolumeClaim
+                  the volume is migrated from
+                type: string
+              sourceDeleted:
+                description: SourceDeleted is set once the source volume was deleted
                 type: boolean
-              volumeSnapshotName:
+              volumeName:
+                description: VolumeName is the name of the volume in the VM spec
                 type: string
             required:
-            - volumeSnapshotName
+            - destinationClaimName
+            - sourceClaimName
+            - volumeName
             type: object
           type: array
           x-kubernetes-list-type: atomic
@@ -201,6 +201,7 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshots",
 					"virtualmachinerestores",
 					"virtualmachinesnapshotcontents",
+					"virtualmachinesnapshotgroups",
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -231,6 +232,17 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"nodes",
+				},
+				Verbs: []string{
+					"list",
+				},
+			},
 			{
 				APIGroups: []string{
 					"instancetype.kubevirt.io",
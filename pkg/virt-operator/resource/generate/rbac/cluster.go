@@ -39,31 +39,34 @@ const (
 	defaultClusterRoleName          = "kubevirt.io:default"
 	instancetypeViewClusterRoleName = "instancetype.kubevirt.io:view"
 
-	apiVersion            = "version"
-	apiGuestFs            = "guestfs"
-	apiExpandVmSpec       = "expand-vm-spec"
-	apiKubevirts          = "kubevirts"
-	apiVM                 = "virtualmachines"
-	apiVMInstances        = "virtualmachineinstances"
-	apiVMIPresets         = "virtualmachineinstancepresets"
-	apiVMIReplicasets     = "virtualmachineinstancereplicasets"
-	apiVMIMigrations      = "virtualmachineinstancemigrations"
-	apiVMSnapshots        = "virtualmachinesnapshots"
-	apiVMSnapshotContents = "virtualmachinesnapshotcontents"
-	apiVMRestores         = "virtualmachinerestores"
-	apiVMExports          = "virtualmachineexports"
-	apiVMClones           = "virtualmachineclones"
-	apiVMPools            = "virtualmachinepools"
+	apiVersion             = "version"
+	apiGuestFs             = "guestfs"
+	apiExpandVmSpec        = "expand-vm-spec"
+	apiKubevirts           = "kubevirts"
+	apiVM                  = "virtualmachines"
+	apiVMInstances         = "virtualmachineinstances"
+	apiVMIPresets          = "virtualmachineinstancepresets"
+	apiVMIReplicasets      = "virtualmachineinstancereplicasets"
+	apiVMIMigrations       = "virtualmachineinstancemigrations"
+	apiVMSnapshots         = "virtualmachinesnapshots"
+	apiVMSnapshotContents  = "virtualmachinesnapshotcontents"
+	apiVMRestores          = "virtualmachinerestores"
+	apiVMSnapshotSchedules = "virtualmachinesnapshotschedules"
+	apiVMSnapshotGroups    = "virtualmachinesnapshotgroups"
+	apiVMExports           = "virtualmachineexports"
+	apiVMClones            = "virtualmachineclones"
+	apiVMPools             = "virtualmachinepools"
 
-	apiVMExpandSpec   = "virtualmachines/expand-spec"
-	apiVMPortForward  = "virtualmachines/portforward"
-	apiVMStart        = "virtualmachines/start"
-	apiVMStop         = "virtualmachines/stop"
-	apiVMRestart      = "virtualmachines/restart"
-	apiVMAddVolume    = "virtualmachines/addvolume"
-	apiVMRemoveVolume = "virtualmachines/removevolume"
-	apiVMMigrate      = "virtualmachines/migrate"
-	apiVMMemoryDump   = "virtualmachines/memorydump"
+	apiVMExpandSpec     = "virtualmachines/expand-spec"
+	apiVMPortForward    = "virtualmachines/portforward"
+	apiVMStart          = "virtualmachines/start"
+	apiVMStop           = "virtualmachines/stop"
+	apiVMRestart        = "virtualmachines/restart"
+	apiVMAddVolume      = "virtualmachines/addvolume"
+	apiVMRemoveVolume   = "virtualmachines/removevolume"
+	apiVMMigrate        = "virtualmachines/migrate"
+	apiVMMigrationCheck = "virtualmachines/migrationcheck"
+	apiVMMemoryDump     = "virtualmachines/memorydump"
 
 	apiVMInstancesConsole                   = "virtualmachineinstances/console"
 	apiVMInstancesVNC                       = "virtualmachineinstances/vnc"
@@ -85,6 +88,7 @@ const (
 	apiVMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
 	apiVMInstancesSEVInjectLaunchSecret     = "virtualmachineinstances/sev/injectlaunchsecret"
 	apiVMInstancesUSBRedir                  = "virtualmachineinstances/usbredir"
+	apiVMInstancesMigrationProgress         = "virtualmachineinstances/migration/progress"
 )
 
 func GetAllCluster() []runtime.Object {
@@ -200,6 +204,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesMigrationProgress,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
@@ -300,6 +305,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotSchedules,
+					apiVMSnapshotGroups,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -363,6 +370,17 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceVirtualMachineStorageMigrations,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
 		},
 	}
 }
@@ -393,6 +411,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesMigrationProgress,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
@@ -493,6 +512,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotSchedules,
+					apiVMSnapshotGroups,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -567,6 +588,17 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceVirtualMachineStorageMigrations,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -595,6 +627,17 @@ func newMigrateClusterRole() *rbacv1.ClusterRole {
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiVMMigrationCheck,
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -644,6 +687,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesMigrationProgress,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 				},
@@ -685,6 +729,8 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotSchedules,
+					apiVMSnapshotGroups,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -748,6 +794,17 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceVirtualMachineStorageMigrations,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -322,6 +322,10 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshotcontents/finalizers",
 					"virtualmachinerestores",
 					"virtualmachinerestores/status",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinesnapshotschedules/status",
+					"virtualmachinesnapshotgroups",
+					"virtualmachinesnapshotgroups/status",
 				},
 				Verbs: []string{
 					"get", "list", "watch", "create", "update", "delete", "patch",
@@ -395,6 +399,8 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/removevolume",
 					"virtualmachineinstances/freeze",
 					"virtualmachineinstances/unfreeze",
+					"virtualmachineinstances/pause",
+					"virtualmachineinstances/unpause",
 					"virtualmachineinstances/reset",
 					"virtualmachineinstances/softreboot",
 					"virtualmachineinstances/sev/setupsession",
@@ -477,6 +483,19 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"delete",
 				},
 			},
+			{
+				APIGroups: []string{
+					"snapshot.storage.k8s.io",
+				},
+				Resources: []string{
+					"volumesnapshotcontents",
+				},
+				Verbs: []string{
+					"get",
+					"create",
+					"delete",
+				},
+			},
 			{
 				APIGroups: []string{
 					"storage.k8s.io",
@@ -515,6 +534,29 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceMigrationPolicies + "/status",
+				},
+				Verbs: []string{
+					"update",
+				},
+			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceVirtualMachineStorageMigrations,
+					migrations.ResourceVirtualMachineStorageMigrations + "/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					clone.GroupName,
@@ -43,6 +43,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
@@ -111,6 +112,16 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
 
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.MigrationConfiguration, newKV.Spec.Configuration.MigrationConfiguration) {
+		results = append(results,
+			validateMigrationConfiguration(field.NewPath("spec").Child("configuration", "migrations"), newKV.Spec.Configuration.MigrationConfiguration)...)
+	}
+
+	if newKV.Spec.Configuration.NetworkConfiguration != nil {
+		results = append(results,
+			netadmitter.ValidateClusterTrafficFilter(field.NewPath("spec").Child("configuration", "network", "trafficFilter"), newKV.Spec.Configuration.NetworkConfiguration.TrafficFilter)...)
+	}
+
 	response := validating_webhooks.NewAdmissionResponse(results)
 
 	if featureGatesChanged(&currKV.Spec, &newKV.Spec) {
@@ -432,6 +443,35 @@ func validateInfraReplicas(replicas *uint8) []metav1.StatusCause {
 	return statuses
 }
 
+func validateMigrationConfiguration(field *field.Path, migrationConfig *v1.MigrationConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if migrationConfig == nil {
+		return causes
+	}
+
+	if migrationConfig.Compression != nil {
+		switch *migrationConfig.Compression {
+		case v1.MigrationCompressionXBZRLE, v1.MigrationCompressionZstd:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("must be one of %s, %s", v1.MigrationCompressionXBZRLE, v1.MigrationCompressionZstd),
+				Field:   field.Child("compression").String(),
+			})
+		}
+	}
+
+	if migrationConfig.MaxDowntimeMilliseconds != nil && *migrationConfig.MaxDowntimeMilliseconds <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be greater than zero",
+			Field:   field.Child("maxDowntimeMilliseconds").String(),
+		})
+	}
+
+	return causes
+}
+
 func featureGatesChanged(currKVSpec, newKVSpec *v1.KubeVirtSpec) bool {
 	currDevConfig := currKVSpec.Configuration.DeveloperConfiguration
 	newDevConfig := newKVSpec.Configuration.DeveloperConfiguration
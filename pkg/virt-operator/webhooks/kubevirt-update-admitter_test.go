@@ -68,6 +68,22 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		}, []string{vmProfileField.Child("customProfile", "runtimeDefaultProfile").String(), vmProfileField.Child("customProfile", "localhostProfile").String()}),
 	)
 
+	DescribeTable("validateMigrationConfiguration", func(migrationConfiguration *v1.MigrationConfiguration, expectedFields []string) {
+		causes := validateMigrationConfiguration(test, migrationConfiguration)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("without migration configuration", nil, nil),
+		Entry("with zstd compression", &v1.MigrationConfiguration{Compression: pointer.P(v1.MigrationCompressionZstd)}, nil),
+		Entry("with xbzrle compression", &v1.MigrationConfiguration{Compression: pointer.P(v1.MigrationCompressionXBZRLE)}, nil),
+		Entry("with unknown compression", &v1.MigrationConfiguration{Compression: pointer.P(v1.MigrationCompression("gzip"))},
+			[]string{test.Child("compression").String()}),
+		Entry("with zero maximum downtime", &v1.MigrationConfiguration{MaxDowntimeMilliseconds: pointer.P(int64(0))},
+			[]string{test.Child("maxDowntimeMilliseconds").String()}),
+	)
+
 	DescribeTable("test validateCustomizeComponents", func(cc v1.CustomizeComponents, expectedCauses int) {
 		causes := validateCustomizeComponents(cc)
 		Expect(causes).To(HaveLen(expectedCauses))
@@ -41,6 +41,7 @@ const (
 	NameFlag                     = "name"
 	SourceNameFlag               = "source-name"
 	TargetNameFlag               = "target-name"
+	TargetNamespaceFlag          = "target-namespace"
 	SourceTypeFlag               = "source-type"
 	TargetTypeFlag               = "target-type"
 	LabelFilterFlag              = "label-filter"
@@ -49,6 +50,9 @@ const (
 	TemplateAnnotationFilterFlag = "template-annotation-filter"
 	NewMacAddressesFlag          = "new-mac-address"
 	NewSMBiosSerialFlag          = "new-smbios-serial"
+	RegenerateGuestIdentityFlag  = "regenerate-guest-identity"
+	CloudInitInstanceIDFlag      = "cloud-init-instance-id"
+	VirtSysprepOperationFlag     = "virt-sysprep-operation"
 
 	supportedSourceTypes = "vm, vmsnapshot"
 	supportedTargetTypes = "vm"
@@ -59,6 +63,7 @@ type createClone struct {
 	name                      string
 	sourceName                string
 	targetName                string
+	targetNamespace           string
 	sourceType                string
 	targetType                string
 	labelFilters              []string
@@ -67,6 +72,9 @@ type createClone struct {
 	templateAnnotationFilters []string
 	newMacAddresses           []string
 	newSmbiosSerial           string
+	regenerateGuestIdentity   bool
+	cloudInitInstanceID       string
+	virtSysprepOperations     []string
 }
 
 type cloneSpec clone.VirtualMachineCloneSpec
@@ -91,6 +99,7 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().StringVar(&c.name, NameFlag, emptyValue, "Specify the name of the clone. If not specified, name would be randomized.")
 	cmd.Flags().StringVar(&c.sourceName, SourceNameFlag, emptyValue, "Specify the clone's source name.")
 	cmd.Flags().StringVar(&c.targetName, TargetNameFlag, emptyValue, "Specify the clone's target name.")
+	cmd.Flags().StringVar(&c.targetNamespace, TargetNamespaceFlag, emptyValue, "Specify the clone's target namespace. If not specified, the target is created in the namespace of the clone.")
 	cmd.Flags().StringVar(&c.sourceType, SourceTypeFlag, emptyValue, "Specify the clone's source type. Default type is VM. Supported types: "+supportedSourceTypes)
 	cmd.Flags().StringVar(&c.targetType, TargetTypeFlag, emptyValue, "Specify the clone's target type. Default type is VM. Supported types: "+supportedTargetTypes)
 	cmd.Flags().StringArrayVar(&c.labelFilters, LabelFilterFlag, nil, "Specify clone's label filters. "+supportsMultipleFlags)
@@ -99,6 +108,9 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().StringArrayVar(&c.templateAnnotationFilters, TemplateAnnotationFilterFlag, nil, "Specify clone's template annotation filters. "+supportsMultipleFlags)
 	cmd.Flags().StringArrayVar(&c.newMacAddresses, NewMacAddressesFlag, nil, "Specify clone's new mac addresses. For example: 'interfaceName0:newAddress0'")
 	cmd.Flags().StringVar(&c.newSmbiosSerial, NewSMBiosSerialFlag, emptyValue, "Specify the clone's new smbios serial")
+	cmd.Flags().BoolVar(&c.regenerateGuestIdentity, RegenerateGuestIdentityFlag, false, "Regenerate the cloud-init instance ID and reset the hostname of the clone.")
+	cmd.Flags().StringVar(&c.cloudInitInstanceID, CloudInitInstanceIDFlag, emptyValue, "Specify the clone's new cloud-init instance ID. Implies --"+RegenerateGuestIdentityFlag+".")
+	cmd.Flags().StringArrayVar(&c.virtSysprepOperations, VirtSysprepOperationFlag, nil, "Specify a virt-sysprep operation to run on the clone's volumes. Implies --"+RegenerateGuestIdentityFlag+". "+supportsMultipleFlags)
 
 	if err := cmd.MarkFlagRequired(SourceNameFlag); err != nil {
 		panic(err)
@@ -151,6 +163,9 @@ func (c *createClone) usage() string {
   # Create a manifest for a clone with a source type snapshot to a target type VM:
   {{ProgramName}} create clone --source-name mySnapshot --source-type snapshot --target-name targetVM
 
+  # Create a manifest for a clone to a target VM in another namespace:
+  {{ProgramName}} create clone --source-name sourceVM --target-name targetVM --target-namespace targetNamespace
+
   # Create a manifest for a clone with label filters:
   {{ProgramName}} create clone --source-name sourceVM --label-filter '*' --label-filter '!some/key'
 
@@ -192,6 +207,7 @@ func (c *createClone) newClone() (*clone.VirtualMachineClone, error) {
 	vmClone.Spec = clone.VirtualMachineCloneSpec{
 		Source:            source,
 		Target:            target,
+		TargetNamespace:   c.targetNamespace,
 		AnnotationFilters: c.annotationFilters,
 		LabelFilters:      c.labelFilters,
 		Template: clone.VirtualMachineCloneTemplateFilters{
@@ -204,6 +220,20 @@ func (c *createClone) newClone() (*clone.VirtualMachineClone, error) {
 		vmClone.Spec.NewSMBiosSerial = pointer.P(c.newSmbiosSerial)
 	}
 
+	if c.regenerateGuestIdentity || c.cloudInitInstanceID != "" || len(c.virtSysprepOperations) > 0 {
+		vmClone.Spec.GuestIdentity = &clone.VirtualMachineCloneGuestIdentity{}
+		if c.cloudInitInstanceID != "" {
+			vmClone.Spec.GuestIdentity.CloudInitInstanceID = pointer.P(c.cloudInitInstanceID)
+		}
+		if len(c.virtSysprepOperations) > 0 {
+			vmClone.Spec.GuestIdentity.Generalization = &clone.VirtualMachineCloneGeneralization{
+				VirtSysprep: &clone.VirtSysprepGeneralization{
+					Operations: c.virtSysprepOperations,
+				},
+			}
+		}
+	}
+
 	return vmClone, nil
 }
 
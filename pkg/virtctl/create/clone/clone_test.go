@@ -242,6 +242,45 @@ var _ = Describe("create clone", func() {
 		Expect(*cloneObj.Spec.NewSMBiosSerial).To(Equal(newSerial))
 	})
 
+	It("regenerate guest identity", func() {
+		flags := getSourceNameFlags()
+		flags = append(flags, fmt.Sprintf("--%s", virtctlclone.RegenerateGuestIdentityFlag))
+
+		cloneObj, err := newCommand(flags...)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(cloneObj.Spec.GuestIdentity).To(Equal(&clone.VirtualMachineCloneGuestIdentity{}))
+	})
+
+	It("new cloud-init instance ID and virt-sysprep operations", func() {
+		flags := getSourceNameFlags()
+
+		const instanceID = "instance-id"
+		flags = addFlag(flags, virtctlclone.CloudInitInstanceIDFlag, instanceID)
+		flags = addFlag(flags, virtctlclone.VirtSysprepOperationFlag, "machine-id")
+		flags = addFlag(flags, virtctlclone.VirtSysprepOperationFlag, "ssh-hostkeys")
+
+		cloneObj, err := newCommand(flags...)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(cloneObj.Spec.GuestIdentity).ToNot(BeNil())
+		Expect(cloneObj.Spec.GuestIdentity.CloudInitInstanceID).To(HaveValue(Equal(instanceID)))
+		Expect(cloneObj.Spec.GuestIdentity.Generalization).ToNot(BeNil())
+		Expect(cloneObj.Spec.GuestIdentity.Generalization.VirtSysprep.Operations).To(Equal([]string{"machine-id", "ssh-hostkeys"}))
+	})
+
+	It("sets the provided target namespace", func() {
+		flags := getSourceNameFlags()
+
+		const targetNamespace = "target-namespace"
+		flags = addFlag(flags, virtctlclone.TargetNamespaceFlag, targetNamespace)
+
+		cloneObj, err := newCommand(flags...)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(cloneObj.Spec.TargetNamespace).To(Equal(targetNamespace))
+	})
+
 	It("sets the provided namespace", func() {
 		flags := getSourceNameFlags()
 
@@ -32,6 +32,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/scp"
 	"kubevirt.io/kubevirt/pkg/virtctl/softreboot"
 	"kubevirt.io/kubevirt/pkg/virtctl/ssh"
+	"kubevirt.io/kubevirt/pkg/virtctl/storage"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 	"kubevirt.io/kubevirt/pkg/virtctl/unpause"
 	"kubevirt.io/kubevirt/pkg/virtctl/usbredir"
@@ -129,6 +130,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		imageupload.NewImageUploadCommand(),
 		guestfs.NewGuestfsShellCommand(),
 		vmexport.NewVirtualMachineExportCommand(),
+		storage.NewCommand(),
 		create.NewCommand(),
 		credentials.NewCommand(),
 		adm.NewCommand(),
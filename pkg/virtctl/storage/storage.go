@@ -0,0 +1,185 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	STORAGE         = "storage"
+	COMMAND_MIGRATE = "migrate"
+
+	vmArg            = "vm"
+	storageClassArg  = "storage-class"
+	volumeArg        = "volume"
+	deleteSourceArg  = "delete-source"
+	watchArg         = "watch"
+	watchIntervalArg = "watch-interval"
+)
+
+type migrateCommand struct {
+	vmName        string
+	storageClass  string
+	volumes       []string
+	deleteSource  bool
+	watch         bool
+	watchInterval time.Duration
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   STORAGE,
+		Short: "Manage the storage of virtual machines.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Printf(cmd.UsageString())
+		},
+	}
+	cmd.AddCommand(NewMigrateCommand())
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func NewMigrateCommand() *cobra.Command {
+	c := migrateCommand{}
+	cmd := &cobra.Command{
+		Use:     COMMAND_MIGRATE,
+		Short:   "Live migrate the volumes of a running virtual machine to another storage class.",
+		Example: migrateUsage(),
+		Args:    cobra.NoArgs,
+		RunE:    c.run,
+	}
+	cmd.Flags().StringVar(&c.vmName, vmArg, "", "The name of the virtual machine whose volumes are migrated.")
+	cmd.Flags().StringVar(&c.storageClass, storageClassArg, "", "The storage class the volumes are migrated to.")
+	cmd.Flags().StringArrayVar(&c.volumes, volumeArg, nil, "The name of a volume to migrate, can be repeated. All volumes are migrated if not set.")
+	cmd.Flags().BoolVar(&c.deleteSource, deleteSourceArg, false, "Delete the source volumes once the migration succeeded.")
+	cmd.Flags().BoolVar(&c.watch, watchArg, false, "Follow the progress of the storage migration until it completes.")
+	cmd.Flags().DurationVar(&c.watchInterval, watchIntervalArg, 2*time.Second, "The interval between two progress updates when using --watch.")
+	_ = cmd.MarkFlagRequired(vmArg)
+	_ = cmd.MarkFlagRequired(storageClassArg)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func migrateUsage() string {
+	return `  # Migrate all volumes of the virtual machine 'myvm' to the storage class 'fast':
+  {{ProgramName}} storage migrate --vm myvm --storage-class fast
+
+  # Migrate the volume 'datadisk' and delete its source once the migration succeeded:
+  {{ProgramName}} storage migrate --vm myvm --storage-class fast --volume datadisk --delete-source
+
+  # Migrate all volumes and follow the progress of the migration:
+  {{ProgramName}} storage migrate --vm myvm --storage-class fast --watch`
+}
+
+func (c *migrateCommand) run(cmd *cobra.Command, _ []string) error {
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	storageMigration := &migrationsv1.VirtualMachineStorageMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-storage-migration-%s", c.vmName, rand.String(5)),
+			Namespace: namespace,
+		},
+		Spec: migrationsv1.VirtualMachineStorageMigrationSpec{
+			VMName:           c.vmName,
+			StorageClassName: c.storageClass,
+			Volumes:          c.volumes,
+		},
+	}
+	if c.deleteSource {
+		storageMigration.Spec.SourceVolumePolicy = migrationsv1.SourceVolumePolicyDelete
+	}
+
+	storageMigration, err = virtClient.VirtualMachineStorageMigration(namespace).Create(context.Background(), storageMigration, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating VirtualMachineStorageMigration: %v", err)
+	}
+
+	cmd.Printf("VirtualMachineStorageMigration %s was created to migrate the volumes of VM %s to storage class %s\n", storageMigration.Name, c.vmName, c.storageClass)
+
+	if c.watch {
+		return c.watchStorageMigration(cmd, virtClient, namespace, storageMigration.Name)
+	}
+	return nil
+}
+
+func (c *migrateCommand) watchStorageMigration(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, name string) error {
+	ticker := time.NewTicker(c.watchInterval)
+	defer ticker.Stop()
+
+	var lastPhase migrationsv1.VirtualMachineStorageMigrationPhase
+	for {
+		storageMigration, err := virtClient.VirtualMachineStorageMigration(namespace).Get(cmd.Context(), name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error getting VirtualMachineStorageMigration %v", err)
+		}
+
+		status := storageMigration.Status
+		switch {
+		case status == nil || status.Phase == migrationsv1.StorageMigrationPending:
+			if lastPhase == "" {
+				cmd.Println("Waiting for the storage migration to start")
+				lastPhase = migrationsv1.StorageMigrationPending
+			}
+		case status.Phase == migrationsv1.StorageMigrationFailed:
+			return fmt.Errorf("storage migration %s failed: %s", name, status.Message)
+		case status.Phase == migrationsv1.StorageMigrationSucceeded:
+			cmd.Printf("Storage migration %s succeeded\n", name)
+			for _, volume := range status.Volumes {
+				line := fmt.Sprintf("  %s: %s -> %s", volume.VolumeName, volume.SourceClaimName, volume.DestinationClaimName)
+				if volume.SourceDeleted {
+					line += " (source deleted)"
+				}
+				cmd.Println(line)
+			}
+			return nil
+		case status.Phase != lastPhase:
+			var volumes []string
+			for _, volume := range status.Volumes {
+				volumes = append(volumes, volume.VolumeName)
+			}
+			cmd.Printf("Migrating volumes %s\n", strings.Join(volumes, ", "))
+			lastPhase = status.Phase
+		}
+
+		select {
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		case <-ticker.C:
+		}
+	}
+}
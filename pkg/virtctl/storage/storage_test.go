@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Storage migrate command", func() {
+	const vmName = "testvm"
+
+	var kubevirtClient *kubevirtfake.Clientset
+
+	createdStorageMigration := func() *migrationsv1.VirtualMachineStorageMigration {
+		list, err := kubevirtClient.MigrationsV1alpha1().VirtualMachineStorageMigrations(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Items).To(HaveLen(1))
+		return &list.Items[0]
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineStorageMigration(metav1.NamespaceDefault).
+			Return(kubevirtClient.MigrationsV1alpha1().VirtualMachineStorageMigrations(metav1.NamespaceDefault)).AnyTimes()
+	})
+
+	DescribeTable("should fail with missing required flag", func(args []string, flag string) {
+		err := testing.NewRepeatableVirtctlCommand(append([]string{"storage", "migrate"}, args...)...)()
+		Expect(err).To(MatchError(ContainSubstring(flag)))
+	},
+		Entry("vm", []string{"--storage-class", "fast"}, "vm"),
+		Entry("storage-class", []string{"--vm", vmName}, "storage-class"),
+	)
+
+	It("should create a storage migration of all volumes", func() {
+		Expect(testing.NewRepeatableVirtctlCommand("storage", "migrate", "--vm", vmName, "--storage-class", "fast")()).To(Succeed())
+
+		storageMigration := createdStorageMigration()
+		Expect(storageMigration.Name).To(HavePrefix(vmName + "-storage-migration-"))
+		Expect(storageMigration.Spec).To(Equal(migrationsv1.VirtualMachineStorageMigrationSpec{
+			VMName:           vmName,
+			StorageClassName: "fast",
+		}))
+	})
+
+	It("should create a storage migration of the selected volumes deleting the sources", func() {
+		Expect(testing.NewRepeatableVirtctlCommand("storage", "migrate", "--vm", vmName, "--storage-class", "fast",
+			"--volume", "rootdisk", "--volume", "datadisk", "--delete-source")()).To(Succeed())
+
+		Expect(createdStorageMigration().Spec).To(Equal(migrationsv1.VirtualMachineStorageMigrationSpec{
+			VMName:             vmName,
+			StorageClassName:   "fast",
+			Volumes:            []string{"rootdisk", "datadisk"},
+			SourceVolumePolicy: migrationsv1.SourceVolumePolicyDelete,
+		}))
+	})
+
+	Context("with --watch", func() {
+		reactToGet := func(statuses ...*migrationsv1.VirtualMachineStorageMigrationStatus) {
+			kubevirtClient.Fake.PrependReactor("get", "virtualmachinestoragemigrations", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				storageMigration := &migrationsv1.VirtualMachineStorageMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      action.(k8stesting.GetAction).GetName(),
+						Namespace: metav1.NamespaceDefault,
+					},
+					Status: statuses[0],
+				}
+				if len(statuses) > 1 {
+					statuses = statuses[1:]
+				}
+				return true, storageMigration, nil
+			})
+		}
+
+		It("should report progress until the storage migration succeeds", func() {
+			volumes := []migrationsv1.StorageMigrationVolume{
+				{VolumeName: "datadisk", SourceClaimName: "data", DestinationClaimName: "data-mig-abcde", SourceDeleted: true},
+			}
+			reactToGet(
+				nil,
+				&migrationsv1.VirtualMachineStorageMigrationStatus{Phase: migrationsv1.StorageMigrationMigrating, Volumes: volumes},
+				&migrationsv1.VirtualMachineStorageMigrationStatus{Phase: migrationsv1.StorageMigrationSucceeded, Volumes: volumes},
+			)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("storage", "migrate", "--vm", vmName, "--storage-class", "fast", "--watch", "--watch-interval=1ms")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("Waiting for the storage migration to start"))
+			Expect(string(out)).To(ContainSubstring("Migrating volumes datadisk"))
+			Expect(string(out)).To(ContainSubstring("datadisk: data -> data-mig-abcde (source deleted)"))
+		})
+
+		It("should fail when the storage migration fails", func() {
+			reactToGet(&migrationsv1.VirtualMachineStorageMigrationStatus{
+				Phase:   migrationsv1.StorageMigrationFailed,
+				Message: "VirtualMachine testvm is not running",
+			})
+
+			err := testing.NewRepeatableVirtctlCommand("storage", "migrate", "--vm", vmName, "--storage-class", "fast", "--watch", "--watch-interval=1ms")()
+			Expect(err).To(MatchError(ContainSubstring("VirtualMachine testvm is not running")))
+		})
+	})
+})
@@ -22,16 +22,38 @@ package vm
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
-const COMMAND_MIGRATE = "migrate"
+const (
+	COMMAND_MIGRATE = "migrate"
+
+	watchArg         = "watch"
+	watchIntervalArg = "watch-interval"
+	watchTimeoutArg  = "watch-timeout"
+	checkArg         = "check"
+)
+
+var (
+	check         bool
+	watch         bool
+	watchInterval time.Duration
+	watchTimeout  time.Duration
+)
 
 func NewMigrateCommand() *cobra.Command {
 	c := Command{command: COMMAND_MIGRATE}
@@ -43,6 +65,10 @@ func NewMigrateCommand() *cobra.Command {
 		RunE:    c.migrateRun,
 	}
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.Flags().BoolVar(&check, checkArg, false, "Report whether the virtual machine can be migrated, without migrating it.")
+	cmd.Flags().BoolVar(&watch, watchArg, false, "Follow the progress of the migration until it completes.")
+	cmd.Flags().DurationVar(&watchInterval, watchIntervalArg, 2*time.Second, "The interval between two progress updates when using --watch.")
+	cmd.Flags().DurationVar(&watchTimeout, watchTimeoutArg, 0, "The maximum time to follow the migration when using --watch, zero means no limit.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -55,8 +81,29 @@ func (o *Command) migrateRun(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if check {
+		report, err := virtClient.VirtualMachine(namespace).MigrateDryRun(context.Background(), vmiName)
+		if err != nil {
+			return fmt.Errorf("Error migrating VirtualMachine %v", err)
+		}
+		printMigrationDryRunReport(cmd, report)
+		if !report.Migratable {
+			return fmt.Errorf("VM %s cannot be migrated", vmiName)
+		}
+		cmd.Printf("VM %s can be migrated\n", vmiName)
+		return nil
+	}
+
 	dryRunOption := setDryRunOption(dryRun)
 
+	var previousMigrations sets.Set[types.UID]
+	if watch && !dryRun {
+		previousMigrations, err = listMigrationUIDs(cmd.Context(), virtClient, namespace, vmiName)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, &v1.MigrateOptions{DryRun: dryRunOption})
 	if err != nil {
 		return fmt.Errorf("Error migrating VirtualMachine %v", err)
@@ -64,5 +111,162 @@ func (o *Command) migrateRun(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("VM %s was scheduled to %s\n", vmiName, o.command)
 
+	if watch && !dryRun {
+		return watchMigration(cmd, virtClient, namespace, vmiName, previousMigrations)
+	}
+
 	return nil
 }
+
+func listMigrations(ctx context.Context, virtClient kubecli.KubevirtClient, namespace, vmiName string) ([]v1.VirtualMachineInstanceMigration, error) {
+	migrations, err := virtClient.VirtualMachineInstanceMigration(namespace).List(ctx, k8smetav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s==%s", v1.MigrationSelectorLabel, vmiName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching virtual machine instance migration list %v", err)
+	}
+	return migrations.Items, nil
+}
+
+func listMigrationUIDs(ctx context.Context, virtClient kubecli.KubevirtClient, namespace, vmiName string) (sets.Set[types.UID], error) {
+	migrations, err := listMigrations(ctx, virtClient, namespace, vmiName)
+	if err != nil {
+		return nil, err
+	}
+	uids := sets.New[types.UID]()
+	for _, migration := range migrations {
+		uids.Insert(migration.UID)
+	}
+	return uids, nil
+}
+
+func printMigrationDryRunReport(cmd *cobra.Command, report *v1.MigrationDryRunReport) {
+	for _, check := range report.Checks {
+		result := "passed"
+		if !check.Passed {
+			result = "failed"
+		}
+		line := fmt.Sprintf("%s: %s", check.Type, result)
+		if check.Message != "" {
+			line = fmt.Sprintf("%s (%s)", line, check.Message)
+		}
+		cmd.Println(line)
+	}
+}
+
+// watchMigration follows the migration created for the VMI, which is the one that did not exist
+// before the migration was requested, until it succeeds or fails
+func watchMigration(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, vmiName string, previousMigrations sets.Set[types.UID]) error {
+	ctx := cmd.Context()
+	if watchTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, watchTimeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	var migrationName string
+	for {
+		migration, err := findNewMigration(ctx, virtClient, namespace, vmiName, previousMigrations)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case migration == nil && migrationName != "":
+			return fmt.Errorf("migration %s was deleted", migrationName)
+		case migration == nil:
+			cmd.Println("Waiting for the migration to be created")
+		case migration.Status.Phase == v1.MigrationFailed:
+			return fmt.Errorf("migration %s failed: %s", migration.Name, migrationFailureReason(migration))
+		case migration.Status.Phase == v1.MigrationSucceeded:
+			cmd.Printf("Migration %s completed\n", migration.Name)
+			return nil
+		case migration.Status.Phase != v1.MigrationRunning:
+			migrationName = migration.Name
+			cmd.Printf("Waiting for migration %s to start (%s)\n", migration.Name, migration.Status.Phase)
+		default:
+			migrationName = migration.Name
+			// The migration might complete between getting it and its progress,
+			// the next iteration will report the final state
+			progress, err := virtClient.VirtualMachineInstance(namespace).MigrationProgress(ctx, vmiName)
+			if err == nil && progress.MigrationUID == migration.UID {
+				cmd.Println(formatMigrationProgress(&progress))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if migrationName == "" {
+				return fmt.Errorf("stopped waiting for the migration of %s: %v", vmiName, ctx.Err())
+			}
+			return fmt.Errorf("stopped waiting for migration %s: %v", migrationName, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func findNewMigration(ctx context.Context, virtClient kubecli.KubevirtClient, namespace, vmiName string, previousMigrations sets.Set[types.UID]) (*v1.VirtualMachineInstanceMigration, error) {
+	migrations, err := listMigrations(ctx, virtClient, namespace, vmiName)
+	if err != nil {
+		return nil, err
+	}
+	for i := range migrations {
+		if !previousMigrations.Has(migrations[i].UID) {
+			return &migrations[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func migrationFailureReason(migration *v1.VirtualMachineInstanceMigration) string {
+	if state := migration.Status.MigrationState; state != nil && state.FailureReason != "" {
+		return state.FailureReason
+	}
+	return "unknown reason"
+}
+
+func formatMigrationProgress(progress *v1.VirtualMachineInstanceMigrationProgress) string {
+	var details []string
+	if progress.MemoryIterations != nil {
+		details = append(details, fmt.Sprintf("iteration %d", *progress.MemoryIterations))
+	}
+	if progress.DataRemainingBytes != nil {
+		remaining := formatBytes(*progress.DataRemainingBytes)
+		if progress.DataTotalBytes != nil {
+			remaining = fmt.Sprintf("%s of %s", remaining, formatBytes(*progress.DataTotalBytes))
+		}
+		details = append(details, "remaining "+remaining)
+	}
+	if progress.MemoryDirtyRateBytes != nil {
+		details = append(details, fmt.Sprintf("dirty rate %s/s", formatBytes(*progress.MemoryDirtyRateBytes)))
+	}
+	if progress.MemoryTransferRateBytes != nil {
+		details = append(details, fmt.Sprintf("transfer rate %s/s", formatBytes(*progress.MemoryTransferRateBytes)))
+	}
+	if progress.AutoConvergeThrottle != nil {
+		details = append(details, fmt.Sprintf("throttle %d%%", *progress.AutoConvergeThrottle))
+	}
+	if progress.PostCopyRequests != nil {
+		details = append(details, fmt.Sprintf("post-copy requests %d", *progress.PostCopyRequests))
+	}
+	if progress.EstimatedRemainingMilliseconds != nil {
+		estimate := time.Duration(*progress.EstimatedRemainingMilliseconds) * time.Millisecond
+		details = append(details, fmt.Sprintf("estimated completion in %s", estimate.Round(time.Second)))
+	}
+
+	line := fmt.Sprintf("Migration %s", progress.MigrationUID)
+	if progress.Mode != "" {
+		line = fmt.Sprintf("%s (%s)", line, progress.Mode)
+	}
+	if len(details) > 0 {
+		line = fmt.Sprintf("%s: %s", line, strings.Join(details, ", "))
+	}
+	return line
+}
+
+func formatBytes(bytes uint64) string {
+	return resource.NewQuantity(int64(bytes), resource.BinarySI).String()
+}
@@ -27,10 +27,12 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virtctl/testing"
 )
 
@@ -68,4 +70,123 @@ var _ = Describe("Migrate command", func() {
 		Entry("with default", &v1.MigrateOptions{}),
 		Entry("with dry-run option", &v1.MigrateOptions{DryRun: []string{k8smetav1.DryRunAll}}),
 	)
+
+	Context("with --check", func() {
+		BeforeEach(func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		})
+
+		It("should print the feasibility report without migrating the vm", func() {
+			vmInterface.EXPECT().MigrateDryRun(context.Background(), vmName).Return(&v1.MigrationDryRunReport{
+				Migratable: true,
+				Checks: []v1.MigrationDryRunCheck{
+					{Type: v1.MigrationDryRunCheckLiveMigratable, Passed: true},
+				},
+			}, nil).Times(1)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("migrate", vmName, "--check")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("LiveMigratable: passed"))
+			Expect(string(out)).To(ContainSubstring("VM testvm can be migrated"))
+		})
+
+		It("should fail when the vm cannot be migrated", func() {
+			vmInterface.EXPECT().MigrateDryRun(context.Background(), vmName).Return(&v1.MigrationDryRunReport{
+				Checks: []v1.MigrationDryRunCheck{
+					{Type: v1.MigrationDryRunCheckStorage, Message: "PVC disk0 is not shared"},
+				},
+			}, nil).Times(1)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("migrate", vmName, "--check")()
+			Expect(err).To(MatchError("VM testvm cannot be migrated"))
+			Expect(string(out)).To(ContainSubstring("Storage: failed (PVC disk0 is not shared)"))
+		})
+	})
+
+	Context("with --watch", func() {
+		var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		var migrationInterface *kubecli.MockVirtualMachineInstanceMigrationInterface
+
+		newMigrationList := func(migrations ...*v1.VirtualMachineInstanceMigration) *v1.VirtualMachineInstanceMigrationList {
+			list := &v1.VirtualMachineInstanceMigrationList{}
+			for _, migration := range migrations {
+				list.Items = append(list.Items, *migration)
+			}
+			return list
+		}
+
+		newMigration := func(uid string, phase v1.VirtualMachineInstanceMigrationPhase) *v1.VirtualMachineInstanceMigration {
+			migration := kubecli.NewMinimalMigration("migration-" + uid)
+			migration.UID = types.UID(uid)
+			migration.Status.Phase = phase
+			return migration
+		}
+
+		expectList := func(migrations ...*v1.VirtualMachineInstanceMigration) *gomock.Call {
+			return migrationInterface.EXPECT().List(gomock.Any(), k8smetav1.ListOptions{
+				LabelSelector: v1.MigrationSelectorLabel + "==" + vmName,
+			}).Return(newMigrationList(migrations...), nil)
+		}
+
+		BeforeEach(func() {
+			vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+			migrationInterface = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstanceMigration(k8smetav1.NamespaceDefault).Return(migrationInterface).AnyTimes()
+			vmInterface.EXPECT().Migrate(context.Background(), vmName, &v1.MigrateOptions{}).Return(nil)
+		})
+
+		It("should report progress until the migration completes", func() {
+			previous := newMigration("old", v1.MigrationSucceeded)
+			gomock.InOrder(
+				expectList(previous),
+				expectList(previous),
+				expectList(previous, newMigration("new", v1.MigrationScheduling)),
+				expectList(previous, newMigration("new", v1.MigrationRunning)),
+				vmiInterface.EXPECT().MigrationProgress(gomock.Any(), vmName).Return(v1.VirtualMachineInstanceMigrationProgress{
+					MigrationUID:     "new",
+					MemoryIterations: pointer.P(uint64(2)),
+				}, nil),
+				expectList(previous, newMigration("new", v1.MigrationSucceeded)),
+			)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("migrate", vmName, "--watch", "--watch-interval=1ms")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("Waiting for migration migration-new to start (Scheduling)"))
+			Expect(string(out)).To(ContainSubstring("Migration new: iteration 2"))
+			Expect(string(out)).To(ContainSubstring("Migration migration-new completed"))
+		})
+
+		It("should fail when the migration fails before it starts", func() {
+			failed := newMigration("new", v1.MigrationFailed)
+			failed.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{FailureReason: "target pod unschedulable"}
+			gomock.InOrder(
+				expectList(),
+				expectList(failed),
+			)
+
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--watch", "--watch-interval=1ms")()
+			Expect(err).To(MatchError("migration migration-new failed: target pod unschedulable"))
+		})
+
+		It("should fail when the migration is deleted", func() {
+			gomock.InOrder(
+				expectList(),
+				expectList(newMigration("new", v1.MigrationPending)),
+				expectList(),
+			)
+
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--watch", "--watch-interval=1ms")()
+			Expect(err).To(MatchError("migration migration-new was deleted"))
+		})
+
+		It("should stop waiting after the timeout", func() {
+			expectList()
+			expectList(newMigration("new", v1.MigrationPending)).AnyTimes()
+
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--watch", "--watch-interval=1ms", "--watch-timeout=10ms")()
+			Expect(err).To(MatchError(ContainSubstring("stopped waiting for migration migration-new")))
+		})
+	})
 })
@@ -90,8 +90,9 @@ const (
 	OUTPUT_FORMAT_YAML = "yaml"
 
 	// Possible output format for volumes
-	GZIP_FORMAT = "gzip"
-	RAW_FORMAT  = "raw"
+	GZIP_FORMAT  = "gzip"
+	RAW_FORMAT   = "raw"
+	QCOW2_FORMAT = "qcow2"
 
 	ACCEPT           = "Accept"
 	APPLICATION_YAML = "application/yaml"
@@ -157,6 +158,7 @@ type VMExportInfo struct {
 	IncludeSecret    bool
 	ExportManifest   bool
 	Decompress       bool
+	Qcow2            bool
 	PortForward      bool
 	LocalPort        string
 	OutputFile       string
@@ -218,6 +220,9 @@ func usage() string {
 	# Create a VirtualMachineExport and download the requested volume from it
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --volume=volume1 --output=disk.img.gz
 
+	# Download a volume as a sparse qcow2 image
+	{{ProgramName}} vmexport download vm1-export --volume=volume1 --format=qcow2 --output=disk.qcow2
+
 	# Create a VirtualMachineExport and get the VirtualMachine manifest in Yaml format
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --manifest
 
@@ -245,7 +250,7 @@ func NewVirtualMachineExportCommand() *cobra.Command {
 	cmd.MarkFlagsMutuallyExclusive("vm", "snapshot", "pvc")
 	cmd.Flags().StringVar(&outputFile, "output", "", "Specifies the output path of the volume to be downloaded.")
 	cmd.Flags().StringVar(&volumeName, "volume", "", "Specifies the volume to be downloaded.")
-	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. There's two options: gzip (default) and raw.")
+	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. There are three options: gzip (default), raw and qcow2.")
 	cmd.Flags().BoolVar(&insecure, "insecure", false, "When used with the 'download' option, specifies that the http request should be insecure.")
 	cmd.Flags().BoolVar(&keepVme, "keep-vme", false, "When used with the 'download' option, specifies that the vmexport object should always be retained after the download finishes.")
 	cmd.Flags().BoolVar(&deleteVme, "delete-vme", false, "When used with the 'download' option, specifies that the vmexport object should always be deleted after the download finishes.")
@@ -354,6 +359,10 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	if format == RAW_FORMAT {
 		vmeInfo.Decompress = true
 	}
+	// If qcow2 format is specified, we'll only download the qcow2 volume
+	if format == QCOW2_FORMAT {
+		vmeInfo.Qcow2 = true
+	}
 	vmeInfo.DownloadRetries = downloadRetries
 	vmeInfo.ShouldCreate = shouldCreate
 	vmeInfo.Insecure = insecure
@@ -653,6 +662,18 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 	for _, exportVolume := range links.Volumes {
 		// Access the requested volume
 		if volumeNumber == 1 || exportVolume.Name == vmeInfo.VolumeName {
+			if vmeInfo.Qcow2 {
+				for _, format = range exportVolume.Formats {
+					if format.Format == exportv1.KubeVirtQcow2 {
+						downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
+						if err != nil {
+							return "", err
+						}
+						break
+					}
+				}
+				continue
+			}
 			for _, format = range exportVolume.Formats {
 				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
 					downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
@@ -1005,8 +1026,8 @@ func handleDownloadFlags() error {
 		}
 	}
 
-	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT {
-		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw")
+	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT && format != QCOW2_FORMAT {
+		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw/qcow2")
 	}
 
 	if downloadRetries < 0 {
@@ -364,7 +364,7 @@ var _ = Describe("vmexport", func() {
 			Entry("Using 'manifest' with volume type", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.VOLUME_FLAG, vmexport.MANIFEST_FLAG), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.VM_FLAG, "test"), setFlag(vmexport.VOLUME_FLAG, "volume")),
 			Entry("Using 'manifest' with invalid output_format_flag", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.OUTPUT_FORMAT_FLAG, "json/yaml"), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.OUTPUT_FORMAT_FLAG, "invalid")),
 			Entry("Using 'port-forward' with invalid port", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.LOCAL_PORT_FLAG, "valid port numbers"), runDownloadCmd, vmexport.PORT_FORWARD_FLAG, setFlag(vmexport.LOCAL_PORT_FLAG, "test")),
-			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw/qcow2"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
 			Entry("Downloading volume without specifying output", fmt.Sprintf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", vmexport.OUTPUT_FLAG, vmexport.OUTPUT_FLAG), runDownloadCmd),
 		)
 	})
@@ -549,6 +549,18 @@ var _ = Describe("vmexport", func() {
 				Expect(err).ToNot(HaveOccurred())
 			})
 
+			It("a VirtualMachineExport with qcow2 format", func() {
+				updateVMEStatusOnCreate(exportv1.KubeVirtQcow2)
+				err := runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.QCOW2_FORMAT),
+					setFlag(vmexport.PVC_FLAG, pvcName),
+					setFlag(vmexport.VOLUME_FLAG, volumeName),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+					vmexport.INSECURE_FLAG,
+				)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
 			It("a VirtualMachineExport without decompressing is url is already raw", func() {
 				updateVMEStatusOnCreate(exportv1.KubeVirtRaw)
 				err := runDownloadCmd(
@@ -934,6 +946,55 @@ var _ = Describe("vmexport", func() {
 			Expect(url).Should(Equal("compressed"))
 		})
 
+		It("Should get qcow2 URL when qcow2 is requested", func() {
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+				Name: volumeName,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{
+					{
+						Format: exportv1.KubeVirtRaw,
+						Url:    "raw",
+					},
+					{
+						Format: exportv1.KubeVirtQcow2,
+						Url:    "qcow2",
+					},
+					{
+						Format: exportv1.KubeVirtGz,
+						Url:    "compressed",
+					},
+				}},
+			})
+			vmeInfo := &vmexport.VMExportInfo{
+				Name:       vme.Name,
+				VolumeName: volumeName,
+				Qcow2:      true,
+			}
+
+			url, err := vmexport.GetUrlFromVirtualMachineExport(vme, vmeInfo)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).Should(Equal("qcow2"))
+			Expect(vmeInfo.Decompress).To(BeFalse())
+		})
+
+		It("Should not get any URL when qcow2 is requested but not available", func() {
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+				Name: volumeName,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{{
+					Format: exportv1.KubeVirtGz,
+					Url:    "compressed",
+				}}},
+			})
+			vmeInfo := &vmexport.VMExportInfo{
+				Name:       vme.Name,
+				VolumeName: volumeName,
+				Qcow2:      true,
+			}
+
+			url, err := vmexport.GetUrlFromVirtualMachineExport(vme, vmeInfo)
+			Expect(err).To(MatchError(fmt.Sprintf("unable to get a valid URL from '%s/%s' VirtualMachineExport", vme.Namespace, vme.Name)))
+			Expect(url).To(BeEmpty())
+		})
+
 		It("Should get raw URL when there's no other option", func() {
 			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
 				Name: volumeName,
@@ -22,6 +22,8 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
 )
 
 // VirtualMachineClone is a CRD that clones one VM into another.
@@ -66,6 +68,13 @@ type VirtualMachineCloneSpec struct {
 	// +optional
 	Target *corev1.TypedLocalObjectReference `json:"target,omitempty"`
 
+	// TargetNamespace is the namespace the target is created in.
+	// If not provided, the target is created in the namespace of the clone.
+	// Cloning into another namespace requires permissions to read the source
+	// and to create virtual machines in the target namespace.
+	// +optional
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+
 	// Example use: "!some/key*".
 	// For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
 	// +optional
@@ -88,18 +97,57 @@ type VirtualMachineCloneSpec struct {
 	// be generated automatically.
 	// +optional
 	NewSMBiosSerial *string `json:"newSMBiosSerial,omitempty"`
+	// GuestIdentity configures the regeneration of the identity of the target's guest,
+	// so the target comes up as a machine distinct from the source.
+	// If this field is not specified, the guest of the target keeps the identity of the source.
+	// +optional
+	GuestIdentity *VirtualMachineCloneGuestIdentity `json:"guestIdentity,omitempty"`
+}
+
+type VirtualMachineCloneGuestIdentity struct {
+	// CloudInitInstanceID sets the cloud-init instance ID of the target.
+	// A new instance ID makes cloud-init run its per-instance modules again on the first boot
+	// of the target, which regenerates the SSH host keys and sets the hostname.
+	// If this field is not specified, a new instance ID will be generated automatically.
+	// The hostname of the target is reset to its name.
+	// +optional
+	CloudInitInstanceID *string `json:"cloudInitInstanceID,omitempty"`
+	// Generalization attaches a generalization step to the clone.
+	// +optional
+	Generalization *VirtualMachineCloneGeneralization `json:"generalization,omitempty"`
+}
+
+type VirtualMachineCloneGeneralization struct {
+	// VirtSysprep runs virt-sysprep from the libguestfs-tools image on the volumes of the target
+	// before the clone succeeds. It resets the machine-id, the SSH host keys and the other
+	// machine specific state of a Linux guest.
+	// +optional
+	VirtSysprep *VirtSysprepGeneralization `json:"virtSysprep,omitempty"`
+	// Sysprep attaches a Windows sysprep answer file to the target, which specializes
+	// a generalized Windows guest on the first boot of the target.
+	// +optional
+	Sysprep *virtv1.SysprepSource `json:"sysprep,omitempty"`
+}
+
+type VirtSysprepGeneralization struct {
+	// Operations is the list of virt-sysprep operations to run.
+	// If this field is not specified, the default operations of virt-sysprep are run.
+	// +optional
+	// +listType=atomic
+	Operations []string `json:"operations,omitempty"`
 }
 
 type VirtualMachineClonePhase string
 
 const (
-	PhaseUnset         VirtualMachineClonePhase = ""
-	SnapshotInProgress VirtualMachineClonePhase = "SnapshotInProgress"
-	CreatingTargetVM   VirtualMachineClonePhase = "CreatingTargetVM"
-	RestoreInProgress  VirtualMachineClonePhase = "RestoreInProgress"
-	Succeeded          VirtualMachineClonePhase = "Succeeded"
-	Failed             VirtualMachineClonePhase = "Failed"
-	Unknown            VirtualMachineClonePhase = "Unknown"
+	PhaseUnset               VirtualMachineClonePhase = ""
+	SnapshotInProgress       VirtualMachineClonePhase = "SnapshotInProgress"
+	CreatingTargetVM         VirtualMachineClonePhase = "CreatingTargetVM"
+	RestoreInProgress        VirtualMachineClonePhase = "RestoreInProgress"
+	GeneralizationInProgress VirtualMachineClonePhase = "GeneralizationInProgress"
+	Succeeded                VirtualMachineClonePhase = "Succeeded"
+	Failed                   VirtualMachineClonePhase = "Failed"
+	Unknown                  VirtualMachineClonePhase = "Unknown"
 )
 
 type VirtualMachineCloneStatus struct {
@@ -19,11 +19,33 @@ func (VirtualMachineCloneSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"source":            "Source is the object that would be cloned. Currently supported source types are:\nVirtualMachine of kubevirt.io API group,\nVirtualMachineSnapshot of snapshot.kubevirt.io API group",
 		"target":            "Target is the outcome of the cloning process.\nCurrently supported source types are:\n- VirtualMachine of kubevirt.io API group\n- Empty (nil).\nIf the target is not provided, the target type would default to VirtualMachine and a random\nname would be generated for the target. The target's name can be viewed by\ninspecting status \"TargetName\" field below.\n+optional",
+		"targetNamespace":   "TargetNamespace is the namespace the target is created in.\nIf not provided, the target is created in the namespace of the clone.\nCloning into another namespace requires permissions to read the source\nand to create virtual machines in the target namespace.\n+optional",
 		"annotationFilters": "Example use: \"!some/key*\".\nFor a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional\n+listType=atomic",
 		"labelFilters":      "Example use: \"!some/key*\".\nFor a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional\n+listType=atomic",
 		"template":          "For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional",
 		"newMacAddresses":   "NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the\nvalue is the new mac address. If this field is not specified, a new MAC address will\nbe generated automatically, as for any interface that is not included in this map.\n+optional",
 		"newSMBiosSerial":   "NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will\nbe generated automatically.\n+optional",
+		"guestIdentity":     "GuestIdentity configures the regeneration of the identity of the target's guest,\nso the target comes up as a machine distinct from the source.\nIf this field is not specified, the guest of the target keeps the identity of the source.\n+optional",
+	}
+}
+
+func (VirtualMachineCloneGuestIdentity) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"cloudInitInstanceID": "CloudInitInstanceID sets the cloud-init instance ID of the target.\nA new instance ID makes cloud-init run its per-instance modules again on the first boot\nof the target, which regenerates the SSH host keys and sets the hostname.\nIf this field is not specified, a new instance ID will be generated automatically.\nThe hostname of the target is reset to its name.\n+optional",
+		"generalization":      "Generalization attaches a generalization step to the clone.\n+optional",
+	}
+}
+
+func (VirtualMachineCloneGeneralization) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"virtSysprep": "VirtSysprep runs virt-sysprep from the libguestfs-tools image on the volumes of the target\nbefore the clone succeeds. It resets the machine-id, the SSH host keys and the other\nmachine specific state of a Linux guest.\n+optional",
+		"sysprep":     "Sysprep attaches a Windows sysprep answer file to the target, which specializes\na generalized Windows guest on the first boot of the target.\n+optional",
+	}
+}
+
+func (VirtSysprepGeneralization) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"operations": "Operations is the list of virt-sysprep operations to run.\nIf this field is not specified, the default operations of virt-sysprep are run.\n+optional\n+listType=atomic",
 	}
 }
 
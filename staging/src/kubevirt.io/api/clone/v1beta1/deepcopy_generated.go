@@ -22,8 +22,9 @@ limitations under the License.
 package v1beta1
 
 import (
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -44,6 +45,27 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtSysprepGeneralization) DeepCopyInto(out *VirtSysprepGeneralization) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtSysprepGeneralization.
+func (in *VirtSysprepGeneralization) DeepCopy() *VirtSysprepGeneralization {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtSysprepGeneralization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineClone) DeepCopyInto(out *VirtualMachineClone) {
 	*out = *in
@@ -72,6 +94,58 @@ func (in *VirtualMachineClone) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCloneGeneralization) DeepCopyInto(out *VirtualMachineCloneGeneralization) {
+	*out = *in
+	if in.VirtSysprep != nil {
+		in, out := &in.VirtSysprep, &out.VirtSysprep
+		*out = new(VirtSysprepGeneralization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sysprep != nil {
+		in, out := &in.Sysprep, &out.Sysprep
+		*out = new(v1.SysprepSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCloneGeneralization.
+func (in *VirtualMachineCloneGeneralization) DeepCopy() *VirtualMachineCloneGeneralization {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCloneGeneralization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCloneGuestIdentity) DeepCopyInto(out *VirtualMachineCloneGuestIdentity) {
+	*out = *in
+	if in.CloudInitInstanceID != nil {
+		in, out := &in.CloudInitInstanceID, &out.CloudInitInstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Generalization != nil {
+		in, out := &in.Generalization, &out.Generalization
+		*out = new(VirtualMachineCloneGeneralization)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCloneGuestIdentity.
+func (in *VirtualMachineCloneGuestIdentity) DeepCopy() *VirtualMachineCloneGuestIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCloneGuestIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineCloneList) DeepCopyInto(out *VirtualMachineCloneList) {
 	*out = *in
@@ -110,12 +184,12 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 	*out = *in
 	if in.Source != nil {
 		in, out := &in.Source, &out.Source
-		*out = new(v1.TypedLocalObjectReference)
+		*out = new(corev1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Target != nil {
 		in, out := &in.Target, &out.Target
-		*out = new(v1.TypedLocalObjectReference)
+		*out = new(corev1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.AnnotationFilters != nil {
@@ -141,6 +215,11 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.GuestIdentity != nil {
+		in, out := &in.GuestIdentity, &out.GuestIdentity
+		*out = new(VirtualMachineCloneGuestIdentity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
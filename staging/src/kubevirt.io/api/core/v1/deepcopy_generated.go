@@ -206,6 +206,33 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimits) DeepCopyInto(out *BandwidthLimits) {
+	*out = *in
+	out.Average = in.Average.DeepCopy()
+	if in.Peak != nil {
+		in, out := &in.Peak, &out.Peak
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimits.
+func (in *BandwidthLimits) DeepCopy() *BandwidthLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockSize) DeepCopyInto(out *BlockSize) {
 	*out = *in
@@ -769,6 +796,11 @@ func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 		*out = make([]DHCPPrivateOptions, len(*in))
 		copy(*out, *in)
 	}
+	if in.DHCPv6Options != nil {
+		in, out := &in.DHCPv6Options, &out.DHCPv6Options
+		*out = make([]DHCPv6Option, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -798,6 +830,22 @@ func (in *DHCPPrivateOptions) DeepCopy() *DHCPPrivateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPv6Option) DeepCopyInto(out *DHCPv6Option) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPv6Option.
+func (in *DHCPv6Option) DeepCopy() *DHCPv6Option {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPv6Option)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolumeSource) DeepCopyInto(out *DataVolumeSource) {
 	*out = *in
@@ -2195,6 +2243,16 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TrafficFilter != nil {
+		in, out := &in.TrafficFilter, &out.TrafficFilter
+		*out = new(InterfaceTrafficFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2208,6 +2266,32 @@ func (in *Interface) DeepCopy() *Interface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidth) DeepCopyInto(out *InterfaceBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandwidthLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandwidthLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidth.
+func (in *InterfaceBandwidth) DeepCopy() *InterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	*out = *in
@@ -2344,6 +2428,42 @@ func (in *InterfaceSRIOV) DeepCopy() *InterfaceSRIOV {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceTrafficFilter) DeepCopyInto(out *InterfaceTrafficFilter) {
+	*out = *in
+	if in.AllowedMACAddresses != nil {
+		in, out := &in.AllowedMACAddresses, &out.AllowedMACAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedIPAddresses != nil {
+		in, out := &in.AllowedIPAddresses, &out.AllowedIPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IngressPorts != nil {
+		in, out := &in.IngressPorts, &out.IngressPorts
+		*out = make([]Port, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressPorts != nil {
+		in, out := &in.EgressPorts, &out.EgressPorts
+		*out = make([]Port, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceTrafficFilter.
+func (in *InterfaceTrafficFilter) DeepCopy() *InterfaceTrafficFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceTrafficFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KSMConfiguration) DeepCopyInto(out *KSMConfiguration) {
 	*out = *in
@@ -3105,6 +3225,43 @@ func (in *MemoryStatus) DeepCopy() *MemoryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataService) DeepCopyInto(out *MetadataService) {
+	*out = *in
+	if in.OpenStack != nil {
+		in, out := &in.OpenStack, &out.OpenStack
+		*out = new(MetadataServiceOpenStack)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataService.
+func (in *MetadataService) DeepCopy() *MetadataService {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataServiceOpenStack) DeepCopyInto(out *MetadataServiceOpenStack) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataServiceOpenStack.
+func (in *MetadataServiceOpenStack) DeepCopy() *MetadataServiceOpenStack {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataServiceOpenStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrateOptions) DeepCopyInto(out *MigrateOptions) {
 	*out = *in
@@ -3195,6 +3352,21 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ParallelMigrationThreads != nil {
+		in, out := &in.ParallelMigrationThreads, &out.ParallelMigrationThreads
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(MigrationCompression)
+		**out = **in
+	}
+	if in.MaxDowntimeMilliseconds != nil {
+		in, out := &in.MaxDowntimeMilliseconds, &out.MaxDowntimeMilliseconds
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -3208,6 +3380,108 @@ func (in *MigrationConfiguration) DeepCopy() *MigrationConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationDryRunCheck) DeepCopyInto(out *MigrationDryRunCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationDryRunCheck.
+func (in *MigrationDryRunCheck) DeepCopy() *MigrationDryRunCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationDryRunCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationDryRunReport) DeepCopyInto(out *MigrationDryRunReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]MigrationDryRunCheck, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationDryRunReport.
+func (in *MigrationDryRunReport) DeepCopy() *MigrationDryRunReport {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationDryRunReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MigrationDryRunReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationRetryPolicy) DeepCopyInto(out *MigrationRetryPolicy) {
+	*out = *in
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.InitialBackoffSeconds != nil {
+		in, out := &in.InitialBackoffSeconds, &out.InitialBackoffSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AllowPostCopyOnRetry != nil {
+		in, out := &in.AllowPostCopyOnRetry, &out.AllowPostCopyOnRetry
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BandwidthPerMigrationOnRetry != nil {
+		in, out := &in.BandwidthPerMigrationOnRetry, &out.BandwidthPerMigrationOnRetry
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationRetryPolicy.
+func (in *MigrationRetryPolicy) DeepCopy() *MigrationRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationRetryStatus) DeepCopyInto(out *MigrationRetryStatus) {
+	*out = *in
+	if in.BackoffUntil != nil {
+		in, out := &in.BackoffUntil, &out.BackoffUntil
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationRetryStatus.
+func (in *MigrationRetryStatus) DeepCopy() *MigrationRetryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationRetryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultusNetwork) DeepCopyInto(out *MultusNetwork) {
 	*out = *in
@@ -3298,6 +3572,11 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.TrafficFilter != nil {
+		in, out := &in.TrafficFilter, &out.TrafficFilter
+		*out = new(InterfaceTrafficFilter)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5064,7 +5343,7 @@ func (in *VirtualMachineInstanceMigration) DeepCopyInto(out *VirtualMachineInsta
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -5155,9 +5434,149 @@ func (in *VirtualMachineInstanceMigrationPhaseTransitionTimestamp) DeepCopy() *V
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationProgress) DeepCopyInto(out *VirtualMachineInstanceMigrationProgress) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DataTotalBytes != nil {
+		in, out := &in.DataTotalBytes, &out.DataTotalBytes
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.DataProcessedBytes != nil {
+		in, out := &in.DataProcessedBytes, &out.DataProcessedBytes
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.DataRemainingBytes != nil {
+		in, out := &in.DataRemainingBytes, &out.DataRemainingBytes
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MemoryDirtyRateBytes != nil {
+		in, out := &in.MemoryDirtyRateBytes, &out.MemoryDirtyRateBytes
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MemoryTransferRateBytes != nil {
+		in, out := &in.MemoryTransferRateBytes, &out.MemoryTransferRateBytes
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MemoryIterations != nil {
+		in, out := &in.MemoryIterations, &out.MemoryIterations
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.AutoConvergeThrottle != nil {
+		in, out := &in.AutoConvergeThrottle, &out.AutoConvergeThrottle
+		*out = new(int)
+		**out = **in
+	}
+	if in.PostCopyRequests != nil {
+		in, out := &in.PostCopyRequests, &out.PostCopyRequests
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ElapsedMilliseconds != nil {
+		in, out := &in.ElapsedMilliseconds, &out.ElapsedMilliseconds
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.EstimatedRemainingMilliseconds != nil {
+		in, out := &in.EstimatedRemainingMilliseconds, &out.EstimatedRemainingMilliseconds
+		*out = new(uint64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationProgress.
+func (in *VirtualMachineInstanceMigrationProgress) DeepCopy() *VirtualMachineInstanceMigrationProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceMigrationProgress) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationReceive) DeepCopyInto(out *VirtualMachineInstanceMigrationReceive) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationReceive.
+func (in *VirtualMachineInstanceMigrationReceive) DeepCopy() *VirtualMachineInstanceMigrationReceive {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationReceive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationSendTo) DeepCopyInto(out *VirtualMachineInstanceMigrationSendTo) {
+	*out = *in
+	out.ConnectionSecretRef = in.ConnectionSecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationSendTo.
+func (in *VirtualMachineInstanceMigrationSendTo) DeepCopy() *VirtualMachineInstanceMigrationSendTo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationSendTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationSpec) DeepCopyInto(out *VirtualMachineInstanceMigrationSpec) {
 	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SendTo != nil {
+		in, out := &in.SendTo, &out.SendTo
+		*out = new(VirtualMachineInstanceMigrationSendTo)
+		**out = **in
+	}
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = new(VirtualMachineInstanceMigrationReceive)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(MigrationPriority)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(MigrationRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5243,6 +5662,11 @@ func (in *VirtualMachineInstanceMigrationStatus) DeepCopyInto(out *VirtualMachin
 		*out = new(VirtualMachineInstanceMigrationState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(MigrationRetryStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5264,6 +5688,11 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopyInto(out *VirtualMachi
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5611,6 +6040,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MetadataService != nil {
+		in, out := &in.MetadataService, &out.MetadataService
+		*out = new(MetadataService)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
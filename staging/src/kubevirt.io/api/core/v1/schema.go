@@ -883,6 +883,11 @@ type MemoryDumpVolumeSource struct {
 	// Directly attached to the virt launcher
 	// +optional
 	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// Format is the format of the memory dump, defaults to Raw.
+	// A volume which is not hotpluggable and holds a SaveState dump is used
+	// to resume the VMI on start.
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
 }
 
 type EphemeralVolumeSource struct {
@@ -1280,31 +1285,107 @@ type Interface struct {
 	// +optional
 	ACPIIndex int `json:"acpiIndex,omitempty"`
 	// State represents the requested operational state of the interface.
-	// The (only) value supported is `absent`, expressing a request to remove the interface.
+	// The values supported are:
+	// `absent`, expressing a request to remove the interface.
+	// `down`, expressing a request to set the link of the interface down.
+	// `up`, expressing a request to set the link of the interface up (the default).
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
+	// If specified, the traffic of the interface is filtered accordingly.
+	// Only supported with bridge binding.
+	// +optional
+	TrafficFilter *InterfaceTrafficFilter `json:"trafficFilter,omitempty"`
+	// If specified, the traffic of the interface is rate limited accordingly.
+	// Only supported with bridge and masquerade bindings.
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
 }
 
 type InterfaceState string
 
 const (
-	InterfaceStateAbsent InterfaceState = "absent"
+	InterfaceStateAbsent   InterfaceState = "absent"
+	InterfaceStateLinkDown InterfaceState = "down"
+	InterfaceStateLinkUp   InterfaceState = "up"
 )
 
+// InterfaceTrafficFilter defines the filtering of the traffic sent and received by the guest on an interface.
+type InterfaceTrafficFilter struct {
+	// AllowedMACAddresses are the source MAC addresses the guest is allowed to send frames with.
+	// Defaults to the MAC address of the interface, if specified.
+	// +optional
+	// +listType=atomic
+	AllowedMACAddresses []string `json:"allowedMACAddresses,omitempty"`
+	// AllowedIPAddresses are the source IP addresses or CIDRs the guest is allowed to send packets with.
+	// When specified, packets with any other source IP address are dropped. IPv6 link-local addresses
+	// and DHCP requests of the guest are always allowed.
+	// +optional
+	// +listType=atomic
+	AllowedIPAddresses []string `json:"allowedIPAddresses,omitempty"`
+	// NeighborDiscoveryProtection drops ARP messages and IPv6 neighbor advertisements sent by the guest
+	// on behalf of addresses which are not allowed.
+	// +optional
+	NeighborDiscoveryProtection bool `json:"neighborDiscoveryProtection,omitempty"`
+	// DHCPServerGuard drops DHCP server messages and IPv6 router advertisements sent by the guest.
+	// +optional
+	DHCPServerGuard bool `json:"dhcpServerGuard,omitempty"`
+	// IngressPorts are the ports the guest is allowed to receive new connections on.
+	// When specified, any other TCP and UDP traffic towards the guest is dropped.
+	// +optional
+	// +listType=atomic
+	IngressPorts []Port `json:"ingressPorts,omitempty"`
+	// EgressPorts are the ports the guest is allowed to open new connections to.
+	// When specified, any other TCP and UDP traffic from the guest is dropped, except for DHCP requests.
+	// +optional
+	// +listType=atomic
+	EgressPorts []Port `json:"egressPorts,omitempty"`
+}
+
+// InterfaceBandwidth represents the quality of service settings of an interface.
+type InterfaceBandwidth struct {
+	// Inbound limits the traffic received by the guest.
+	// +optional
+	Inbound *BandwidthLimits `json:"inbound,omitempty"`
+	// Outbound limits the traffic sent by the guest.
+	// +optional
+	Outbound *BandwidthLimits `json:"outbound,omitempty"`
+}
+
+// BandwidthLimits represents the rate limits of a traffic direction.
+type BandwidthLimits struct {
+	// Average is the average bit rate of the shaped traffic, in bits per second (e.g. 10M).
+	Average resource.Quantity `json:"average"`
+	// Peak is the maximum bit rate the traffic can be sent at, in bits per second.
+	// +optional
+	Peak *resource.Quantity `json:"peak,omitempty"`
+	// Burst is the amount of data that can be sent at the peak rate, in bytes (e.g. 1Mi).
+	// +optional
+	Burst *resource.Quantity `json:"burst,omitempty"`
+}
+
 // Extra DHCP options to use in the interface.
 type DHCPOptions struct {
-	// If specified will pass option 67 to interface's DHCP server
+	// If specified will pass option 67 to interface's DHCP server.
+	// The DHCPv6 server passes it as the boot file URL option 59 (RFC 5970), served from
+	// the TFTP server unless it is a URL.
 	// +optional
 	BootFileName string `json:"bootFileName,omitempty"`
 	// If specified will pass option 66 to interface's DHCP server
 	// +optional
 	TFTPServerName string `json:"tftpServerName,omitempty"`
 	// If specified will pass the configured NTP server to the VM via DHCP option 042.
+	// IPv6 NTP servers are passed via DHCPv6 option 56.
 	// +optional
 	NTPServers []string `json:"ntpServers,omitempty"`
 	// If specified will pass extra DHCP options for private use, range: 224-254
 	// +optional
 	PrivateOptions []DHCPPrivateOptions `json:"privateOptions,omitempty"`
+	// If specified will pass extra options to interface's DHCPv6 server.
+	// DHCPv6 has no range of options for private use, see DHCPv6Option for the allowed option codes.
+	// Prefix delegation (IA_PD) is not offered by the DHCPv6 server.
+	// +listType=atomic
+	// +optional
+	DHCPv6Options []DHCPv6Option `json:"dhcpv6Options,omitempty"`
 }
 
 func (d *DHCPOptions) UnmarshalJSON(data []byte) error {
@@ -1335,6 +1416,19 @@ type DHCPPrivateOptions struct {
 	Value string `json:"value"`
 }
 
+// DHCPv6Option defines an extra DHCPv6 option for a VM.
+type DHCPv6Option struct {
+	// Option is the DHCPv6 option code, an Integer value from 1-65535.
+	// The options set by the DHCPv6 server itself cannot be specified: client and server identifiers (1, 2),
+	// address assignment (3, 4, 5, 13, 25, 26), DNS servers (23), domain search list (24),
+	// NTP servers (56) and boot file URL (59).
+	// Required.
+	Option int `json:"option"`
+	// Value is a String value for the Option provided
+	// Required.
+	Value string `json:"value"`
+}
+
 // Represents the method which will be used to connect the interface to the guest.
 // Only one of its members may be specified.
 type InterfaceBindingMethod struct {
@@ -1515,6 +1609,21 @@ type AccessCredential struct {
 	UserPassword *UserPasswordAccessCredential `json:"userPassword,omitempty"`
 }
 
+// MetadataService represents a metadata HTTP service answered from the virt-launcher pod.
+// It serves the instance ID, hostname, SSH public keys of the access credentials,
+// and the user and network data of the cloud-init volume.
+// The data is read on every request, reflecting the updates of the referenced secrets.
+type MetadataService struct {
+	// OpenStack also serves the OpenStack metadata tree, under /openstack.
+	// The OpenStack data source of cloud-init does not use session tokens, so this tree,
+	// including the SSH public keys and the user data, is served to any client of the guest.
+	// +optional
+	OpenStack *MetadataServiceOpenStack `json:"openStack,omitempty"`
+}
+
+// MetadataServiceOpenStack enables the OpenStack metadata tree of the metadata service.
+type MetadataServiceOpenStack struct{}
+
 // Network represents a network type and a resource that should be connected to the vm.
 type Network struct {
 	// Network name.
@@ -476,7 +476,9 @@ func (PersistentVolumeClaimVolumeSource) SwaggerDoc() map[string]string {
 }
 
 func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"format": "Format is the format of the memory dump, defaults to Raw.\nA volume which is not hotpluggable and holds a SaveState dump is used\nto resume the VMI on start.\n+optional",
+	}
 }
 
 func (EphemeralVolumeSource) SwaggerDoc() map[string]string {
@@ -668,27 +670,59 @@ func (I6300ESBWatchdog) SwaggerDoc() map[string]string {
 
 func (Interface) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"name":        "Logical name of the interface as well as a reference to the associated networks.\nMust match the Name of a Network.",
-		"model":       "Interface model.\nOne of: e1000, e1000e, igb, ne2k_pci, pcnet, rtl8139, virtio.\nDefaults to virtio.",
-		"binding":     "Binding specifies the binding plugin that will be used to connect the interface to the guest.\nIt provides an alternative to InterfaceBindingMethod.\nversion: 1alphav1",
-		"ports":       "List of ports to be forwarded to the virtual machine.",
-		"macAddress":  "Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.",
-		"bootOrder":   "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach interface or disk that has a boot order must have a unique value.\nInterfaces without a boot order are not tried.\n+optional",
-		"pciAddress":  "If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
-		"dhcpOptions": "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
-		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
-		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
-		"state":       "State represents the requested operational state of the interface.\nThe (only) value supported is `absent`, expressing a request to remove the interface.\n+optional",
+		"name":          "Logical name of the interface as well as a reference to the associated networks.\nMust match the Name of a Network.",
+		"model":         "Interface model.\nOne of: e1000, e1000e, igb, ne2k_pci, pcnet, rtl8139, virtio.\nDefaults to virtio.",
+		"binding":       "Binding specifies the binding plugin that will be used to connect the interface to the guest.\nIt provides an alternative to InterfaceBindingMethod.\nversion: 1alphav1",
+		"ports":         "List of ports to be forwarded to the virtual machine.",
+		"macAddress":    "Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.",
+		"bootOrder":     "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach interface or disk that has a boot order must have a unique value.\nInterfaces without a boot order are not tried.\n+optional",
+		"pciAddress":    "If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
+		"dhcpOptions":   "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
+		"tag":           "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"acpiIndex":     "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
+		"state":         "State represents the requested operational state of the interface.\nThe values supported are:\n`absent`, expressing a request to remove the interface.\n`down`, expressing a request to set the link of the interface down.\n`up`, expressing a request to set the link of the interface up (the default).\n+optional",
+		"trafficFilter": "If specified, the traffic of the interface is filtered accordingly.\nOnly supported with bridge binding.\n+optional",
+		"bandwidth":     "If specified, the traffic of the interface is rate limited accordingly.\nOnly supported with bridge and masquerade bindings.\n+optional",
+	}
+}
+
+func (InterfaceTrafficFilter) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                            "InterfaceTrafficFilter defines the filtering of the traffic sent and received by the guest on an interface.",
+		"allowedMACAddresses":         "AllowedMACAddresses are the source MAC addresses the guest is allowed to send frames with.\nDefaults to the MAC address of the interface, if specified.\n+optional\n+listType=atomic",
+		"allowedIPAddresses":          "AllowedIPAddresses are the source IP addresses or CIDRs the guest is allowed to send packets with.\nWhen specified, packets with any other source IP address are dropped. IPv6 link-local addresses\nand DHCP requests of the guest are always allowed.\n+optional\n+listType=atomic",
+		"neighborDiscoveryProtection": "NeighborDiscoveryProtection drops ARP messages and IPv6 neighbor advertisements sent by the guest\non behalf of addresses which are not allowed.\n+optional",
+		"dhcpServerGuard":             "DHCPServerGuard drops DHCP server messages and IPv6 router advertisements sent by the guest.\n+optional",
+		"ingressPorts":                "IngressPorts are the ports the guest is allowed to receive new connections on.\nWhen specified, any other TCP and UDP traffic towards the guest is dropped.\n+optional\n+listType=atomic",
+		"egressPorts":                 "EgressPorts are the ports the guest is allowed to open new connections to.\nWhen specified, any other TCP and UDP traffic from the guest is dropped, except for DHCP requests.\n+optional\n+listType=atomic",
+	}
+}
+
+func (InterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceBandwidth represents the quality of service settings of an interface.",
+		"inbound":  "Inbound limits the traffic received by the guest.\n+optional",
+		"outbound": "Outbound limits the traffic sent by the guest.\n+optional",
+	}
+}
+
+func (BandwidthLimits) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "BandwidthLimits represents the rate limits of a traffic direction.",
+		"average": "Average is the average bit rate of the shaped traffic, in bits per second (e.g. 10M).",
+		"peak":    "Peak is the maximum bit rate the traffic can be sent at, in bits per second.\n+optional",
+		"burst":   "Burst is the amount of data that can be sent at the peak rate, in bytes (e.g. 1Mi).\n+optional",
 	}
 }
 
 func (DHCPOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "Extra DHCP options to use in the interface.",
-		"bootFileName":   "If specified will pass option 67 to interface's DHCP server\n+optional",
+		"bootFileName":   "If specified will pass option 67 to interface's DHCP server.\nThe DHCPv6 server passes it as the boot file URL option 59 (RFC 5970), served from\nthe TFTP server unless it is a URL.\n+optional",
 		"tftpServerName": "If specified will pass option 66 to interface's DHCP server\n+optional",
-		"ntpServers":     "If specified will pass the configured NTP server to the VM via DHCP option 042.\n+optional",
+		"ntpServers":     "If specified will pass the configured NTP server to the VM via DHCP option 042.\nIPv6 NTP servers are passed via DHCPv6 option 56.\n+optional",
 		"privateOptions": "If specified will pass extra DHCP options for private use, range: 224-254\n+optional",
+		"dhcpv6Options":  "If specified will pass extra options to interface's DHCPv6 server.\nDHCPv6 has no range of options for private use, see DHCPv6Option for the allowed option codes.\nPrefix delegation (IA_PD) is not offered by the DHCPv6 server.\n+listType=atomic\n+optional",
 	}
 }
 
@@ -700,6 +734,14 @@ func (DHCPPrivateOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (DHCPv6Option) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "DHCPv6Option defines an extra DHCPv6 option for a VM.",
+		"option": "Option is the DHCPv6 option code, an Integer value from 1-65535.\nThe options set by the DHCPv6 server itself cannot be specified: client and server identifiers (1, 2),\naddress assignment (3, 4, 5, 13, 25, 26), DNS servers (23), domain search list (24),\nNTP servers (56) and boot file URL (59).\nRequired.",
+		"value":  "Value is a String value for the Option provided\nRequired.",
+	}
+}
+
 func (InterfaceBindingMethod) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":        "Represents the method which will be used to connect the interface to the guest.\nOnly one of its members may be specified.",
@@ -846,6 +888,19 @@ func (AccessCredential) SwaggerDoc() map[string]string {
 	}
 }
 
+func (MetadataService) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "MetadataService represents a metadata HTTP service answered from the virt-launcher pod.\nIt serves the instance ID, hostname, SSH public keys of the access credentials,\nand the user and network data of the cloud-init volume.\nThe data is read on every request, reflecting the updates of the referenced secrets.",
+		"openStack": "OpenStack also serves the OpenStack metadata tree, under /openstack.\nThe OpenStack data source of cloud-init does not use session tokens, so this tree,\nincluding the SSH public keys and the user data, is served to any client of the guest.\n+optional",
+	}
+}
+
+func (MetadataServiceOpenStack) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "MetadataServiceOpenStack enables the OpenStack metadata tree of the metadata service.",
+	}
+}
+
 func (Network) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":     "Network represents a network type and a resource that should be connected to the vm.",
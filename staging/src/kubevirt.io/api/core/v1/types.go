@@ -168,6 +168,13 @@ type VirtualMachineInstanceSpec struct {
 	// +optional
 	// +kubebuilder:validation:MaxItems:=256
 	AccessCredentials []AccessCredential `json:"accessCredentials,omitempty"`
+	// MetadataService exposes an EC2 and, optionally, an OpenStack compatible metadata service
+	// to the guest, on the link-local address 169.254.169.254.
+	// The EC2 metadata is served to IMDSv2 clients only, presenting a session token.
+	// The OpenStack metadata, when enabled, is served without a session token.
+	// Requires the pod network to use the masquerade binding.
+	// +optional
+	MetadataService *MetadataService `json:"metadataService,omitempty"`
 	// Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components
 	Architecture string `json:"architecture,omitempty"`
 }
@@ -664,6 +671,11 @@ func (m *VirtualMachineInstanceMigration) IsFinal() bool {
 	return m.Status.Phase == MigrationFailed || m.Status.Phase == MigrationSucceeded
 }
 
+// IsCrossCluster returns true if the migration moves the VMI between clusters
+func (m *VirtualMachineInstanceMigration) IsCrossCluster() bool {
+	return m.Spec.SendTo != nil || m.Spec.Receive != nil
+}
+
 func (m *VirtualMachineInstanceMigration) IsRunning() bool {
 	switch m.Status.Phase {
 	case MigrationFailed, MigrationPending, MigrationPhaseUnset, MigrationSucceeded:
@@ -706,6 +718,8 @@ type VirtualMachineInstanceNetworkInterface struct {
 	QueueCount int32 `json:"queueCount,omitempty"`
 	// LinkState Reports the current operational link state`. values: up, down.
 	LinkState string `json:"linkState,omitempty"`
+	// Bandwidth reports the bandwidth limits applied on the interface
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
 }
 
 type VirtualMachineInstanceGuestOSInfo struct {
@@ -790,6 +804,10 @@ type VirtualMachineInstanceMigrationState struct {
 	SourcePersistentStatePVCName string `json:"sourcePersistentStatePVCName,omitempty"`
 	// If the VMI being migrated uses persistent features (backend-storage), its target PVC name is saved here
 	TargetPersistentStatePVCName string `json:"targetPersistentStatePVCName,omitempty"`
+
+	// The UID of the VMI receiving the migration in another cluster.
+	// Only set on the source of a cross-cluster migration.
+	TargetVirtualMachineInstanceUID types.UID `json:"targetVirtualMachineInstanceUID,omitempty"`
 }
 
 type MigrationAbortStatus string
@@ -814,6 +832,38 @@ const (
 	MigrationPaused MigrationMode = "Paused"
 )
 
+// VirtualMachineInstanceMigrationProgress reports the progress of the live migration
+// currently running on the source node, as reported by the hypervisor
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceMigrationProgress struct {
+	metav1.TypeMeta `json:",inline"`
+	// The UID of the migration in progress
+	MigrationUID types.UID `json:"migrationUid,omitempty"`
+	// The mode of the migration in progress
+	Mode MigrationMode `json:"mode,omitempty"`
+	// The total amount of guest data to be migrated, in bytes
+	DataTotalBytes *uint64 `json:"dataTotalBytes,omitempty"`
+	// The amount of guest data already migrated, in bytes
+	DataProcessedBytes *uint64 `json:"dataProcessedBytes,omitempty"`
+	// The amount of guest data still to be migrated, in bytes
+	DataRemainingBytes *uint64 `json:"dataRemainingBytes,omitempty"`
+	// The rate at which the guest dirties its memory, in bytes per second
+	MemoryDirtyRateBytes *uint64 `json:"memoryDirtyRateBytes,omitempty"`
+	// The rate at which memory is transferred to the target, in bytes per second
+	MemoryTransferRateBytes *uint64 `json:"memoryTransferRateBytes,omitempty"`
+	// The number of memory pre-copy iterations performed so far
+	MemoryIterations *uint64 `json:"memoryIterations,omitempty"`
+	// The percentage by which auto-converge currently throttles the guest vCPUs
+	AutoConvergeThrottle *int `json:"autoConvergeThrottle,omitempty"`
+	// The number of page faults requested by the target during post-copy
+	PostCopyRequests *uint64 `json:"postCopyRequests,omitempty"`
+	// The time elapsed since the migration started, in milliseconds
+	ElapsedMilliseconds *uint64 `json:"elapsedMilliseconds,omitempty"`
+	// The estimated time until the migration completes, in milliseconds
+	EstimatedRemainingMilliseconds *uint64 `json:"estimatedRemainingMilliseconds,omitempty"`
+}
+
 type VirtualMachineInstanceMigrationTransport string
 
 const (
@@ -913,6 +963,12 @@ const (
 	// This annotation indicates that a migration is the result of an
 	// automated workload update
 	WorkloadUpdateMigrationAnnotation string = "kubevirt.io/workloadUpdateMigration"
+	// This annotation indicates that a migration retries a failed migration,
+	// it holds the name of the failed migration
+	MigrationRetryOfAnnotation string = "kubevirt.io/migrationRetryOf"
+	// This annotation holds the number of the attempt of a migration
+	// retrying a failed migration
+	MigrationAttemptAnnotation string = "kubevirt.io/migrationAttempt"
 	// This annotation indicates to abort any migration due to an automated
 	// workload update. It should only be used for testing purposes.
 	WorkloadUpdateMigrationAbortionAnnotation string = "kubevirt.io/testWorkloadUpdateMigrationAbortion"
@@ -1021,6 +1077,14 @@ const (
 	// This exists for functional testing
 	MigrationPendingPodTimeoutSecondsAnnotation string = "kubevirt.io/migrationPendingPodTimeoutSeconds"
 
+	// MigrationReceiverAnnotation marks a VirtualMachineInstance which waits for its state to be received
+	// by a cross-cluster migration. No virt-launcher pod is created for it until the migration completes.
+	MigrationReceiverAnnotation string = "kubevirt.io/migration-receiver"
+
+	// CrossClusterMigrationRunStrategyAnnotation stores the run strategy a VirtualMachine had before
+	// it was handed off to another cluster by a cross-cluster migration
+	CrossClusterMigrationRunStrategyAnnotation string = "kubevirt.io/cross-cluster-migration-run-strategy"
+
 	// CustomLibvirtLogFiltersAnnotation can be used to customized libvirt log filters. Example value could be
 	// "3:remote 4:event 3:util.json 3:util.object 3:util.dbus 3:util.netlink 3:node_device 3:rpc 3:access 1:*".
 	// For more info: https://libvirt.org/kbase/debuglogs.html
@@ -1096,6 +1160,10 @@ const (
 	// in which freePageReporting is always disabled.
 	FreePageReportingDisabledAnnotation string = "kubevirt.io/free-page-reporting-disabled"
 
+	// CloudInitInstanceIDAnnotation overrides the instance ID in the cloud-init metadata of the vmi.
+	// A new instance ID makes cloud-init in the guest treat the vmi as a new instance.
+	CloudInitInstanceIDAnnotation string = "kubevirt.io/cloud-init-instance-id"
+
 	// VirtualMachinePodCPULimitsLabel indicates VMI pod CPU resource limits
 	VirtualMachinePodCPULimitsLabel string = "kubevirt.io/vmi-pod-cpu-resource-limits"
 	// VirtualMachinePodMemoryRequestsLabel indicates VMI pod Memory resource requests
@@ -1383,6 +1451,105 @@ type VirtualMachineInstanceMigrationList struct {
 type VirtualMachineInstanceMigrationSpec struct {
 	// The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace
 	VMIName string `json:"vmiName,omitempty" valid:"required"`
+
+	// NodeSelector restricts the set of nodes the VMI can be migrated to.
+	// It is merged into the node selector of the VMI, in case of key collisions
+	// the values set on the VMI are preserved, so the migration can only
+	// restrict but not bypass the constraints already set on the VMI.
+	// More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Affinity adds scheduling constraints for the migration target.
+	// Required node affinity terms are combined with the terms set on the VMI,
+	// all other terms are added to the ones set on the VMI.
+	// +optional
+	Affinity *k8sv1.Affinity `json:"affinity,omitempty"`
+
+	// SendTo migrates the VMI to another cluster, where a migration with
+	// a matching Receive section prepares the target.
+	// Mutually exclusive with Receive.
+	// +optional
+	SendTo *VirtualMachineInstanceMigrationSendTo `json:"sendTo,omitempty"`
+
+	// Receive prepares the VMI as the target of a migration sent
+	// from another cluster. The VMI has to be created with the
+	// kubevirt.io/migration-receiver annotation.
+	// Mutually exclusive with SendTo.
+	// +optional
+	Receive *VirtualMachineInstanceMigrationReceive `json:"receive,omitempty"`
+
+	// Priority defines the order in which pending migrations are started when
+	// the parallel migration limits are reached, supported values are:
+	// system-critical - Migrations required to evacuate nodes.
+	// user-triggered - Migrations requested by users.
+	// system-maintenance - Migrations rolling out workload updates.
+	// Defaults to system-critical for evacuations, system-maintenance for
+	// workload updates and user-triggered for all other migrations.
+	// Only users allowed to update the KubeVirt resource can request another
+	// priority than user-triggered.
+	// Requires the MigrationPriorityQueue feature gate.
+	// +optional
+	// +kubebuilder:validation:Enum=system-critical;user-triggered;system-maintenance
+	Priority *MigrationPriority `json:"priority,omitempty"`
+
+	// RetryPolicy defines how the migration is retried when it fails.
+	// Takes precedence over the retry policy of a matching MigrationPolicy.
+	// +optional
+	RetryPolicy *MigrationRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// MigrationRetryPolicy defines how failed migrations are retried. Every attempt
+// is a new VirtualMachineInstanceMigration carrying the retry policy of the
+// migration it retries.
+type MigrationRetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts to migrate the VMI,
+	// including the first one. Defaults to 3.
+	// +optional
+	MaxAttempts *uint32 `json:"maxAttempts,omitempty"`
+	// InitialBackoffSeconds is the time to wait before the first retry,
+	// it doubles with every further retry. Defaults to 20.
+	// +optional
+	InitialBackoffSeconds *int64 `json:"initialBackoffSeconds,omitempty"`
+	// AllowPostCopyOnRetry allows retries to switch to post-copy when
+	// the migration does not converge.
+	// Only honored in the retry policy of a MigrationPolicy.
+	// +optional
+	AllowPostCopyOnRetry *bool `json:"allowPostCopyOnRetry,omitempty"`
+	// BandwidthPerMigrationOnRetry is the bandwidth limit of retries.
+	// Only honored in the retry policy of a MigrationPolicy.
+	// +optional
+	BandwidthPerMigrationOnRetry *resource.Quantity `json:"bandwidthPerMigrationOnRetry,omitempty"`
+}
+
+type MigrationPriority string
+
+const (
+	// MigrationPrioritySystemCritical is the priority of migrations evacuating nodes
+	MigrationPrioritySystemCritical MigrationPriority = "system-critical"
+	// MigrationPriorityUserTriggered is the priority of migrations requested by users
+	MigrationPriorityUserTriggered MigrationPriority = "user-triggered"
+	// MigrationPrioritySystemMaintenance is the priority of migrations rolling out workload updates
+	MigrationPrioritySystemMaintenance MigrationPriority = "system-maintenance"
+)
+
+// VirtualMachineInstanceMigrationSendTo describes the target of a cross-cluster migration.
+// The virt-handlers of the two clusters trust each other once the CA of each cluster is added to
+// the kubevirt-migration-remote-ca configmap of the other cluster, in the namespace KubeVirt is installed in.
+type VirtualMachineInstanceMigrationSendTo struct {
+	// MigrationID identifies the receiving migration in the target cluster
+	MigrationID string `json:"migrationID"`
+	// ConnectionSecretRef references a secret in the namespace KubeVirt is installed in,
+	// holding a kubeconfig for the target cluster under the "kubeconfig" key.
+	// The creator of the migration has to be allowed to get the secret.
+	// The receiving migration is looked up in the namespace of the migration.
+	ConnectionSecretRef k8sv1.LocalObjectReference `json:"connectionSecretRef"`
+}
+
+// VirtualMachineInstanceMigrationReceive describes the receiving side of a cross-cluster migration
+type VirtualMachineInstanceMigrationReceive struct {
+	// MigrationID identifies this migration for the sending cluster
+	MigrationID string `json:"migrationID"`
 }
 
 // VirtualMachineInstanceMigrationPhaseTransitionTimestamp gives a timestamp in relation to when a phase is set on a vmi
@@ -1403,6 +1570,24 @@ type VirtualMachineInstanceMigrationStatus struct {
 	PhaseTransitionTimestamps []VirtualMachineInstanceMigrationPhaseTransitionTimestamp `json:"phaseTransitionTimestamps,omitempty"`
 	// Represents the status of a live migration
 	MigrationState *VirtualMachineInstanceMigrationState `json:"migrationState,omitempty"`
+	// Retry records the attempts of a migration with a retry policy
+	// +optional
+	Retry *MigrationRetryStatus `json:"retry,omitempty"`
+}
+
+// MigrationRetryStatus records an attempt of a migration with a retry policy
+type MigrationRetryStatus struct {
+	// Attempt is the number of this attempt to migrate the VMI, starting at 1
+	Attempt uint32 `json:"attempt"`
+	// RetryOf is the name of the failed migration retried by this migration
+	// +optional
+	RetryOf string `json:"retryOf,omitempty"`
+	// RetriedBy is the name of the migration retrying this migration after it failed
+	// +optional
+	RetriedBy string `json:"retriedBy,omitempty"`
+	// BackoffUntil is the time this migration waits for before it starts
+	// +optional
+	BackoffUntil *metav1.Time `json:"backoffUntil,omitempty"`
 }
 
 // VirtualMachineInstanceMigrationPhase is a label for the condition of a VirtualMachineInstanceMigration at the current time.
@@ -2277,6 +2462,45 @@ type MigrateOptions struct {
 	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
 }
 
+// MigrationDryRunReport is returned by the migrationcheck subresource and reports
+// whether the VirtualMachineInstance could be live migrated
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MigrationDryRunReport struct {
+	metav1.TypeMeta `json:",inline"`
+	// Migratable is true when all the checks passed
+	Migratable bool `json:"migratable"`
+	// Checks contains the result of each evaluated check
+	// +listType=atomic
+	Checks []MigrationDryRunCheck `json:"checks,omitempty"`
+}
+
+type MigrationDryRunCheckType string
+
+const (
+	// MigrationDryRunCheckLiveMigratable reflects the LiveMigratable condition of the VMI
+	MigrationDryRunCheckLiveMigratable MigrationDryRunCheckType = "LiveMigratable"
+	// MigrationDryRunCheckStorage verifies that the VMI volumes can be live migrated
+	MigrationDryRunCheckStorage MigrationDryRunCheckType = "Storage"
+	// MigrationDryRunCheckHostDevices verifies that the VMI does not use PCI host devices
+	MigrationDryRunCheckHostDevices MigrationDryRunCheckType = "HostDevices"
+	// MigrationDryRunCheckCPUModel verifies that other nodes support the VMI CPU model
+	MigrationDryRunCheckCPUModel MigrationDryRunCheckType = "CPUModel"
+	// MigrationDryRunCheckScheduling verifies that another node satisfies the node selector of the migration target pod
+	MigrationDryRunCheckScheduling MigrationDryRunCheckType = "Scheduling"
+	// MigrationDryRunCheckAdmission verifies that the requesting user may create the migration and that it would be admitted
+	MigrationDryRunCheckAdmission MigrationDryRunCheckType = "Admission"
+)
+
+// MigrationDryRunCheck is the result of a single migration dry-run check
+type MigrationDryRunCheck struct {
+	Type   MigrationDryRunCheckType `json:"type"`
+	Passed bool                     `json:"passed"`
+	// Message explains the result of the check
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -2380,8 +2604,22 @@ type VirtualMachineMemoryDumpRequest struct {
 	// Message is a detailed message about failure of the memory dump
 	// +optional
 	Message string `json:"message,omitempty"`
+	// Format is the format the memory is dumped in, defaults to Raw
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
 }
 
+// MemoryDumpFormat is the format of a memory dump
+type MemoryDumpFormat string
+
+const (
+	// MemoryDumpFormatRaw is a raw dump of the guest memory, meant for analysis tools
+	MemoryDumpFormatRaw MemoryDumpFormat = "Raw"
+	// MemoryDumpFormatSaveState is a save image of the guest memory and device state,
+	// the VMI can be resumed from it
+	MemoryDumpFormatSaveState MemoryDumpFormat = "SaveState"
+)
+
 type MemoryDumpPhase string
 
 const (
@@ -2746,7 +2984,28 @@ type MigrationConfiguration struct {
 	// That will ensure the target virt-launcher doesn't share categories with another pod on the node.
 	// However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
-}
+	// ParallelMigrationThreads is the number of multifd streams used to transfer the memory of a VMI.
+	// Setting it to 0 disables multifd. By default, 8 streams are used unless the VMI has a CPU limit
+	// or post-copy is allowed.
+	ParallelMigrationThreads *uint32 `json:"parallelMigrationThreads,omitempty"`
+	// Compression is the compression method used for the memory of a VMI.
+	// zstd requires multifd and is not applied when multifd is not in use. xbzrle disables multifd.
+	// By default, the memory is not compressed.
+	Compression *MigrationCompression `json:"compression,omitempty"`
+	// MaxDowntimeMilliseconds is the maximum time in milliseconds a VMI may be paused at the end of a
+	// live migration. By default, the hypervisor default of 300 milliseconds is used.
+	MaxDowntimeMilliseconds *int64 `json:"maxDowntimeMilliseconds,omitempty"`
+}
+
+// MigrationCompression is the compression method for the memory of a migrating VMI
+type MigrationCompression string
+
+const (
+	// MigrationCompressionXBZRLE compresses pages which were sent before with XBZRLE
+	MigrationCompressionXBZRLE MigrationCompression = "xbzrle"
+	// MigrationCompressionZstd compresses the multifd streams with zstd
+	MigrationCompressionZstd MigrationCompression = "zstd"
+)
 
 // DiskVerification holds container disks verification limits
 type DiskVerification struct {
@@ -2901,6 +3160,11 @@ type NetworkConfiguration struct {
 	DeprecatedPermitSlirpInterface    *bool                             `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool                             `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
 	Binding                           map[string]InterfaceBindingPlugin `json:"binding,omitempty"`
+	// TrafficFilter is enforced on all interfaces with bridge binding.
+	// The protections it enables cannot be disabled by the traffic filter of an interface,
+	// its allowed addresses and ports apply to the interfaces which do not specify their own.
+	// +optional
+	TrafficFilter *InterfaceTrafficFilter `json:"trafficFilter,omitempty"`
 }
 
 type InterfaceBindingPlugin struct {
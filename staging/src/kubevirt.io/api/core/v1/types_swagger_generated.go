@@ -38,6 +38,7 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"dnsPolicy":                     "Set DNS policy for the pod.\nDefaults to \"ClusterFirst\".\nValid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.\nDNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.\nTo have DNS options set along with hostNetwork, you have to specify DNS policy\nexplicitly to 'ClusterFirstWithHostNet'.\n+optional",
 		"dnsConfig":                     "Specifies the DNS parameters of a pod.\nParameters specified here will be merged to the generated DNS\nconfiguration based on DNSPolicy.\n+optional",
 		"accessCredentials":             "Specifies a set of public keys to inject into the vm guest\n+listType=atomic\n+optional\n+kubebuilder:validation:MaxItems:=256",
+		"metadataService":               "MetadataService exposes an EC2 and, optionally, an OpenStack compatible metadata service\nto the guest, on the link-local address 169.254.169.254.\nThe EC2 metadata is served to IMDSv2 clients only, presenting a session token.\nThe OpenStack metadata, when enabled, is served without a session token.\nRequires the pod network to use the masquerade binding.\n+optional",
 		"architecture":                  "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
 	}
 }
@@ -196,6 +197,7 @@ func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 		"infoSource":       "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
 		"queueCount":       "Specifies how many queues are allocated by MultiQueue",
 		"linkState":        "LinkState Reports the current operational link state`. values: up, down.",
+		"bandwidth":        "Bandwidth reports the bandwidth limits applied on the interface",
 	}
 }
 
@@ -214,30 +216,49 @@ func (VirtualMachineInstanceGuestOSInfo) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                               "+k8s:openapi-gen=true",
-		"startTimestamp":                 "The time the migration action began\n+nullable",
-		"endTimestamp":                   "The time the migration action ended\n+nullable",
-		"targetNodeDomainReadyTimestamp": "The timestamp at which the target node detects the domain is active",
-		"targetNodeDomainDetected":       "The Target Node has seen the Domain Start Event",
-		"targetNodeAddress":              "The address of the target node to use for the migration",
-		"targetDirectMigrationNodePorts": "The list of ports opened for live migration on the destination node",
-		"targetNode":                     "The target node that the VMI is moving to",
-		"targetPod":                      "The target pod that the VMI is moving to",
-		"targetAttachmentPodUID":         "The UID of the target attachment pod for hotplug volumes",
-		"sourceNode":                     "The source node that the VMI originated on",
-		"completed":                      "Indicates the migration completed",
-		"failed":                         "Indicates that the migration failed",
-		"abortRequested":                 "Indicates that the migration has been requested to abort",
-		"abortStatus":                    "Indicates the final status of the live migration abortion",
-		"failureReason":                  "Contains the reason why the migration failed",
-		"migrationUid":                   "The VirtualMachineInstanceMigration object associated with this migration",
-		"mode":                           "Lets us know if the vmi is currently running pre or post copy migration",
-		"migrationPolicyName":            "Name of the migration policy. If string is empty, no policy is matched",
-		"migrationConfiguration":         "Migration configurations to apply",
-		"targetCPUSet":                   "If the VMI requires dedicated CPUs, this field will\nhold the dedicated CPU set on the target node\n+listType=atomic",
-		"targetNodeTopology":             "If the VMI requires dedicated CPUs, this field will\nhold the numa topology on the target node",
-		"sourcePersistentStatePVCName":   "If the VMI being migrated uses persistent features (backend-storage), its source PVC name is saved here",
-		"targetPersistentStatePVCName":   "If the VMI being migrated uses persistent features (backend-storage), its target PVC name is saved here",
+		"":                                "+k8s:openapi-gen=true",
+		"startTimestamp":                  "The time the migration action began\n+nullable",
+		"endTimestamp":                    "The time the migration action ended\n+nullable",
+		"targetNodeDomainReadyTimestamp":  "The timestamp at which the target node detects the domain is active",
+		"targetNodeDomainDetected":        "The Target Node has seen the Domain Start Event",
+		"targetNodeAddress":               "The address of the target node to use for the migration",
+		"targetDirectMigrationNodePorts":  "The list of ports opened for live migration on the destination node",
+		"targetNode":                      "The target node that the VMI is moving to",
+		"targetPod":                       "The target pod that the VMI is moving to",
+		"targetAttachmentPodUID":          "The UID of the target attachment pod for hotplug volumes",
+		"sourceNode":                      "The source node that the VMI originated on",
+		"completed":                       "Indicates the migration completed",
+		"failed":                          "Indicates that the migration failed",
+		"abortRequested":                  "Indicates that the migration has been requested to abort",
+		"abortStatus":                     "Indicates the final status of the live migration abortion",
+		"failureReason":                   "Contains the reason why the migration failed",
+		"migrationUid":                    "The VirtualMachineInstanceMigration object associated with this migration",
+		"mode":                            "Lets us know if the vmi is currently running pre or post copy migration",
+		"migrationPolicyName":             "Name of the migration policy. If string is empty, no policy is matched",
+		"migrationConfiguration":          "Migration configurations to apply",
+		"targetCPUSet":                    "If the VMI requires dedicated CPUs, this field will\nhold the dedicated CPU set on the target node\n+listType=atomic",
+		"targetNodeTopology":              "If the VMI requires dedicated CPUs, this field will\nhold the numa topology on the target node",
+		"sourcePersistentStatePVCName":    "If the VMI being migrated uses persistent features (backend-storage), its source PVC name is saved here",
+		"targetPersistentStatePVCName":    "If the VMI being migrated uses persistent features (backend-storage), its target PVC name is saved here",
+		"targetVirtualMachineInstanceUID": "The UID of the VMI receiving the migration in another cluster.\nOnly set on the source of a cross-cluster migration.",
+	}
+}
+
+func (VirtualMachineInstanceMigrationProgress) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                               "VirtualMachineInstanceMigrationProgress reports the progress of the live migration\ncurrently running on the source node, as reported by the hypervisor\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"migrationUid":                   "The UID of the migration in progress",
+		"mode":                           "The mode of the migration in progress",
+		"dataTotalBytes":                 "The total amount of guest data to be migrated, in bytes",
+		"dataProcessedBytes":             "The amount of guest data already migrated, in bytes",
+		"dataRemainingBytes":             "The amount of guest data still to be migrated, in bytes",
+		"memoryDirtyRateBytes":           "The rate at which the guest dirties its memory, in bytes per second",
+		"memoryTransferRateBytes":        "The rate at which memory is transferred to the target, in bytes per second",
+		"memoryIterations":               "The number of memory pre-copy iterations performed so far",
+		"autoConvergeThrottle":           "The percentage by which auto-converge currently throttles the guest vCPUs",
+		"postCopyRequests":               "The number of page faults requested by the target during post-copy",
+		"elapsedMilliseconds":            "The time elapsed since the migration started, in milliseconds",
+		"estimatedRemainingMilliseconds": "The estimated time until the migration completes, in milliseconds",
 	}
 }
 
@@ -317,7 +338,38 @@ func (VirtualMachineInstanceMigrationList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceMigrationSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"vmiName": "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"vmiName":      "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"nodeSelector": "NodeSelector restricts the set of nodes the VMI can be migrated to.\nIt is merged into the node selector of the VMI, in case of key collisions\nthe values set on the VMI are preserved, so the migration can only\nrestrict but not bypass the constraints already set on the VMI.\nMore info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/\n+optional",
+		"affinity":     "Affinity adds scheduling constraints for the migration target.\nRequired node affinity terms are combined with the terms set on the VMI,\nall other terms are added to the ones set on the VMI.\n+optional",
+		"sendTo":       "SendTo migrates the VMI to another cluster, where a migration with\na matching Receive section prepares the target.\nMutually exclusive with Receive.\n+optional",
+		"receive":      "Receive prepares the VMI as the target of a migration sent\nfrom another cluster. The VMI has to be created with the\nkubevirt.io/migration-receiver annotation.\nMutually exclusive with SendTo.\n+optional",
+		"priority":     "Priority defines the order in which pending migrations are started when\nthe parallel migration limits are reached, supported values are:\nsystem-critical - Migrations required to evacuate nodes.\nuser-triggered - Migrations requested by users.\nsystem-maintenance - Migrations rolling out workload updates.\nDefaults to system-critical for evacuations, system-maintenance for\nworkload updates and user-triggered for all other migrations.\nOnly users allowed to update the KubeVirt resource can request another\npriority than user-triggered.\nRequires the MigrationPriorityQueue feature gate.\n+optional\n+kubebuilder:validation:Enum=system-critical;user-triggered;system-maintenance",
+		"retryPolicy":  "RetryPolicy defines how the migration is retried when it fails.\nTakes precedence over the retry policy of a matching MigrationPolicy.\n+optional",
+	}
+}
+
+func (MigrationRetryPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                             "MigrationRetryPolicy defines how failed migrations are retried. Every attempt\nis a new VirtualMachineInstanceMigration carrying the retry policy of the\nmigration it retries.",
+		"maxAttempts":                  "MaxAttempts is the maximum number of attempts to migrate the VMI,\nincluding the first one. Defaults to 3.\n+optional",
+		"initialBackoffSeconds":        "InitialBackoffSeconds is the time to wait before the first retry,\nit doubles with every further retry. Defaults to 20.\n+optional",
+		"allowPostCopyOnRetry":         "AllowPostCopyOnRetry allows retries to switch to post-copy when\nthe migration does not converge.\nOnly honored in the retry policy of a MigrationPolicy.\n+optional",
+		"bandwidthPerMigrationOnRetry": "BandwidthPerMigrationOnRetry is the bandwidth limit of retries.\nOnly honored in the retry policy of a MigrationPolicy.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMigrationSendTo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "VirtualMachineInstanceMigrationSendTo describes the target of a cross-cluster migration.\nThe virt-handlers of the two clusters trust each other once the CA of each cluster is added to\nthe kubevirt-migration-remote-ca configmap of the other cluster, in the namespace KubeVirt is installed in.",
+		"migrationID":         "MigrationID identifies the receiving migration in the target cluster",
+		"connectionSecretRef": "ConnectionSecretRef references a secret in the namespace KubeVirt is installed in,\nholding a kubeconfig for the target cluster under the \"kubeconfig\" key.\nThe creator of the migration has to be allowed to get the secret.\nThe receiving migration is looked up in the namespace of the migration.",
+	}
+}
+
+func (VirtualMachineInstanceMigrationReceive) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceMigrationReceive describes the receiving side of a cross-cluster migration",
+		"migrationID": "MigrationID identifies this migration for the sending cluster",
 	}
 }
 
@@ -334,6 +386,17 @@ func (VirtualMachineInstanceMigrationStatus) SwaggerDoc() map[string]string {
 		"":                          "VirtualMachineInstanceMigration reprents information pertaining to a VMI's migration.",
 		"phaseTransitionTimestamps": "PhaseTransitionTimestamp is the timestamp of when the last phase change occurred\n+listType=atomic\n+optional",
 		"migrationState":            "Represents the status of a live migration",
+		"retry":                     "Retry records the attempts of a migration with a retry policy\n+optional",
+	}
+}
+
+func (MigrationRetryStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "MigrationRetryStatus records an attempt of a migration with a retry policy",
+		"attempt":      "Attempt is the number of this attempt to migrate the VMI, starting at 1",
+		"retryOf":      "RetryOf is the name of the failed migration retried by this migration\n+optional",
+		"retriedBy":    "RetriedBy is the name of the migration retrying this migration after it failed\n+optional",
+		"backoffUntil": "BackoffUntil is the time this migration waits for before it starts\n+optional",
 	}
 }
 
@@ -630,6 +693,21 @@ func (MigrateOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (MigrationDryRunReport) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "MigrationDryRunReport is returned by the migrationcheck subresource and reports\nwhether the VirtualMachineInstance could be live migrated\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"migratable": "Migratable is true when all the checks passed",
+		"checks":     "Checks contains the result of each evaluated check\n+listType=atomic",
+	}
+}
+
+func (MigrationDryRunCheck) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "MigrationDryRunCheck is the result of a single migration dry-run check",
+		"message": "Message explains the result of the check\n+optional",
+	}
+}
+
 func (VirtualMachineInstanceGuestAgentInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
@@ -702,6 +780,7 @@ func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 		"endTimestamp":   "EndTimestamp represents the time the memory dump was completed\n+optional",
 		"fileName":       "FileName represents the name of the output file\n+optional",
 		"message":        "Message is a detailed message about failure of the memory dump\n+optional",
+		"format":         "Format is the format the memory is dumped in, defaults to Raw\n+optional",
 	}
 }
 
@@ -865,6 +944,9 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
+		"parallelMigrationThreads":          "ParallelMigrationThreads is the number of multifd streams used to transfer the memory of a VMI.\nSetting it to 0 disables multifd. By default, 8 streams are used unless the VMI has a CPU limit\nor post-copy is allowed.",
+		"compression":                       "Compression is the compression method used for the memory of a VMI.\nzstd requires multifd and is not applied when multifd is not in use. xbzrle disables multifd.\nBy default, the memory is not compressed.",
+		"maxDowntimeMilliseconds":           "MaxDowntimeMilliseconds is the maximum time in milliseconds a VMI may be paused at the end of a\nlive migration. By default, the hypervisor default of 300 milliseconds is used.",
 	}
 }
 
@@ -960,6 +1042,7 @@ func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                     "NetworkConfiguration holds network options",
 		"permitSlirpInterface": "DeprecatedPermitSlirpInterface is an alias for the deprecated PermitSlirpInterface.\nDeprecated: Removed in v1.3.",
+		"trafficFilter":        "TrafficFilter is enforced on all interfaces with bridge binding.\nThe protections it enables cannot be disabled by the traffic filter of an interface,\nits allowed addresses and ports apply to the interfaces which do not specify their own.\n+optional",
 	}
 }
 
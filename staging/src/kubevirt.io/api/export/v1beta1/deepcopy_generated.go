@@ -193,6 +193,11 @@ func (in *VirtualMachineExportSpec) DeepCopyInto(out *VirtualMachineExportSpec)
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.BaseSnapshotName != nil {
+		in, out := &in.BaseSnapshotName, &out.BaseSnapshotName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -67,6 +67,16 @@ type VirtualMachineExportSpec struct {
 	// If this field is omitted, a reasonable default is applied.
 	// +optional
 	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+
+	// BaseSnapshotName is the name of a VirtualMachineSnapshot of the same VirtualMachine as the source.
+	// When set, every exported disk image additionally provides the extents that changed between the
+	// base snapshot and the source snapshot, and a stream containing only the data of those extents.
+	// The extents are computed by comparing the disk images: the volumes of the base snapshot are
+	// restored into additional PersistentVolumeClaims for the lifetime of the export, and both images
+	// are read once, skipping the ranges unallocated in both.
+	// Only valid when the source is a VirtualMachineSnapshot.
+	// +optional
+	BaseSnapshotName *string `json:"baseSnapshotName,omitempty"`
 }
 
 // VirtualMachineExportPhase is the current phase of the VirtualMachineExport
@@ -176,10 +186,16 @@ const (
 	KubeVirtRaw ExportVolumeFormat = "raw"
 	// KubeVirtGZ is the volume in gzipped RAW format.
 	KubeVirtGz ExportVolumeFormat = "gzip"
+	// KubeVirtQcow2 is the volume in sparse qcow2 format, only the clusters containing data are included
+	KubeVirtQcow2 ExportVolumeFormat = "qcow2"
 	// Dir is an uncompressed directory, which points to the root of a PersistentVolumeClaim, exposed using a FileServer https://pkg.go.dev/net/http#FileServer
 	Dir ExportVolumeFormat = "dir"
 	// ArchiveGz is a tarred and gzipped version of the root of a PersistentVolumeClaim
 	ArchiveGz ExportVolumeFormat = "tar.gz"
+	// ChangedExtents is a JSON document listing the extents of the volume that changed since the base snapshot
+	ChangedExtents ExportVolumeFormat = "changed-extents"
+	// KubeVirtRawDelta is the data of the changed extents of the volume, concatenated in the order of the changed extents document
+	KubeVirtRawDelta ExportVolumeFormat = "raw-delta"
 )
 
 // VirtualMachineExportVolumeFormat contains the format type and URL to get the volume in that format
@@ -18,9 +18,10 @@ func (VirtualMachineExportList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineExportSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":               "VirtualMachineExportSpec is the spec for a VirtualMachineExport resource",
-		"tokenSecretRef": "+optional\nTokenSecretRef is the name of the custom-defined secret that contains the token used by the export server pod",
-		"ttlDuration":    "ttlDuration limits the lifetime of an export\nIf this field is set, after this duration has passed from counting from CreationTimestamp,\nthe export is eligible to be automatically deleted.\nIf this field is omitted, a reasonable default is applied.\n+optional",
+		"":                 "VirtualMachineExportSpec is the spec for a VirtualMachineExport resource",
+		"tokenSecretRef":   "+optional\nTokenSecretRef is the name of the custom-defined secret that contains the token used by the export server pod",
+		"ttlDuration":      "ttlDuration limits the lifetime of an export\nIf this field is set, after this duration has passed from counting from CreationTimestamp,\nthe export is eligible to be automatically deleted.\nIf this field is omitted, a reasonable default is applied.\n+optional",
+		"baseSnapshotName": "BaseSnapshotName is the name of a VirtualMachineSnapshot of the same VirtualMachine as the source.\nWhen set, every exported disk image additionally provides the extents that changed between the\nbase snapshot and the source snapshot, and a stream containing only the data of those extents.\nThe extents are computed by comparing the disk images: the volumes of the base snapshot are\nrestored into additional PersistentVolumeClaims for the lifetime of the export, and both images\nare read once, skipping the ranges unallocated in both.\nOnly valid when the source is a VirtualMachineSnapshot.\n+optional",
 	}
 }
 
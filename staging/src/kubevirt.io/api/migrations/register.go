@@ -24,5 +24,6 @@ const (
 	GroupName = "migrations.kubevirt.io"
 	Version   = "v1alpha1"
 
-	ResourceMigrationPolicies = "migrationpolicies"
+	ResourceMigrationPolicies               = "migrationpolicies"
+	ResourceVirtualMachineStorageMigrations = "virtualmachinestoragemigrations"
 )
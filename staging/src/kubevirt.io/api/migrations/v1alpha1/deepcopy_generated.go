@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -47,13 +48,29 @@ func (in LabelSelector) DeepCopy() LabelSelector {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchedVirtualMachineInstance) DeepCopyInto(out *MatchedVirtualMachineInstance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchedVirtualMachineInstance.
+func (in *MatchedVirtualMachineInstance) DeepCopy() *MatchedVirtualMachineInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchedVirtualMachineInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationPolicy) DeepCopyInto(out *MigrationPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -141,6 +158,31 @@ func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ParallelMigrationThreads != nil {
+		in, out := &in.ParallelMigrationThreads, &out.ParallelMigrationThreads
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(v1.MigrationCompression)
+		**out = **in
+	}
+	if in.MaxDowntimeMilliseconds != nil {
+		in, out := &in.MaxDowntimeMilliseconds, &out.MaxDowntimeMilliseconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(v1.MigrationRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -157,6 +199,11 @@ func (in *MigrationPolicySpec) DeepCopy() *MigrationPolicySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationPolicyStatus) DeepCopyInto(out *MigrationPolicyStatus) {
 	*out = *in
+	if in.MatchedVirtualMachineInstances != nil {
+		in, out := &in.MatchedVirtualMachineInstances, &out.MatchedVirtualMachineInstances
+		*out = make([]MatchedVirtualMachineInstance, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -199,3 +246,134 @@ func (in *Selectors) DeepCopy() *Selectors {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageMigrationVolume) DeepCopyInto(out *StorageMigrationVolume) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageMigrationVolume.
+func (in *StorageMigrationVolume) DeepCopy() *StorageMigrationVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageMigrationVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStorageMigration) DeepCopyInto(out *VirtualMachineStorageMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(VirtualMachineStorageMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStorageMigration.
+func (in *VirtualMachineStorageMigration) DeepCopy() *VirtualMachineStorageMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStorageMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineStorageMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStorageMigrationList) DeepCopyInto(out *VirtualMachineStorageMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineStorageMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStorageMigrationList.
+func (in *VirtualMachineStorageMigrationList) DeepCopy() *VirtualMachineStorageMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStorageMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineStorageMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStorageMigrationSpec) DeepCopyInto(out *VirtualMachineStorageMigrationSpec) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStorageMigrationSpec.
+func (in *VirtualMachineStorageMigrationSpec) DeepCopy() *VirtualMachineStorageMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStorageMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStorageMigrationStatus) DeepCopyInto(out *VirtualMachineStorageMigrationStatus) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]StorageMigrationVolume, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStorageMigrationStatus.
+func (in *VirtualMachineStorageMigrationStatus) DeepCopy() *VirtualMachineStorageMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStorageMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -37,6 +37,9 @@ var (
 	// GroupVersionKind
 	MigrationPolicyKind     = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "MigrationPolicy"}
 	MigrationPolicyListKind = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "MigrationPolicyList"}
+
+	VirtualMachineStorageMigrationKind     = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "VirtualMachineStorageMigration"}
+	VirtualMachineStorageMigrationListKind = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "VirtualMachineStorageMigrationList"}
 )
 
 // Kind takes an unqualified kind and returns back a Group qualified GroupKind
@@ -60,7 +63,9 @@ var (
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&MigrationPolicy{},
-		&MigrationPolicyList{})
+		&MigrationPolicyList{},
+		&VirtualMachineStorageMigration{},
+		&VirtualMachineStorageMigrationList{})
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -53,6 +53,19 @@ type MigrationPolicySpec struct {
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
 	//+optional
 	AllowWorkloadDisruption *bool `json:"allowWorkloadDisruption,omitempty"`
+	//+optional
+	ParallelMigrationThreads *uint32 `json:"parallelMigrationThreads,omitempty"`
+	//+optional
+	Compression *k6tv1.MigrationCompression `json:"compression,omitempty"`
+	//+optional
+	MaxDowntimeMilliseconds *int64 `json:"maxDowntimeMilliseconds,omitempty"`
+	// Network is the name of the CNI network to use for live migrations. It has to be the
+	// migration network of the cluster, or empty to migrate through the pod network.
+	//+optional
+	Network *string `json:"network,omitempty"`
+	// RetryPolicy defines how failed migrations of the matched VMIs are retried
+	//+optional
+	RetryPolicy *k6tv1.MigrationRetryPolicy `json:"retryPolicy,omitempty"`
 }
 
 type LabelSelector map[string]string
@@ -65,6 +78,26 @@ type Selectors struct {
 }
 
 type MigrationPolicyStatus struct {
+	// MatchedVirtualMachineInstances lists up to 50 of the VMIs the policy currently applies to,
+	// sorted by namespace and name
+	// +listType=atomic
+	// +optional
+	MatchedVirtualMachineInstances []MatchedVirtualMachineInstance `json:"matchedVirtualMachineInstances,omitempty"`
+	// MatchedVirtualMachineInstanceCount is the number of VMIs the policy currently applies to
+	// +optional
+	MatchedVirtualMachineInstanceCount int64 `json:"matchedVirtualMachineInstanceCount,omitempty"`
+	// SucceededMigrations is the number of recent migrations which succeeded with the policy
+	// +optional
+	SucceededMigrations int64 `json:"succeededMigrations,omitempty"`
+	// FailedMigrations is the number of recent migrations which failed with the policy
+	// +optional
+	FailedMigrations int64 `json:"failedMigrations,omitempty"`
+}
+
+// MatchedVirtualMachineInstance references a VMI matched by a migration policy
+type MatchedVirtualMachineInstance struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
 }
 
 // MigrationPolicyList is a list of MigrationPolicy
@@ -109,6 +142,125 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 		// value of AllowPostCopy, if not explicitly set
 		*clusterMigrationConfigurations.AllowWorkloadDisruption = *policySpec.AllowPostCopy
 	}
+	if policySpec.ParallelMigrationThreads != nil {
+		changed = true
+		threads := *policySpec.ParallelMigrationThreads
+		clusterMigrationConfigurations.ParallelMigrationThreads = &threads
+	}
+	if policySpec.Compression != nil {
+		changed = true
+		compression := *policySpec.Compression
+		clusterMigrationConfigurations.Compression = &compression
+	}
+	if policySpec.MaxDowntimeMilliseconds != nil {
+		changed = true
+		maxDowntime := *policySpec.MaxDowntimeMilliseconds
+		clusterMigrationConfigurations.MaxDowntimeMilliseconds = &maxDowntime
+	}
+	if policySpec.Network != nil {
+		changed = true
+		network := *policySpec.Network
+		clusterMigrationConfigurations.Network = &network
+	}
 
 	return changed, nil
 }
+
+// VirtualMachineStorageMigration moves the volumes of a running VirtualMachine to
+// another storage class. The destination volumes are created as blank DataVolumes
+// and populated by a live migration of the VM.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type VirtualMachineStorageMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VirtualMachineStorageMigrationSpec `json:"spec" valid:"required"`
+	// +optional
+	Status *VirtualMachineStorageMigrationStatus `json:"status,omitempty"`
+}
+
+type VirtualMachineStorageMigrationSpec struct {
+	// VMName is the name of the VirtualMachine whose volumes are migrated
+	VMName string `json:"vmName"`
+	// StorageClassName is the storage class of the destination volumes
+	StorageClassName string `json:"storageClassName"`
+	// Volumes lists the names of the VM volumes to migrate. When empty, all
+	// volumes backed by a DataVolume or a PersistentVolumeClaim are migrated,
+	// except for those already using the storage class. The migration fails
+	// if a name is not a volume of the VM.
+	// +listType=atomic
+	// +optional
+	Volumes []string `json:"volumes,omitempty"`
+	// SourceVolumePolicy defines what happens to the source volumes once the
+	// migration succeeded. Can be "Retain" or "Delete". Defaults to "Retain".
+	// +optional
+	// +kubebuilder:validation:Enum=Retain;Delete
+	SourceVolumePolicy SourceVolumePolicy `json:"sourceVolumePolicy,omitempty"`
+}
+
+type SourceVolumePolicy string
+
+const (
+	// SourceVolumePolicyRetain keeps the source volumes after the migration
+	SourceVolumePolicyRetain SourceVolumePolicy = "Retain"
+	// SourceVolumePolicyDelete deletes the source volumes after the migration succeeded
+	SourceVolumePolicyDelete SourceVolumePolicy = "Delete"
+)
+
+type VirtualMachineStorageMigrationPhase string
+
+const (
+	// StorageMigrationPending means the destination volumes are not created yet
+	StorageMigrationPending VirtualMachineStorageMigrationPhase = "Pending"
+	// StorageMigrationMigrating means the VM was updated to use the destination
+	// volumes and waits for the volume migration to complete
+	StorageMigrationMigrating VirtualMachineStorageMigrationPhase = "Migrating"
+	// StorageMigrationSucceeded means the VM runs with the destination volumes
+	StorageMigrationSucceeded VirtualMachineStorageMigrationPhase = "Succeeded"
+	// StorageMigrationFailed means the migration failed, see the message for the reason
+	StorageMigrationFailed VirtualMachineStorageMigrationPhase = "Failed"
+)
+
+type VirtualMachineStorageMigrationStatus struct {
+	// +optional
+	Phase VirtualMachineStorageMigrationPhase `json:"phase,omitempty"`
+	// Message describes why the migration failed
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Volumes lists the source and destination of the migrated volumes
+	// +listType=atomic
+	// +optional
+	Volumes []StorageMigrationVolume `json:"volumes,omitempty"`
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// +optional
+	// +nullable
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+}
+
+// StorageMigrationVolume tracks a volume migrated by a VirtualMachineStorageMigration
+type StorageMigrationVolume struct {
+	// VolumeName is the name of the volume in the VM spec
+	VolumeName string `json:"volumeName"`
+	// SourceClaimName is the name of the DataVolume or PersistentVolumeClaim the volume is migrated from
+	SourceClaimName string `json:"sourceClaimName"`
+	// DestinationClaimName is the name of the DataVolume the volume is migrated to
+	DestinationClaimName string `json:"destinationClaimName"`
+	// SourceDeleted is set once the source volume was deleted
+	// +optional
+	SourceDeleted bool `json:"sourceDeleted,omitempty"`
+}
+
+// VirtualMachineStorageMigrationList is a list of VirtualMachineStorageMigration
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineStorageMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []VirtualMachineStorageMigration `json:"items"`
+}
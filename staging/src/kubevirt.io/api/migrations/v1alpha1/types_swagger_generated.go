@@ -11,11 +11,16 @@ func (MigrationPolicy) SwaggerDoc() map[string]string {
 
 func (MigrationPolicySpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"allowAutoConverge":       "+optional",
-		"bandwidthPerMigration":   "+optional",
-		"completionTimeoutPerGiB": "+optional",
-		"allowPostCopy":           "+optional",
-		"allowWorkloadDisruption": "+optional",
+		"allowAutoConverge":        "+optional",
+		"bandwidthPerMigration":    "+optional",
+		"completionTimeoutPerGiB":  "+optional",
+		"allowPostCopy":            "+optional",
+		"allowWorkloadDisruption":  "+optional",
+		"parallelMigrationThreads": "+optional",
+		"compression":              "+optional",
+		"maxDowntimeMilliseconds":  "+optional",
+		"network":                  "Network is the name of the CNI network to use for live migrations. It has to be the\nmigration network of the cluster, or empty to migrate through the pod network.\n+optional",
+		"retryPolicy":              "RetryPolicy defines how failed migrations of the matched VMIs are retried\n+optional",
 	}
 }
 
@@ -27,7 +32,18 @@ func (Selectors) SwaggerDoc() map[string]string {
 }
 
 func (MigrationPolicyStatus) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"matchedVirtualMachineInstances":     "MatchedVirtualMachineInstances lists up to 50 of the VMIs the policy currently applies to,\nsorted by namespace and name\n+listType=atomic\n+optional",
+		"matchedVirtualMachineInstanceCount": "MatchedVirtualMachineInstanceCount is the number of VMIs the policy currently applies to\n+optional",
+		"succeededMigrations":                "SucceededMigrations is the number of recent migrations which succeeded with the policy\n+optional",
+		"failedMigrations":                   "FailedMigrations is the number of recent migrations which failed with the policy\n+optional",
+	}
+}
+
+func (MatchedVirtualMachineInstance) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "MatchedVirtualMachineInstance references a VMI matched by a migration policy",
+	}
 }
 
 func (MigrationPolicyList) SwaggerDoc() map[string]string {
@@ -36,3 +52,46 @@ func (MigrationPolicyList) SwaggerDoc() map[string]string {
 		"items": "+listType=atomic",
 	}
 }
+
+func (VirtualMachineStorageMigration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineStorageMigration moves the volumes of a running VirtualMachine to\nanother storage class. The destination volumes are created as blank DataVolumes\nand populated by a live migration of the VM.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineStorageMigrationSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"vmName":             "VMName is the name of the VirtualMachine whose volumes are migrated",
+		"storageClassName":   "StorageClassName is the storage class of the destination volumes",
+		"volumes":            "Volumes lists the names of the VM volumes to migrate. When empty, all\nvolumes backed by a DataVolume or a PersistentVolumeClaim are migrated,\nexcept for those already using the storage class. The migration fails\nif a name is not a volume of the VM.\n+listType=atomic\n+optional",
+		"sourceVolumePolicy": "SourceVolumePolicy defines what happens to the source volumes once the\nmigration succeeded. Can be \"Retain\" or \"Delete\". Defaults to \"Retain\".\n+optional\n+kubebuilder:validation:Enum=Retain;Delete",
+	}
+}
+
+func (VirtualMachineStorageMigrationStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"phase":          "+optional",
+		"message":        "Message describes why the migration failed\n+optional",
+		"volumes":        "Volumes lists the source and destination of the migrated volumes\n+listType=atomic\n+optional",
+		"startTimestamp": "+optional\n+nullable",
+		"endTimestamp":   "+optional\n+nullable",
+	}
+}
+
+func (StorageMigrationVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "StorageMigrationVolume tracks a volume migrated by a VirtualMachineStorageMigration",
+		"volumeName":           "VolumeName is the name of the volume in the VM spec",
+		"sourceClaimName":      "SourceClaimName is the name of the DataVolume or PersistentVolumeClaim the volume is migrated from",
+		"destinationClaimName": "DestinationClaimName is the name of the DataVolume the volume is migrated to",
+		"sourceDeleted":        "SourceDeleted is set once the source volume was deleted\n+optional",
+	}
+}
+
+func (VirtualMachineStorageMigrationList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VirtualMachineStorageMigrationList is a list of VirtualMachineStorageMigration\n\n+k8s:openapi-gen=true\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
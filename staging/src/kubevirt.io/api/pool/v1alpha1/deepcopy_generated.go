@@ -24,6 +24,7 @@ package v1alpha1
 import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -54,6 +55,37 @@ func (in *VirtualMachinePool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolAutohealing) DeepCopyInto(out *VirtualMachinePoolAutohealing) {
+	*out = *in
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make([]VirtualMachinePoolHealthCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnhealthyTimeout != nil {
+		in, out := &in.UnhealthyTimeout, &out.UnhealthyTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConcurrentReplacements != nil {
+		in, out := &in.MaxConcurrentReplacements, &out.MaxConcurrentReplacements
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolAutohealing.
+func (in *VirtualMachinePoolAutohealing) DeepCopy() *VirtualMachinePoolAutohealing {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolAutohealing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolCondition) DeepCopyInto(out *VirtualMachinePoolCondition) {
 	*out = *in
@@ -105,6 +137,48 @@ func (in *VirtualMachinePoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolPersistentVolumeClaimRetentionPolicy) DeepCopyInto(out *VirtualMachinePoolPersistentVolumeClaimRetentionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolPersistentVolumeClaimRetentionPolicy.
+func (in *VirtualMachinePoolPersistentVolumeClaimRetentionPolicy) DeepCopy() *VirtualMachinePoolPersistentVolumeClaimRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolPersistentVolumeClaimRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolRollingUpdate) DeepCopyInto(out *VirtualMachinePoolRollingUpdate) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolRollingUpdate.
+func (in *VirtualMachinePoolRollingUpdate) DeepCopy() *VirtualMachinePoolRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
 	*out = *in
@@ -123,6 +197,21 @@ func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
 		*out = new(VirtualMachineTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(VirtualMachinePoolUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolumeClaimRetentionPolicy != nil {
+		in, out := &in.PersistentVolumeClaimRetentionPolicy, &out.PersistentVolumeClaimRetentionPolicy
+		*out = new(VirtualMachinePoolPersistentVolumeClaimRetentionPolicy)
+		**out = **in
+	}
+	if in.Autohealing != nil {
+		in, out := &in.Autohealing, &out.Autohealing
+		*out = new(VirtualMachinePoolAutohealing)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -159,6 +248,27 @@ func (in *VirtualMachinePoolStatus) DeepCopy() *VirtualMachinePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolUpdateStrategy) DeepCopyInto(out *VirtualMachinePoolUpdateStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(VirtualMachinePoolRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolUpdateStrategy.
+func (in *VirtualMachinePoolUpdateStrategy) DeepCopy() *VirtualMachinePoolUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineTemplateSpec) DeepCopyInto(out *VirtualMachineTemplateSpec) {
 	*out = *in
@@ -22,6 +22,7 @@ package v1alpha1
 import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	virtv1 "kubevirt.io/api/core/v1"
 )
@@ -64,6 +65,11 @@ const (
 	// VirtualMachinePoolReplicaPaused is added in a pool when the pool got paused by the controller.
 	// After this condition was added, it is safe to remove or add vms by hand and adjust the replica count manually
 	VirtualMachinePoolReplicaPaused VirtualMachinePoolConditionType = "ReplicaPaused"
+
+	// VirtualMachinePoolReplicaUnhealthy is added in a pool when some of its vms
+	// fail the health checks of the autohealing configuration. The reason tells
+	// whether the vms got replaced or whether the replacement is rate limited.
+	VirtualMachinePoolReplicaUnhealthy VirtualMachinePoolConditionType = "ReplicaUnhealthy"
 )
 
 // +k8s:openapi-gen=true
@@ -89,6 +95,73 @@ type VirtualMachinePoolStatus struct {
 
 	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// UpdatedReplicas is the number of VMs which, including their running VMIs,
+	// match the current virtual machine template of the pool.
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty" optional:"true"`
+
+	// OutdatedReplicas is the number of VMs which, or whose running VMIs,
+	// still have to be updated to the current virtual machine template of the pool.
+	OutdatedReplicas int32 `json:"outdatedReplicas,omitempty" optional:"true"`
+
+	// UnhealthyReplicas is the number of VMs whose running VMIs fail the health
+	// checks of the autohealing configuration.
+	UnhealthyReplicas int32 `json:"unhealthyReplicas,omitempty" optional:"true"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategyType string
+
+const (
+	// VirtualMachinePoolRollingUpdateStrategyType restarts the outdated VMIs of the pool
+	// a few at a time, respecting the maxUnavailable and maxSurge limits.
+	VirtualMachinePoolRollingUpdateStrategyType VirtualMachinePoolUpdateStrategyType = "RollingUpdate"
+
+	// VirtualMachinePoolOnDeleteStrategyType only updates the VMs of the pool.
+	// Running VMIs pick up the changes once they get restarted.
+	VirtualMachinePoolOnDeleteStrategyType VirtualMachinePoolUpdateStrategyType = "OnDelete"
+)
+
+// VirtualMachinePoolUpdateStrategy describes how changes to the virtual machine
+// template of a pool are rolled out to its running VMIs.
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategy struct {
+	// Type of the update strategy. Can be "RollingUpdate" or "OnDelete".
+	// Defaults to "RollingUpdate".
+	// +optional
+	Type VirtualMachinePoolUpdateStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate configures the rolling update. Only allowed with the "RollingUpdate" type.
+	// +optional
+	RollingUpdate *VirtualMachinePoolRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolRollingUpdate struct {
+	// The maximum number of VMs which can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of the desired
+	// replicas (ex: 10%). The absolute number is calculated from the percentage
+	// by rounding down. Can not be 0 if maxSurge is 0.
+	// Defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// The maximum number of VMs which can be created above the desired replicas
+	// during the update. Value can be an absolute number (ex: 5) or a percentage
+	// of the desired replicas (ex: 10%). The absolute number is calculated from
+	// the percentage by rounding up.
+	// Defaults to 0.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// LiveUpdate applies template changes which only touch live-updatable fields,
+	// like CPU sockets, guest memory, node selector, affinity and tolerations,
+	// to the running VMIs without restarting them. The VMIs get migrated when
+	// this is required to apply the changes. Requires the LiveUpdate VM rollout
+	// strategy, otherwise the VMIs are restarted.
+	// +optional
+	LiveUpdate bool `json:"liveUpdate,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -108,6 +181,93 @@ type VirtualMachinePoolSpec struct {
 	// Indicates that the pool is paused.
 	// +optional
 	Paused bool `json:"paused,omitempty" protobuf:"varint,7,opt,name=paused"`
+
+	// UpdateStrategy describes how changes to the virtual machine template are
+	// rolled out to running VMIs. When unset, all outdated VMIs are restarted at once.
+	// +optional
+	UpdateStrategy *VirtualMachinePoolUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// PersistentVolumeClaimRetentionPolicy describes the lifecycle of the volumes
+	// created from the data volume templates of the VMs. When set, the pool scales
+	// in starting with the VM with the highest ordinal, like a StatefulSet does.
+	// By default, the volumes are deleted together with their VM.
+	// +optional
+	PersistentVolumeClaimRetentionPolicy *VirtualMachinePoolPersistentVolumeClaimRetentionPolicy `json:"persistentVolumeClaimRetentionPolicy,omitempty"`
+
+	// Autohealing replaces the VMs whose running VMIs stay unhealthy for too long.
+	// By default, unhealthy VMs are not replaced.
+	// +optional
+	Autohealing *VirtualMachinePoolAutohealing `json:"autohealing,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolHealthCheck string
+
+const (
+	// VirtualMachinePoolReadyHealthCheck requires the VMI to be ready. The readiness
+	// is reported by the readiness probes of the VMI, if it has any. Failing liveness
+	// probes make the VMI fail and get restarted by its VM.
+	VirtualMachinePoolReadyHealthCheck VirtualMachinePoolHealthCheck = "Ready"
+
+	// VirtualMachinePoolAgentConnectedHealthCheck requires the guest agent of the VMI
+	// to be connected.
+	VirtualMachinePoolAgentConnectedHealthCheck VirtualMachinePoolHealthCheck = "AgentConnected"
+)
+
+// VirtualMachinePoolAutohealing describes when the VMs of a pool are considered
+// unhealthy and how fast they are replaced. An unhealthy VM is deleted and created
+// again with the same name.
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolAutohealing struct {
+	// HealthChecks the running VMIs of the pool have to pass to be healthy.
+	// Can contain "Ready" and "AgentConnected". Defaults to ["Ready"].
+	// +optional
+	// +listType=set
+	HealthChecks []VirtualMachinePoolHealthCheck `json:"healthChecks,omitempty"`
+
+	// UnhealthyTimeout is the duration a running VMI has to fail its health checks
+	// before its VM is replaced. Defaults to 5m.
+	// +optional
+	UnhealthyTimeout *metav1.Duration `json:"unhealthyTimeout,omitempty"`
+
+	// MaxConcurrentReplacements is the maximum number of VMs which are replaced
+	// at the same time. A VM counts as being replaced until it is deleted.
+	// Value can be an absolute number (ex: 5) or a percentage of the desired
+	// replicas (ex: 10%). The absolute number is calculated from the percentage
+	// by rounding up. Defaults to 1.
+	// +optional
+	MaxConcurrentReplacements *intstr.IntOrString `json:"maxConcurrentReplacements,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type PersistentVolumeClaimRetentionPolicyType string
+
+const (
+	// RetainPersistentVolumeClaimRetentionPolicyType keeps the volumes of a VM
+	// after the VM is deleted. A VM created with the same ordinal reuses them.
+	RetainPersistentVolumeClaimRetentionPolicyType PersistentVolumeClaimRetentionPolicyType = "Retain"
+
+	// DeletePersistentVolumeClaimRetentionPolicyType deletes the volumes of a VM
+	// together with the VM.
+	DeletePersistentVolumeClaimRetentionPolicyType PersistentVolumeClaimRetentionPolicyType = "Delete"
+)
+
+// VirtualMachinePoolPersistentVolumeClaimRetentionPolicy describes what happens to the
+// DataVolumes and PVCs created from the data volume templates of the VMs in the pool.
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolPersistentVolumeClaimRetentionPolicy struct {
+	// WhenDeleted specifies what happens to the volumes when the pool is deleted.
+	// Can be "Retain" or "Delete". Defaults to "Delete".
+	// +optional
+	WhenDeleted PersistentVolumeClaimRetentionPolicyType `json:"whenDeleted,omitempty"`
+
+	// WhenScaled specifies what happens to the volumes of the VMs which are
+	// removed when the pool is scaled in. Can be "Retain" or "Delete".
+	// Defaults to "Delete".
+	// +optional
+	WhenScaled PersistentVolumeClaimRetentionPolicyType `json:"whenScaled,omitempty"`
 }
 
 // VirtualMachinePoolList is a list of VirtualMachinePool resources.
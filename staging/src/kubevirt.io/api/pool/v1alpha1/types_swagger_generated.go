@@ -26,19 +26,59 @@ func (VirtualMachinePoolCondition) SwaggerDoc() map[string]string {
 
 func (VirtualMachinePoolStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "+k8s:openapi-gen=true",
-		"conditions":    "+listType=atomic",
-		"labelSelector": "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"":                  "+k8s:openapi-gen=true",
+		"conditions":        "+listType=atomic",
+		"labelSelector":     "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"updatedReplicas":   "UpdatedReplicas is the number of VMs which, including their running VMIs,\nmatch the current virtual machine template of the pool.",
+		"outdatedReplicas":  "OutdatedReplicas is the number of VMs which, or whose running VMIs,\nstill have to be updated to the current virtual machine template of the pool.",
+		"unhealthyReplicas": "UnhealthyReplicas is the number of VMs whose running VMIs fail the health\nchecks of the autohealing configuration.",
+	}
+}
+
+func (VirtualMachinePoolUpdateStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachinePoolUpdateStrategy describes how changes to the virtual machine\ntemplate of a pool are rolled out to its running VMIs.\n\n+k8s:openapi-gen=true",
+		"type":          "Type of the update strategy. Can be \"RollingUpdate\" or \"OnDelete\".\nDefaults to \"RollingUpdate\".\n+optional",
+		"rollingUpdate": "RollingUpdate configures the rolling update. Only allowed with the \"RollingUpdate\" type.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolRollingUpdate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "+k8s:openapi-gen=true",
+		"maxUnavailable": "The maximum number of VMs which can be unavailable during the update.\nValue can be an absolute number (ex: 5) or a percentage of the desired\nreplicas (ex: 10%). The absolute number is calculated from the percentage\nby rounding down. Can not be 0 if maxSurge is 0.\nDefaults to 1.\n+optional",
+		"maxSurge":       "The maximum number of VMs which can be created above the desired replicas\nduring the update. Value can be an absolute number (ex: 5) or a percentage\nof the desired replicas (ex: 10%). The absolute number is calculated from\nthe percentage by rounding up.\nDefaults to 0.\n+optional",
+		"liveUpdate":     "LiveUpdate applies template changes which only touch live-updatable fields,\nlike CPU sockets, guest memory, node selector, affinity and tolerations,\nto the running VMIs without restarting them. The VMIs get migrated when\nthis is required to apply the changes. Requires the LiveUpdate VM rollout\nstrategy, otherwise the VMIs are restarted.\n+optional",
 	}
 }
 
 func (VirtualMachinePoolSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                       "+k8s:openapi-gen=true",
-		"replicas":               "Number of desired pods. This is a pointer to distinguish between explicit\nzero and not specified. Defaults to 1.\n+optional",
-		"selector":               "Label selector for pods. Existing Poolss whose pods are\nselected by this will be the ones affected by this deployment.",
-		"virtualMachineTemplate": "Template describes the VM that will be created.",
-		"paused":                 "Indicates that the pool is paused.\n+optional",
+		"":                                     "+k8s:openapi-gen=true",
+		"replicas":                             "Number of desired pods. This is a pointer to distinguish between explicit\nzero and not specified. Defaults to 1.\n+optional",
+		"selector":                             "Label selector for pods. Existing Poolss whose pods are\nselected by this will be the ones affected by this deployment.",
+		"virtualMachineTemplate":               "Template describes the VM that will be created.",
+		"paused":                               "Indicates that the pool is paused.\n+optional",
+		"updateStrategy":                       "UpdateStrategy describes how changes to the virtual machine template are\nrolled out to running VMIs. When unset, all outdated VMIs are restarted at once.\n+optional",
+		"persistentVolumeClaimRetentionPolicy": "PersistentVolumeClaimRetentionPolicy describes the lifecycle of the volumes\ncreated from the data volume templates of the VMs. When set, the pool scales\nin starting with the VM with the highest ordinal, like a StatefulSet does.\nBy default, the volumes are deleted together with their VM.\n+optional",
+		"autohealing":                          "Autohealing replaces the VMs whose running VMIs stay unhealthy for too long.\nBy default, unhealthy VMs are not replaced.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolAutohealing) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "VirtualMachinePoolAutohealing describes when the VMs of a pool are considered\nunhealthy and how fast they are replaced. An unhealthy VM is deleted and created\nagain with the same name.\n\n+k8s:openapi-gen=true",
+		"healthChecks":              "HealthChecks the running VMIs of the pool have to pass to be healthy.\nCan contain \"Ready\" and \"AgentConnected\". Defaults to [\"Ready\"].\n+optional\n+listType=set",
+		"unhealthyTimeout":          "UnhealthyTimeout is the duration a running VMI has to fail its health checks\nbefore its VM is replaced. Defaults to 5m.\n+optional",
+		"maxConcurrentReplacements": "MaxConcurrentReplacements is the maximum number of VMs which are replaced\nat the same time. A VM counts as being replaced until it is deleted.\nValue can be an absolute number (ex: 5) or a percentage of the desired\nreplicas (ex: 10%). The absolute number is calculated from the percentage\nby rounding up. Defaults to 1.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolPersistentVolumeClaimRetentionPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachinePoolPersistentVolumeClaimRetentionPolicy describes what happens to the\nDataVolumes and PVCs created from the data volume templates of the VMs in the pool.\n\n+k8s:openapi-gen=true",
+		"whenDeleted": "WhenDeleted specifies what happens to the volumes when the pool is deleted.\nCan be \"Retain\" or \"Delete\". Defaults to \"Delete\".\n+optional",
+		"whenScaled":  "WhenScaled specifies what happens to the volumes of the VMs which are\nremoved when the pool is scaled in. Can be \"Retain\" or \"Delete\".\nDefaults to \"Delete\".\n+optional",
 	}
 }
 
@@ -72,6 +72,13 @@ type VirtualMachineSnapshotSpec struct {
 	// Defaults to DefaultFailureDeadline - 5min
 	// +optional
 	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+
+	// IncludeMemory saves the guest memory and device state of a running vm
+	// to a PVC which is snapshotted along with the disks. The vm is paused
+	// while the snapshot is taken instead of being frozen.
+	// A vm restored from such a snapshot resumes where it was on its next start.
+	// +optional
+	IncludeMemory *bool `json:"includeMemory,omitempty"`
 }
 
 // Indication is a way to indicate the state of the vm when taking the snapshot
@@ -81,6 +88,7 @@ const (
 	VMSnapshotOnlineSnapshotIndication Indication = "Online"
 	VMSnapshotNoGuestAgentIndication   Indication = "NoGuestAgent"
 	VMSnapshotGuestAgentIndication     Indication = "GuestAgent"
+	VMSnapshotMemoryIndication         Indication = "Memory"
 )
 
 // VirtualMachineSnapshotPhase is the current phase of the VirtualMachineSnapshot
@@ -352,6 +360,66 @@ type VirtualMachineRestoreSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+
+	// VolumeRestoreOverrides gives the option to change properties of each restored volume,
+	// for example the name of the restored PVC or its storage class.
+	// If a volume has no override, the restored PVC gets a generated name and the
+	// properties of the PVC in the snapshot.
+	//
+	// +optional
+	// +listType=atomic
+	VolumeRestoreOverrides []VolumeRestoreOverride `json:"volumeRestoreOverrides,omitempty"`
+
+	// Volumes limits the restore to the listed volumes of the snapshot.
+	// If it is set, only the listed volumes are restored and the spec of the target
+	// is kept, apart from the restored volumes when they are restored in place.
+	//
+	// +optional
+	// +listType=set
+	Volumes []string `json:"volumes,omitempty"`
+
+	// VolumeRestoreMode specifies how the volumes listed in Volumes are restored.
+	// Defaults to InPlace.
+	// +optional
+	VolumeRestoreMode *VolumeRestoreMode `json:"volumeRestoreMode,omitempty"`
+}
+
+// VolumeRestoreMode defines how the volumes of a volume scoped restore are restored
+type VolumeRestoreMode string
+
+const (
+	// VolumeRestoreModeInPlace replaces the volumes of the target with the restored PVCs.
+	// The target has to exist and to be stopped.
+	VolumeRestoreModeInPlace VolumeRestoreMode = "InPlace"
+
+	// VolumeRestoreModeStandalone only creates the restored PVCs, which are not owned by the target.
+	// The target is left untouched and does not have to be stopped.
+	VolumeRestoreModeStandalone VolumeRestoreMode = "Standalone"
+)
+
+// VolumeRestoreOverride specifies how a volume of the snapshot should be restored
+type VolumeRestoreOverride struct {
+	// VolumeName is the name of the volume in the VM spec of the snapshot
+	VolumeName string `json:"volumeName"`
+
+	// RestoreName is the name of the restored PVC.
+	// If it is not specified, a name is generated.
+	// +optional
+	RestoreName string `json:"restoreName,omitempty"`
+
+	// StorageClassName is the storage class of the restored PVC.
+	// If it is not specified, the storage class of the PVC in the snapshot is used.
+	// The storage class has to be able to provision volumes from the volume snapshot.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// Labels are added to the labels of the restored PVC
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are added to the annotations of the restored PVC
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 // VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource
@@ -395,3 +463,212 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// VirtualMachineSnapshotSchedule defines a policy to periodically snapshot the selected VMs
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleSpec struct {
+	// Selector selects the VirtualMachines, in the namespace of the schedule, to snapshot
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Schedule in Cron format, either the five standard fields or a
+	// descriptor like @hourly, @daily, @weekly or @monthly.
+	// Times are interpreted in UTC
+	Schedule string `json:"schedule"`
+
+	// Disabled pauses the creation and the pruning of snapshots
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// Retention defines which of the snapshots created by the schedule are kept.
+	// All snapshots are kept when not set
+	// +optional
+	Retention *VirtualMachineSnapshotScheduleRetention `json:"retention,omitempty"`
+
+	// FailureDeadline is passed to the created VirtualMachineSnapshots
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleRetention defines which snapshots of a VM are kept.
+// A snapshot is kept as long as one of the Keep rules selects it and it is
+// not older than MaxAge. When no Keep rule is set, all snapshots are selected.
+// Failed snapshots are deleted once a newer snapshot succeeded
+type VirtualMachineSnapshotScheduleRetention struct {
+	// KeepLast is the number of most recent snapshots to keep
+	// +optional
+	KeepLast *int32 `json:"keepLast,omitempty"`
+
+	// KeepHourly is the number of hours for which the most recent snapshot is kept
+	// +optional
+	KeepHourly *int32 `json:"keepHourly,omitempty"`
+
+	// KeepDaily is the number of days for which the most recent snapshot is kept
+	// +optional
+	KeepDaily *int32 `json:"keepDaily,omitempty"`
+
+	// KeepWeekly is the number of weeks for which the most recent snapshot is kept
+	// +optional
+	KeepWeekly *int32 `json:"keepWeekly,omitempty"`
+
+	// KeepMonthly is the number of months for which the most recent snapshot is kept
+	// +optional
+	KeepMonthly *int32 `json:"keepMonthly,omitempty"`
+
+	// MaxAge is the maximum age of a snapshot, older snapshots are deleted
+	// even when selected by one of the Keep rules
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleStatus struct {
+	// +optional
+	// +nullable
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// +optional
+	// +nullable
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	VirtualMachines []VirtualMachineSnapshotScheduleVMStatus `json:"virtualMachines,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleVMStatus is the status of a VM selected by a VirtualMachineSnapshotSchedule
+type VirtualMachineSnapshotScheduleVMStatus struct {
+	// Name of the VirtualMachine
+	Name string `json:"name"`
+
+	// LastSnapshotName is the name of the most recently created VirtualMachineSnapshot
+	// +optional
+	LastSnapshotName *string `json:"lastSnapshotName,omitempty"`
+
+	// LastSuccessfulSnapshotName is the name of the most recent VirtualMachineSnapshot that succeeded
+	// +optional
+	LastSuccessfulSnapshotName *string `json:"lastSuccessfulSnapshotName,omitempty"`
+
+	// +optional
+	// +nullable
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotSchedule `json:"items"`
+}
+
+// VirtualMachineSnapshotGroupLabel is set on the VirtualMachineSnapshots created by a
+// VirtualMachineSnapshotGroup to the name of the group. It is reserved to the members of the group.
+const VirtualMachineSnapshotGroupLabel = "snapshot.kubevirt.io/group"
+
+// VirtualMachineSnapshotGroup defines the operation of snapshotting several VMs at the same instant.
+// A VirtualMachineSnapshot is created for every selected VM, all of their file systems are frozen
+// before the first volume is snapshotted and thawed once every volume snapshot was taken.
+// Every member can be restored with a VirtualMachineRestore
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotGroupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotGroupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource
+type VirtualMachineSnapshotGroupSpec struct {
+	// Selector selects the VirtualMachines, in the namespace of the group, to snapshot.
+	// The members are determined once, when the group is created
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// DeletionPolicy is passed to the created VirtualMachineSnapshots
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// This time represents the number of seconds we permit the group snapshot
+	// to take. In case we pass this deadline we mark the group and its
+	// VirtualMachineSnapshots as failed.
+	// Defaults to DefaultFailureDeadline - 5min
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource
+type VirtualMachineSnapshotGroupStatus struct {
+	// +optional
+	Phase VirtualMachineSnapshotPhase `json:"phase,omitempty"`
+
+	// FreezeTime is the time the file systems of all members were frozen.
+	// The volumes of the members are only snapshotted once it is set
+	// +optional
+	// +nullable
+	FreezeTime *metav1.Time `json:"freezeTime,omitempty"`
+
+	// CreationTime is the time the volumes of all members were snapshotted
+	// +optional
+	// +nullable
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=virtualMachineName
+	Members []VirtualMachineSnapshotGroupMember `json:"members,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupMember is a VM snapshotted by a VirtualMachineSnapshotGroup
+type VirtualMachineSnapshotGroupMember struct {
+	// VirtualMachineName is the name of the snapshotted VirtualMachine
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	// VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot of the VM
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotGroup `json:"items"`
+}
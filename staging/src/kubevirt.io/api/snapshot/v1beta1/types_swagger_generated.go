@@ -14,6 +14,7 @@ func (VirtualMachineSnapshotSpec) SwaggerDoc() map[string]string {
 		"":                "VirtualMachineSnapshotSpec is the spec for a VirtualMachineSnapshot resource",
 		"deletionPolicy":  "+optional",
 		"failureDeadline": "This time represents the number of seconds we permit the vm snapshot\nto take. In case we pass this deadline we mark this snapshot\nas failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+		"includeMemory":   "IncludeMemory saves the guest memory and device state of a running vm\nto a PVC which is snapshotted along with the disks. The vm is paused\nwhile the snapshot is taken instead of being frozen.\nA vm restored from such a snapshot resumes where it was on its next start.\n+optional",
 	}
 }
 
@@ -139,10 +140,24 @@ func (VirtualMachineRestore) SwaggerDoc() map[string]string {
 
 func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                      "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
-		"target":                "initially only VirtualMachine type supported",
-		"targetReadinessPolicy": "+optional",
-		"patches":               "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"":                       "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
+		"target":                 "initially only VirtualMachine type supported",
+		"targetReadinessPolicy":  "+optional",
+		"patches":                "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"volumeRestoreOverrides": "VolumeRestoreOverrides gives the option to change properties of each restored volume,\nfor example the name of the restored PVC or its storage class.\nIf a volume has no override, the restored PVC gets a generated name and the\nproperties of the PVC in the snapshot.\n\n+optional\n+listType=atomic",
+		"volumes":                "Volumes limits the restore to the listed volumes of the snapshot.\nIf it is set, only the listed volumes are restored and the spec of the target\nis kept, apart from the restored volumes when they are restored in place.\n\n+optional\n+listType=set",
+		"volumeRestoreMode":      "VolumeRestoreMode specifies how the volumes listed in Volumes are restored.\nDefaults to InPlace.\n+optional",
+	}
+}
+
+func (VolumeRestoreOverride) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VolumeRestoreOverride specifies how a volume of the snapshot should be restored",
+		"volumeName":       "VolumeName is the name of the volume in the VM spec of the snapshot",
+		"restoreName":      "RestoreName is the name of the restored PVC.\nIf it is not specified, a name is generated.\n+optional",
+		"storageClassName": "StorageClassName is the storage class of the restored PVC.\nIf it is not specified, the storage class of the PVC in the snapshot is used.\nThe storage class has to be able to provision volumes from the volume snapshot.\n+optional",
+		"labels":           "Labels are added to the labels of the restored PVC\n+optional",
+		"annotations":      "Annotations are added to the annotations of the restored PVC\n+optional",
 	}
 }
 
@@ -169,3 +184,104 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineSnapshotSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotSchedule defines a policy to periodically snapshot the selected VMs\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+		"selector":        "Selector selects the VirtualMachines, in the namespace of the schedule, to snapshot",
+		"schedule":        "Schedule in Cron format, either the five standard fields or a\ndescriptor like @hourly, @daily, @weekly or @monthly.\nTimes are interpreted in UTC",
+		"disabled":        "Disabled pauses the creation and the pruning of snapshots\n+optional",
+		"retention":       "Retention defines which of the snapshots created by the schedule are kept.\nAll snapshots are kept when not set\n+optional",
+		"failureDeadline": "FailureDeadline is passed to the created VirtualMachineSnapshots\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleRetention) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineSnapshotScheduleRetention defines which snapshots of a VM are kept.\nA snapshot is kept as long as one of the Keep rules selects it and it is\nnot older than MaxAge. When no Keep rule is set, all snapshots are selected.\nFailed snapshots are deleted once a newer snapshot succeeded",
+		"keepLast":    "KeepLast is the number of most recent snapshots to keep\n+optional",
+		"keepHourly":  "KeepHourly is the number of hours for which the most recent snapshot is kept\n+optional",
+		"keepDaily":   "KeepDaily is the number of days for which the most recent snapshot is kept\n+optional",
+		"keepWeekly":  "KeepWeekly is the number of weeks for which the most recent snapshot is kept\n+optional",
+		"keepMonthly": "KeepMonthly is the number of months for which the most recent snapshot is kept\n+optional",
+		"maxAge":      "MaxAge is the maximum age of a snapshot, older snapshots are deleted\neven when selected by one of the Keep rules\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+		"lastScheduleTime": "+optional\n+nullable",
+		"nextScheduleTime": "+optional\n+nullable",
+		"virtualMachines":  "+optional\n+listType=map\n+listMapKey=name",
+		"conditions":       "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleVMStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "VirtualMachineSnapshotScheduleVMStatus is the status of a VM selected by a VirtualMachineSnapshotSchedule",
+		"name":                       "Name of the VirtualMachine",
+		"lastSnapshotName":           "LastSnapshotName is the name of the most recently created VirtualMachineSnapshot\n+optional",
+		"lastSuccessfulSnapshotName": "LastSuccessfulSnapshotName is the name of the most recent VirtualMachineSnapshot that succeeded\n+optional",
+		"lastSuccessTime":            "+optional\n+nullable",
+		"error":                      "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineSnapshotGroup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotGroup defines the operation of snapshotting several VMs at the same instant.\nA VirtualMachineSnapshot is created for every selected VM, all of their file systems are frozen\nbefore the first volume is snapshotted and thawed once every volume snapshot was taken.\nEvery member can be restored with a VirtualMachineRestore\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource",
+		"selector":        "Selector selects the VirtualMachines, in the namespace of the group, to snapshot.\nThe members are determined once, when the group is created",
+		"deletionPolicy":  "DeletionPolicy is passed to the created VirtualMachineSnapshots\n+optional",
+		"failureDeadline": "This time represents the number of seconds we permit the group snapshot\nto take. In case we pass this deadline we mark the group and its\nVirtualMachineSnapshots as failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource",
+		"phase":        "+optional",
+		"freezeTime":   "FreezeTime is the time the file systems of all members were frozen.\nThe volumes of the members are only snapshotted once it is set\n+optional\n+nullable",
+		"creationTime": "CreationTime is the time the volumes of all members were snapshotted\n+optional\n+nullable",
+		"readyToUse":   "+optional",
+		"error":        "+optional",
+		"members":      "+optional\n+listType=map\n+listMapKey=virtualMachineName",
+		"conditions":   "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineSnapshotGroupMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "VirtualMachineSnapshotGroupMember is a VM snapshotted by a VirtualMachineSnapshotGroup",
+		"virtualMachineName":         "VirtualMachineName is the name of the snapshotted VirtualMachine",
+		"virtualMachineSnapshotName": "VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot of the VM",
+		"readyToUse":                 "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
@@ -208,6 +208,26 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineRestore(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineRestore", arg0)
 }
 
+func (_m *MockKubevirtClient) VirtualMachineSnapshotSchedule(namespace string) v1beta119.VirtualMachineSnapshotScheduleInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotSchedule", namespace)
+	ret0, _ := ret[0].(v1beta119.VirtualMachineSnapshotScheduleInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotSchedule(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotSchedule", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineSnapshotGroup(namespace string) v1beta119.VirtualMachineSnapshotGroupInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotGroup", namespace)
+	ret0, _ := ret[0].(v1beta119.VirtualMachineSnapshotGroupInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotGroup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotGroup", arg0)
+}
+
 func (_m *MockKubevirtClient) VirtualMachineExport(namespace string) v1beta117.VirtualMachineExportInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineExport", namespace)
 	ret0, _ := ret[0].(v1beta117.VirtualMachineExportInterface)
@@ -268,6 +288,16 @@ func (_mr *_MockKubevirtClientRecorder) MigrationPolicy() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrationPolicy")
 }
 
+func (_m *MockKubevirtClient) VirtualMachineStorageMigration(namespace string) v1alpha110.VirtualMachineStorageMigrationInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineStorageMigration", namespace)
+	ret0, _ := ret[0].(v1alpha110.VirtualMachineStorageMigrationInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineStorageMigration(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineStorageMigration", arg0)
+}
+
 func (_m *MockKubevirtClient) ExpandSpec(namespace string) ExpandSpecInterface {
 	ret := _m.ctrl.Call(_m, "ExpandSpec", namespace)
 	ret0, _ := ret[0].(ExpandSpecInterface)
@@ -1270,6 +1300,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) FilesystemList(arg0, ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "FilesystemList", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) MigrationProgress(ctx context.Context, name string) (v121.VirtualMachineInstanceMigrationProgress, error) {
+	ret := _m.ctrl.Call(_m, "MigrationProgress", ctx, name)
+	ret0, _ := ret[0].(v121.VirtualMachineInstanceMigrationProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) MigrationProgress(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrationProgress", arg0, arg1)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) AddVolume(ctx context.Context, name string, addVolumeOptions *v121.AddVolumeOptions) error {
 	ret := _m.ctrl.Call(_m, "AddVolume", ctx, name, addVolumeOptions)
 	ret0, _ := ret[0].(error)
@@ -1796,6 +1837,17 @@ func (_mr *_MockVirtualMachineInterfaceRecorder) Migrate(arg0, arg1, arg2 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Migrate", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInterface) MigrateDryRun(ctx context.Context, name string) (*v121.MigrationDryRunReport, error) {
+	ret := _m.ctrl.Call(_m, "MigrateDryRun", ctx, name)
+	ret0, _ := ret[0].(*v121.MigrationDryRunReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) MigrateDryRun(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrateDryRun", arg0, arg1)
+}
+
 func (_m *MockVirtualMachineInterface) AddVolume(ctx context.Context, name string, addVolumeOptions *v121.AddVolumeOptions) error {
 	ret := _m.ctrl.Call(_m, "AddVolume", ctx, name, addVolumeOptions)
 	ret0, _ := ret[0].(error)
@@ -65,12 +65,15 @@ type KubevirtClient interface {
 	VirtualMachineSnapshot(namespace string) snapshotv1.VirtualMachineSnapshotInterface
 	VirtualMachineSnapshotContent(namespace string) snapshotv1.VirtualMachineSnapshotContentInterface
 	VirtualMachineRestore(namespace string) snapshotv1.VirtualMachineRestoreInterface
+	VirtualMachineSnapshotSchedule(namespace string) snapshotv1.VirtualMachineSnapshotScheduleInterface
+	VirtualMachineSnapshotGroup(namespace string) snapshotv1.VirtualMachineSnapshotGroupInterface
 	VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface
 	VirtualMachineInstancetype(namespace string) instancetypev1beta1.VirtualMachineInstancetypeInterface
 	VirtualMachineClusterInstancetype() instancetypev1beta1.VirtualMachineClusterInstancetypeInterface
 	VirtualMachinePreference(namespace string) instancetypev1beta1.VirtualMachinePreferenceInterface
 	VirtualMachineClusterPreference() instancetypev1beta1.VirtualMachineClusterPreferenceInterface
 	MigrationPolicy() migrationsv1.MigrationPolicyInterface
+	VirtualMachineStorageMigration(namespace string) migrationsv1.VirtualMachineStorageMigrationInterface
 	ExpandSpec(namespace string) ExpandSpecInterface
 	ServerVersion() ServerVersionInterface
 	VirtualMachineClone(namespace string) clone.VirtualMachineCloneInterface
@@ -183,6 +186,14 @@ func (k kubevirtClient) VirtualMachineRestore(namespace string) snapshotv1.Virtu
 	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineRestores(namespace)
 }
 
+func (k kubevirtClient) VirtualMachineSnapshotSchedule(namespace string) snapshotv1.VirtualMachineSnapshotScheduleInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineSnapshotGroup(namespace string) snapshotv1.VirtualMachineSnapshotGroupInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface {
 	return k.generatedKubeVirtClient.ExportV1beta1().VirtualMachineExports(namespace)
 }
@@ -215,6 +226,10 @@ func (k kubevirtClient) MigrationPolicy() migrationsv1.MigrationPolicyInterface
 	return k.generatedKubeVirtClient.MigrationsV1alpha1().MigrationPolicies()
 }
 
+func (k kubevirtClient) VirtualMachineStorageMigration(namespace string) migrationsv1.VirtualMachineStorageMigrationInterface {
+	return k.generatedKubeVirtClient.MigrationsV1alpha1().VirtualMachineStorageMigrations(namespace)
+}
+
 func (k kubevirtClient) MigrationPolicyClient() *migrationsv1.MigrationsV1alpha1Client {
 	return k.migrationsClient
 }
@@ -247,6 +247,27 @@ var _ = Describe("Kubevirt VirtualMachine Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should dry-run a VirtualMachine migration", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		report := &virtv1.MigrationDryRunReport{
+			Migratable: true,
+			Checks:     []virtv1.MigrationDryRunCheck{{Type: virtv1.MigrationDryRunCheckScheduling, Passed: true}},
+		}
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMPath, "migrationcheck")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, report),
+		))
+		fetchedReport, err := client.VirtualMachine(k8sv1.NamespaceDefault).MigrateDryRun(context.Background(), "testvm")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedReport).To(Equal(report))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	AfterEach(func() {
 		server.Close()
 	})
@@ -449,6 +449,32 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should fetch migration progress from VirtualMachineInstance via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		dataRemaining := uint64(1024)
+		iterations := uint64(3)
+		progress := v1.VirtualMachineInstanceMigrationProgress{
+			MigrationUID:       "1234",
+			Mode:               v1.MigrationPreCopy,
+			DataRemainingBytes: &dataRemaining,
+			MemoryIterations:   &iterations,
+		}
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMIPath, "migration/progress")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, progress),
+		))
+		fetchedProgress, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).MigrationProgress(context.Background(), "testvm")
+
+		Expect(err).ToNot(HaveOccurred(), "should fetch progress normally")
+		Expect(fetchedProgress).To(Equal(progress), "fetched progress should be the same as passed in")
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	DescribeTable("should fetch SEV platform info via subresource", func(proxyPath string) {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
@@ -73,6 +73,16 @@ func (c *FakeVirtualMachines) Migrate(ctx context.Context, name string, migrateO
 	return err
 }
 
+func (c *FakeVirtualMachines) MigrateDryRun(ctx context.Context, name string) (*v1.MigrationDryRunReport, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachinesResource, c.ns, "migrationcheck", name), &v1.MigrationDryRunReport{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MigrationDryRunReport), err
+}
+
 func (c *FakeVirtualMachines) MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "memorydump", name, memoryDumpRequest), nil)
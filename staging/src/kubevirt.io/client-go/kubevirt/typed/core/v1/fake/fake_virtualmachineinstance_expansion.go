@@ -114,6 +114,13 @@ func (c *FakeVirtualMachineInstances) FilesystemList(ctx context.Context, name s
 	return v1.VirtualMachineInstanceFileSystemList{}, err
 }
 
+func (c *FakeVirtualMachineInstances) MigrationProgress(ctx context.Context, name string) (v1.VirtualMachineInstanceMigrationProgress, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "migration/progress", name), &v1.VirtualMachineInstanceMigrationProgress{})
+
+	return v1.VirtualMachineInstanceMigrationProgress{}, err
+}
+
 func (c *FakeVirtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "addvolume", name, addVolumeOptions), nil)
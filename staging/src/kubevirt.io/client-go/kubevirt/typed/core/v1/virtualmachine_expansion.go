@@ -41,6 +41,7 @@ type VirtualMachineExpansion interface {
 	Start(ctx context.Context, name string, startOptions *v1.StartOptions) error
 	Stop(ctx context.Context, name string, stopOptions *v1.StopOptions) error
 	Migrate(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) error
+	MigrateDryRun(ctx context.Context, name string) (*v1.MigrationDryRunReport, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
@@ -130,6 +131,19 @@ func (c *virtualMachines) Migrate(ctx context.Context, name string, migrateOptio
 		Error()
 }
 
+func (c *virtualMachines) MigrateDryRun(ctx context.Context, name string) (*v1.MigrationDryRunReport, error) {
+	report := &v1.MigrationDryRunReport{}
+	err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("migrationcheck").
+		Do(ctx).
+		Into(report)
+	return report, err
+}
+
 func (c *virtualMachines) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	body, err := json.Marshal(addVolumeOptions)
 	if err != nil {
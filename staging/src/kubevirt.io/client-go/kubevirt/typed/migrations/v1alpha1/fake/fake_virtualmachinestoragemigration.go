@@ -0,0 +1,147 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	v1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+)
+
+// FakeVirtualMachineStorageMigrations implements VirtualMachineStorageMigrationInterface
+type FakeVirtualMachineStorageMigrations struct {
+	Fake *FakeMigrationsV1alpha1
+	ns   string
+}
+
+var virtualmachinestoragemigrationsResource = v1alpha1.SchemeGroupVersion.WithResource("virtualmachinestoragemigrations")
+
+var virtualmachinestoragemigrationsKind = v1alpha1.SchemeGroupVersion.WithKind("VirtualMachineStorageMigration")
+
+// Get takes name of the virtualMachineStorageMigration, and returns the corresponding virtualMachineStorageMigration object, and an error if there is any.
+func (c *FakeVirtualMachineStorageMigrations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.VirtualMachineStorageMigration, err error) {
+	emptyResult := &v1alpha1.VirtualMachineStorageMigration{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetActionWithOptions(virtualmachinestoragemigrationsResource, c.ns, name, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.VirtualMachineStorageMigration), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineStorageMigrations that match those selectors.
+func (c *FakeVirtualMachineStorageMigrations) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.VirtualMachineStorageMigrationList, err error) {
+	emptyResult := &v1alpha1.VirtualMachineStorageMigrationList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewListActionWithOptions(virtualmachinestoragemigrationsResource, virtualmachinestoragemigrationsKind, c.ns, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.VirtualMachineStorageMigrationList{ListMeta: obj.(*v1alpha1.VirtualMachineStorageMigrationList).ListMeta}
+	for _, item := range obj.(*v1alpha1.VirtualMachineStorageMigrationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineStorageMigrations.
+func (c *FakeVirtualMachineStorageMigrations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchActionWithOptions(virtualmachinestoragemigrationsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineStorageMigration and creates it.  Returns the server's representation of the virtualMachineStorageMigration, and an error, if there is any.
+func (c *FakeVirtualMachineStorageMigrations) Create(ctx context.Context, virtualMachineStorageMigration *v1alpha1.VirtualMachineStorageMigration, opts v1.CreateOptions) (result *v1alpha1.VirtualMachineStorageMigration, err error) {
+	emptyResult := &v1alpha1.VirtualMachineStorageMigration{}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateActionWithOptions(virtualmachinestoragemigrationsResource, c.ns, virtualMachineStorageMigration, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.VirtualMachineStorageMigration), err
+}
+
+// Update takes the representation of a virtualMachineStorageMigration and updates it. Returns the server's representation of the virtualMachineStorageMigration, and an error, if there is any.
+func (c *FakeVirtualMachineStorageMigrations) Update(ctx context.Context, virtualMachineStorageMigration *v1alpha1.VirtualMachineStorageMigration, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineStorageMigration, err error) {
+	emptyResult := &v1alpha1.VirtualMachineStorageMigration{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateActionWithOptions(virtualmachinestoragemigrationsResource, c.ns, virtualMachineStorageMigration, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.VirtualMachineStorageMigration), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineStorageMigrations) UpdateStatus(ctx context.Context, virtualMachineStorageMigration *v1alpha1.VirtualMachineStorageMigration, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineStorageMigration, err error) {
+	emptyResult := &v1alpha1.VirtualMachineStorageMigration{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(virtualmachinestoragemigrationsResource, "status", c.ns, virtualMachineStorageMigration, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.VirtualMachineStorageMigration), err
+}
+
+// Delete takes name of the virtualMachineStorageMigration and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineStorageMigrations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(virtualmachinestoragemigrationsResource, c.ns, name, opts), &v1alpha1.VirtualMachineStorageMigration{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineStorageMigrations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionActionWithOptions(virtualmachinestoragemigrationsResource, c.ns, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.VirtualMachineStorageMigrationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineStorageMigration.
+func (c *FakeVirtualMachineStorageMigrations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineStorageMigration, err error) {
+	emptyResult := &v1alpha1.VirtualMachineStorageMigration{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(virtualmachinestoragemigrationsResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.VirtualMachineStorageMigration), err
+}
@@ -29,6 +29,7 @@ import (
 type MigrationsV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	MigrationPoliciesGetter
+	VirtualMachineStorageMigrationsGetter
 }
 
 // MigrationsV1alpha1Client is used to interact with features provided by the migrations.kubevirt.io group.
@@ -40,6 +41,10 @@ func (c *MigrationsV1alpha1Client) MigrationPolicies() MigrationPolicyInterface
 	return newMigrationPolicies(c)
 }
 
+func (c *MigrationsV1alpha1Client) VirtualMachineStorageMigrations(namespace string) VirtualMachineStorageMigrationInterface {
+	return newVirtualMachineStorageMigrations(c, namespace)
+}
+
 // NewForConfig creates a new MigrationsV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -0,0 +1,71 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	v1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+	scheme "kubevirt.io/client-go/kubevirt/scheme"
+)
+
+// VirtualMachineStorageMigrationsGetter has a method to return a VirtualMachineStorageMigrationInterface.
+// A group's client should implement this interface.
+type VirtualMachineStorageMigrationsGetter interface {
+	VirtualMachineStorageMigrations(namespace string) VirtualMachineStorageMigrationInterface
+}
+
+// VirtualMachineStorageMigrationInterface has methods to work with VirtualMachineStorageMigration resources.
+type VirtualMachineStorageMigrationInterface interface {
+	Create(ctx context.Context, virtualMachineStorageMigration *v1alpha1.VirtualMachineStorageMigration, opts v1.CreateOptions) (*v1alpha1.VirtualMachineStorageMigration, error)
+	Update(ctx context.Context, virtualMachineStorageMigration *v1alpha1.VirtualMachineStorageMigration, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineStorageMigration, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineStorageMigration *v1alpha1.VirtualMachineStorageMigration, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineStorageMigration, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.VirtualMachineStorageMigration, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.VirtualMachineStorageMigrationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineStorageMigration, err error)
+	VirtualMachineStorageMigrationExpansion
+}
+
+// virtualMachineStorageMigrations implements VirtualMachineStorageMigrationInterface
+type virtualMachineStorageMigrations struct {
+	*gentype.ClientWithList[*v1alpha1.VirtualMachineStorageMigration, *v1alpha1.VirtualMachineStorageMigrationList]
+}
+
+// newVirtualMachineStorageMigrations returns a VirtualMachineStorageMigrations
+func newVirtualMachineStorageMigrations(c *MigrationsV1alpha1Client, namespace string) *virtualMachineStorageMigrations {
+	return &virtualMachineStorageMigrations{
+		gentype.NewClientWithList[*v1alpha1.VirtualMachineStorageMigration, *v1alpha1.VirtualMachineStorageMigrationList](
+			"virtualmachinestoragemigrations",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha1.VirtualMachineStorageMigration { return &v1alpha1.VirtualMachineStorageMigration{} },
+			func() *v1alpha1.VirtualMachineStorageMigrationList {
+				return &v1alpha1.VirtualMachineStorageMigrationList{}
+			}),
+	}
+}
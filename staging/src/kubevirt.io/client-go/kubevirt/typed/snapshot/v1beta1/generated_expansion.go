@@ -23,3 +23,7 @@ type VirtualMachineRestoreExpansion interface{}
 type VirtualMachineSnapshotExpansion interface{}
 
 type VirtualMachineSnapshotContentExpansion interface{}
+
+type VirtualMachineSnapshotGroupExpansion interface{}
+
+type VirtualMachineSnapshotScheduleExpansion interface{}
@@ -39,6 +39,8 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 	instancetypeapi "kubevirt.io/api/instancetype"
+	"kubevirt.io/api/migrations"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	pool "kubevirt.io/api/pool"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 )
@@ -314,6 +316,33 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				denyModificationsFor("view"),
 				denyAllFor("instancetype:view"),
 				denyAllFor("default")),
+			Entry("[test_id:TODO]given a vmsnapshotschedule",
+				snapshotv1.SchemeGroupVersion.Group,
+				"virtualmachinesnapshotschedules",
+				false,
+				allowAllFor("admin"),
+				denyDeleteCollectionFor("edit"),
+				denyModificationsFor("view"),
+				denyAllFor("instancetype:view"),
+				denyAllFor("default")),
+			Entry("[test_id:TODO]given a vmsnapshotgroup",
+				snapshotv1.SchemeGroupVersion.Group,
+				"virtualmachinesnapshotgroups",
+				false,
+				allowAllFor("admin"),
+				denyDeleteCollectionFor("edit"),
+				denyModificationsFor("view"),
+				denyAllFor("instancetype:view"),
+				denyAllFor("default")),
+			Entry("[test_id:TODO]given a vmstoragemigration",
+				migrationsv1.SchemeGroupVersion.Group,
+				migrations.ResourceVirtualMachineStorageMigrations,
+				false,
+				allowAllFor("admin"),
+				denyDeleteCollectionFor("edit"),
+				denyModificationsFor("view"),
+				denyAllFor("instancetype:view"),
+				denyAllFor("default")),
 			Entry("[test_id:TODO]given a virtualmachineinstancetype",
 				instancetypeapi.GroupName,
 				instancetypeapi.PluralResourceName,
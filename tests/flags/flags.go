@@ -63,6 +63,8 @@ var DNSServiceNamespace = ""
 
 var MigrationNetworkNIC = "eth1"
 
+var RemoteKubeConfig = ""
+
 func init() {
 	kubecli.Init()
 	flag.StringVar(&KubeVirtUtilityVersionTag, "utility-container-tag", "", "Set the image tag or digest to use")
@@ -78,6 +80,7 @@ func init() {
 	flag.StringVar(&KubeVirtExampleGuestAgentPath, "example-guest-agent-path", "", "Set path to the example-guest-agent binary which is used for vsock testing")
 	flag.StringVar(&KubeVirtGoCliPath, "gocli-path", "", "Set path to gocli binary")
 	flag.StringVar(&KubeVirtInstallNamespace, "installed-namespace", "", "Set the namespace KubeVirt is installed in")
+	flag.StringVar(&RemoteKubeConfig, "remote-kubeconfig", "", "Set path to the kubeconfig of a second cluster running KubeVirt in the same namespace with the CrossClusterLiveMigration feature gate, used by cross-cluster migration tests")
 	flag.StringVar(&PrometheusNamespace, "prometheus-installed-namespace", "monitoring", "Set the namespace Prometheus is installed in")
 	flag.BoolVar(&DeployFakeKWOKNodesFlag, "deploy-fake-kwok-nodes", false, "Deploy fake KWOK nodes to test performance.")
 	flag.BoolVar(&DeployTestingInfrastructureFlag, "deploy-testing-infra", false, "Deploy testing infrastructure if set")
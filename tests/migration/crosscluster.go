@@ -0,0 +1,187 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/tests/console"
+	"kubevirt.io/kubevirt/tests/decorators"
+	"kubevirt.io/kubevirt/tests/flags"
+	"kubevirt.io/kubevirt/tests/framework/kubevirt"
+	"kubevirt.io/kubevirt/tests/framework/matcher"
+	kvconfig "kubevirt.io/kubevirt/tests/libkubevirt/config"
+	"kubevirt.io/kubevirt/tests/libmigration"
+	"kubevirt.io/kubevirt/tests/libnet"
+	"kubevirt.io/kubevirt/tests/libvmifact"
+	"kubevirt.io/kubevirt/tests/libvmops"
+	"kubevirt.io/kubevirt/tests/testsuite"
+)
+
+var _ = SIGMigrationDescribe("Cross-cluster live migration", decorators.RequiresTwoSchedulableNodes, func() {
+	var (
+		virtClient   kubecli.KubevirtClient
+		remoteClient kubecli.KubevirtClient
+		secretName   string
+	)
+
+	BeforeEach(func() {
+		if flags.RemoteKubeConfig == "" {
+			Skip("Cross-cluster migrations require a second cluster, set by the remote-kubeconfig flag")
+		}
+		virtClient = kubevirt.Client()
+		var err error
+		remoteClient, err = kubecli.GetKubevirtClientFromFlags("", flags.RemoteKubeConfig)
+		Expect(err).ToNot(HaveOccurred())
+
+		kvconfig.EnableFeatureGate(featuregate.CrossClusterLiveMigration)
+
+		By("Storing the kubeconfig of the remote cluster in a connection secret")
+		kubeconfig, err := os.ReadFile(flags.RemoteKubeConfig)
+		Expect(err).ToNot(HaveOccurred())
+		secretName = "remote-cluster-" + rand.String(5)
+		secret := &k8sv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName},
+			Data:       map[string][]byte{"kubeconfig": kubeconfig},
+		}
+		_, err = virtClient.CoreV1().Secrets(flags.KubeVirtInstallNamespace).Create(context.Background(), secret, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() {
+			err := virtClient.CoreV1().Secrets(flags.KubeVirtInstallNamespace).Delete(context.Background(), secretName, metav1.DeleteOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		By("Creating the test namespace in the remote cluster")
+		namespace := &k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testsuite.GetTestNamespace(nil)}}
+		_, err = remoteClient.CoreV1().Namespaces().Create(context.Background(), namespace, metav1.CreateOptions{})
+		if !k8serrors.IsAlreadyExists(err) {
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	// trustRemoteCA makes the virt-handlers of the cluster trust the CA of the other cluster
+	trustRemoteCA := func(client, remoteClient kubecli.KubevirtClient) {
+		remoteCA, err := remoteClient.CoreV1().ConfigMaps(flags.KubeVirtInstallNamespace).Get(context.Background(), components.KubeVirtCASecretName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		configMap := &k8sv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: controller.MigrationRemoteCAConfigMapName},
+			Data:       map[string]string{components.CABundleKey: remoteCA.Data[components.CABundleKey]},
+		}
+		_, err = client.CoreV1().ConfigMaps(flags.KubeVirtInstallNamespace).Create(context.Background(), configMap, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() {
+			err := client.CoreV1().ConfigMaps(flags.KubeVirtInstallNamespace).Delete(context.Background(), controller.MigrationRemoteCAConfigMapName, metav1.DeleteOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+	}
+
+	startCrossClusterMigration := func(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstanceMigration {
+		migrationID := "test-" + rand.String(5)
+
+		By("Creating the receiving VMI in the remote cluster")
+		receiver := vmi.DeepCopy()
+		receiver.ObjectMeta = metav1.ObjectMeta{
+			Name:        vmi.Name,
+			Namespace:   vmi.Namespace,
+			Annotations: map[string]string{v1.MigrationReceiverAnnotation: ""},
+		}
+		receiver.Status = v1.VirtualMachineInstanceStatus{}
+		_, err := remoteClient.VirtualMachineInstance(vmi.Namespace).Create(context.Background(), receiver, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() {
+			err := remoteClient.VirtualMachineInstance(vmi.Namespace).Delete(context.Background(), vmi.Name, metav1.DeleteOptions{})
+			if !k8serrors.IsNotFound(err) {
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		By("Creating the receiving migration in the remote cluster")
+		receive := libmigration.New(vmi.Name, vmi.Namespace)
+		receive.Spec.Receive = &v1.VirtualMachineInstanceMigrationReceive{MigrationID: migrationID}
+		_, err = remoteClient.VirtualMachineInstanceMigration(vmi.Namespace).Create(context.Background(), receive, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		By("Creating the sending migration")
+		send := libmigration.New(vmi.Name, vmi.Namespace)
+		send.Spec.SendTo = &v1.VirtualMachineInstanceMigrationSendTo{
+			MigrationID:         migrationID,
+			ConnectionSecretRef: k8sv1.LocalObjectReference{Name: secretName},
+		}
+		return libmigration.RunMigration(virtClient, send)
+	}
+
+	runVMI := func() *v1.VirtualMachineInstance {
+		vmi := libvmops.RunVMIAndExpectLaunch(libvmifact.NewAlpine(
+			libnet.WithMasqueradeNetworking(),
+			libvmi.WithNamespace(testsuite.GetTestNamespace(nil)),
+		), 240)
+		Expect(console.LoginToAlpine(vmi)).To(Succeed())
+		return vmi
+	}
+
+	It("should send the VMI to the remote cluster when the clusters trust the CA of each other", func() {
+		trustRemoteCA(virtClient, remoteClient)
+		trustRemoteCA(remoteClient, virtClient)
+		vmi := runVMI()
+
+		migration := startCrossClusterMigration(vmi)
+		libmigration.ExpectMigrationToSucceedWithDefaultTimeout(virtClient, migration)
+
+		By("Expecting the VMI to run in the remote cluster")
+		Eventually(func() (*v1.VirtualMachineInstance, error) {
+			return remoteClient.VirtualMachineInstance(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+		}, 2*time.Minute, time.Second).Should(And(
+			matcher.BeInPhase(v1.Running),
+			WithTransform(func(vmi *v1.VirtualMachineInstance) bool {
+				return vmi.Status.MigrationState != nil && vmi.Status.MigrationState.Completed && !vmi.Status.MigrationState.Failed
+			}, BeTrue()),
+		))
+
+		By("Expecting the VMI to be gone from the local cluster")
+		Eventually(matcher.ThisVMI(vmi), 2*time.Minute, time.Second).Should(Or(matcher.BeGone(), matcher.BeInPhase(v1.Succeeded)))
+	})
+
+	It("should fail to send the VMI when the clusters do not trust the CA of each other", func() {
+		vmi := runVMI()
+
+		migration := startCrossClusterMigration(vmi)
+		Eventually(matcher.ThisMigration(migration), libmigration.MigrationWaitTime, time.Second).Should(matcher.BeInPhase(v1.MigrationFailed))
+
+		By("Expecting the VMI to keep running in the local cluster")
+		Consistently(matcher.ThisVMI(vmi), 10*time.Second, time.Second).Should(matcher.BeInPhase(v1.Running))
+	})
+})
@@ -86,6 +86,9 @@ var _ = Describe("[sig-monitoring]Metrics", decorators.SigMonitoring, func() {
 			"kubevirt_vmi_migration_dirty_memory_rate_bytes":                     true,
 			"kubevirt_vmi_migration_disk_transfer_rate_bytes":                    true,
 			"kubevirt_vmi_migration_data_total_bytes":                            true,
+			"kubevirt_vmi_migration_memory_iterations_total":                     true,
+			"kubevirt_vmi_migration_auto_converge_throttle_percent":              true,
+			"kubevirt_vmi_migration_postcopy_requests_total":                     true,
 			"kubevirt_vmi_migration_start_time_seconds":                          true,
 			"kubevirt_vmi_migration_end_time_seconds":                            true,
 		}
@@ -0,0 +1,102 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+
+	"kubevirt.io/kubevirt/tests/console"
+	"kubevirt.io/kubevirt/tests/framework/kubevirt"
+	"kubevirt.io/kubevirt/tests/libvmifact"
+	"kubevirt.io/kubevirt/tests/libwait"
+	"kubevirt.io/kubevirt/tests/testsuite"
+)
+
+var _ = SIGDescribe("interface bandwidth", func() {
+	const (
+		serverPort = 1500
+		// 4MiB sent at 8Mbit/s take at least 4 seconds, unshaped they are sent in a fraction of a second
+		payloadSizeKiB    = 4096
+		minSendingSeconds = 3
+	)
+
+	createAndWait := func(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstance {
+		vmi, err := kubevirt.Client().VirtualMachineInstance(testsuite.GetTestNamespace(nil)).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return libwait.WaitUntilVMIReady(vmi, console.LoginToAlpine)
+	}
+
+	It("should apply the outbound limits of the interface and report them", func() {
+		bandwidth := &v1.InterfaceBandwidth{
+			Outbound: &v1.BandwidthLimits{Average: resource.MustParse("8M")},
+		}
+		iface := libvmi.InterfaceDeviceWithMasqueradeBinding()
+		iface.Bandwidth = bandwidth
+
+		By("Starting a server VMI discarding the data it receives")
+		server := createAndWait(libvmifact.NewAlpineWithTestTooling(
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		))
+		Expect(console.RunCommand(server, fmt.Sprintf("nc -klp %d > /dev/null &", serverPort), 30*time.Second)).To(Succeed())
+
+		By("Starting a client VMI with limited outbound bandwidth")
+		client := createAndWait(libvmifact.NewAlpineWithTestTooling(
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		))
+
+		By("Waiting for the limits to be reported as applied")
+		Eventually(func() *v1.InterfaceBandwidth {
+			vmi, err := kubevirt.Client().VirtualMachineInstance(client.Namespace).Get(context.Background(), client.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			if len(vmi.Status.Interfaces) == 0 {
+				return nil
+			}
+			return vmi.Status.Interfaces[0].Bandwidth
+		}).WithTimeout(time.Minute).WithPolling(time.Second).Should(Equal(bandwidth))
+
+		By("Sending data to the server")
+		Expect(console.RunCommand(client, fmt.Sprintf("dd if=/dev/zero of=/tmp/payload bs=1k count=%d", payloadSizeKiB), 30*time.Second)).To(Succeed())
+		output, err := console.RunCommandAndStoreOutput(client,
+			fmt.Sprintf("s=$(date +%%s); nc -w 1 %s %d < /tmp/payload; echo $(($(date +%%s)-s))", server.Status.Interfaces[0].IP, serverPort),
+			time.Minute,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		sendingSeconds, err := strconv.Atoi(strings.TrimSpace(output))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sendingSeconds).To(BeNumerically(">=", minSendingSeconds))
+	})
+})
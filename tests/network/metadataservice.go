@@ -0,0 +1,97 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+
+	"kubevirt.io/kubevirt/tests/console"
+	"kubevirt.io/kubevirt/tests/framework/kubevirt"
+	"kubevirt.io/kubevirt/tests/libkubevirt/config"
+	"kubevirt.io/kubevirt/tests/libvmifact"
+	"kubevirt.io/kubevirt/tests/libwait"
+	"kubevirt.io/kubevirt/tests/testsuite"
+)
+
+var _ = SIGDescribe("metadata service", Serial, func() {
+	const metadataServiceURL = "http://169.254.169.254"
+
+	BeforeEach(func() {
+		config.EnableFeatureGate(featuregate.MetadataService)
+	})
+
+	It("should serve the EC2 metadata to IMDSv2 clients on port 80", func() {
+		vmi := libvmifact.NewFedora(
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+		vmi.Spec.MetadataService = &v1.MetadataService{}
+
+		vmi, err := kubevirt.Client().VirtualMachineInstance(testsuite.GetTestNamespace(nil)).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		vmi = libwait.WaitUntilVMIReady(vmi, console.LoginToFedora)
+
+		expectedInstanceID := string(vmi.UID)
+		if vmi.Spec.Domain.Firmware != nil && vmi.Spec.Domain.Firmware.UUID != "" {
+			expectedInstanceID = string(vmi.Spec.Domain.Firmware.UUID)
+		}
+
+		By("Requesting the instance ID without a session token")
+		output, err := console.RunCommandAndStoreOutput(vmi,
+			fmt.Sprintf("curl -s -o /dev/null -w '%%{http_code}' %s/latest/meta-data/instance-id", metadataServiceURL),
+			30*time.Second,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(strings.TrimSpace(output)).To(Equal("401"))
+
+		By("Requesting the OpenStack metadata, which is not enabled")
+		output, err = console.RunCommandAndStoreOutput(vmi,
+			fmt.Sprintf("curl -s -o /dev/null -w '%%{http_code}' %s/openstack/latest/meta_data.json", metadataServiceURL),
+			30*time.Second,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(strings.TrimSpace(output)).To(Equal("404"))
+
+		By("Requesting the instance ID with a session token")
+		Expect(console.RunCommand(vmi,
+			fmt.Sprintf("TOKEN=$(curl -s -X PUT -H 'X-aws-ec2-metadata-token-ttl-seconds: 60' %s/latest/api/token)", metadataServiceURL),
+			30*time.Second,
+		)).To(Succeed())
+		output, err = console.RunCommandAndStoreOutput(vmi,
+			fmt.Sprintf(`curl -s -H "X-aws-ec2-metadata-token: $TOKEN" %s/latest/meta-data/instance-id`, metadataServiceURL),
+			30*time.Second,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(strings.TrimSpace(output)).To(Equal(expectedInstanceID))
+	})
+})
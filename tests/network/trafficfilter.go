@@ -0,0 +1,121 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmici "kubevirt.io/kubevirt/pkg/libvmi/cloudinit"
+
+	"kubevirt.io/kubevirt/tests/console"
+	"kubevirt.io/kubevirt/tests/framework/kubevirt"
+	"kubevirt.io/kubevirt/tests/libkubevirt"
+	"kubevirt.io/kubevirt/tests/libkubevirt/config"
+	"kubevirt.io/kubevirt/tests/libnet"
+	"kubevirt.io/kubevirt/tests/libnet/cloudinit"
+	"kubevirt.io/kubevirt/tests/libnet/vmnetserver"
+	"kubevirt.io/kubevirt/tests/libvmifact"
+	"kubevirt.io/kubevirt/tests/libwait"
+	"kubevirt.io/kubevirt/tests/testsuite"
+)
+
+var _ = SIGDescribe("interface traffic filter", func() {
+	const (
+		nadName        = "filtered-bridge"
+		bridgeName     = "br-filter"
+		serverIP       = "10.1.3.1"
+		clientIP       = "10.1.3.2"
+		subnetMask     = "/24"
+		allowedPort    = 1500
+		notAllowedPort = 1501
+	)
+
+	newVMI := func(ipAddress string, filter *v1.InterfaceTrafficFilter, opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		iface := libvmi.InterfaceDeviceWithBridgeBinding(nadName)
+		iface.TrafficFilter = filter
+		opts = append(opts,
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(libvmi.MultusNetwork(nadName, nadName)),
+			libvmi.WithCloudInitNoCloud(libvmici.WithNoCloudNetworkData(
+				cloudinit.CreateNetworkDataWithStaticIPsByIface("eth0", ipAddress+subnetMask),
+			)),
+		)
+		return libvmifact.NewAlpineWithTestTooling(opts...)
+	}
+
+	createAndWait := func(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstance {
+		vmi, err := kubevirt.Client().VirtualMachineInstance(testsuite.GetTestNamespace(nil)).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return libwait.WaitUntilVMIReady(vmi, console.LoginToAlpine)
+	}
+
+	expectIngressPortsFiltered := func(serverFilter *v1.InterfaceTrafficFilter) {
+		By("Starting a server VMI listening on an allowed and a not allowed port")
+		server := createAndWait(newVMI(serverIP, serverFilter))
+		vmnetserver.StartTCPServer(server, allowedPort, console.LoginToAlpine)
+		vmnetserver.StartTCPServer(server, notAllowedPort, console.LoginToAlpine)
+
+		By("Starting a client VMI on the node of the server, the bridge is local to the node")
+		client := createAndWait(newVMI(clientIP, nil, libvmi.WithNodeAffinityFor(server.Status.NodeName)))
+
+		By("Connecting to the allowed port")
+		Expect(console.SafeExpectBatch(client, createExpectConnectToServer(serverIP, allowedPort, true), 30)).To(Succeed())
+
+		By("Connecting to the not allowed port")
+		Expect(console.SafeExpectBatch(client, createExpectConnectToServer(serverIP, notAllowedPort, false), 30)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		netAttachDef := libnet.NewBridgeNetAttachDef(nadName, bridgeName)
+		_, err := libnet.CreateNetAttachDef(context.Background(), testsuite.GetTestNamespace(nil), netAttachDef)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should only accept new connections on the allowed ingress ports", func() {
+		expectIngressPortsFiltered(&v1.InterfaceTrafficFilter{
+			IngressPorts: []v1.Port{{Protocol: "TCP", Port: allowedPort}},
+		})
+	})
+
+	Context("enforced by the cluster", Serial, func() {
+		BeforeEach(func() {
+			kv := libkubevirt.GetCurrentKv(kubevirt.Client())
+			if kv.Spec.Configuration.NetworkConfiguration == nil {
+				kv.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{}
+			}
+			kv.Spec.Configuration.NetworkConfiguration.TrafficFilter = &v1.InterfaceTrafficFilter{
+				IngressPorts: []v1.Port{{Protocol: "TCP", Port: allowedPort}},
+			}
+			config.UpdateKubeVirtConfigValueAndWait(kv.Spec.Configuration)
+		})
+
+		It("should filter the interfaces without traffic filter", func() {
+			expectIngressPortsFiltered(nil)
+		})
+	})
+})
@@ -204,6 +204,9 @@ func (r *KubernetesReporter) dumpTestObjects(duration time.Duration, vmiNamespac
 	r.logPods(pods)
 	r.logVMs(virtCli)
 	r.logVMRestore(virtCli)
+	r.logVMSnapshotSchedules(virtCli)
+	r.logVMSnapshotGroups(virtCli)
+	r.logVMStorageMigrations(virtCli)
 	r.logDVs(virtCli)
 	r.logVMExports(virtCli)
 	r.logDeployments(virtCli)
@@ -311,6 +314,33 @@ func (r *KubernetesReporter) logVMRestore(virtCli kubecli.KubevirtClient) {
 	r.logObjects(restores, "virtualmachinerestores")
 }
 
+func (r *KubernetesReporter) logVMSnapshotSchedules(virtCli kubecli.KubevirtClient) {
+	schedules, err := virtCli.VirtualMachineSnapshotSchedule(v1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		printError("failed to fetch vmsnapshotschedules: %v", err)
+		return
+	}
+	r.logObjects(schedules, "virtualmachinesnapshotschedules")
+}
+
+func (r *KubernetesReporter) logVMStorageMigrations(virtCli kubecli.KubevirtClient) {
+	storageMigrations, err := virtCli.VirtualMachineStorageMigration(v1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		printError("failed to fetch vmstoragemigrations: %v", err)
+		return
+	}
+	r.logObjects(storageMigrations, "virtualmachinestoragemigrations")
+}
+
+func (r *KubernetesReporter) logVMSnapshotGroups(virtCli kubecli.KubevirtClient) {
+	groups, err := virtCli.VirtualMachineSnapshotGroup(v1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		printError("failed to fetch vmsnapshotgroups: %v", err)
+		return
+	}
+	r.logObjects(groups, "virtualmachinesnapshotgroups")
+}
+
 func (r *KubernetesReporter) logDMESG(virtCli kubecli.KubevirtClient, logsdir string, nodes []string, since time.Time) {
 
 	if logsdir == "" {
@@ -1316,9 +1316,9 @@ var _ = SIGDescribe("Export", func() {
 		Expect(vmExport.Status.Links).ToNot(BeNil())
 		Expect(vmExport.Status.Links.Internal).NotTo(BeNil())
 		Expect(vmExport.Status.Links.Internal.Cert).NotTo(BeEmpty())
-		Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(len(expectedVolumeFormats) / 2))
+		Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(len(expectedVolumeFormats) / 3))
 		for _, volume := range vmExport.Status.Links.Internal.Volumes {
-			Expect(volume.Formats).To(HaveLen(2))
+			Expect(volume.Formats).To(HaveLen(3))
 			Expect(expectedVolumeFormats).To(ContainElements(volume.Formats))
 		}
 	}
@@ -1336,6 +1336,10 @@ var _ = SIGDescribe("Export", func() {
 					Format: exportv1.KubeVirtGz,
 					Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
 				},
+				exportv1.VirtualMachineExportVolumeFormat{
+					Format: exportv1.KubeVirtQcow2,
+					Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
+				},
 			)
 		}
 
@@ -1351,6 +1355,10 @@ var _ = SIGDescribe("Export", func() {
 			exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.KubeVirtGz,
 				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
+			},
+			exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
 			})
 	}
 